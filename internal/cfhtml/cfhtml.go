@@ -0,0 +1,138 @@
+// Pure Go encoding and decoding of the CF_HTML clipboard format, a UTF-8 HTML
+// document preceded by a header with byte offsets.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/dataxchg/html-clipboard-format
+package cfhtml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	_START_MARKER = "<!--StartFragment-->"
+	_END_MARKER   = "<!--EndFragment-->"
+)
+
+var ErrFormat = errors.New("cfhtml: invalid format")
+
+// Encodes an HTML fragment, wrapping it in a full document. The sourceUrl is
+// optional.
+func Encode(fragment, sourceUrl string) []byte {
+	// All offsets have 10 digits, so the header length is known beforehand.
+	const headerFmt = "Version:0.9\r\n" +
+		"StartHTML:%010d\r\n" +
+		"EndHTML:%010d\r\n" +
+		"StartFragment:%010d\r\n" +
+		"EndFragment:%010d\r\n"
+
+	sourceLine := ""
+	if sourceUrl != "" {
+		sourceLine = "SourceURL:" + sourceUrl + "\r\n"
+	}
+
+	prefix := "<html>\r\n<body>\r\n" + _START_MARKER
+	suffix := _END_MARKER + "\r\n</body>\r\n</html>"
+
+	headerLen := len(fmt.Sprintf(headerFmt, 0, 0, 0, 0)) + len(sourceLine)
+	startHtml := headerLen
+	startFragment := startHtml + len(prefix)
+	endFragment := startFragment + len(fragment)
+	endHtml := endFragment + len(suffix)
+
+	var buf bytes.Buffer
+	buf.Grow(endHtml + 1)
+	fmt.Fprintf(&buf, headerFmt, startHtml, endHtml, startFragment, endFragment)
+	buf.WriteString(sourceLine)
+	buf.WriteString(prefix)
+	buf.WriteString(fragment)
+	buf.WriteString(suffix)
+	buf.WriteByte(0) // clipboard data is null-terminated
+	return buf.Bytes()
+}
+
+// Decodes CF_HTML data, returning the HTML fragment and the source URL, which
+// may be empty.
+//
+// If the fragment offsets are missing or invalid, the fragment markers are
+// searched; if absent too, the whole HTML document is returned.
+func Decode(data []byte) (fragment, sourceUrl string, e error) {
+	if idx := bytes.IndexByte(data, 0); idx != -1 {
+		data = data[:idx] // trailing null and garbage
+	}
+
+	startHtml, endHtml := -1, -1
+	startFragment, endFragment := -1, -1
+
+	rest := data
+	pos := 0
+	for len(rest) > 0 && rest[0] != '<' {
+		lineEnd := bytes.IndexAny(rest, "\r\n")
+		if lineEnd == -1 {
+			lineEnd = len(rest)
+		}
+		line := string(rest[:lineEnd])
+
+		next := lineEnd
+		for next < len(rest) && (rest[next] == '\r' || rest[next] == '\n') {
+			next++
+		}
+		rest = rest[next:]
+		pos += next
+
+		key, val, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		num := func() int {
+			n, err := strconv.Atoi(strings.TrimSpace(val))
+			if err != nil {
+				return -1
+			}
+			return n
+		}
+		switch key {
+		case "StartHTML":
+			startHtml = num()
+		case "EndHTML":
+			endHtml = num()
+		case "StartFragment":
+			startFragment = num()
+		case "EndFragment":
+			endFragment = num()
+		case "SourceURL":
+			sourceUrl = strings.TrimSpace(val)
+		}
+	}
+	headerEnd := pos
+
+	if startHtml == -1 && startFragment == -1 && headerEnd == 0 {
+		return "", "", ErrFormat // no header at all
+	}
+
+	valid := func(start, end int) bool {
+		return start >= headerEnd && end >= start && end <= len(data)
+	}
+
+	if valid(startFragment, endFragment) {
+		return string(data[startFragment:endFragment]), sourceUrl, nil
+	}
+
+	doc := data[headerEnd:]
+	if valid(startHtml, endHtml) {
+		doc = data[startHtml:endHtml]
+	} else if valid(startHtml, len(data)) {
+		doc = data[startHtml:]
+	}
+
+	if start := bytes.Index(doc, []byte(_START_MARKER)); start != -1 {
+		start += len(_START_MARKER)
+		if end := bytes.Index(doc[start:], []byte(_END_MARKER)); end != -1 {
+			return string(doc[start : start+end]), sourceUrl, nil
+		}
+	}
+	return string(doc), sourceUrl, nil
+}
@@ -0,0 +1,150 @@
+package cfhtml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	cases := []struct {
+		fragment, sourceUrl string
+	}{
+		{"<b>bold</b>", ""},
+		{"<p>hello</p>", "https://example.com/page"},
+		{"", ""},
+		{"<i>ação – 日本語 😀</i>", "file:///C:/Temp/ção.html"},
+		{"<pre>line 1\r\nline 2</pre>", ""},
+	}
+
+	for _, c := range cases {
+		data := Encode(c.fragment, c.sourceUrl)
+		if data[len(data)-1] != 0 {
+			t.Errorf("%q: missing terminating null", c.fragment)
+		}
+
+		fragment, sourceUrl, err := Decode(data)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", c.fragment, err)
+			continue
+		}
+		if fragment != c.fragment {
+			t.Errorf("fragment: got %q, want %q", fragment, c.fragment)
+		}
+		if sourceUrl != c.sourceUrl {
+			t.Errorf("source URL: got %q, want %q", sourceUrl, c.sourceUrl)
+		}
+	}
+}
+
+// Parses the numeric header value, failing the test if absent.
+func headerNum(t *testing.T, data []byte, key string) int {
+	t.Helper()
+	for _, line := range strings.Split(string(data), "\r\n") {
+		if val, ok := strings.CutPrefix(line, key+":"); ok {
+			n, err := strconv.Atoi(val)
+			if err != nil {
+				t.Fatalf("%s: %v", key, err)
+			}
+			return n
+		}
+	}
+	t.Fatalf("%s not found", key)
+	return 0
+}
+
+func TestEncodeOffsets(t *testing.T) {
+	fragment := "<span>Ünïcödé → 中文 🎉</span>"
+	data := Encode(fragment, "https://example.com/ü")
+	data = data[:len(data)-1] // terminating null
+
+	startHtml := headerNum(t, data, "StartHTML")
+	endHtml := headerNum(t, data, "EndHTML")
+	startFragment := headerNum(t, data, "StartFragment")
+	endFragment := headerNum(t, data, "EndFragment")
+
+	// Offsets are in bytes of the UTF-8 data, not in characters.
+	if got := string(data[startFragment:endFragment]); got != fragment {
+		t.Errorf("fragment slice: got %q, want %q", got, fragment)
+	}
+	if endFragment-startFragment != len(fragment) {
+		t.Errorf("fragment length: got %d, want %d bytes",
+			endFragment-startFragment, len(fragment))
+	}
+	if !bytes.HasPrefix(data[startHtml:], []byte("<html>")) {
+		t.Errorf("StartHTML %d doesn't point to <html>", startHtml)
+	}
+	if endHtml != len(data) {
+		t.Errorf("EndHTML: got %d, want %d", endHtml, len(data))
+	}
+	if !bytes.HasSuffix(data[:startFragment], []byte(_START_MARKER)) {
+		t.Errorf("StartFragment %d doesn't follow the start marker", startFragment)
+	}
+	if !bytes.HasPrefix(data[endFragment:], []byte(_END_MARKER)) {
+		t.Errorf("EndFragment %d doesn't precede the end marker", endFragment)
+	}
+}
+
+func TestDecodeForeign(t *testing.T) {
+	// Header written by other applications: no zero padding, LF line breaks,
+	// and offsets which don't include the fragment markers.
+	doc := "<html><body><!--StartFragment--><b>ç</b><!--EndFragment--></body></html>"
+	header := "Version:1.0\nStartHTML:%03d\nEndHTML:%03d\nStartFragment:%03d\nEndFragment:%03d\n"
+	headerLen := len(fmt.Sprintf(header, 0, 0, 0, 0))
+	start := headerLen + strings.Index(doc, "<b>")
+	end := headerLen + strings.Index(doc, "<!--End")
+	data := fmt.Sprintf(header, headerLen, headerLen+len(doc), start, end) + doc
+
+	fragment, _, err := Decode([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fragment != "<b>ç</b>" {
+		t.Errorf("got %q", fragment)
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	const doc = "<html><body><!--StartFragment--><u>x</u><!--EndFragment--></body></html>"
+
+	cases := []struct {
+		name     string
+		data     string
+		fragment string
+		err      error
+	}{
+		{"empty", "", "", ErrFormat},
+		{"no header", doc, "", ErrFormat},
+		{"non-numeric offsets",
+			"Version:0.9\r\nStartHTML:abc\r\nEndHTML:x\r\nStartFragment:?\r\nEndFragment:\r\n" + doc,
+			"<u>x</u>", nil},
+		{"offsets out of range",
+			"Version:0.9\r\nStartHTML:0000000999\r\nEndHTML:0000009999\r\nStartFragment:0000000998\r\nEndFragment:0000009998\r\n" + doc,
+			"<u>x</u>", nil},
+		{"fragment inside header",
+			"Version:0.9\r\nStartFragment:0000000002\r\nEndFragment:0000000005\r\n" + doc,
+			"<u>x</u>", nil},
+		{"reversed fragment",
+			"Version:0.9\r\nStartFragment:0000000070\r\nEndFragment:0000000060\r\n" + doc,
+			"<u>x</u>", nil},
+		{"no markers",
+			"Version:0.9\r\nStartHTML:x\r\n<p>whole</p>",
+			"<p>whole</p>", nil},
+		{"garbage after null",
+			"Version:0.9\r\n" + doc + "\x00trailing garbage",
+			"<u>x</u>", nil},
+	}
+
+	for _, c := range cases {
+		fragment, _, err := Decode([]byte(c.data))
+		if !errors.Is(err, c.err) {
+			t.Errorf("%s: error: got %v, want %v", c.name, err, c.err)
+		}
+		if fragment != c.fragment {
+			t.Errorf("%s: fragment: got %q, want %q", c.name, fragment, c.fragment)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+//go:build windows
+
+// Package comobj implements COM objects in Go, whose methods are called by
+// native code through syscall callbacks.
+package comobj
+
+import (
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com/comco"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Go state of a COM object, whose native memory block contains only the
+// pointer to the virtual table.
+type _Entry struct {
+	refCount uint32
+	iids     []win.GUID
+	impl     interface{}
+}
+
+var (
+	_globalObjs  = make(map[uintptr]*_Entry, 10) // arbitrary
+	_globalMutex = sync.Mutex{}
+)
+
+// Allocates a COM object whose virtual table is vt, which must begin with a
+// comvt.IUnknown filled with QueryInterface, AddRef and Release, and must not
+// be freed. The object answers QueryInterface() for IUnknown and the given
+// IIDs, and starts with a reference count of 1.
+//
+// The memory block is freed when the reference count reaches zero.
+func New(vt unsafe.Pointer, impl interface{}, iids ...co.IID) **comvt.IUnknown {
+	block := win.CoTaskMemAlloc(int(unsafe.Sizeof(uintptr(0))))
+	*(*uintptr)(unsafe.Pointer(block)) = uintptr(vt)
+
+	entry := &_Entry{
+		refCount: 1,
+		iids:     make([]win.GUID, 0, len(iids)+1),
+		impl:     impl,
+	}
+	entry.iids = append(entry.iids, *win.GuidFromIid(comco.IID_IUnknown))
+	for _, iid := range iids {
+		entry.iids = append(entry.iids, *win.GuidFromIid(iid))
+	}
+
+	_globalMutex.Lock()
+	_globalObjs[uintptr(block)] = entry
+	_globalMutex.Unlock()
+
+	return (**comvt.IUnknown)(unsafe.Pointer(block))
+}
+
+// Returns the Go implementation of the COM object, as passed to New(), or nil
+// if the object was already freed.
+func Impl(pObj uintptr) interface{} {
+	_globalMutex.Lock()
+	defer _globalMutex.Unlock()
+
+	if entry, ok := _globalObjs[pObj]; ok {
+		return entry.impl
+	}
+	return nil
+}
+
+// Native IUnknown methods, to be placed in the virtual tables.
+var (
+	QueryInterface = syscall.NewCallback(
+		func(pObj uintptr, riid *win.GUID, ppv *uintptr) uintptr {
+			if ppv == nil {
+				return uintptr(errco.E_POINTER)
+			}
+			*ppv = 0
+
+			_globalMutex.Lock()
+			defer _globalMutex.Unlock()
+
+			if entry, ok := _globalObjs[pObj]; ok {
+				for i := range entry.iids {
+					if entry.iids[i] == *riid {
+						entry.refCount++
+						*ppv = pObj
+						return uintptr(errco.S_OK)
+					}
+				}
+			}
+			return uintptr(errco.E_NOINTERFACE)
+		})
+
+	AddRef = syscall.NewCallback(
+		func(pObj uintptr) uintptr {
+			_globalMutex.Lock()
+			defer _globalMutex.Unlock()
+
+			if entry, ok := _globalObjs[pObj]; ok {
+				entry.refCount++
+				return uintptr(entry.refCount)
+			}
+			return 0
+		})
+
+	Release = syscall.NewCallback(
+		func(pObj uintptr) uintptr {
+			_globalMutex.Lock()
+			defer _globalMutex.Unlock()
+
+			entry, ok := _globalObjs[pObj]
+			if !ok {
+				return 0
+			}
+			entry.refCount--
+			if entry.refCount == 0 {
+				delete(_globalObjs, pObj)
+				win.HTASKMEM(pObj).CoTaskMemFree()
+			}
+			return uintptr(entry.refCount)
+		})
+)
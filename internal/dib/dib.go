@@ -0,0 +1,292 @@
+// Pure Go encoding and decoding of device-independent bitmaps, the packed
+// BITMAPINFO + pixels layout used by CF_DIB, CF_DIBV5 and .bmp files, along
+// with the pixel conversions used by GDI bitmaps and icons.
+//
+// This package has no Windows dependencies, so it can be tested on any OS.
+package dib
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math/bits"
+)
+
+const (
+	_BI_RGB            = 0
+	_BI_BITFIELDS      = 3
+	_BI_ALPHABITFIELDS = 6
+
+	_CORE_HEADER_SIZE = 12
+	_INFO_HEADER_SIZE = 40
+	_V5_HEADER_SIZE   = 124
+)
+
+var ErrFormat = errors.New("dib: invalid format")
+
+// Parsed header, along with the color masks and the palette.
+type _Header struct {
+	width, height int
+	topDown       bool
+	bitCount      int
+	compression   uint32
+	masks         [4]uint32 // R, G, B, A
+	palette       color.Palette
+	bitsOffset    int // where the pixels start, relative to the header
+}
+
+// Parses the header of a packed DIB.
+func parseHeader(data []byte) (_Header, error) {
+	var h _Header
+	if len(data) < 4 {
+		return h, ErrFormat
+	}
+	hdrSize := int(binary.LittleEndian.Uint32(data))
+	if hdrSize < _CORE_HEADER_SIZE || len(data) < hdrSize {
+		return h, ErrFormat
+	}
+
+	numColorsUsed := 0
+	palEntrySize := 4
+	if hdrSize == _CORE_HEADER_SIZE { // BITMAPCOREHEADER
+		h.width = int(binary.LittleEndian.Uint16(data[4:]))
+		h.height = int(binary.LittleEndian.Uint16(data[6:]))
+		h.bitCount = int(binary.LittleEndian.Uint16(data[10:]))
+		h.compression = _BI_RGB
+		palEntrySize = 3
+	} else {
+		if hdrSize < _INFO_HEADER_SIZE {
+			return h, ErrFormat
+		}
+		h.width = int(int32(binary.LittleEndian.Uint32(data[4:])))
+		h.height = int(int32(binary.LittleEndian.Uint32(data[8:])))
+		h.bitCount = int(binary.LittleEndian.Uint16(data[14:]))
+		h.compression = binary.LittleEndian.Uint32(data[16:])
+		numColorsUsed = int(binary.LittleEndian.Uint32(data[32:]))
+	}
+
+	if h.height < 0 {
+		h.height = -h.height
+		h.topDown = true
+	}
+	if h.width <= 0 || h.height <= 0 || h.width > 1<<15 || h.height > 1<<15 {
+		return h, fmt.Errorf("dib: invalid dimensions %dx%d", h.width, h.height)
+	}
+
+	off := hdrSize
+	switch h.compression {
+	case _BI_RGB:
+		switch h.bitCount {
+		case 16:
+			h.masks = [4]uint32{0x7c00, 0x03e0, 0x001f, 0}
+		case 24, 32:
+			h.masks = [4]uint32{0x00ff_0000, 0x0000_ff00, 0x0000_00ff, 0}
+		}
+	case _BI_BITFIELDS, _BI_ALPHABITFIELDS:
+		if h.bitCount != 16 && h.bitCount != 32 {
+			return h, ErrFormat
+		}
+		numMasks := 3
+		if h.compression == _BI_ALPHABITFIELDS {
+			numMasks = 4
+		}
+		if hdrSize == _INFO_HEADER_SIZE { // masks follow the header
+			if len(data) < off+numMasks*4 {
+				return h, ErrFormat
+			}
+			for i := 0; i < numMasks; i++ {
+				h.masks[i] = binary.LittleEndian.Uint32(data[off+i*4:])
+			}
+			off += numMasks * 4
+		} else { // masks are part of V2/V3/V4/V5 headers
+			for i := 0; i < 4 && _INFO_HEADER_SIZE+i*4+4 <= hdrSize; i++ {
+				h.masks[i] = binary.LittleEndian.Uint32(data[_INFO_HEADER_SIZE+i*4:])
+			}
+		}
+	default:
+		return h, fmt.Errorf("dib: unsupported compression %d", h.compression)
+	}
+
+	switch h.bitCount {
+	case 1, 4, 8:
+		numColors := numColorsUsed
+		if numColors == 0 || numColors > 1<<h.bitCount {
+			numColors = 1 << h.bitCount
+		}
+		if len(data) < off+numColors*palEntrySize {
+			return h, ErrFormat
+		}
+		h.palette = make(color.Palette, numColors)
+		for i := range h.palette {
+			p := data[off+i*palEntrySize:]
+			h.palette[i] = color.RGBA{R: p[2], G: p[1], B: p[0], A: 0xff}
+		}
+		off += numColors * palEntrySize
+	case 16, 24, 32:
+		off += numColorsUsed * palEntrySize // optimization palette, ignored
+	default:
+		return h, fmt.Errorf("dib: unsupported bit count %d", h.bitCount)
+	}
+
+	h.bitsOffset = off
+	return h, nil
+}
+
+// Returns the dimensions and color model of a packed DIB.
+func DecodeConfig(data []byte) (image.Config, error) {
+	h, err := parseHeader(data)
+	if err != nil {
+		return image.Config{}, err
+	}
+	if h.palette != nil {
+		return image.Config{ColorModel: h.palette, Width: h.width, Height: h.height}, nil
+	}
+	return image.Config{ColorModel: color.NRGBAModel, Width: h.width, Height: h.height}, nil
+}
+
+// Decodes a packed DIB. If bitsOffset is positive, it is used as the offset of
+// the pixels, otherwise the offset is computed right after the header and the
+// palette.
+func Decode(data []byte, bitsOffset int) (image.Image, error) {
+	h, err := parseHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if bitsOffset <= 0 {
+		bitsOffset = h.bitsOffset
+	}
+	return decodePixels(h, data, bitsOffset)
+}
+
+// Decodes the pixels, according to the already parsed header.
+func decodePixels(h _Header, data []byte, bitsOffset int) (image.Image, error) {
+	stride := ((h.width*h.bitCount + 31) / 32) * 4 // rows are DWORD-aligned
+	if bitsOffset > len(data) || len(data)-bitsOffset < stride*h.height {
+		return nil, ErrFormat
+	}
+	pixels := data[bitsOffset:]
+
+	rowAt := func(y int) []byte {
+		if !h.topDown {
+			y = h.height - 1 - y
+		}
+		return pixels[y*stride : (y+1)*stride]
+	}
+
+	if h.palette != nil {
+		img := image.NewPaletted(image.Rect(0, 0, h.width, h.height), h.palette)
+		pixelsPerByte := 8 / h.bitCount
+		mask := byte(1<<h.bitCount - 1)
+		for y := 0; y < h.height; y++ {
+			row := rowAt(y)
+			for x := 0; x < h.width; x++ {
+				b := row[x/pixelsPerByte]
+				shift := uint(8 - h.bitCount*(x%pixelsPerByte+1))
+				idx := (b >> shift) & mask
+				if int(idx) >= len(h.palette) {
+					idx = 0
+				}
+				img.Pix[y*img.Stride+x] = idx
+			}
+		}
+		return img, nil
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, h.width, h.height))
+	bytesPerPixel := h.bitCount / 8
+	hasAlpha := h.masks[3] != 0
+	for y := 0; y < h.height; y++ {
+		row := rowAt(y)
+		dst := img.Pix[y*img.Stride:]
+		for x := 0; x < h.width; x++ {
+			var px uint32
+			switch bytesPerPixel {
+			case 2:
+				px = uint32(binary.LittleEndian.Uint16(row[x*2:]))
+			case 3:
+				px = uint32(row[x*3]) | uint32(row[x*3+1])<<8 | uint32(row[x*3+2])<<16
+			case 4:
+				px = binary.LittleEndian.Uint32(row[x*4:])
+			}
+			dst[x*4+0] = extract(px, h.masks[0])
+			dst[x*4+1] = extract(px, h.masks[1])
+			dst[x*4+2] = extract(px, h.masks[2])
+			if hasAlpha {
+				dst[x*4+3] = extract(px, h.masks[3])
+			} else {
+				dst[x*4+3] = 0xff
+			}
+		}
+	}
+	return img, nil
+}
+
+// Extracts the masked channel, scaled to 8 bits.
+func extract(px, mask uint32) byte {
+	if mask == 0 {
+		return 0
+	}
+	shift := bits.TrailingZeros32(mask)
+	width := bits.OnesCount32(mask)
+	val := (px & mask) >> shift
+	switch {
+	case width == 8:
+		return byte(val)
+	case width > 8:
+		return byte(val >> (width - 8))
+	default:
+		max := uint32(1)<<width - 1
+		return byte((val*255 + max/2) / max)
+	}
+}
+
+// Encodes the image as a packed DIB with a BITMAPV5HEADER, 32 bits per pixel
+// with straight alpha, bottom-up.
+func Encode(img image.Image) []byte {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	sizeImage := width * height * 4
+
+	buf := make([]byte, _V5_HEADER_SIZE+sizeImage)
+	le := binary.LittleEndian
+	le.PutUint32(buf[0:], _V5_HEADER_SIZE)
+	le.PutUint32(buf[4:], uint32(int32(width)))
+	le.PutUint32(buf[8:], uint32(int32(height))) // positive: bottom-up
+	le.PutUint16(buf[12:], 1)                    // planes
+	le.PutUint16(buf[14:], 32)                   // bit count
+	le.PutUint32(buf[16:], _BI_BITFIELDS)
+	le.PutUint32(buf[20:], uint32(sizeImage))
+	le.PutUint32(buf[24:], 2835) // 72 DPI
+	le.PutUint32(buf[28:], 2835)
+	le.PutUint32(buf[40:], 0x00ff_0000) // red mask
+	le.PutUint32(buf[44:], 0x0000_ff00) // green mask
+	le.PutUint32(buf[48:], 0x0000_00ff) // blue mask
+	le.PutUint32(buf[52:], 0xff00_0000) // alpha mask
+	le.PutUint32(buf[56:], 0x7352_4742) // LCS_sRGB
+	le.PutUint32(buf[108:], 4)          // LCS_GM_IMAGES
+
+	pixels := buf[_V5_HEADER_SIZE:]
+	for y := 0; y < height; y++ {
+		row := pixels[(height-1-y)*width*4:]
+		for x := 0; x < width; x++ {
+			c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+			row[x*4+0] = c.B
+			row[x*4+1] = c.G
+			row[x*4+2] = c.R
+			row[x*4+3] = c.A
+		}
+	}
+	return buf
+}
+
+// Returns the offset of the pixels in a packed DIB, right after the header,
+// the color masks and the palette; or zero if the header is invalid.
+func HeaderSize(data []byte) int {
+	h, err := parseHeader(data)
+	if err != nil {
+		return 0
+	}
+	return h.bitsOffset
+}
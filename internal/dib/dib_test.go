@@ -0,0 +1,98 @@
+package dib
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// Builds a 24-bit BITMAPINFOHEADER DIB, 1 pixel wide, with one pixel per row;
+// the height is negative if top-down.
+func dib24(height int32, rows ...[3]byte) []byte {
+	data := make([]byte, _INFO_HEADER_SIZE)
+	le := binary.LittleEndian
+	le.PutUint32(data[0:], _INFO_HEADER_SIZE)
+	le.PutUint32(data[4:], 1)
+	le.PutUint32(data[8:], uint32(height))
+	le.PutUint16(data[12:], 1)
+	le.PutUint16(data[14:], 24)
+	for _, bgr := range rows {
+		data = append(data, bgr[0], bgr[1], bgr[2], 0) // row padded to 4 bytes
+	}
+	return data
+}
+
+func TestRowOrder(t *testing.T) {
+	red, blue := [3]byte{0, 0, 255}, [3]byte{255, 0, 0}
+
+	bottomUp, err := Decode(dib24(2, red, blue), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bottomUp.At(0, 0); got != (color.NRGBA{0, 0, 255, 255}) {
+		t.Errorf("bottom-up: first stored row must be the bottom one, top is %v", got)
+	}
+
+	topDown, err := Decode(dib24(-2, red, blue), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := topDown.At(0, 0); got != (color.NRGBA{255, 0, 0, 255}) {
+		t.Errorf("top-down: first stored row must be the top one, top is %v", got)
+	}
+}
+
+func TestEncodeBottomUp(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 2))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 1, A: 255}) // top
+	img.SetNRGBA(0, 1, color.NRGBA{R: 2, A: 10})  // bottom
+
+	data := Encode(img)
+	if h := int32(binary.LittleEndian.Uint32(data[8:])); h != 2 {
+		t.Errorf("height: got %d, want positive 2", h)
+	}
+	pixels := data[HeaderSize(data):]
+	if pixels[2] != 2 || pixels[3] != 10 || pixels[6] != 1 {
+		t.Errorf("rows are not bottom-up: %v", pixels)
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	for i := range img.Pix {
+		img.Pix[i] = byte(i * 13)
+	}
+
+	decoded, err := Decode(Encode(img), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			if got, want := decoded.At(x, y), img.At(x, y); got != want {
+				t.Errorf("(%d,%d): got %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	truncated := dib24(2, [3]byte{})
+	zeroWidth := dib24(1, [3]byte{})
+	binary.LittleEndian.PutUint32(zeroWidth[4:], 0)
+
+	for name, data := range map[string][]byte{
+		"empty":            {},
+		"short header":     {40, 0, 0, 0, 1},
+		"truncated pixels": truncated,
+		"zero width":       zeroWidth,
+	} {
+		if _, err := Decode(data, 0); err == nil {
+			t.Errorf("%s: expected error", name)
+		} else if name != "zero width" && !errors.Is(err, ErrFormat) {
+			t.Errorf("%s: got %v, want ErrFormat", name, err)
+		}
+	}
+}
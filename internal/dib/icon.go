@@ -0,0 +1,102 @@
+package dib
+
+import (
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+// Decodes the DIB of an .ico/.cur entry or an RT_ICON resource, whose header
+// has twice the image height, because the pixels are followed by a 1-bit AND
+// mask.
+//
+// 32-bit images use the 4th byte as straight alpha, unless it's zero for all
+// pixels; in this case, and for lower bit counts, transparency comes from the
+// AND mask.
+func DecodeIcon(data []byte) (*image.NRGBA, error) {
+	h, err := parseHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	h.height /= 2
+	if h.height == 0 {
+		return nil, ErrFormat
+	}
+
+	decoded, err := decodePixels(h, data, h.bitsOffset)
+	if err != nil {
+		return nil, err
+	}
+	img, ok := decoded.(*image.NRGBA)
+	if !ok { // paletted
+		img = image.NewNRGBA(decoded.Bounds())
+		draw.Draw(img, img.Bounds(), decoded, image.Point{}, draw.Src)
+	}
+
+	xorStride := ((h.width*h.bitCount + 31) / 32) * 4
+	xorPix := data[h.bitsOffset : h.bitsOffset+xorStride*h.height]
+
+	if h.bitCount == 32 && h.compression == _BI_RGB {
+		hasAlpha := false
+		for i := 3; i < len(xorPix); i += 4 {
+			if xorPix[i] != 0 {
+				hasAlpha = true
+				break
+			}
+		}
+		if hasAlpha {
+			for y := 0; y < h.height; y++ {
+				srcRow := xorPix[(h.height-1-y)*xorStride:] // bottom-up
+				for x := 0; x < h.width; x++ {
+					img.Pix[y*img.Stride+x*4+3] = srcRow[x*4+3]
+				}
+			}
+			return img, nil
+		}
+	}
+
+	andStride := ((h.width + 31) / 32) * 4
+	andOff := h.bitsOffset + xorStride*h.height
+	if len(data)-andOff < andStride*h.height {
+		return img, nil // AND mask is missing, image is opaque
+	}
+	andPix := data[andOff:]
+	for y := 0; y < h.height; y++ {
+		maskRow := andPix[(h.height-1-y)*andStride:] // bottom-up
+		for x := 0; x < h.width; x++ {
+			if maskRow[x/8]&(0x80>>(x%8)) != 0 {
+				copy(img.Pix[y*img.Stride+x*4:], []byte{0, 0, 0, 0})
+			}
+		}
+	}
+	return img, nil
+}
+
+// Encodes the image as the DIB of an .ico/.cur entry: a BITMAPINFOHEADER with
+// twice the height, 32-bit bottom-up pixels with straight alpha, followed by
+// the 1-bit AND mask.
+func EncodeIcon(img image.Image) []byte {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	bgra := ToBgra(img, false)         // top-down
+	andMask := AndMask(img, 4)         // top-down
+	andStride := len(andMask) / height // DWORD-aligned
+	pixOff := _INFO_HEADER_SIZE
+	andOff := pixOff + len(bgra)
+
+	buf := make([]byte, andOff+len(andMask))
+	le := binary.LittleEndian
+	le.PutUint32(buf[0:], _INFO_HEADER_SIZE)
+	le.PutUint32(buf[4:], uint32(int32(width)))
+	le.PutUint32(buf[8:], uint32(int32(height*2)))
+	le.PutUint16(buf[12:], 1)  // planes
+	le.PutUint16(buf[14:], 32) // bit count
+	le.PutUint32(buf[20:], uint32(len(bgra)+len(andMask)))
+
+	for y := 0; y < height; y++ { // flip to bottom-up
+		copy(buf[pixOff+(height-1-y)*width*4:], bgra[y*width*4:(y+1)*width*4])
+		copy(buf[andOff+(height-1-y)*andStride:], andMask[y*andStride:(y+1)*andStride])
+	}
+	return buf
+}
@@ -0,0 +1,150 @@
+package dib
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// Builds an icon DIB 1 pixel wide, with a BITMAPINFOHEADER of twice the height,
+// followed by the bottom-up pixel rows and the bottom-up AND mask rows, if any.
+func iconDib(bitCount int, pixelRows [][]byte, maskRows []byte) []byte {
+	data := make([]byte, _INFO_HEADER_SIZE)
+	le := binary.LittleEndian
+	le.PutUint32(data[0:], _INFO_HEADER_SIZE)
+	le.PutUint32(data[4:], 1)
+	le.PutUint32(data[8:], uint32(len(pixelRows)*2))
+	le.PutUint16(data[12:], 1)
+	le.PutUint16(data[14:], uint16(bitCount))
+	for _, row := range pixelRows {
+		data = append(data, row...)
+		data = append(data, make([]byte, 4-len(row))...) // padded to 4 bytes
+	}
+	for _, mask := range maskRows {
+		data = append(data, mask, 0, 0, 0) // padded to 4 bytes
+	}
+	return data
+}
+
+func TestEncodeIcon(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.SetNRGBA(0, 0, color.NRGBA{})                                // transparent
+	img.SetNRGBA(1, 0, color.NRGBA{R: 200, G: 100, B: 50, A: 128})   // half-transparent
+	img.SetNRGBA(0, 1, color.NRGBA{R: 1, G: 2, B: 3, A: 255})        // opaque
+	img.SetNRGBA(1, 1, color.NRGBA{R: 255, G: 255, B: 255, A: 0x7f}) // mostly transparent
+
+	data := EncodeIcon(img)
+	le := binary.LittleEndian
+	if h := int32(le.Uint32(data[8:])); h != 4 {
+		t.Errorf("height: got %d, want twice the image height", h)
+	}
+	if bitCount := le.Uint16(data[14:]); bitCount != 32 {
+		t.Errorf("bit count: got %d, want 32", bitCount)
+	}
+
+	andMask := data[_INFO_HEADER_SIZE+2*2*4:]
+	if want := []byte{0x40, 0, 0, 0, 0x80, 0, 0, 0}; string(andMask) != string(want) {
+		t.Errorf("AND mask, bottom-up: got %v, want %v", andMask, want)
+	}
+
+	decoded, err := DecodeIcon(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Bounds() != img.Bounds() {
+		t.Fatalf("bounds: got %v, want %v", decoded.Bounds(), img.Bounds())
+	}
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			if got, want := decoded.NRGBAAt(x, y), img.NRGBAAt(x, y); got != want {
+				t.Errorf("pixel %d,%d: got %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestDecodeIconMask32(t *testing.T) {
+	// 32-bit pixels with zero alpha everywhere: the AND mask is used.
+	data := iconDib(32,
+		[][]byte{{10, 20, 30, 0}, {40, 50, 60, 0}}, // bottom, top
+		[]byte{0x80, 0}) // bottom is transparent
+
+	img, err := DecodeIcon(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := img.NRGBAAt(0, 0), (color.NRGBA{R: 60, G: 50, B: 40, A: 255}); got != want {
+		t.Errorf("top: got %v, want %v", got, want)
+	}
+	if got := img.NRGBAAt(0, 1); got != (color.NRGBA{}) {
+		t.Errorf("bottom: got %v, want transparent", got)
+	}
+}
+
+func TestDecodeIconAlpha32(t *testing.T) {
+	// 32-bit pixels with alpha: the AND mask is ignored.
+	data := iconDib(32,
+		[][]byte{{10, 20, 30, 40}, {40, 50, 60, 255}},
+		[]byte{0x80, 0x80})
+
+	img, err := DecodeIcon(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := img.NRGBAAt(0, 1), (color.NRGBA{R: 30, G: 20, B: 10, A: 40}); got != want {
+		t.Errorf("bottom: got %v, want %v", got, want)
+	}
+	if got, want := img.NRGBAAt(0, 0), (color.NRGBA{R: 60, G: 50, B: 40, A: 255}); got != want {
+		t.Errorf("top: got %v, want %v", got, want)
+	}
+}
+
+func TestDecodeIconMask24(t *testing.T) {
+	data := iconDib(24,
+		[][]byte{{10, 20, 30}, {40, 50, 60}},
+		[]byte{0, 0x80}) // top is transparent
+
+	img, err := DecodeIcon(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.NRGBAAt(0, 0); got != (color.NRGBA{}) {
+		t.Errorf("top: got %v, want transparent", got)
+	}
+	if got, want := img.NRGBAAt(0, 1), (color.NRGBA{R: 30, G: 20, B: 10, A: 255}); got != want {
+		t.Errorf("bottom: got %v, want %v", got, want)
+	}
+}
+
+func TestDecodeIconNoMask(t *testing.T) {
+	data := iconDib(24, [][]byte{{10, 20, 30}}, nil)
+
+	img, err := DecodeIcon(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := img.NRGBAAt(0, 0), (color.NRGBA{R: 30, G: 20, B: 10, A: 255}); got != want {
+		t.Errorf("got %v, want opaque %v", got, want)
+	}
+}
+
+func TestDecodeIconInvalid(t *testing.T) {
+	halfRow := iconDib(24, [][]byte{{10, 20, 30}}, nil)
+	binary.LittleEndian.PutUint32(halfRow[8:], 1) // halved to zero
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"zero height", halfRow},
+		{"truncated pixels", iconDib(24, [][]byte{{10, 20, 30}}, nil)[:_INFO_HEADER_SIZE+2]},
+	}
+	for _, tt := range tests {
+		if _, err := DecodeIcon(tt.data); !errors.Is(err, ErrFormat) {
+			t.Errorf("%s: got %v, want ErrFormat", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package dib
+
+import (
+	"image"
+	"image/color"
+)
+
+// Converts the image into top-down 32-bit BGRA rows, with no padding, as used
+// by 32-bit DIB sections. If premultiply is true, the color channels are
+// premultiplied by alpha, as expected by AlphaBlend(); icons expect straight
+// alpha.
+func ToBgra(img image.Image, premultiply bool) []byte {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	pix := make([]byte, width*height*4)
+
+	for y := 0; y < height; y++ {
+		row := pix[y*width*4:]
+		for x := 0; x < width; x++ {
+			c := img.At(bounds.Min.X+x, bounds.Min.Y+y)
+			if premultiply {
+				r, g, b, a := c.RGBA() // premultiplied, 16 bits
+				row[x*4+0] = byte(b >> 8)
+				row[x*4+1] = byte(g >> 8)
+				row[x*4+2] = byte(r >> 8)
+				row[x*4+3] = byte(a >> 8)
+			} else {
+				n := color.NRGBAModel.Convert(c).(color.NRGBA)
+				row[x*4+0] = n.B
+				row[x*4+1] = n.G
+				row[x*4+2] = n.R
+				row[x*4+3] = n.A
+			}
+		}
+	}
+	return pix
+}
+
+// Tells whether any pixel of the 32-bit BGRA rows has a nonzero alpha. GDI
+// bitmaps which were never drawn with alpha have all alpha bytes zeroed, and
+// must be treated as opaque.
+func HasAlpha(pix []byte) bool {
+	for i := 3; i < len(pix); i += 4 {
+		if pix[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Creates an image from top-down 32-bit BGRA rows, with no padding.
+//
+// If no pixel has alpha, the image is opaque. Otherwise, if premultiplied is
+// true, an *image.RGBA is returned, else an *image.NRGBA.
+func FromBgra(pix []byte, width, height int, premultiplied bool) image.Image {
+	rect := image.Rect(0, 0, width, height)
+	hasAlpha := HasAlpha(pix[:width*height*4])
+
+	var dst []byte
+	var img image.Image
+	if hasAlpha && !premultiplied {
+		nrgba := image.NewNRGBA(rect)
+		dst, img = nrgba.Pix, nrgba
+	} else {
+		rgba := image.NewRGBA(rect)
+		dst, img = rgba.Pix, rgba
+	}
+
+	for i := 0; i < width*height*4; i += 4 {
+		b, g, r, a := pix[i], pix[i+1], pix[i+2], pix[i+3]
+		if !hasAlpha {
+			a = 0xff
+		} else if premultiplied { // invalid premultiplied colors are clamped
+			b, g, r = min8(b, a), min8(g, a), min8(r, a)
+		}
+		dst[i+0], dst[i+1], dst[i+2], dst[i+3] = r, g, b, a
+	}
+	return img
+}
+
+// Applies an icon AND mask to 32-bit BGRA rows which have no alpha: where the
+// mask is set, the pixel becomes transparent, otherwise opaque.
+//
+// The mask must be given as 32-bit BGRA rows too, of the same dimensions, as
+// retrieved with GetDIBits() from the monochrome mask bitmap.
+func ApplyAndMask(pix, mask []byte) {
+	for i := 0; i+3 < len(pix) && i+3 < len(mask); i += 4 {
+		if mask[i] != 0 || mask[i+1] != 0 || mask[i+2] != 0 {
+			pix[i], pix[i+1], pix[i+2], pix[i+3] = 0, 0, 0, 0
+		} else {
+			pix[i+3] = 0xff
+		}
+	}
+}
+
+// Creates a 1-bit AND mask from the alpha of the image, top-down, with each row
+// aligned to rowAlign bytes: 2 for CreateBitmap(), 4 for DIBs and .ico files.
+// Pixels with alpha below 128 are set in the mask, meaning transparent.
+func AndMask(img image.Image, rowAlign int) []byte {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	stride := ((width + rowAlign*8 - 1) / (rowAlign * 8)) * rowAlign
+	mask := make([]byte, stride*height)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			_, _, _, a := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			if a < 0x8000 {
+				mask[y*stride+x/8] |= 0x80 >> (x % 8)
+			}
+		}
+	}
+	return mask
+}
+
+func min8(a, b byte) byte {
+	if a < b {
+		return a
+	}
+	return b
+}
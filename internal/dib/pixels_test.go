@@ -0,0 +1,125 @@
+package dib
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// A 2x1 image: a half-transparent orange and an opaque blue.
+func testImage() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 200, G: 100, B: 50, A: 128})
+	img.SetNRGBA(1, 0, color.NRGBA{R: 0, G: 0, B: 255, A: 255})
+	return img
+}
+
+func TestToBgra(t *testing.T) {
+	straight := ToBgra(testImage(), false)
+	if want := []byte{50, 100, 200, 128, 255, 0, 0, 255}; !bytes.Equal(straight, want) {
+		t.Errorf("straight: got %v, want %v", straight, want)
+	}
+
+	premultiplied := ToBgra(testImage(), true)
+	if want := []byte{25, 50, 100, 128, 255, 0, 0, 255}; !bytes.Equal(premultiplied, want) {
+		t.Errorf("premultiplied: got %v, want %v", premultiplied, want)
+	}
+}
+
+func TestToBgraRows(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(10, 20, 11, 22)) // non-zero origin
+	img.SetNRGBA(10, 20, color.NRGBA{R: 1, A: 255})
+	img.SetNRGBA(10, 21, color.NRGBA{R: 2, A: 255})
+
+	pix := ToBgra(img, false)
+	if pix[2] != 1 || pix[6] != 2 {
+		t.Errorf("rows are not top-down: %v", pix)
+	}
+}
+
+func TestFromBgra(t *testing.T) {
+	// Straight alpha, which is kept as it is.
+	img := FromBgra([]byte{50, 100, 200, 128, 255, 0, 0, 255}, 2, 1, false)
+	nrgba, ok := img.(*image.NRGBA)
+	if !ok {
+		t.Fatalf("straight: got %T, want *image.NRGBA", img)
+	}
+	if got := nrgba.NRGBAAt(0, 0); got != (color.NRGBA{200, 100, 50, 128}) {
+		t.Errorf("straight: got %v", got)
+	}
+
+	// Premultiplied alpha, which is unpremultiplied when converted.
+	img = FromBgra([]byte{25, 50, 100, 128, 255, 0, 0, 255}, 2, 1, true)
+	if _, ok := img.(*image.RGBA); !ok {
+		t.Fatalf("premultiplied: got %T, want *image.RGBA", img)
+	}
+	got := color.NRGBAModel.Convert(img.At(0, 0)).(color.NRGBA)
+	want := testImage().NRGBAAt(0, 0)
+	if diff(got.R, want.R) > 2 || diff(got.G, want.G) > 2 ||
+		diff(got.B, want.B) > 2 || got.A != want.A {
+		t.Errorf("unpremultiplied: got %v, want about %v", got, want)
+	}
+	if got := color.NRGBAModel.Convert(img.At(1, 0)); got != (color.NRGBA{0, 0, 255, 255}) {
+		t.Errorf("opaque pixel: got %v", got)
+	}
+}
+
+func TestFromBgraClamp(t *testing.T) {
+	// Colors above alpha are invalid when premultiplied.
+	img := FromBgra([]byte{200, 10, 90, 64}, 1, 1, true).(*image.RGBA)
+	if got := img.RGBAAt(0, 0); got != (color.RGBA{R: 64, G: 10, B: 64, A: 64}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestFromBgraNoAlpha(t *testing.T) {
+	// GDI bitmaps without alpha have all alpha bytes zeroed.
+	pix := []byte{1, 2, 3, 0, 4, 5, 6, 0}
+	if HasAlpha(pix) {
+		t.Errorf("HasAlpha: got true")
+	}
+	for _, premultiplied := range []bool{false, true} {
+		img := FromBgra(pix, 2, 1, premultiplied).(*image.RGBA)
+		if got := img.RGBAAt(1, 0); got != (color.RGBA{6, 5, 4, 255}) {
+			t.Errorf("premultiplied %v: got %v", premultiplied, got)
+		}
+	}
+}
+
+func TestApplyAndMask(t *testing.T) {
+	pix := []byte{1, 2, 3, 0, 4, 5, 6, 0}
+	mask := []byte{0, 0, 0, 0, 255, 255, 255, 0} // second pixel transparent
+	ApplyAndMask(pix, mask)
+	if want := []byte{1, 2, 3, 255, 0, 0, 0, 0}; !bytes.Equal(pix, want) {
+		t.Errorf("got %v, want %v", pix, want)
+	}
+}
+
+func TestAndMask(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 9, 2))
+	for x := 0; x < 9; x++ {
+		img.SetNRGBA(x, 0, color.NRGBA{A: 255})
+	}
+	img.SetNRGBA(8, 0, color.NRGBA{A: 127}) // transparent
+	img.SetNRGBA(0, 1, color.NRGBA{A: 128}) // opaque
+
+	for _, c := range []struct {
+		rowAlign int
+		want     []byte
+	}{
+		{2, []byte{0x00, 0x80, 0x7f, 0x80}},
+		{4, []byte{0x00, 0x80, 0, 0, 0x7f, 0x80, 0, 0}},
+	} {
+		if got := AndMask(img, c.rowAlign); !bytes.Equal(got, c.want) {
+			t.Errorf("align %d: got %x, want %x", c.rowAlign, got, c.want)
+		}
+	}
+}
+
+func diff(a, b uint8) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
@@ -9,16 +9,59 @@ import (
 var (
 	advapi32 = syscall.NewLazyDLL("advapi32.dll")
 
-	RegCloseKey       = advapi32.NewProc("RegCloseKey")
-	RegDeleteKey      = advapi32.NewProc("RegDeleteKeyW")
-	RegDeleteKeyEx    = advapi32.NewProc("RegDeleteKeyExW")
-	RegDeleteKeyValue = advapi32.NewProc("RegDeleteKeyValueW")
-	RegDeleteTree     = advapi32.NewProc("RegDeleteTreeW")
-	RegEnumKeyEx      = advapi32.NewProc("RegEnumKeyExW")
-	RegEnumValue      = advapi32.NewProc("RegEnumValueW")
-	RegFlushKey       = advapi32.NewProc("RegFlushKey")
-	RegGetValue       = advapi32.NewProc("RegGetValueW")
-	RegOpenKeyEx      = advapi32.NewProc("RegOpenKeyExW")
-	RegQueryInfoKey   = advapi32.NewProc("RegQueryInfoKeyW")
-	RegSetKeyValue    = advapi32.NewProc("RegSetKeyValueW")
+	AdjustTokenPrivileges                               = advapi32.NewProc("AdjustTokenPrivileges")
+	ChangeServiceConfig                                 = advapi32.NewProc("ChangeServiceConfigW")
+	ChangeServiceConfig2                                = advapi32.NewProc("ChangeServiceConfig2W")
+	CloseServiceHandle                                  = advapi32.NewProc("CloseServiceHandle")
+	ControlService                                      = advapi32.NewProc("ControlService")
+	ConvertSecurityDescriptorToStringSecurityDescriptor = advapi32.NewProc("ConvertSecurityDescriptorToStringSecurityDescriptorW")
+	ConvertStringSecurityDescriptorToSecurityDescriptor = advapi32.NewProc("ConvertStringSecurityDescriptorToSecurityDescriptorW")
+	ConvertStringSidToSid                               = advapi32.NewProc("ConvertStringSidToSidW")
+	CreateService                                       = advapi32.NewProc("CreateServiceW")
+	CreateWellKnownSid                                  = advapi32.NewProc("CreateWellKnownSid")
+	CredDelete                                          = advapi32.NewProc("CredDeleteW")
+	CredEnumerate                                       = advapi32.NewProc("CredEnumerateW")
+	CredFree                                            = advapi32.NewProc("CredFree")
+	CredRead                                            = advapi32.NewProc("CredReadW")
+	CredWrite                                           = advapi32.NewProc("CredWriteW")
+	DeleteService                                       = advapi32.NewProc("DeleteService")
+	DeregisterEventSource                               = advapi32.NewProc("DeregisterEventSource")
+	EqualSid                                            = advapi32.NewProc("EqualSid")
+	GetLengthSid                                        = advapi32.NewProc("GetLengthSid")
+	GetNamedSecurityInfo                                = advapi32.NewProc("GetNamedSecurityInfoW")
+	GetSecurityDescriptorLength                         = advapi32.NewProc("GetSecurityDescriptorLength")
+	GetTokenInformation                                 = advapi32.NewProc("GetTokenInformation")
+	IsValidSid                                          = advapi32.NewProc("IsValidSid")
+	IsWellKnownSid                                      = advapi32.NewProc("IsWellKnownSid")
+	LookupAccountName                                   = advapi32.NewProc("LookupAccountNameW")
+	LookupAccountSid                                    = advapi32.NewProc("LookupAccountSidW")
+	LookupPrivilegeName                                 = advapi32.NewProc("LookupPrivilegeNameW")
+	LookupPrivilegeValue                                = advapi32.NewProc("LookupPrivilegeValueW")
+	MakeSelfRelativeSD                                  = advapi32.NewProc("MakeSelfRelativeSD")
+	OpenProcessToken                                    = advapi32.NewProc("OpenProcessToken")
+	OpenSCManager                                       = advapi32.NewProc("OpenSCManagerW")
+	OpenService                                         = advapi32.NewProc("OpenServiceW")
+	QueryServiceConfig                                  = advapi32.NewProc("QueryServiceConfigW")
+	QueryServiceConfig2                                 = advapi32.NewProc("QueryServiceConfig2W")
+	QueryServiceStatusEx                                = advapi32.NewProc("QueryServiceStatusEx")
+	RegCloseKey                                         = advapi32.NewProc("RegCloseKey")
+	RegDeleteKey                                        = advapi32.NewProc("RegDeleteKeyW")
+	RegDeleteKeyEx                                      = advapi32.NewProc("RegDeleteKeyExW")
+	RegDeleteKeyValue                                   = advapi32.NewProc("RegDeleteKeyValueW")
+	RegDeleteTree                                       = advapi32.NewProc("RegDeleteTreeW")
+	RegEnumKeyEx                                        = advapi32.NewProc("RegEnumKeyExW")
+	RegEnumValue                                        = advapi32.NewProc("RegEnumValueW")
+	RegFlushKey                                         = advapi32.NewProc("RegFlushKey")
+	RegGetValue                                         = advapi32.NewProc("RegGetValueW")
+	RegisterEventSource                                 = advapi32.NewProc("RegisterEventSourceW")
+	RegisterServiceCtrlHandlerEx                        = advapi32.NewProc("RegisterServiceCtrlHandlerExW")
+	RegOpenKeyEx                                        = advapi32.NewProc("RegOpenKeyExW")
+	RegQueryInfoKey                                     = advapi32.NewProc("RegQueryInfoKeyW")
+	RegSetKeyValue                                      = advapi32.NewProc("RegSetKeyValueW")
+	ReportEvent                                         = advapi32.NewProc("ReportEventW")
+	SetEntriesInAcl                                     = advapi32.NewProc("SetEntriesInAclW")
+	SetNamedSecurityInfo                                = advapi32.NewProc("SetNamedSecurityInfoW")
+	SetServiceStatus                                    = advapi32.NewProc("SetServiceStatus")
+	StartService                                        = advapi32.NewProc("StartServiceW")
+	StartServiceCtrlDispatcher                          = advapi32.NewProc("StartServiceCtrlDispatcherW")
 )
@@ -0,0 +1,17 @@
+//go:build windows
+
+package proc
+
+import (
+	"syscall"
+)
+
+var (
+	combase = syscall.NewLazyDLL("combase.dll")
+
+	RoActivateInstance        = combase.NewProc("RoActivateInstance")
+	RoGetActivationFactory    = combase.NewProc("RoGetActivationFactory")
+	WindowsCreateString       = combase.NewProc("WindowsCreateString")
+	WindowsDeleteString       = combase.NewProc("WindowsDeleteString")
+	WindowsGetStringRawBuffer = combase.NewProc("WindowsGetStringRawBuffer")
+)
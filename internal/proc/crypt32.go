@@ -0,0 +1,14 @@
+//go:build windows
+
+package proc
+
+import (
+	"syscall"
+)
+
+var (
+	crypt32 = syscall.NewLazyDLL("crypt32.dll")
+
+	CryptProtectData   = crypt32.NewProc("CryptProtectData")
+	CryptUnprotectData = crypt32.NewProc("CryptUnprotectData")
+)
@@ -0,0 +1,13 @@
+//go:build windows
+
+package proc
+
+import (
+	"syscall"
+)
+
+var (
+	dwrite = syscall.NewLazyDLL("dwrite.dll")
+
+	DWriteCreateFactory = dwrite.NewProc("DWriteCreateFactory")
+)
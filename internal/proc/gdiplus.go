@@ -0,0 +1,176 @@
+//go:build windows
+
+package proc
+
+import (
+	"syscall"
+)
+
+var (
+	gdiplus = syscall.NewLazyDLL("gdiplus.dll")
+
+	GdipAddPathArc                             = gdiplus.NewProc("GdipAddPathArc")
+	GdipAddPathBezier                          = gdiplus.NewProc("GdipAddPathBezier")
+	GdipAddPathEllipse                         = gdiplus.NewProc("GdipAddPathEllipse")
+	GdipAddPathLine                            = gdiplus.NewProc("GdipAddPathLine")
+	GdipAddPathLine2                           = gdiplus.NewProc("GdipAddPathLine2")
+	GdipAddPathPath                            = gdiplus.NewProc("GdipAddPathPath")
+	GdipAddPathPie                             = gdiplus.NewProc("GdipAddPathPie")
+	GdipAddPathPolygon                         = gdiplus.NewProc("GdipAddPathPolygon")
+	GdipAddPathRectangle                       = gdiplus.NewProc("GdipAddPathRectangle")
+	GdipAddPathString                          = gdiplus.NewProc("GdipAddPathString")
+	GdipBitmapGetPixel                         = gdiplus.NewProc("GdipBitmapGetPixel")
+	GdipBitmapLockBits                         = gdiplus.NewProc("GdipBitmapLockBits")
+	GdipBitmapSetPixel                         = gdiplus.NewProc("GdipBitmapSetPixel")
+	GdipBitmapSetResolution                    = gdiplus.NewProc("GdipBitmapSetResolution")
+	GdipBitmapUnlockBits                       = gdiplus.NewProc("GdipBitmapUnlockBits")
+	GdipCloneFontFamily                        = gdiplus.NewProc("GdipCloneFontFamily")
+	GdipCloneImage                             = gdiplus.NewProc("GdipCloneImage")
+	GdipCloneMatrix                            = gdiplus.NewProc("GdipCloneMatrix")
+	GdipClonePath                              = gdiplus.NewProc("GdipClonePath")
+	GdipCloneStringFormat                      = gdiplus.NewProc("GdipCloneStringFormat")
+	GdipClosePathFigure                        = gdiplus.NewProc("GdipClosePathFigure")
+	GdipClosePathFigures                       = gdiplus.NewProc("GdipClosePathFigures")
+	GdipCreateBitmapFromFile                   = gdiplus.NewProc("GdipCreateBitmapFromFile")
+	GdipCreateBitmapFromGraphics               = gdiplus.NewProc("GdipCreateBitmapFromGraphics")
+	GdipCreateBitmapFromHBITMAP                = gdiplus.NewProc("GdipCreateBitmapFromHBITMAP")
+	GdipCreateBitmapFromHICON                  = gdiplus.NewProc("GdipCreateBitmapFromHICON")
+	GdipCreateBitmapFromScan0                  = gdiplus.NewProc("GdipCreateBitmapFromScan0")
+	GdipCreateFont                             = gdiplus.NewProc("GdipCreateFont")
+	GdipCreateFontFamilyFromName               = gdiplus.NewProc("GdipCreateFontFamilyFromName")
+	GdipCreateFontFromDC                       = gdiplus.NewProc("GdipCreateFontFromDC")
+	GdipCreateFontFromLogfont                  = gdiplus.NewProc("GdipCreateFontFromLogfontW")
+	GdipCreateFromHDC                          = gdiplus.NewProc("GdipCreateFromHDC")
+	GdipCreateFromHWND                         = gdiplus.NewProc("GdipCreateFromHWND")
+	GdipCreateHatchBrush                       = gdiplus.NewProc("GdipCreateHatchBrush")
+	GdipCreateHBITMAPFromBitmap                = gdiplus.NewProc("GdipCreateHBITMAPFromBitmap")
+	GdipCreateHICONFromBitmap                  = gdiplus.NewProc("GdipCreateHICONFromBitmap")
+	GdipCreateLineBrush                        = gdiplus.NewProc("GdipCreateLineBrush")
+	GdipCreateLineBrushFromRect                = gdiplus.NewProc("GdipCreateLineBrushFromRect")
+	GdipCreateMatrix                           = gdiplus.NewProc("GdipCreateMatrix")
+	GdipCreateMatrix2                          = gdiplus.NewProc("GdipCreateMatrix2")
+	GdipCreatePath                             = gdiplus.NewProc("GdipCreatePath")
+	GdipCreatePathGradientFromPath             = gdiplus.NewProc("GdipCreatePathGradientFromPath")
+	GdipCreatePen1                             = gdiplus.NewProc("GdipCreatePen1")
+	GdipCreatePen2                             = gdiplus.NewProc("GdipCreatePen2")
+	GdipCreateSolidFill                        = gdiplus.NewProc("GdipCreateSolidFill")
+	GdipCreateStringFormat                     = gdiplus.NewProc("GdipCreateStringFormat")
+	GdipCreateTexture                          = gdiplus.NewProc("GdipCreateTexture")
+	GdipDeleteBrush                            = gdiplus.NewProc("GdipDeleteBrush")
+	GdipDeleteFont                             = gdiplus.NewProc("GdipDeleteFont")
+	GdipDeleteFontFamily                       = gdiplus.NewProc("GdipDeleteFontFamily")
+	GdipDeleteGraphics                         = gdiplus.NewProc("GdipDeleteGraphics")
+	GdipDeleteMatrix                           = gdiplus.NewProc("GdipDeleteMatrix")
+	GdipDeletePath                             = gdiplus.NewProc("GdipDeletePath")
+	GdipDeletePen                              = gdiplus.NewProc("GdipDeletePen")
+	GdipDeleteStringFormat                     = gdiplus.NewProc("GdipDeleteStringFormat")
+	GdipDisposeImage                           = gdiplus.NewProc("GdipDisposeImage")
+	GdipDrawArc                                = gdiplus.NewProc("GdipDrawArc")
+	GdipDrawBezier                             = gdiplus.NewProc("GdipDrawBezier")
+	GdipDrawEllipse                            = gdiplus.NewProc("GdipDrawEllipse")
+	GdipDrawImage                              = gdiplus.NewProc("GdipDrawImage")
+	GdipDrawImageRect                          = gdiplus.NewProc("GdipDrawImageRect")
+	GdipDrawImageRectRect                      = gdiplus.NewProc("GdipDrawImageRectRect")
+	GdipDrawLine                               = gdiplus.NewProc("GdipDrawLine")
+	GdipDrawLines                              = gdiplus.NewProc("GdipDrawLines")
+	GdipDrawPath                               = gdiplus.NewProc("GdipDrawPath")
+	GdipDrawPie                                = gdiplus.NewProc("GdipDrawPie")
+	GdipDrawPolygon                            = gdiplus.NewProc("GdipDrawPolygon")
+	GdipDrawRectangle                          = gdiplus.NewProc("GdipDrawRectangle")
+	GdipDrawString                             = gdiplus.NewProc("GdipDrawString")
+	GdipFillEllipse                            = gdiplus.NewProc("GdipFillEllipse")
+	GdipFillPath                               = gdiplus.NewProc("GdipFillPath")
+	GdipFillPie                                = gdiplus.NewProc("GdipFillPie")
+	GdipFillPolygon                            = gdiplus.NewProc("GdipFillPolygon")
+	GdipFillRectangle                          = gdiplus.NewProc("GdipFillRectangle")
+	GdipFlattenPath                            = gdiplus.NewProc("GdipFlattenPath")
+	GdipFlush                                  = gdiplus.NewProc("GdipFlush")
+	GdipGetBrushType                           = gdiplus.NewProc("GdipGetBrushType")
+	GdipGetDC                                  = gdiplus.NewProc("GdipGetDC")
+	GdipGetDpiX                                = gdiplus.NewProc("GdipGetDpiX")
+	GdipGetDpiY                                = gdiplus.NewProc("GdipGetDpiY")
+	GdipGetFamily                              = gdiplus.NewProc("GdipGetFamily")
+	GdipGetFamilyName                          = gdiplus.NewProc("GdipGetFamilyName")
+	GdipGetFontHeight                          = gdiplus.NewProc("GdipGetFontHeight")
+	GdipGetFontSize                            = gdiplus.NewProc("GdipGetFontSize")
+	GdipGetFontStyle                           = gdiplus.NewProc("GdipGetFontStyle")
+	GdipGetGenericFontFamilyMonospace          = gdiplus.NewProc("GdipGetGenericFontFamilyMonospace")
+	GdipGetGenericFontFamilySansSerif          = gdiplus.NewProc("GdipGetGenericFontFamilySansSerif")
+	GdipGetGenericFontFamilySerif              = gdiplus.NewProc("GdipGetGenericFontFamilySerif")
+	GdipGetImageDecoders                       = gdiplus.NewProc("GdipGetImageDecoders")
+	GdipGetImageDecodersSize                   = gdiplus.NewProc("GdipGetImageDecodersSize")
+	GdipGetImageEncoders                       = gdiplus.NewProc("GdipGetImageEncoders")
+	GdipGetImageEncodersSize                   = gdiplus.NewProc("GdipGetImageEncodersSize")
+	GdipGetImageGraphicsContext                = gdiplus.NewProc("GdipGetImageGraphicsContext")
+	GdipGetImageHeight                         = gdiplus.NewProc("GdipGetImageHeight")
+	GdipGetImagePixelFormat                    = gdiplus.NewProc("GdipGetImagePixelFormat")
+	GdipGetImageThumbnail                      = gdiplus.NewProc("GdipGetImageThumbnail")
+	GdipGetImageType                           = gdiplus.NewProc("GdipGetImageType")
+	GdipGetImageWidth                          = gdiplus.NewProc("GdipGetImageWidth")
+	GdipGetMatrixElements                      = gdiplus.NewProc("GdipGetMatrixElements")
+	GdipGetPathWorldBounds                     = gdiplus.NewProc("GdipGetPathWorldBounds")
+	GdipGetPenColor                            = gdiplus.NewProc("GdipGetPenColor")
+	GdipGetPenWidth                            = gdiplus.NewProc("GdipGetPenWidth")
+	GdipGetPointCount                          = gdiplus.NewProc("GdipGetPointCount")
+	GdipGetSmoothingMode                       = gdiplus.NewProc("GdipGetSmoothingMode")
+	GdipGetSolidFillColor                      = gdiplus.NewProc("GdipGetSolidFillColor")
+	GdipGetWorldTransform                      = gdiplus.NewProc("GdipGetWorldTransform")
+	GdipGraphicsClear                          = gdiplus.NewProc("GdipGraphicsClear")
+	GdipImageRotateFlip                        = gdiplus.NewProc("GdipImageRotateFlip")
+	GdipInvertMatrix                           = gdiplus.NewProc("GdipInvertMatrix")
+	GdipIsMatrixIdentity                       = gdiplus.NewProc("GdipIsMatrixIdentity")
+	GdipIsOutlineVisiblePathPoint              = gdiplus.NewProc("GdipIsOutlineVisiblePathPoint")
+	GdipIsVisiblePathPoint                     = gdiplus.NewProc("GdipIsVisiblePathPoint")
+	GdipLoadImageFromFile                      = gdiplus.NewProc("GdipLoadImageFromFile")
+	GdiplusShutdown                            = gdiplus.NewProc("GdiplusShutdown")
+	GdiplusStartup                             = gdiplus.NewProc("GdiplusStartup")
+	GdipMeasureString                          = gdiplus.NewProc("GdipMeasureString")
+	GdipMultiplyMatrix                         = gdiplus.NewProc("GdipMultiplyMatrix")
+	GdipReleaseDC                              = gdiplus.NewProc("GdipReleaseDC")
+	GdipResetClip                              = gdiplus.NewProc("GdipResetClip")
+	GdipResetPath                              = gdiplus.NewProc("GdipResetPath")
+	GdipResetWorldTransform                    = gdiplus.NewProc("GdipResetWorldTransform")
+	GdipRestoreGraphics                        = gdiplus.NewProc("GdipRestoreGraphics")
+	GdipRotateMatrix                           = gdiplus.NewProc("GdipRotateMatrix")
+	GdipRotateWorldTransform                   = gdiplus.NewProc("GdipRotateWorldTransform")
+	GdipSaveGraphics                           = gdiplus.NewProc("GdipSaveGraphics")
+	GdipSaveImageToFile                        = gdiplus.NewProc("GdipSaveImageToFile")
+	GdipScaleMatrix                            = gdiplus.NewProc("GdipScaleMatrix")
+	GdipScaleWorldTransform                    = gdiplus.NewProc("GdipScaleWorldTransform")
+	GdipSetClipPath                            = gdiplus.NewProc("GdipSetClipPath")
+	GdipSetClipRect                            = gdiplus.NewProc("GdipSetClipRect")
+	GdipSetCompositingMode                     = gdiplus.NewProc("GdipSetCompositingMode")
+	GdipSetCompositingQuality                  = gdiplus.NewProc("GdipSetCompositingQuality")
+	GdipSetInterpolationMode                   = gdiplus.NewProc("GdipSetInterpolationMode")
+	GdipSetMatrixElements                      = gdiplus.NewProc("GdipSetMatrixElements")
+	GdipSetPageUnit                            = gdiplus.NewProc("GdipSetPageUnit")
+	GdipSetPathFillMode                        = gdiplus.NewProc("GdipSetPathFillMode")
+	GdipSetPathGradientCenterColor             = gdiplus.NewProc("GdipSetPathGradientCenterColor")
+	GdipSetPathGradientSurroundColorsWithCount = gdiplus.NewProc("GdipSetPathGradientSurroundColorsWithCount")
+	GdipSetPenColor                            = gdiplus.NewProc("GdipSetPenColor")
+	GdipSetPenDashArray                        = gdiplus.NewProc("GdipSetPenDashArray")
+	GdipSetPenDashStyle                        = gdiplus.NewProc("GdipSetPenDashStyle")
+	GdipSetPenEndCap                           = gdiplus.NewProc("GdipSetPenEndCap")
+	GdipSetPenLineJoin                         = gdiplus.NewProc("GdipSetPenLineJoin")
+	GdipSetPenStartCap                         = gdiplus.NewProc("GdipSetPenStartCap")
+	GdipSetPenWidth                            = gdiplus.NewProc("GdipSetPenWidth")
+	GdipSetPixelOffsetMode                     = gdiplus.NewProc("GdipSetPixelOffsetMode")
+	GdipSetSmoothingMode                       = gdiplus.NewProc("GdipSetSmoothingMode")
+	GdipSetSolidFillColor                      = gdiplus.NewProc("GdipSetSolidFillColor")
+	GdipSetStringFormatAlign                   = gdiplus.NewProc("GdipSetStringFormatAlign")
+	GdipSetStringFormatFlags                   = gdiplus.NewProc("GdipSetStringFormatFlags")
+	GdipSetStringFormatHotkeyPrefix            = gdiplus.NewProc("GdipSetStringFormatHotkeyPrefix")
+	GdipSetStringFormatLineAlign               = gdiplus.NewProc("GdipSetStringFormatLineAlign")
+	GdipSetStringFormatTrimming                = gdiplus.NewProc("GdipSetStringFormatTrimming")
+	GdipSetTextRenderingHint                   = gdiplus.NewProc("GdipSetTextRenderingHint")
+	GdipSetWorldTransform                      = gdiplus.NewProc("GdipSetWorldTransform")
+	GdipShearMatrix                            = gdiplus.NewProc("GdipShearMatrix")
+	GdipStartPathFigure                        = gdiplus.NewProc("GdipStartPathFigure")
+	GdipStringFormatGetGenericDefault          = gdiplus.NewProc("GdipStringFormatGetGenericDefault")
+	GdipStringFormatGetGenericTypographic      = gdiplus.NewProc("GdipStringFormatGetGenericTypographic")
+	GdipTransformMatrixPoints                  = gdiplus.NewProc("GdipTransformMatrixPoints")
+	GdipTransformPath                          = gdiplus.NewProc("GdipTransformPath")
+	GdipTranslateMatrix                        = gdiplus.NewProc("GdipTranslateMatrix")
+	GdipTranslateWorldTransform                = gdiplus.NewProc("GdipTranslateWorldTransform")
+	GdipWidenPath                              = gdiplus.NewProc("GdipWidenPath")
+)
@@ -9,15 +9,18 @@ import (
 var (
 	ole32 = syscall.NewLazyDLL("ole32.dll")
 
-	CLSIDFromProgID  = ole32.NewProc("CLSIDFromProgID")
-	CoCreateInstance = ole32.NewProc("CoCreateInstance")
-	CoInitializeEx   = ole32.NewProc("CoInitializeEx")
-	CoTaskMemAlloc   = ole32.NewProc("CoTaskMemAlloc")
-	CoTaskMemFree    = ole32.NewProc("CoTaskMemFree")
-	CoTaskMemRealloc = ole32.NewProc("CoTaskMemRealloc")
-	CoUninitialize   = ole32.NewProc("CoUninitialize")
-	OleInitialize    = ole32.NewProc("OleInitialize")
-	OleUninitialize  = ole32.NewProc("OleUninitialize")
-	RegisterDragDrop = ole32.NewProc("RegisterDragDrop")
-	RevokeDragDrop   = ole32.NewProc("RevokeDragDrop")
+	CLSIDFromProgID       = ole32.NewProc("CLSIDFromProgID")
+	CoCreateInstance      = ole32.NewProc("CoCreateInstance")
+	CoInitializeEx        = ole32.NewProc("CoInitializeEx")
+	CoRegisterClassObject = ole32.NewProc("CoRegisterClassObject")
+	CoRevokeClassObject   = ole32.NewProc("CoRevokeClassObject")
+	CoTaskMemAlloc        = ole32.NewProc("CoTaskMemAlloc")
+	CoTaskMemFree         = ole32.NewProc("CoTaskMemFree")
+	CoTaskMemRealloc      = ole32.NewProc("CoTaskMemRealloc")
+	CoUninitialize        = ole32.NewProc("CoUninitialize")
+	OleInitialize         = ole32.NewProc("OleInitialize")
+	OleUninitialize       = ole32.NewProc("OleUninitialize")
+	PropVariantClear      = ole32.NewProc("PropVariantClear")
+	RegisterDragDrop      = ole32.NewProc("RegisterDragDrop")
+	RevokeDragDrop        = ole32.NewProc("RevokeDragDrop")
 )
@@ -9,14 +9,28 @@ import (
 var (
 	shell32 = syscall.NewLazyDLL("shell32.dll")
 
-	CommandLineToArgv           = shell32.NewProc("CommandLineToArgvW")
-	DragAcceptFiles             = shell32.NewProc("DragAcceptFiles")
-	DragFinish                  = shell32.NewProc("DragFinish")
-	DragQueryFile               = shell32.NewProc("DragQueryFileW")
-	DragQueryPoint              = shell32.NewProc("DragQueryPoint")
-	DuplicateIcon               = shell32.NewProc("DuplicateIcon")
-	ExtractIconEx               = shell32.NewProc("ExtractIconExW")
-	SHCreateItemFromParsingName = shell32.NewProc("SHCreateItemFromParsingName")
-	Shell_NotifyIcon            = shell32.NewProc("Shell_NotifyIconW")
-	SHGetFileInfo               = shell32.NewProc("SHGetFileInfoW")
+	CommandLineToArgv                       = shell32.NewProc("CommandLineToArgvW")
+	DragAcceptFiles                         = shell32.NewProc("DragAcceptFiles")
+	DragFinish                              = shell32.NewProc("DragFinish")
+	DragQueryFile                           = shell32.NewProc("DragQueryFileW")
+	DragQueryPoint                          = shell32.NewProc("DragQueryPoint")
+	DuplicateIcon                           = shell32.NewProc("DuplicateIcon")
+	ExtractIconEx                           = shell32.NewProc("ExtractIconExW")
+	GetCurrentProcessExplicitAppUserModelID = shell32.NewProc("GetCurrentProcessExplicitAppUserModelID")
+	ILClone                                 = shell32.NewProc("ILClone")
+	ILFree                                  = shell32.NewProc("ILFree")
+	ILIsEqual                               = shell32.NewProc("ILIsEqual")
+	ILRemoveLastID                          = shell32.NewProc("ILRemoveLastID")
+	SetCurrentProcessExplicitAppUserModelID = shell32.NewProc("SetCurrentProcessExplicitAppUserModelID")
+	SHAddToRecentDocs                       = shell32.NewProc("SHAddToRecentDocs")
+	SHBindToParent                          = shell32.NewProc("SHBindToParent")
+	SHChangeNotify                          = shell32.NewProc("SHChangeNotify")
+	SHCreateItemFromParsingName             = shell32.NewProc("SHCreateItemFromParsingName")
+	Shell_NotifyIcon                        = shell32.NewProc("Shell_NotifyIconW")
+	ShellExecuteEx                          = shell32.NewProc("ShellExecuteExW")
+	SHGetFileInfo                           = shell32.NewProc("SHGetFileInfoW")
+	SHGetKnownFolderItem                    = shell32.NewProc("SHGetKnownFolderItem")
+	SHGetKnownFolderPath                    = shell32.NewProc("SHGetKnownFolderPath")
+	SHGetPropertyStoreForWindow             = shell32.NewProc("SHGetPropertyStoreForWindow")
+	SHParseDisplayName                      = shell32.NewProc("SHParseDisplayName")
 )
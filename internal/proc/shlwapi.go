@@ -9,5 +9,6 @@ import (
 var (
 	shlwapi = syscall.NewLazyDLL("shlwapi")
 
+	AssocQueryString  = shlwapi.NewProc("AssocQueryStringW")
 	SHCreateMemStream = shlwapi.NewProc("SHCreateMemStream")
 )
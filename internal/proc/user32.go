@@ -9,6 +9,7 @@ import (
 var (
 	user32 = syscall.NewLazyDLL("user32.dll")
 
+	AddClipboardFormatListener    = user32.NewProc("AddClipboardFormatListener")
 	AdjustWindowRectEx            = user32.NewProc("AdjustWindowRectEx")
 	AllowSetForegroundWindow      = user32.NewProc("AllowSetForegroundWindow")
 	AppendMenu                    = user32.NewProc("AppendMenuW")
@@ -60,6 +61,7 @@ var (
 	DestroyWindow                 = user32.NewProc("DestroyWindow")
 	DialogBoxParam                = user32.NewProc("DialogBoxParamW")
 	DispatchMessage               = user32.NewProc("DispatchMessageW")
+	DrawFocusRect                 = user32.NewProc("DrawFocusRect")
 	DrawIcon                      = user32.NewProc("DrawIcon")
 	DrawIconEx                    = user32.NewProc("DrawIconEx")
 	DrawMenuBar                   = user32.NewProc("DrawMenuBar")
@@ -78,11 +80,14 @@ var (
 	FindWindow                    = user32.NewProc("FindWindowW")
 	GetAncestor                   = user32.NewProc("GetAncestor")
 	GetAsyncKeyState              = user32.NewProc("GetAsyncKeyState")
+	GetCapture                    = user32.NewProc("GetCapture")
 	GetCaretPos                   = user32.NewProc("GetCaretPos")
 	GetClassInfoEx                = user32.NewProc("GetClassInfoExW")
 	GetClassLongPtr               = user32.NewProc("GetClassLongPtrW")
 	GetClassName                  = user32.NewProc("GetClassNameW")
 	GetClientRect                 = user32.NewProc("GetClientRect")
+	GetClipboardData              = user32.NewProc("GetClipboardData")
+	GetClipboardFormatName        = user32.NewProc("GetClipboardFormatNameW")
 	GetClipboardOwner             = user32.NewProc("GetClipboardOwner")
 	GetClipboardSequenceNumber    = user32.NewProc("GetClipboardSequenceNumber")
 	GetCursorPos                  = user32.NewProc("GetCursorPos")
@@ -91,6 +96,7 @@ var (
 	GetDialogBaseUnits            = user32.NewProc("GetDialogBaseUnits")
 	GetDlgCtrlID                  = user32.NewProc("GetDlgCtrlID")
 	GetDlgItem                    = user32.NewProc("GetDlgItem")
+	GetDpiForWindow               = user32.NewProc("GetDpiForWindow")
 	GetFocus                      = user32.NewProc("GetFocus")
 	GetForegroundWindow           = user32.NewProc("GetForegroundWindow")
 	GetGUIThreadInfo              = user32.NewProc("GetGUIThreadInfo")
@@ -114,6 +120,7 @@ var (
 	GetParent                     = user32.NewProc("GetParent")
 	GetPhysicalCursorPos          = user32.NewProc("GetPhysicalCursorPos")
 	GetProcessDefaultLayout       = user32.NewProc("GetProcessDefaultLayout")
+	GetProp                       = user32.NewProc("GetPropW")
 	GetQueueStatus                = user32.NewProc("GetQueueStatus")
 	GetScrollInfo                 = user32.NewProc("GetScrollInfo")
 	GetShellWindow                = user32.NewProc("GetShellWindow")
@@ -163,6 +170,8 @@ var (
 	MonitorFromRect               = user32.NewProc("MonitorFromRect")
 	MonitorFromWindow             = user32.NewProc("MonitorFromWindow")
 	MoveWindow                    = user32.NewProc("MoveWindow")
+	MsgWaitForMultipleObjectsEx   = user32.NewProc("MsgWaitForMultipleObjectsEx")
+	NotifyWinEvent                = user32.NewProc("NotifyWinEvent")
 	OpenClipboard                 = user32.NewProc("OpenClipboard")
 	PaintDesktop                  = user32.NewProc("PaintDesktop")
 	PeekMessage                   = user32.NewProc("PeekMessageW")
@@ -173,13 +182,18 @@ var (
 	RealChildWindowFromPoint      = user32.NewProc("RealChildWindowFromPoint")
 	RealGetWindowClass            = user32.NewProc("RealGetWindowClassW")
 	RegisterClassEx               = user32.NewProc("RegisterClassExW")
+	RegisterClipboardFormat       = user32.NewProc("RegisterClipboardFormatW")
 	RegisterWindowMessage         = user32.NewProc("RegisterWindowMessageW")
+	ReleaseCapture                = user32.NewProc("ReleaseCapture")
 	ReleaseDC                     = user32.NewProc("ReleaseDC")
+	RemoveClipboardFormatListener = user32.NewProc("RemoveClipboardFormatListener")
 	RemoveMenu                    = user32.NewProc("RemoveMenu")
+	RemoveProp                    = user32.NewProc("RemovePropW")
 	ReplyMessage                  = user32.NewProc("ReplyMessage")
 	ScreenToClient                = user32.NewProc("ScreenToClient")
 	SendMessage                   = user32.NewProc("SendMessageW")
 	SendMessageTimeout            = user32.NewProc("SendMessageTimeoutW")
+	SetCapture                    = user32.NewProc("SetCapture")
 	SetClipboardData              = user32.NewProc("SetClipboardData")
 	SetFocus                      = user32.NewProc("SetFocus")
 	SetForegroundWindow           = user32.NewProc("SetForegroundWindow")
@@ -193,6 +207,7 @@ var (
 	SetProcessDefaultLayout       = user32.NewProc("SetProcessDefaultLayout")
 	SetProcessDPIAware            = user32.NewProc("SetProcessDPIAware")
 	SetProcessDpiAwarenessContext = user32.NewProc("SetProcessDpiAwarenessContext")
+	SetProp                       = user32.NewProc("SetPropW")
 	SetScrollInfo                 = user32.NewProc("SetScrollInfo")
 	SetSystemCursor               = user32.NewProc("SetSystemCursor")
 	SetTimer                      = user32.NewProc("SetTimer")
@@ -205,10 +220,12 @@ var (
 	ShowCaret                     = user32.NewProc("ShowCaret")
 	ShowWindow                    = user32.NewProc("ShowWindow")
 	SystemParametersInfo          = user32.NewProc("SystemParametersInfoW")
+	TrackMouseEvent               = user32.NewProc("TrackMouseEvent")
 	TrackPopupMenu                = user32.NewProc("TrackPopupMenu")
 	TranslateAccelerator          = user32.NewProc("TranslateAcceleratorW")
 	TranslateMessage              = user32.NewProc("TranslateMessage")
 	UnhookWindowsHookEx           = user32.NewProc("UnhookWindowsHookEx")
 	UnregisterClass               = user32.NewProc("UnregisterClassW")
 	UpdateWindow                  = user32.NewProc("UpdateWindow")
+	ValidateRect                  = user32.NewProc("ValidateRect")
 )
@@ -0,0 +1,17 @@
+//go:build windows
+
+package proc
+
+import (
+	"syscall"
+)
+
+var (
+	wevtapi = syscall.NewLazyDLL("wevtapi.dll")
+
+	EvtClose               = wevtapi.NewProc("EvtClose")
+	EvtCreateRenderContext = wevtapi.NewProc("EvtCreateRenderContext")
+	EvtNext                = wevtapi.NewProc("EvtNext")
+	EvtQuery               = wevtapi.NewProc("EvtQuery")
+	EvtRender              = wevtapi.NewProc("EvtRender")
+)
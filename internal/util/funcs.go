@@ -6,8 +6,19 @@ import (
 	"encoding/binary"
 	"strings"
 	"time"
+	"unsafe"
 )
 
+// Returns the syscall arguments of a 64-bit value passed by value, like a
+// float64 or an 8-byte struct: one argument on 64-bit, two on 32-bit.
+func Arg64(val uint64) []uintptr {
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		return []uintptr{uintptr(val)}
+	}
+	lo, hi := Break64(val)
+	return []uintptr{uintptr(lo), uintptr(hi)}
+}
+
 // Tells whether the number has the nth bit set.
 //
 // bitPosition must be in the range 0-7.
@@ -10,6 +10,7 @@ import (
 	"github.com/rodrigocfd/windigo/ui/wm"
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
 )
 
 // Native list view control.
@@ -37,6 +38,7 @@ type ListView interface {
 	SetExtendedStyle(doSet bool, styles co.LVS_EX)                    // Sets or unsets extended style flags.
 	SetImageList(which co.LVSIL, himgl win.HIMAGELIST) win.HIMAGELIST // Sets one of the current image lists. If the list has LVS_SHAREIMAGELISTS, it's shared, otherwise it will be automatically destroyed.
 	SetRedraw(allowRedraw bool)                                       // Sends WM_SETREDRAW to enable or disable UI updates.
+	SetShellContextMenu(selectedPaths func() []string)                // Shows the native shell context menu for the file paths returned by the callback, instead of ContextMenu(). Pass nil to disable.
 	SetView(view co.LV_VIEW)                                          // Sets current view.
 	View() co.LV_VIEW                                                 // Retrieves current view.
 }
@@ -49,6 +51,7 @@ type _ListView struct {
 	columns      _ListViewColumns
 	items        _ListViewItems
 	hContextMenu win.HMENU
+	shellPaths   func() []string
 }
 
 // Creates a new ListView. Call ui.ListViewOpts() to define the options to be
@@ -198,6 +201,10 @@ func (me *_ListView) SetRedraw(allowRedraw bool) {
 		win.WPARAM(util.BoolToUintptr(allowRedraw)), 0)
 }
 
+func (me *_ListView) SetShellContextMenu(selectedPaths func() []string) {
+	me.shellPaths = selectedPaths
+}
+
 func (me *_ListView) SetView(view co.LV_VIEW) {
 	ret := me.Hwnd().SendMessage(co.LVM_SETVIEW, win.WPARAM(view), 0)
 	if int(ret) == -1 {
@@ -232,7 +239,7 @@ func (me *_ListView) handledEvents() {
 }
 
 func (me *_ListView) showContextMenu(followCursor, hasCtrl, hasShift bool) {
-	if me.hContextMenu == win.HMENU(0) { // no menu, nothing to do
+	if me.hContextMenu == win.HMENU(0) && me.shellPaths == nil { // no menu, nothing to do
 		return
 	}
 
@@ -262,7 +269,20 @@ func (me *_ListView) showContextMenu(followCursor, hasCtrl, hasShift bool) {
 		}
 	}
 
-	me.hContextMenu.ShowAtPoint(menuPos, me.Hwnd().GetParent(), me.Hwnd())
+	if me.shellPaths != nil {
+		if paths := me.shellPaths(); len(paths) > 0 { // no paths, like when clicking the empty area
+			flags := shellco.CMF_NORMAL
+			if hasShift {
+				flags |= shellco.CMF_EXTENDEDVERBS
+			}
+			ShellMenu.Show(me.Hwnd(), menuPos, paths, flags) // errors are ignored, like when a file was deleted meanwhile
+			return
+		}
+	}
+
+	if me.hContextMenu != win.HMENU(0) {
+		me.hContextMenu.ShowAtPoint(menuPos, me.Hwnd().GetParent(), me.Hwnd())
+	}
 }
 
 //------------------------------------------------------------------------------
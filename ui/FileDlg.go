@@ -0,0 +1,465 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comco"
+	"github.com/rodrigocfd/windigo/win/com/shell"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+)
+
+type _FileDlgT struct{}
+
+// Displays the common file dialogs, to open and save files, or to pick a
+// folder.
+//
+// The methods are high-level wrappers to shell.IFileOpenDialog and
+// shell.IFileSaveDialog. Call FileDlgOpts() to define the options, which also
+// hold the values of the custom controls after the dialog is closed.
+//
+// Depends of CoInitializeEx().
+var FileDlg _FileDlgT
+
+// Displays a dialog to open a single file.
+//
+// Returns false if the user cancelled.
+//
+// Example:
+//
+//	var owner ui.AnyParent // initialized somewhere
+//
+//	path, ok := ui.FileDlg.Open(owner,
+//		ui.FileDlgOpts().
+//			Filters([]shell.FilterSpec{
+//				{Name: "Text files", Spec: "*.txt"},
+//				{Name: "All files", Spec: "*.*"},
+//			}).
+//			ClientGuid("4a2f9c21-1c5e-4b8a-9f3e-0b6d2e7c8a11"),
+//	)
+func (_FileDlgT) Open(parent AnyParent, opts *_FileDlgO) (string, bool) {
+	if paths, ok := FileDlg.generate(parent, opts, _FILEDLG_OPEN); ok {
+		return paths[0], true
+	}
+	return "", false
+}
+
+// Displays a dialog to open multiple files.
+//
+// Returns false if the user cancelled.
+func (_FileDlgT) OpenMany(parent AnyParent, opts *_FileDlgO) ([]string, bool) {
+	return FileDlg.generate(parent, opts, _FILEDLG_OPEN_MANY)
+}
+
+// Displays a dialog to pick a folder. The filters are ignored.
+//
+// Returns false if the user cancelled.
+func (_FileDlgT) PickFolder(parent AnyParent, opts *_FileDlgO) (string, bool) {
+	if paths, ok := FileDlg.generate(parent, opts, _FILEDLG_FOLDER); ok {
+		return paths[0], true
+	}
+	return "", false
+}
+
+// Displays a dialog to save a file.
+//
+// Returns false if the user cancelled.
+//
+// Example:
+//
+//	var owner ui.AnyParent // initialized somewhere
+//
+//	opts := ui.FileDlgOpts().
+//		Filters([]shell.FilterSpec{{Name: "Text files", Spec: "*.txt"}}).
+//		DefaultExt("txt").
+//		CheckBox(1001, "Use UTF-8", true)
+//
+//	if path, ok := ui.FileDlg.Save(owner, opts); ok {
+//		utf8 := opts.CheckBoxValue(1001)
+//		println(path, utf8)
+//	}
+func (_FileDlgT) Save(parent AnyParent, opts *_FileDlgO) (string, bool) {
+	if paths, ok := FileDlg.generate(parent, opts, _FILEDLG_SAVE); ok {
+		return paths[0], true
+	}
+	return "", false
+}
+
+type _FILEDLG uint8
+
+const (
+	_FILEDLG_OPEN _FILEDLG = iota
+	_FILEDLG_OPEN_MANY
+	_FILEDLG_FOLDER
+	_FILEDLG_SAVE
+)
+
+func (_FileDlgT) generate(
+	parent AnyParent, opts *_FileDlgO, mode _FILEDLG) ([]string, bool) {
+
+	if opts == nil {
+		opts = FileDlgOpts()
+	}
+
+	var fd shell.IFileDialog
+	var fod shell.IFileOpenDialog
+	if mode == _FILEDLG_SAVE {
+		fsd := shell.NewIFileSaveDialog(
+			com.CoCreateInstance(
+				shellco.CLSID_FileSaveDialog, nil,
+				comco.CLSCTX_INPROC_SERVER,
+				shellco.IID_IFileSaveDialog),
+		)
+		defer fsd.Release()
+		fd = fsd
+	} else {
+		fod = shell.NewIFileOpenDialog(
+			com.CoCreateInstance(
+				shellco.CLSID_FileOpenDialog, nil,
+				comco.CLSCTX_INPROC_SERVER,
+				shellco.IID_IFileOpenDialog),
+		)
+		defer fod.Release()
+		fd = fod
+	}
+
+	fos := fd.GetOptions() | shellco.FOS_FORCEFILESYSTEM | opts.options
+	switch mode {
+	case _FILEDLG_OPEN_MANY:
+		fos |= shellco.FOS_ALLOWMULTISELECT
+	case _FILEDLG_FOLDER:
+		fos |= shellco.FOS_PICKFOLDERS
+	}
+	fd.SetOptions(fos)
+
+	if opts.title != "" {
+		fd.SetTitle(opts.title)
+	}
+	if opts.okLabel != "" {
+		fd.SetOkButtonLabel(opts.okLabel)
+	}
+	if len(opts.filters) > 0 && mode != _FILEDLG_FOLDER {
+		fd.SetFileTypes(opts.filters)
+		fd.SetFileTypeIndex(opts.filterIndex + 1) // one-based
+	}
+	if opts.defaultExt != "" {
+		fd.SetDefaultExtension(opts.defaultExt)
+	}
+	if opts.fileName != "" {
+		fd.SetFileName(opts.fileName)
+	}
+	if opts.clientGuid != "" {
+		fd.SetClientGuid(win.GuidFromClsid(co.CLSID(opts.clientGuid)))
+	}
+	if opts.defaultFolder != "" {
+		if si, err := shell.NewShellItemFromPath(opts.defaultFolder); err == nil {
+			defer si.Release()
+			fd.SetDefaultFolder(si)
+		}
+	}
+	if opts.folder != "" {
+		if si, err := shell.NewShellItemFromPath(opts.folder); err == nil {
+			defer si.Release()
+			fd.SetFolder(si)
+		}
+	}
+
+	var fdc shell.IFileDialogCustomize
+	if len(opts.ctrls) > 0 {
+		fdc = shell.NewIFileDialogCustomize(
+			fd.QueryInterface(shellco.IID_IFileDialogCustomize),
+		)
+		defer fdc.Release()
+		opts.addCtrls(fdc)
+	}
+
+	if opts.hasEvents() {
+		events := shell.NewIFileDialogEventsImpl()
+		defer events.Release()
+		opts.setEvents(events)
+
+		cookie := fd.Advise(events)
+		defer fd.Unadvise(cookie)
+	}
+
+	var hOwner win.HWND
+	if parent != nil {
+		hOwner = parent.Hwnd()
+	}
+	if !fd.Show(hOwner) {
+		return nil, false
+	}
+
+	if fdc != nil {
+		opts.readCtrls(fdc)
+	}
+
+	if mode == _FILEDLG_OPEN_MANY {
+		return fod.ListResultDisplayNames(shellco.SIGDN_FILESYSPATH), true
+	}
+	return []string{fd.GetResultDisplayName(shellco.SIGDN_FILESYSPATH)}, true
+}
+
+//------------------------------------------------------------------------------
+
+type _FILEDLG_CTRL uint8
+
+const (
+	_FILEDLG_CTRL_CHECKBOX _FILEDLG_CTRL = iota
+	_FILEDLG_CTRL_COMBOBOX
+	_FILEDLG_CTRL_EDITBOX
+)
+
+// A custom control added to the file dialog, which also stores its value.
+type _FileDlgCtrl struct {
+	kind     _FILEDLG_CTRL
+	id       int
+	label    string
+	items    []string
+	checked  bool
+	selected int
+	text     string
+}
+
+type _FileDlgO struct {
+	title         string
+	okLabel       string
+	filters       []shell.FilterSpec
+	filterIndex   int
+	defaultExt    string
+	fileName      string
+	clientGuid    string
+	defaultFolder string
+	folder        string
+	options       shellco.FOS
+	ctrls         []_FileDlgCtrl
+
+	onSelectionChange func(path string)
+	onTypeChange      func(filterIndex int)
+	onOverwrite       func(path string) bool
+}
+
+// Title of the dialog.
+//
+// Defaults to the system title, like "Open" or "Save As".
+func (o *_FileDlgO) Title(t string) *_FileDlgO { o.title = t; return o }
+
+// Text of the OK button.
+//
+// Defaults to the system text.
+func (o *_FileDlgO) OkLabel(t string) *_FileDlgO { o.okLabel = t; return o }
+
+// File type filters, like {Name: "Text files", Spec: "*.txt;*.log"}.
+//
+// Defaults to none.
+func (o *_FileDlgO) Filters(f []shell.FilterSpec) *_FileDlgO { o.filters = f; return o }
+
+// Zero-based index of the initially selected filter.
+//
+// Defaults to 0.
+func (o *_FileDlgO) FilterIndex(i int) *_FileDlgO { o.filterIndex = i; return o }
+
+// Extension appended to the file name if the user types none, without the
+// leading dot, like "txt".
+//
+// Defaults to none.
+func (o *_FileDlgO) DefaultExt(e string) *_FileDlgO { o.defaultExt = e; return o }
+
+// Initial file name.
+//
+// Defaults to none.
+func (o *_FileDlgO) FileName(n string) *_FileDlgO { o.fileName = n; return o }
+
+// GUID which identifies the dialog, like "4a2f9c21-1c5e-4b8a-9f3e-0b6d2e7c8a11".
+// The system remembers the last folder, size and position separately for each
+// GUID.
+//
+// Defaults to none, which shares the state with other dialogs of the
+// application.
+func (o *_FileDlgO) ClientGuid(g string) *_FileDlgO { o.clientGuid = g; return o }
+
+// Folder used when there is no recently used folder. Ignored if the folder
+// doesn't exist.
+//
+// Defaults to none.
+func (o *_FileDlgO) DefaultFolder(p string) *_FileDlgO { o.defaultFolder = p; return o }
+
+// Folder always opened, overriding the recently used folder. Ignored if the
+// folder doesn't exist.
+//
+// Defaults to none.
+func (o *_FileDlgO) Folder(p string) *_FileDlgO { o.folder = p; return o }
+
+// Additional flags, combined with the ones set by the dialog.
+//
+// Defaults to none.
+func (o *_FileDlgO) Options(f shellco.FOS) *_FileDlgO { o.options = f; return o }
+
+// Adds a custom check box. After the dialog is closed, the value can be
+// retrieved with CheckBoxValue().
+func (o *_FileDlgO) CheckBox(id int, label string, checked bool) *_FileDlgO {
+	o.ctrls = append(o.ctrls, _FileDlgCtrl{
+		kind: _FILEDLG_CTRL_CHECKBOX, id: id, label: label, checked: checked,
+	})
+	return o
+}
+
+// Adds a custom combo box, with a label shown before it. After the dialog is
+// closed, the zero-based index of the selected item can be retrieved with
+// ComboBoxValue().
+func (o *_FileDlgO) ComboBox(id int, label string, items []string, selected int) *_FileDlgO {
+	o.ctrls = append(o.ctrls, _FileDlgCtrl{
+		kind: _FILEDLG_CTRL_COMBOBOX, id: id, label: label,
+		items: items, selected: selected,
+	})
+	return o
+}
+
+// Adds a custom edit box, with a label shown before it. After the dialog is
+// closed, the text can be retrieved with EditBoxValue().
+func (o *_FileDlgO) EditBox(id int, label string, text string) *_FileDlgO {
+	o.ctrls = append(o.ctrls, _FileDlgCtrl{
+		kind: _FILEDLG_CTRL_EDITBOX, id: id, label: label, text: text,
+	})
+	return o
+}
+
+// Returns the state of the custom check box after the dialog is closed.
+//
+// Panics if there is no check box with the given ID.
+func (o *_FileDlgO) CheckBoxValue(id int) bool {
+	return o.ctrl(id, _FILEDLG_CTRL_CHECKBOX).checked
+}
+
+// Returns the zero-based index of the item selected in the custom combo box
+// after the dialog is closed.
+//
+// Panics if there is no combo box with the given ID.
+func (o *_FileDlgO) ComboBoxValue(id int) int {
+	return o.ctrl(id, _FILEDLG_CTRL_COMBOBOX).selected
+}
+
+// Returns the text of the custom edit box after the dialog is closed.
+//
+// Panics if there is no edit box with the given ID.
+func (o *_FileDlgO) EditBoxValue(id int) string {
+	return o.ctrl(id, _FILEDLG_CTRL_EDITBOX).text
+}
+
+// Called when the selection changes, receiving the path of the selected item.
+func (o *_FileDlgO) OnSelectionChange(fun func(path string)) *_FileDlgO {
+	o.onSelectionChange = fun
+	return o
+}
+
+// Called when the user picks another filter, receiving its zero-based index.
+func (o *_FileDlgO) OnTypeChange(fun func(filterIndex int)) *_FileDlgO {
+	o.onTypeChange = fun
+	return o
+}
+
+// Called by the save dialog when the chosen file already exists, instead of
+// the default confirmation prompt. Return true to overwrite the file, or false
+// to keep the dialog open.
+func (o *_FileDlgO) OnOverwrite(fun func(path string) bool) *_FileDlgO {
+	o.onOverwrite = fun
+	return o
+}
+
+func (o *_FileDlgO) ctrl(id int, kind _FILEDLG_CTRL) *_FileDlgCtrl {
+	for i := range o.ctrls {
+		if o.ctrls[i].id == id && o.ctrls[i].kind == kind {
+			return &o.ctrls[i]
+		}
+	}
+	panic("No custom control with the given ID.")
+}
+
+func (o *_FileDlgO) addCtrls(fdc shell.IFileDialogCustomize) {
+	for i := range o.ctrls {
+		ctrl := &o.ctrls[i]
+		switch ctrl.kind {
+		case _FILEDLG_CTRL_CHECKBOX:
+			fdc.AddCheckButton(ctrl.id, ctrl.label, ctrl.checked)
+		case _FILEDLG_CTRL_COMBOBOX:
+			fdc.StartVisualGroup(ctrl.id+0x1_0000, ctrl.label) // arbitrary group ID
+			fdc.AddComboBox(ctrl.id)
+			for itemId, item := range ctrl.items {
+				fdc.AddControlItem(ctrl.id, itemId, item)
+			}
+			if ctrl.selected >= 0 && ctrl.selected < len(ctrl.items) {
+				fdc.SetSelectedControlItem(ctrl.id, ctrl.selected)
+			}
+			fdc.EndVisualGroup()
+		case _FILEDLG_CTRL_EDITBOX:
+			fdc.StartVisualGroup(ctrl.id+0x1_0000, ctrl.label)
+			fdc.AddEditBox(ctrl.id, ctrl.text)
+			fdc.EndVisualGroup()
+		}
+	}
+}
+
+func (o *_FileDlgO) readCtrls(fdc shell.IFileDialogCustomize) {
+	for i := range o.ctrls {
+		ctrl := &o.ctrls[i]
+		switch ctrl.kind {
+		case _FILEDLG_CTRL_CHECKBOX:
+			ctrl.checked = fdc.GetCheckButtonState(ctrl.id)
+		case _FILEDLG_CTRL_COMBOBOX:
+			if itemId, ok := fdc.GetSelectedControlItem(ctrl.id); ok {
+				ctrl.selected = itemId
+			} else {
+				ctrl.selected = -1
+			}
+		case _FILEDLG_CTRL_EDITBOX:
+			ctrl.text = fdc.GetEditBoxText(ctrl.id)
+		}
+	}
+}
+
+func (o *_FileDlgO) hasEvents() bool {
+	return o.onSelectionChange != nil || o.onTypeChange != nil ||
+		o.onOverwrite != nil
+}
+
+func (o *_FileDlgO) setEvents(events shell.IFileDialogEvents) {
+	if o.onSelectionChange != nil {
+		events.OnSelectionChange(func(fd shell.IFileDialog) {
+			if path, ok := _FileDlgCurrentSelection(fd); ok {
+				o.onSelectionChange(path)
+			}
+		})
+	}
+	if o.onTypeChange != nil {
+		events.OnTypeChange(func(fd shell.IFileDialog) {
+			o.onTypeChange(fd.GetFileTypeIndex() - 1) // one-based
+		})
+	}
+	if o.onOverwrite != nil {
+		events.OnOverwrite(func(_ shell.IFileDialog, item shell.IShellItem) shellco.FDEOR {
+			if o.onOverwrite(item.GetDisplayName(shellco.SIGDN_FILESYSPATH)) {
+				return shellco.FDEOR_ACCEPT
+			}
+			return shellco.FDEOR_REFUSE
+		})
+	}
+}
+
+// Returns the path of the item currently selected in the dialog, if any.
+func _FileDlgCurrentSelection(fd shell.IFileDialog) (string, bool) {
+	si, err := fd.GetCurrentSelectionErr() // fails if nothing is selected
+	if err != nil {
+		return "", false
+	}
+	defer si.Release()
+
+	path, err := si.GetDisplayNameErr(shellco.SIGDN_FILESYSPATH) // fails if not a file
+	return path, err == nil
+}
+
+// Options for FileDlg methods.
+func FileDlgOpts() *_FileDlgO {
+	return &_FileDlgO{}
+}
@@ -0,0 +1,202 @@
+//go:build windows
+
+package ui
+
+import (
+	"syscall"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+type _ShellMenuT struct{}
+
+// Displays the native shell context menu – the same one shown by Windows
+// Explorer – for files and folders, and runs the command chosen by the user.
+//
+// The methods are high-level wrappers to shell.IShellFolder and
+// shell.IContextMenu.
+//
+// Depends of CoInitializeEx().
+var ShellMenu _ShellMenuT
+
+// Displays the context menu for the given paths, which must be in the same
+// folder, at a position relative to the client area of hWnd. Returns
+// errco.E_INVALIDARG if they are not.
+//
+// The hWnd becomes the owner of the menu, and it's temporarily subclassed to
+// forward WM_INITMENUPOPUP, WM_DRAWITEM, WM_MEASUREITEM and WM_MENUCHAR to the
+// context menu, so submenus like "Open with" and "Send to" work.
+//
+// Returns true if the user chose a command, which was then run.
+//
+// Example:
+//
+//	var wnd ui.WindowMain // initialized somewhere
+//
+//	ran, err := ui.ShellMenu.Show(wnd.Hwnd(), win.POINT{X: 10, Y: 10},
+//		[]string{"C:\\Temp\\foo.txt", "C:\\Temp\\bar.txt"},
+//		shellco.CMF_NORMAL)
+func (_ShellMenuT) Show(
+	hWnd win.HWND, pos win.POINT,
+	paths []string, flags shellco.CMF) (bool, error) {
+
+	if len(paths) == 0 {
+		return false, nil
+	}
+
+	var folder shell.IShellFolder
+	var folderPidl shell.PIDL
+	pidls := make([]shell.PIDL, 0, len(paths))
+	children := make([]shell.PIDL, 0, len(paths)) // point into pidls, not freed
+	defer func() {
+		for _, pidl := range pidls {
+			pidl.ILFree()
+		}
+		if folderPidl != 0 {
+			folderPidl.ILFree()
+		}
+		if folder != nil {
+			folder.Release()
+		}
+	}()
+
+	for _, path := range paths {
+		pidl, err := shell.SHParseDisplayName(path)
+		if err != nil {
+			return false, err
+		}
+		pidls = append(pidls, pidl)
+
+		parentPidl := pidl.ILClone()
+		parentPidl.ILRemoveLastID()
+		if folderPidl == 0 {
+			folderPidl = parentPidl
+		} else {
+			sameFolder := parentPidl.ILIsEqual(folderPidl)
+			parentPidl.ILFree()
+			if !sameFolder {
+				return false, errco.E_INVALIDARG // all items must be in the same folder
+			}
+		}
+
+		parent, child, err := shell.SHBindToParent(pidl)
+		if err != nil {
+			return false, err
+		}
+		if folder == nil {
+			folder = parent
+		} else {
+			parent.Release()
+		}
+		children = append(children, child)
+	}
+
+	obj, err := folder.GetUIObjectOf(hWnd, children, shellco.IID_IContextMenu)
+	if err != nil {
+		return false, err
+	}
+	cm := shell.NewIContextMenu(obj)
+	defer cm.Release()
+
+	hMenu := win.CreatePopupMenu()
+	defer hMenu.DestroyMenu()
+
+	_, err = cm.QueryContextMenu(hMenu, 0,
+		_SHELLMENU_FIRST_ID, _SHELLMENU_LAST_ID, flags)
+	if err != nil {
+		return false, err
+	}
+
+	if obj := _ShellMenuQuery(cm, shellco.IID_IContextMenu3); obj != nil {
+		_globalShellMenuCm3 = shell.NewIContextMenu3(obj)
+		defer _globalShellMenuCm3.Release()
+	} else if obj := _ShellMenuQuery(cm, shellco.IID_IContextMenu2); obj != nil {
+		_globalShellMenuCm2 = shell.NewIContextMenu2(obj)
+		defer _globalShellMenuCm2.Release()
+	}
+
+	hWnd.SetWindowSubclass(_globalShellMenuSubclassProc, _SHELLMENU_SUBCLASS_ID, nil)
+	hWnd.ClientToScreenPt(&pos) // now relative to screen
+	cmdId := hMenu.TrackPopupMenu(co.TPM_RETURNCMD|co.TPM_RIGHTBUTTON,
+		pos.X, pos.Y, hWnd)
+	hWnd.RemoveWindowSubclass(_globalShellMenuSubclassProc, _SHELLMENU_SUBCLASS_ID)
+	_globalShellMenuCm2, _globalShellMenuCm3 = nil, nil
+
+	if cmdId == 0 { // menu was cancelled
+		return false, nil
+	}
+
+	cmi := shell.CMINVOKECOMMANDINFOEX{}
+	cmi.SetCbSize()
+	cmi.FMask = shellco.CMIC_MASK_UNICODE | shellco.CMIC_MASK_PTINVOKE
+	if (win.GetAsyncKeyState(co.VK_CONTROL) & 0x8000) != 0 {
+		cmi.FMask |= shellco.CMIC_MASK_CONTROL_DOWN
+	}
+	if (win.GetAsyncKeyState(co.VK_SHIFT) & 0x8000) != 0 {
+		cmi.FMask |= shellco.CMIC_MASK_SHIFT_DOWN
+	}
+	cmi.Hwnd = hWnd
+	cmi.LpVerb = uintptr(cmdId - _SHELLMENU_FIRST_ID)
+	cmi.LpVerbW = uintptr(cmdId - _SHELLMENU_FIRST_ID)
+	cmi.NShow = co.SW_SHOWNORMAL
+	cmi.PtInvoke = pos
+
+	if err := cm.InvokeCommand(&cmi); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+const (
+	_SHELLMENU_FIRST_ID    = 1 // zero is returned by TrackPopupMenu() when cancelled
+	_SHELLMENU_LAST_ID     = 0x7fff
+	_SHELLMENU_SUBCLASS_ID = 1
+)
+
+var (
+	// Interfaces of the context menu being currently shown, if any.
+	_globalShellMenuCm2 shell.IContextMenu2
+	_globalShellMenuCm3 shell.IContextMenu3
+
+	// Forwards the menu messages to the context menu being shown.
+	_globalShellMenuSubclassProc uintptr = syscall.NewCallback(_ShellMenuSubclassProc)
+)
+
+func _ShellMenuSubclassProc(
+	hWnd win.HWND, uMsg co.WM, wParam win.WPARAM, lParam win.LPARAM,
+	uIdSubclass, dwRefData uintptr) uintptr {
+
+	isMenuMsg := uMsg == co.WM_INITMENUPOPUP || uMsg == co.WM_MENUCHAR ||
+		((uMsg == co.WM_DRAWITEM || uMsg == co.WM_MEASUREITEM) && wParam == 0) // sent by menus with zero ID
+
+	if isMenuMsg {
+		if _globalShellMenuCm3 != nil {
+			if ret, err := _globalShellMenuCm3.HandleMenuMsg2(uMsg, wParam, lParam); err == nil {
+				return ret
+			}
+		} else if _globalShellMenuCm2 != nil && uMsg != co.WM_MENUCHAR {
+			if err := _globalShellMenuCm2.HandleMenuMsg(uMsg, wParam, lParam); err == nil {
+				if uMsg == co.WM_INITMENUPOPUP {
+					return 0
+				}
+				return 1 // TRUE for WM_DRAWITEM and WM_MEASUREITEM
+			}
+		}
+	}
+
+	return hWnd.DefSubclassProc(uMsg, wParam, lParam)
+}
+
+// Queries an optional interface, returning nil if it's not implemented.
+func _ShellMenuQuery(cm shell.IContextMenu, riid co.IID) com.IUnknown {
+	obj, err := cm.QueryInterfaceErr(riid)
+	if err != nil {
+		return nil
+	}
+	return obj
+}
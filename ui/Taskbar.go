@@ -0,0 +1,254 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comco"
+	"github.com/rodrigocfd/windigo/win/com/shell"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+)
+
+// Taskbar button of a main window, exposed by WindowMain.Taskbar(), which wraps
+// ITaskbarList3.
+//
+// The taskbar button only exists after the window is shown, so all calls made
+// before are stored, and applied when the button is created. The whole state
+// is applied again if Explorer restarts.
+//
+// The underlying ITaskbarList3 is only created when a method is first called,
+// so windows which don't use the taskbar don't depend on COM.
+//
+// Depends of CoInitializeEx(); if COM was not initialized, the calls are
+// stored, and applied by the first call made after it is.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/shell/taskbar-extensions
+type _Taskbar struct {
+	wnd          AnyParent
+	taskbl       shell.ITaskbarList3 // created on demand, after TaskbarButtonCreated
+	used         bool                // any method was called
+	btnCreated   bool                // TaskbarButtonCreated was received
+	progState    shellco.TBPF
+	progDone     uint64
+	progTotal    uint64
+	hOverlay     win.HICON
+	overlayDescr string
+	tooltip      string
+	buttons      []shell.THUMBBUTTON
+	buttonFuncs  []func()
+	buttonsAdded bool
+}
+
+func (me *_Taskbar) new(wnd AnyParent) {
+	me.wnd = wnd
+	me.taskbl = nil
+	me.used = false
+	me.btnCreated = false
+	me.progState = shellco.TBPF_NOPROGRESS
+	me.progDone = 0
+	me.progTotal = 0
+	me.hOverlay = win.HICON(0)
+	me.overlayDescr = ""
+	me.tooltip = ""
+	me.buttons = nil
+	me.buttonFuncs = nil
+	me.buttonsAdded = false
+
+	wmTaskbarButtonCreated, err := win.RegisterWindowMessage("TaskbarButtonCreated")
+	if err != nil {
+		panic(err)
+	}
+
+	// Sent when the button is created, and again if Explorer restarts.
+	wnd.internalOn().addMsgZero(wmTaskbarButtonCreated, func(_ wm.Any) {
+		me.btnCreated = true
+		me.buttonsAdded = false
+		if me.used && me.ready() {
+			me.applyAll()
+		}
+	})
+
+	wnd.internalOn().addMsgZero(co.WM_COMMAND, func(p wm.Any) {
+		cmd := wm.Command{Msg: p}
+		if co.CMD(cmd.ControlNotifCode()) == co.THBN_CLICKED {
+			for i := range me.buttons {
+				if int(me.buttons[i].IId) == cmd.ControlId() {
+					me.buttonFuncs[i]()
+					break
+				}
+			}
+		}
+	})
+
+	wnd.internalOn().addMsgZero(co.WM_NCDESTROY, func(_ wm.Any) {
+		if me.taskbl != nil {
+			me.taskbl.Release()
+			me.taskbl = nil
+		}
+	})
+}
+
+// Adds a button to the thumbnail toolbar, returning its zero-based index. Up
+// to 7 buttons can be added.
+//
+// Panics if called after the window is created, since the thumbnail toolbar
+// buttons cannot be added later.
+//
+// Example:
+//
+//	var wnd ui.WindowMain // initialized somewhere
+//	var hIconPlay win.HICON // initialized somewhere
+//
+//	wnd.Taskbar().AddThumbButton(hIconPlay, "Play", func() {
+//		println("Play clicked.")
+//	})
+func (me *_Taskbar) AddThumbButton(
+	hIcon win.HICON, tooltip string, onClick func()) int {
+
+	if me.wnd.Hwnd() != 0 {
+		panic("Cannot add thumbnail buttons after the window is created.")
+	}
+	if len(me.buttons) == 7 {
+		panic("A thumbnail toolbar can have up to 7 buttons.")
+	}
+
+	btn := shell.THUMBBUTTON{
+		DwMask:  shellco.THB_ICON | shellco.THB_TOOLTIP | shellco.THB_FLAGS,
+		IId:     uint32(_NextCtrlId()),
+		HIcon:   hIcon,
+		DwFlags: shellco.THBF_ENABLED,
+	}
+	btn.SetSzTip(tooltip)
+
+	me.buttons = append(me.buttons, btn)
+	me.buttonFuncs = append(me.buttonFuncs, onClick)
+	me.used = true
+	return len(me.buttons) - 1
+}
+
+// Sets the icon, which is not destroyed by the taskbar, displayed over the
+// taskbar button. Pass zero to remove it.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-itaskbarlist3-setoverlayicon
+func (me *_Taskbar) SetOverlayIcon(hIcon win.HICON, description string) {
+	me.hOverlay = hIcon
+	me.overlayDescr = description
+	if me.ready() {
+		me.taskbl.SetOverlayIcon(me.wnd.Hwnd(), me.hOverlay, me.overlayDescr)
+	}
+}
+
+// Sets the state of the progress bar in the taskbar button.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-itaskbarlist3-setprogressstate
+func (me *_Taskbar) SetProgressState(state shellco.TBPF) {
+	me.progState = state
+	if me.ready() {
+		me.taskbl.SetProgressState(me.wnd.Hwnd(), me.progState)
+	}
+}
+
+// Sets the position of the progress bar in the taskbar button. If the state is
+// TBPF_NOPROGRESS or TBPF_INDETERMINATE, it is changed to TBPF_NORMAL.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-itaskbarlist3-setprogressvalue
+func (me *_Taskbar) SetProgressValue(completed, total uint64) {
+	me.progDone = completed
+	me.progTotal = total
+	if me.progState == shellco.TBPF_NOPROGRESS ||
+		me.progState == shellco.TBPF_INDETERMINATE {
+
+		me.progState = shellco.TBPF_NORMAL // same behavior of the native call
+	}
+
+	if me.ready() {
+		me.taskbl.SetProgressValue(me.wnd.Hwnd(), me.progDone, me.progTotal)
+	}
+}
+
+// Sets the flags of the given thumbnail toolbar button, like THBF_DISABLED or
+// THBF_HIDDEN.
+func (me *_Taskbar) SetThumbButtonFlags(index int, flags shellco.THBF) {
+	me.buttons[index].DwFlags = flags
+	me.updateButtons()
+}
+
+// Sets the icon of the given thumbnail toolbar button, which is not destroyed
+// by the taskbar.
+func (me *_Taskbar) SetThumbButtonIcon(index int, hIcon win.HICON) {
+	me.buttons[index].HIcon = hIcon
+	me.updateButtons()
+}
+
+// Sets the tooltip of the given thumbnail toolbar button.
+func (me *_Taskbar) SetThumbButtonTooltip(index int, tooltip string) {
+	me.buttons[index].SetSzTip(tooltip)
+	me.updateButtons()
+}
+
+// Sets the tooltip of the thumbnail shown when hovering the taskbar button.
+// Pass an empty string to show the window title.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-itaskbarlist3-setthumbnailtooltip
+func (me *_Taskbar) SetThumbnailTooltip(tooltip string) {
+	me.tooltip = tooltip
+	if me.ready() {
+		me.taskbl.SetThumbnailTooltip(me.wnd.Hwnd(), me.tooltip)
+	}
+}
+
+// Marks the taskbar as used, and creates the ITaskbarList3 if the button
+// already exists, applying the whole stored state. Returns true if the
+// ITaskbarList3 already existed, so the caller must apply its own change.
+//
+// Nothing is created if the button doesn't exist yet, or if COM fails, like
+// when CoInitializeEx() was not called.
+func (me *_Taskbar) ready() bool {
+	me.used = true
+	if me.taskbl == nil && me.btnCreated {
+		obj, err := com.CoCreateInstanceErr(
+			shellco.CLSID_TaskbarList, nil,
+			comco.CLSCTX_INPROC_SERVER,
+			shellco.IID_ITaskbarList3)
+		if err != nil {
+			return false // the stored state is kept, and tried again on next call
+		}
+		me.taskbl = shell.NewITaskbarList3(obj)
+		me.taskbl.HrInit()
+		me.applyAll() // includes the change being made by the caller
+		return false
+	}
+	return me.taskbl != nil
+}
+
+func (me *_Taskbar) applyAll() {
+	hWnd := me.wnd.Hwnd()
+
+	if me.progState != shellco.TBPF_NOPROGRESS &&
+		me.progState != shellco.TBPF_INDETERMINATE {
+
+		me.taskbl.SetProgressValue(hWnd, me.progDone, me.progTotal)
+	}
+	me.taskbl.SetProgressState(hWnd, me.progState)
+
+	if me.hOverlay != 0 {
+		me.taskbl.SetOverlayIcon(hWnd, me.hOverlay, me.overlayDescr)
+	}
+	if me.tooltip != "" {
+		me.taskbl.SetThumbnailTooltip(hWnd, me.tooltip)
+	}
+
+	if len(me.buttons) > 0 {
+		me.taskbl.ThumbBarAddButtons(hWnd, me.buttons)
+		me.buttonsAdded = true
+	}
+}
+
+func (me *_Taskbar) updateButtons() {
+	if me.taskbl != nil && me.buttonsAdded {
+		me.taskbl.ThumbBarUpdateButtons(me.wnd.Hwnd(), me.buttons)
+	}
+}
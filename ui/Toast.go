@@ -0,0 +1,316 @@
+//go:build windows
+
+package ui
+
+import (
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/autom"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comco"
+	"github.com/rodrigocfd/windigo/win/com/shell"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/winrt"
+	"github.com/rodrigocfd/windigo/win/com/winrt/winrtco"
+	"github.com/rodrigocfd/windigo/win/toast"
+)
+
+// Command line argument passed by COM when the application is launched by a
+// click on a toast.
+const _TOAST_ACTIVATED_ARG = "-ToastActivated"
+
+type _ToastT struct{}
+
+// Displays toast notifications, built with the toast package, and receives
+// their activations through a COM server implemented in Go, so clicks are
+// delivered even if the application was closed and relaunched by the click.
+//
+// The methods are high-level wrappers to shell.INotificationActivationCallback
+// and the winrt toast notification classes.
+//
+// Depends of CoInitializeEx().
+var Toast _ToastT
+
+var _globalToast struct {
+	mutex   sync.Mutex
+	appId   string
+	factory com.IClassFactory
+	cookie  uint32
+}
+
+// Registers the application to display toasts:
+//
+//   - sets the AppUserModelID of the process;
+//   - creates a Start Menu shortcut with the AppUserModelID and the activator
+//     CLSID, which is required by the system;
+//   - registers the executable as the COM local server of the activator CLSID,
+//     under HKEY_CURRENT_USER;
+//   - registers the class factory of the activator, which calls OnActivate.
+//
+// Must be called at each application start, and before the main window runs,
+// specially when LaunchedByToast() is true.
+//
+// The thread in which OnActivate runs depends on how the thread which called
+// Register() initialized COM. With COINIT_APARTMENTTHREADED, the usual for UI
+// applications, it runs in that same thread, dispatched by its message loop.
+// With COINIT_MULTITHREADED, it runs in an arbitrary COM thread, so it must
+// use RunUiThread() of the main window to update the UI.
+//
+// Panics if AppId, ActivatorClsid or DisplayName are not set.
+//
+// Example:
+//
+//	ui.Toast.Register(ui.ToastOpts().
+//		AppId("MyCompany.MyApp").
+//		ActivatorClsid("0d9d5b6a-1d2b-4c84-9b4c-3ffb1c2a5f21").
+//		DisplayName("My App").
+//		OnActivate(func(args string, inputs map[string]string) {
+//			println(args, inputs["reply"])
+//		}),
+//	)
+//	defer ui.Toast.Unregister()
+func (_ToastT) Register(opts *_ToastO) error {
+	if opts.appId == "" || opts.clsid == "" || opts.displayName == "" {
+		panic("Toast AppId, ActivatorClsid and DisplayName must be set.")
+	}
+
+	_globalToast.mutex.Lock()
+	defer _globalToast.mutex.Unlock()
+
+	if _globalToast.factory != nil {
+		panic("Toast is already registered.")
+	}
+
+	win.SetCurrentProcessExplicitAppUserModelID(opts.appId)
+
+	exePath := win.HINSTANCE(0).GetModuleFileName()
+	if err := _ToastCreateShortcut(exePath, opts); err != nil {
+		return err
+	}
+
+	command16 := win.Str.ToNativeSlice(
+		"\"" + exePath + "\" " + _TOAST_ACTIVATED_ARG)
+	err := win.HKEY_CURRENT_USER.RegSetKeyValue(
+		"Software\\Classes\\CLSID\\{"+string(opts.clsid)+"}\\LocalServer32", "",
+		co.REG_SZ, unsafe.Pointer(&command16[0]),
+		uint32(len(command16)*2)) // size in bytes, including terminating null
+	runtime.KeepAlive(command16)
+	if err != nil {
+		return err
+	}
+
+	onActivate := opts.onActivate
+	factory := com.NewIClassFactoryImpl()
+	factory.OnCreateInstance(func() com.IUnknown {
+		callback := shell.NewINotificationActivationCallbackImpl()
+		callback.OnActivate(
+			func(_, invokedArgs string, inputs map[string]string) {
+				if onActivate != nil {
+					onActivate(invokedArgs, inputs)
+				}
+			})
+		return callback
+	})
+
+	cookie, err := com.CoRegisterClassObject(opts.clsid, factory,
+		comco.CLSCTX_LOCAL_SERVER, comco.REGCLS_MULTIPLEUSE)
+	if err != nil {
+		factory.Release()
+		return err
+	}
+
+	_globalToast.appId = opts.appId
+	_globalToast.factory = factory
+	_globalToast.cookie = cookie
+	return nil
+}
+
+// Revokes the class factory registered by Register(). The shortcut and the
+// registry entries are kept, so the system can relaunch the application when a
+// toast is clicked.
+func (_ToastT) Unregister() error {
+	_globalToast.mutex.Lock()
+	defer _globalToast.mutex.Unlock()
+
+	if _globalToast.factory == nil {
+		return nil
+	}
+
+	err := com.CoRevokeClassObject(_globalToast.cookie)
+	_globalToast.factory.Release()
+	_globalToast.factory = nil
+	_globalToast.cookie = 0
+	_globalToast.appId = ""
+	return err
+}
+
+// Displays the toast. Its content is validated, and if it's invalid, the
+// returned error wraps toast.ErrInvalid.
+//
+// Panics if Register() was not called.
+//
+// Example:
+//
+//	ui.Toast.Show(&toast.Toast{
+//		Launch: "action=open",
+//		Texts:  []toast.Text{{Text: "Download finished"}},
+//		Actions: []toast.Action{
+//			{Content: "Open folder", Arguments: "action=folder"},
+//		},
+//	})
+func (_ToastT) Show(t *toast.Toast) error {
+	xml, err := t.Xml()
+	if err != nil {
+		return err
+	}
+
+	_globalToast.mutex.Lock()
+	appId := _globalToast.appId
+	_globalToast.mutex.Unlock()
+	if appId == "" {
+		panic("Toast.Register() must be called before Toast.Show().")
+	}
+
+	unkManager, err := winrt.RoGetActivationFactory(
+		winrtco.RUNTIMECLASS_ToastNotificationManager,
+		winrtco.IID_IToastNotificationManagerStatics)
+	if err != nil {
+		return err
+	}
+	manager := winrt.NewIToastNotificationManagerStatics(unkManager)
+	defer manager.Release()
+
+	notifier, err := manager.CreateToastNotifierWithId(appId)
+	if err != nil {
+		return err
+	}
+	defer notifier.Release()
+
+	inspDoc, err := winrt.RoActivateInstance(winrtco.RUNTIMECLASS_XmlDocument)
+	if err != nil {
+		return err
+	}
+	defer inspDoc.Release()
+
+	docIo := winrt.NewIXmlDocumentIO(inspDoc.QueryInterface(winrtco.IID_IXmlDocumentIO))
+	defer docIo.Release()
+	if err := docIo.LoadXml(xml); err != nil {
+		return err
+	}
+
+	doc := winrt.NewIXmlDocument(inspDoc.QueryInterface(winrtco.IID_IXmlDocument))
+	defer doc.Release()
+
+	unkFactory, err := winrt.RoGetActivationFactory(
+		winrtco.RUNTIMECLASS_ToastNotification,
+		winrtco.IID_IToastNotificationFactory)
+	if err != nil {
+		return err
+	}
+	factory := winrt.NewIToastNotificationFactory(unkFactory)
+	defer factory.Release()
+
+	notification, err := factory.CreateToastNotification(doc)
+	if err != nil {
+		return err
+	}
+	defer notification.Release()
+
+	return notifier.Show(notification)
+}
+
+// Tells whether the application was launched by COM because the user clicked a
+// toast while the application was not running. In this case, Register() must
+// still be called, and OnActivate will be called shortly after.
+func (_ToastT) LaunchedByToast() bool {
+	for _, arg := range os.Args[1:] {
+		if strings.EqualFold(arg, _TOAST_ACTIVATED_ARG) ||
+			strings.EqualFold(arg, "-Embedding") {
+			return true
+		}
+	}
+	return false
+}
+
+// Creates or overwrites the Start Menu shortcut which binds the AppUserModelID
+// and the activator CLSID to the executable.
+func _ToastCreateShortcut(exePath string, opts *_ToastO) error {
+	programs, err := shell.SHGetKnownFolderPath(shellco.FOLDERID_Programs,
+		shellco.KF_FLAG_DEFAULT, win.HACCESSTOKEN(0))
+	if err != nil {
+		return err
+	}
+
+	link := shell.NewIShellLink(
+		com.CoCreateInstance(
+			shellco.CLSID_ShellLink, nil,
+			comco.CLSCTX_INPROC_SERVER,
+			shellco.IID_IShellLink),
+	)
+	defer link.Release()
+
+	link.SetPath(exePath)
+	link.SetWorkingDirectory(win.Path.GetPath(exePath))
+
+	props := shell.NewIPropertyStore(link.QueryInterface(shellco.IID_IPropertyStore))
+	defer props.Release()
+
+	pvAppId := autom.NewPropVariantStr(opts.appId)
+	defer pvAppId.PropVariantClear()
+	props.SetValue(shellco.PKEY_AppUserModel_ID, &pvAppId)
+
+	pvClsid := autom.NewPropVariantClsid(opts.clsid)
+	defer pvClsid.PropVariantClear()
+	props.SetValue(shellco.PKEY_AppUserModel_ToastActivatorCLSID, &pvClsid)
+
+	props.Commit()
+
+	persist := com.NewIPersistFile(link.QueryInterface(comco.IID_IPersistFile))
+	defer persist.Release()
+
+	return persist.Save(
+		win.StrOptSome(programs+"\\"+opts.displayName+".lnk"), true)
+}
+
+//------------------------------------------------------------------------------
+
+type _ToastO struct {
+	appId       string
+	clsid       co.CLSID
+	displayName string
+	onActivate  func(args string, inputs map[string]string)
+}
+
+// Options for Toast.Register().
+func ToastOpts() *_ToastO {
+	return &_ToastO{}
+}
+
+// AppUserModelID of the application, like "MyCompany.MyApp".
+// Must be set.
+func (o *_ToastO) AppId(i string) *_ToastO { o.appId = i; return o }
+
+// CLSID of the toast activator COM server, in the format
+// "00000000-0000-0000-0000-000000000000". It must be unique to the
+// application, and never change.
+// Must be set.
+func (o *_ToastO) ActivatorClsid(c co.CLSID) *_ToastO { o.clsid = c; return o }
+
+// Name of the Start Menu shortcut, which is shown as the toast source.
+// Must be set.
+func (o *_ToastO) DisplayName(n string) *_ToastO { o.displayName = n; return o }
+
+// Called when the user clicks the toast or one of its buttons, receiving the
+// arguments of the toast or button, and the values of the toast inputs, keyed
+// by their IDs. See Toast.Register() for the thread in which it runs.
+// Defaults to none.
+func (o *_ToastO) OnActivate(fun func(args string, inputs map[string]string)) *_ToastO {
+	o.onActivate = fun
+	return o
+}
@@ -0,0 +1,433 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Icon in the system tray (notification area) of the taskbar, attached to a
+// parent window.
+//
+// The icon is added when the parent is created, re-added if Explorer restarts,
+// and removed when the parent is destroyed.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/shell/notification-area
+type TrayIcon interface {
+	implTrayIcon() // prevent public implementation
+
+	// Exposes all the TrayIcon notifications the can be handled.
+	//
+	// Panics if called after the parent window was created.
+	On() *_TrayIconEvents
+
+	// Returns the parent window of this tray icon.
+	Parent() AnyParent
+
+	// Cycles through the given icons, starting immediately. Any previous
+	// animation is stopped.
+	//
+	// The icons are not destroyed by the TrayIcon.
+	Animate(frames []win.HICON, msInterval int)
+
+	// Sets the popup menu shown when the icon is right-clicked. The commands
+	// are sent to the parent window as WM_COMMAND.
+	//
+	// The menu is not destroyed by the TrayIcon.
+	SetContextMenu(hMenu win.HMENU)
+
+	// Sets the icon, which is not destroyed by the TrayIcon.
+	SetIcon(hIcon win.HICON)
+
+	// Sets the tooltip text.
+	SetTip(tip string)
+
+	// Shows or hides the icon, without removing it.
+	SetVisible(visible bool)
+
+	// Shows a balloon notification with one of the system icons: NIIF_NONE,
+	// NIIF_INFO, NIIF_WARNING or NIIF_ERROR, optionally combined with
+	// NIIF_NOSOUND or NIIF_RESPECT_QUIET_TIME.
+	ShowBalloon(title, text string, flags co.NIIF)
+
+	// Shows a balloon notification with a custom icon, which is not destroyed
+	// by the TrayIcon.
+	ShowBalloonIcon(title, text string, hIcon win.HICON, flags co.NIIF)
+
+	// Stops the animation started with Animate(), restoring the icon.
+	StopAnimation()
+}
+
+//------------------------------------------------------------------------------
+
+type _TrayIcon struct {
+	parent      AnyParent
+	opts        *_TrayIconO
+	uId         uint32
+	callbackMsg co.WM
+	isAdded     bool
+	animFrames  []win.HICON
+	animIdx     int
+	animTimerId uintptr
+	events      _TrayIconEvents
+}
+
+// Creates a new TrayIcon, attached to the given window. Call TrayIconOpts()
+// to define the options to be passed to the underlying Shell_NotifyIcon.
+//
+// Example:
+//
+//	var wnd ui.WindowMain // initialized somewhere
+//
+//	tray := ui.NewTrayIcon(wnd,
+//		ui.TrayIconOpts().
+//			HIcon(win.HINSTANCE(0).LoadIcon(win.IconResIdi(co.IDI_APPLICATION))).
+//			Tip("My application"),
+//	)
+//	tray.On().NinSelect(func(_ win.POINT) {
+//		wnd.Hwnd().ShowWindow(co.SW_RESTORE)
+//	})
+func NewTrayIcon(parent AnyParent, opts *_TrayIconO) TrayIcon {
+	if opts == nil {
+		opts = TrayIconOpts()
+	}
+
+	me := &_TrayIcon{}
+	me.parent = parent
+	me.opts = opts
+	me.uId = uint32(_NextCtrlId())
+	me.callbackMsg = _NextTrayIconMsg()
+	me.isAdded = false
+	me.animFrames = nil
+	me.animIdx = 0
+	me.animTimerId = 0
+	me.events.new()
+
+	wmTaskbarCreated, err := win.RegisterWindowMessage("TaskbarCreated")
+	if err != nil {
+		panic(err)
+	}
+
+	parent.internalOn().addMsgZero(_CreateOrInitDialog(parent), func(_ wm.Any) {
+		me.add()
+	})
+
+	parent.internalOn().addMsgZero(wmTaskbarCreated, func(_ wm.Any) {
+		me.isAdded = false // Explorer restarted, all icons are gone
+		me.add()
+	})
+
+	parent.internalOn().addMsgZero(me.callbackMsg, func(p wm.Any) {
+		me.processCallback(p)
+	})
+
+	parent.internalOn().addMsgZero(co.WM_DESTROY, func(_ wm.Any) {
+		me.StopAnimation()
+		if me.isAdded {
+			nid := me.newNid(0)
+			win.ShellNotifyIcon(co.NIM_DELETE, &nid)
+			me.isAdded = false
+		}
+	})
+
+	return me
+}
+
+// Implements TrayIcon.
+func (*_TrayIcon) implTrayIcon() {}
+
+func (me *_TrayIcon) On() *_TrayIconEvents {
+	if me.parent.Hwnd() != 0 {
+		panic("Cannot add event handling after the TrayIcon is created.")
+	}
+	return &me.events
+}
+
+func (me *_TrayIcon) Parent() AnyParent {
+	return me.parent
+}
+
+func (me *_TrayIcon) Animate(frames []win.HICON, msInterval int) {
+	me.StopAnimation()
+	if len(frames) == 0 {
+		return
+	}
+
+	me.animFrames = append([]win.HICON{}, frames...) // keep our own copy
+	me.animIdx = 0
+	me.modifyIcon(me.animFrames[0])
+
+	me.animTimerId = me.parent.Hwnd().SetTimerCallback(msInterval, func(_ uintptr) {
+		me.animIdx = (me.animIdx + 1) % len(me.animFrames)
+		me.modifyIcon(me.animFrames[me.animIdx])
+	})
+}
+
+func (me *_TrayIcon) SetContextMenu(hMenu win.HMENU) {
+	me.opts.hMenu = hMenu
+}
+
+func (me *_TrayIcon) SetIcon(hIcon win.HICON) {
+	me.opts.hIcon = hIcon
+	if me.animTimerId == 0 { // when animating, the icon will be set when it stops
+		me.modifyIcon(hIcon)
+	}
+}
+
+func (me *_TrayIcon) SetTip(tip string) {
+	me.opts.tip = tip
+	nid := me.newNid(co.NIF_TIP)
+	me.putTip(&nid)
+	me.modify(&nid)
+}
+
+func (me *_TrayIcon) SetVisible(visible bool) {
+	me.opts.visible = visible
+	nid := me.newNid(co.NIF_STATE)
+	me.putState(&nid)
+	me.modify(&nid)
+}
+
+func (me *_TrayIcon) ShowBalloon(title, text string, flags co.NIIF) {
+	nid := me.newNid(co.NIF_INFO)
+	nid.SetSzInfoTitle(title)
+	nid.SetSzInfo(text)
+	nid.DwInfoFlags = flags
+	me.modify(&nid)
+}
+
+func (me *_TrayIcon) ShowBalloonIcon(
+	title, text string, hIcon win.HICON, flags co.NIIF) {
+
+	nid := me.newNid(co.NIF_INFO)
+	nid.SetSzInfoTitle(title)
+	nid.SetSzInfo(text)
+	nid.DwInfoFlags = co.NIIF_USER | flags
+	nid.HBalloonIcon = hIcon
+	me.modify(&nid)
+}
+
+func (me *_TrayIcon) StopAnimation() {
+	if me.animTimerId != 0 {
+		me.parent.Hwnd().KillTimer(me.animTimerId)
+		me.animTimerId = 0
+		me.animFrames = nil
+		me.modifyIcon(me.opts.hIcon)
+	}
+}
+
+// Builds a NOTIFYICONDATA with the identity of this icon.
+func (me *_TrayIcon) newNid(flags co.NIF) win.NOTIFYICONDATA {
+	nid := win.NOTIFYICONDATA{}
+	nid.SetCbSize()
+	nid.Hwnd = me.parent.Hwnd()
+	nid.UID = me.uId
+	nid.UFlags = flags
+
+	if me.opts.guid != "" {
+		nid.UFlags |= co.NIF_GUID
+		nid.GuidItem = *win.GuidFromClsid(co.CLSID(me.opts.guid))
+	}
+	return nid
+}
+
+func (me *_TrayIcon) putTip(nid *win.NOTIFYICONDATA) {
+	nid.SetSzTip(me.opts.tip)
+	if me.opts.tip != "" {
+		nid.UFlags |= co.NIF_SHOWTIP // otherwise NIN_POPUPOPEN is sent
+	}
+}
+
+func (me *_TrayIcon) putState(nid *win.NOTIFYICONDATA) {
+	nid.DwStateMask = co.NIS_HIDDEN
+	if me.opts.visible {
+		nid.DwState = 0
+	} else {
+		nid.DwState = co.NIS_HIDDEN
+	}
+}
+
+func (me *_TrayIcon) add() {
+	nid := me.newNid(co.NIF_MESSAGE | co.NIF_ICON | co.NIF_TIP | co.NIF_STATE)
+	nid.UCallbackMessage = me.callbackMsg
+	nid.HIcon = me.opts.hIcon
+	me.putTip(&nid)
+	me.putState(&nid)
+
+	if err := win.ShellNotifyIcon(co.NIM_ADD, &nid); err != nil {
+		if me.opts.guid == "" {
+			return // taskbar not available yet, wait for TaskbarCreated
+		}
+
+		// A GUID icon may be left behind by a previous instance which didn't
+		// exit cleanly, so we remove it and try once more.
+		delNid := me.newNid(0)
+		win.ShellNotifyIcon(co.NIM_DELETE, &delNid)
+		if err := win.ShellNotifyIcon(co.NIM_ADD, &nid); err != nil {
+			return
+		}
+	}
+	me.isAdded = true
+
+	verNid := me.newNid(0)
+	verNid.UTimeoutVersion = 4 // NOTIFYICON_VERSION_4
+	win.ShellNotifyIcon(co.NIM_SETVERSION, &verNid)
+}
+
+func (me *_TrayIcon) modify(nid *win.NOTIFYICONDATA) {
+	if me.isAdded {
+		win.ShellNotifyIcon(co.NIM_MODIFY, nid)
+	}
+}
+
+func (me *_TrayIcon) modifyIcon(hIcon win.HICON) {
+	nid := me.newNid(co.NIF_ICON)
+	nid.HIcon = hIcon
+	me.modify(&nid)
+}
+
+func (me *_TrayIcon) processCallback(p wm.Any) {
+	event := co.NIN(p.LParam.LoWord())
+	pt := win.POINT{ // anchor coordinates, relative to screen
+		X: int32(int16(p.WParam.LoWord())),
+		Y: int32(int16(p.WParam.HiWord())),
+	}
+
+	if userFunc, has := me.events.handlers[event]; has {
+		userFunc(pt)
+	}
+
+	if event == co.NIN(co.WM_CONTEXTMENU) && me.opts.hMenu != 0 {
+		hParent := me.parent.Hwnd()
+		hParent.SetForegroundWindow() // so the menu is closed when clicking outside
+		me.opts.hMenu.TrackPopupMenu(co.TPM_RIGHTBUTTON, pt.X, pt.Y, hParent)
+		hParent.PostMessage(co.WM_NULL, 0, 0) // necessary according to TrackMenuPopup docs
+	}
+}
+
+//------------------------------------------------------------------------------
+
+type _TrayIconO struct {
+	guid    string
+	hIcon   win.HICON
+	tip     string
+	hMenu   win.HMENU
+	visible bool
+}
+
+// Options for NewTrayIcon().
+func TrayIconOpts() *_TrayIconO {
+	return &_TrayIconO{
+		visible: true,
+	}
+}
+
+// GUID which identifies the icon across sessions, in the format
+// "00000000-0000-0000-0000-000000000000". Note that the GUID is bound to the
+// path of the executable.
+// Defaults to none, the icon is identified by the parent window.
+func (o *_TrayIconO) Guid(g string) *_TrayIconO { o.guid = g; return o }
+
+// Icon to be displayed, which is not destroyed by the TrayIcon.
+// Defaults to none.
+func (o *_TrayIconO) HIcon(h win.HICON) *_TrayIconO { o.hIcon = h; return o }
+
+// Tooltip text. If empty, NIN_POPUPOPEN and NIN_POPUPCLOSE are sent instead.
+// Defaults to empty string.
+func (o *_TrayIconO) Tip(t string) *_TrayIconO { o.tip = t; return o }
+
+// Popup menu shown when the icon is right-clicked, which is not destroyed by
+// the TrayIcon.
+// Defaults to none.
+func (o *_TrayIconO) ContextMenu(h win.HMENU) *_TrayIconO { o.hMenu = h; return o }
+
+// Whether the icon is initially visible.
+// Defaults to true.
+func (o *_TrayIconO) Visible(v bool) *_TrayIconO { o.visible = v; return o }
+
+//------------------------------------------------------------------------------
+
+// TrayIcon notifications, sent with NOTIFYICON_VERSION_4. The point is the
+// anchor position of the icon, relative to screen.
+type _TrayIconEvents struct {
+	handlers map[co.NIN]func(pt win.POINT)
+}
+
+func (me *_TrayIconEvents) new() {
+	me.handlers = make(map[co.NIN]func(pt win.POINT), 5) // arbitrary
+}
+
+// Icon was left-clicked, or selected with the keyboard spacebar.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func (me *_TrayIconEvents) NinSelect(userFunc func(pt win.POINT)) {
+	me.handlers[co.NIN_SELECT] = userFunc
+}
+
+// Icon was selected with the keyboard Enter key.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func (me *_TrayIconEvents) NinKeySelect(userFunc func(pt win.POINT)) {
+	me.handlers[co.NIN_KEYSELECT] = userFunc
+}
+
+// Icon was double-clicked with the left button.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/inputdev/wm-lbuttondblclk
+func (me *_TrayIconEvents) WmLButtonDblClk(userFunc func(pt win.POINT)) {
+	me.handlers[co.NIN(co.WM_LBUTTONDBLCLK)] = userFunc
+}
+
+// Icon was right-clicked, or Shift+F10 was pressed. If a context menu was set,
+// it is shown right after this handler returns.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/menurc/wm-contextmenu
+func (me *_TrayIconEvents) WmContextMenu(userFunc func(pt win.POINT)) {
+	me.handlers[co.NIN(co.WM_CONTEXTMENU)] = userFunc
+}
+
+// Balloon notification was shown.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func (me *_TrayIconEvents) NinBalloonShow(userFunc func()) {
+	me.handlers[co.NIN_BALLOONSHOW] = func(_ win.POINT) { userFunc() }
+}
+
+// Balloon notification was dismissed, either by the user or because the icon
+// was removed.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func (me *_TrayIconEvents) NinBalloonHide(userFunc func()) {
+	me.handlers[co.NIN_BALLOONHIDE] = func(_ win.POINT) { userFunc() }
+}
+
+// Balloon notification was dismissed because of a timeout.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func (me *_TrayIconEvents) NinBalloonTimeout(userFunc func()) {
+	me.handlers[co.NIN_BALLOONTIMEOUT] = func(_ win.POINT) { userFunc() }
+}
+
+// Balloon notification was clicked.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func (me *_TrayIconEvents) NinBalloonUserClick(userFunc func()) {
+	me.handlers[co.NIN_BALLOONUSERCLICK] = func(_ win.POINT) { userFunc() }
+}
+
+// Mouse hovered the icon, and a custom popup UI should be shown instead of the
+// tooltip. Only sent if the tooltip text is empty.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func (me *_TrayIconEvents) NinPopupOpen(userFunc func(pt win.POINT)) {
+	me.handlers[co.NIN_POPUPOPEN] = userFunc
+}
+
+// Custom popup UI should be closed.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
+func (me *_TrayIconEvents) NinPopupClose(userFunc func()) {
+	me.handlers[co.NIN_POPUPCLOSE] = func(_ win.POINT) { userFunc() }
+}
@@ -0,0 +1,453 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/d2d1"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
+)
+
+// User-custom child control, drawn with Direct2D.
+//
+// The render target is created on the first paint, resized along with the
+// control and adjusted when the DPI changes. If the device is lost, the render
+// target is discarded and created again on the next paint.
+type WindowCustomControl interface {
+	WindowControl
+	AnyFocusControl
+	AnyTextControl
+	implWindowCustomControl() // prevent public implementation
+
+	CaptureMouse()         // Captures the mouse with SetCapture().
+	HasFocus() bool        // Tells whether the control has the keyboard focus.
+	HasMouseCapture() bool // Tells whether the control has the mouse capture.
+	Invalidate()           // Marks the whole client area to be repainted.
+	IsHovered() bool       // Tells whether the mouse is currently over the control.
+	ReleaseMouse()         // Releases the mouse capture with ReleaseCapture().
+
+	// Marks a rectangle of the client area, in pixels, to be repainted.
+	InvalidateRect(rc win.RECT)
+
+	// Calls NotifyWinEvent() for the client area of the control, so that
+	// accessibility clients are notified of changes made to its content.
+	//
+	// WINEVENT_OBJECT_FOCUS is automatically sent when the control receives
+	// the focus, and WINEVENT_OBJECT_NAMECHANGE when SetText() is called. The
+	// control text is used as its accessible name.
+	NotifyAccessibility(event co.WINEVENT)
+
+	// Defines the function called after the render target is discarded due to
+	// a device loss, so that the device-dependent resources, like brushes and
+	// bitmaps, can be released and created again.
+	//
+	// Cannot be called after the control was created.
+	OnDiscardResources(userFunc func())
+
+	// Defines the function called to draw the control contents, between
+	// BeginDraw() and EndDraw() calls. Coordinates are in device-independent
+	// pixels.
+	//
+	// Cannot be called after the control was created.
+	OnRender(userFunc func(rt d2d1.ID2D1RenderTarget))
+
+	// Retrieves a color from the visual style class defined in the options; if
+	// no visual style is active, returns the system color fallback.
+	ThemeColor(partStateId co.VS, propId co.TMT_COLOR,
+		fallback co.COLOR) win.COLORREF
+}
+
+//------------------------------------------------------------------------------
+
+type _WindowCustomControl struct {
+	_WindowRawControl
+	customOpts         *_WindowCustomControlO
+	renderTarget       d2d1.ID2D1HwndRenderTarget
+	dpi                uint32 // set on WM_CREATE and WM_DPICHANGED_AFTERPARENT
+	hTheme             win.HTHEME
+	isHovered          bool
+	onRender           func(rt d2d1.ID2D1RenderTarget)
+	onDiscardResources func()
+}
+
+// Creates a new WindowCustomControl. Call WindowCustomControlOpts() to define
+// the options to be passed to the underlying CreateWindowEx().
+//
+// Example:
+//
+//	var owner AnyParent // initialized somewhere
+//
+//	myControl := ui.NewWindowCustomControl(
+//		owner,
+//		ui.WindowCustomControlOpts().
+//			Position(win.POINT{X: 100, Y: 100}).
+//			Size(win.SIZE{Cx: 300, Cy: 200}),
+//	)
+//
+//	var brush d2d1.ID2D1SolidColorBrush
+//
+//	myControl.OnRender(func(rt d2d1.ID2D1RenderTarget) {
+//		if brush == nil {
+//			brush = rt.CreateSolidColorBrush(
+//				&d2d1.COLOR_F{R: 0.2, G: 0.4, B: 0.8, A: 1}, nil)
+//		}
+//		bkgnd := d2d1.ColorFFromColorref(win.GetSysColor(co.COLOR_WINDOW))
+//		rt.Clear(&bkgnd)
+//		rt.FillEllipse(&d2d1.ELLIPSE{
+//			Point: d2d1.POINT_2F{X: 50, Y: 50}, RadiusX: 40, RadiusY: 40,
+//		}, brush)
+//	})
+//	myControl.OnDiscardResources(func() {
+//		brush.Release()
+//		brush = nil
+//	})
+func NewWindowCustomControl(
+	parent AnyParent, opts *_WindowCustomControlO) WindowCustomControl {
+
+	if opts == nil {
+		opts = WindowCustomControlOpts()
+	}
+	opts.lateDefaults()
+
+	me := &_WindowCustomControl{}
+	me._WindowRawControl.new(parent, &_WindowControlO{
+		ctrlId:      opts.ctrlId,
+		className:   opts.className,
+		classStyles: opts.classStyles,
+		hCursor:     opts.hCursor,
+		hBrushBkgnd: win.HBRUSH(0), // background is painted by Direct2D
+		wndStyles:   opts.wndStyles,
+		wndExStyles: opts.wndExStyles,
+		position:    opts.position,
+		size:        opts.size,
+		horz:        opts.horz,
+		vert:        opts.vert,
+	})
+	me.customOpts = opts
+
+	me.defaultMessages()
+	return me
+}
+
+// Implements WindowCustomControl.
+func (*_WindowCustomControl) implWindowCustomControl() {}
+
+// Implements AnyFocusControl.
+func (me *_WindowCustomControl) Focus() {
+	me.parent.Hwnd().SendMessage(co.WM_NEXTDLGCTL, win.WPARAM(me.Hwnd()), 1)
+}
+
+// Implements AnyTextControl.
+func (me *_WindowCustomControl) SetText(text string) {
+	me.Hwnd().SetWindowText(text)
+	me.NotifyAccessibility(co.WINEVENT_OBJECT_NAMECHANGE)
+}
+
+// Implements AnyTextControl.
+func (me *_WindowCustomControl) Text() string {
+	return me.Hwnd().GetWindowText()
+}
+
+func (me *_WindowCustomControl) CaptureMouse() {
+	me.Hwnd().SetCapture()
+}
+
+func (me *_WindowCustomControl) HasFocus() bool {
+	return win.GetFocus() == me.Hwnd()
+}
+
+func (me *_WindowCustomControl) HasMouseCapture() bool {
+	return win.GetCapture() == me.Hwnd()
+}
+
+func (me *_WindowCustomControl) Invalidate() {
+	me.Hwnd().InvalidateRect(nil, false)
+}
+
+func (me *_WindowCustomControl) InvalidateRect(rc win.RECT) {
+	me.Hwnd().InvalidateRect(&rc, false)
+}
+
+func (me *_WindowCustomControl) IsHovered() bool {
+	return me.isHovered
+}
+
+func (me *_WindowCustomControl) NotifyAccessibility(event co.WINEVENT) {
+	win.NotifyWinEvent(event, me.Hwnd(), co.OBJID_CLIENT, 0) // CHILDID_SELF
+}
+
+func (me *_WindowCustomControl) OnDiscardResources(userFunc func()) {
+	if me.Hwnd() != 0 {
+		panic("Cannot add event handling after the WindowCustomControl is created.")
+	}
+	me.onDiscardResources = userFunc
+}
+
+func (me *_WindowCustomControl) OnRender(
+	userFunc func(rt d2d1.ID2D1RenderTarget)) {
+
+	if me.Hwnd() != 0 {
+		panic("Cannot add event handling after the WindowCustomControl is created.")
+	}
+	me.onRender = userFunc
+}
+
+func (me *_WindowCustomControl) ReleaseMouse() {
+	win.ReleaseCapture()
+}
+
+func (me *_WindowCustomControl) ThemeColor(
+	partStateId co.VS, propId co.TMT_COLOR, fallback co.COLOR) win.COLORREF {
+
+	if me.hTheme == 0 {
+		return win.GetSysColor(fallback)
+	}
+	return me.hTheme.GetThemeColor(partStateId, propId)
+}
+
+func (me *_WindowCustomControl) defaultMessages() {
+	me.internalOn().addMsgZero(co.WM_CREATE, func(_ wm.Any) {
+		me.dpi = me.Hwnd().GetDpiForWindow()
+		me.openTheme()
+	})
+
+	me.internalOn().addMsgZero(co.WM_PAINT, func(_ wm.Any) {
+		me.render()
+	})
+
+	me.internalOn().addMsgZero(co.WM_SIZE, func(p wm.Any) {
+		if me.renderTarget != nil {
+			sz := wm.Size{Msg: p}.ClientAreaSize()
+			me.renderTarget.Resize(d2d1.SIZE_U{
+				Width:  uint32(sz.Cx),
+				Height: uint32(sz.Cy),
+			})
+		}
+	})
+
+	me.internalOn().addMsgZero(co.WM_DPICHANGED_AFTERPARENT, func(_ wm.Any) {
+		me.dpi = me.Hwnd().GetDpiForWindow()
+		if me.renderTarget != nil {
+			me.renderTarget.SetDpi(float32(me.dpi), float32(me.dpi))
+		}
+		me.Invalidate()
+	})
+
+	me.internalOn().addMsgZero(co.WM_MOUSEMOVE, func(_ wm.Any) {
+		if !me.isHovered {
+			tme := win.TRACKMOUSEEVENT{
+				DwFlags:   co.TME_LEAVE,
+				HwndTrack: me.Hwnd(),
+			}
+			tme.SetCbSize()
+			win.TrackMouseEvent(&tme) // WM_MOUSELEAVE will be posted once
+			me.isHovered = true
+			me.Invalidate()
+		}
+	})
+
+	me.internalOn().addMsgZero(co.WM_MOUSELEAVE, func(_ wm.Any) {
+		me.isHovered = false
+		me.Invalidate()
+	})
+
+	me.internalOn().addMsgZero(co.WM_SETFOCUS, func(_ wm.Any) {
+		me.NotifyAccessibility(co.WINEVENT_OBJECT_FOCUS)
+		me.Invalidate()
+	})
+
+	me.internalOn().addMsgZero(co.WM_KILLFOCUS, func(_ wm.Any) {
+		me.Invalidate()
+	})
+
+	me.internalOn().addMsgZero(co.WM_THEMECHANGED, func(_ wm.Any) {
+		me.closeTheme()
+		me.openTheme()
+		me.Invalidate()
+	})
+
+	me.internalOn().addMsgZero(co.WM_NCDESTROY, func(_ wm.Any) {
+		me.closeTheme()
+		me.discardRenderTarget()
+	})
+
+	me.On().WmGetDlgCode(func(_ wm.GetDlgCode) co.DLGC {
+		return me.customOpts.dlgCode // can be overwritten by the user
+	})
+}
+
+// Draws the control contents with the user function, creating the render
+// target if needed.
+func (me *_WindowCustomControl) render() {
+	if me.renderTarget == nil {
+		me.createRenderTarget()
+	}
+
+	deviceLost := false
+	if (me.renderTarget.CheckWindowState() & d2d1co.WINDOW_STATE_OCCLUDED) == 0 {
+		me.renderTarget.BeginDraw()
+		if me.onRender != nil {
+			me.onRender(me.renderTarget)
+		}
+		if _, _, err := me.renderTarget.EndDrawErr(); err != nil { // D2DERR_RECREATE_TARGET
+			deviceLost = true
+		}
+	}
+	me.Hwnd().ValidateRect(nil)
+
+	if deviceLost {
+		me.discardRenderTarget()
+		me.Invalidate() // render target will be created again on next paint
+	}
+}
+
+func (me *_WindowCustomControl) createRenderTarget() {
+	rc := me.Hwnd().GetClientRect()
+	me.renderTarget = _D2dFactory().CreateHwndRenderTarget(
+		&d2d1.RENDER_TARGET_PROPERTIES{
+			DpiX: float32(me.dpi),
+			DpiY: float32(me.dpi),
+		},
+		&d2d1.HWND_RENDER_TARGET_PROPERTIES{
+			Hwnd: me.Hwnd(),
+			PixelSize: d2d1.SIZE_U{
+				Width:  uint32(rc.Right - rc.Left),
+				Height: uint32(rc.Bottom - rc.Top),
+			},
+		})
+}
+
+func (me *_WindowCustomControl) discardRenderTarget() {
+	if me.renderTarget != nil {
+		me.renderTarget.Release()
+		me.renderTarget = nil
+		if me.onDiscardResources != nil {
+			me.onDiscardResources()
+		}
+	}
+}
+
+func (me *_WindowCustomControl) openTheme() {
+	if me.customOpts.themeClass != "" && win.IsThemeActive() && win.IsAppThemed() {
+		if hTheme, err := me.Hwnd().OpenThemeData(me.customOpts.themeClass); err == nil {
+			me.hTheme = hTheme
+		}
+	}
+}
+
+func (me *_WindowCustomControl) closeTheme() {
+	if me.hTheme != 0 {
+		me.hTheme.CloseThemeData()
+		me.hTheme = win.HTHEME(0)
+	}
+}
+
+//------------------------------------------------------------------------------
+
+type _WindowCustomControlO struct {
+	ctrlId int
+
+	className   string
+	classStyles co.CS
+	hCursor     win.HCURSOR
+
+	wndStyles   co.WS
+	wndExStyles co.WS_EX
+	position    win.POINT
+	size        win.SIZE
+	horz        HORZ
+	vert        VERT
+
+	themeClass string
+	dlgCode    co.DLGC
+}
+
+// Control ID.
+// Defaults to an auto-generated ID.
+func (o *_WindowCustomControlO) CtrlId(i int) *_WindowCustomControlO { o.ctrlId = i; return o }
+
+// Class name registered with RegisterClassEx().
+// Defaults to a computed hash.
+func (o *_WindowCustomControlO) ClassName(n string) *_WindowCustomControlO { o.className = n; return o }
+
+// Window class styles, passed to RegisterClassEx().
+// Defaults to CS_DBLCLKS | CS_HREDRAW | CS_VREDRAW.
+func (o *_WindowCustomControlO) ClassStyles(s co.CS) *_WindowCustomControlO {
+	o.classStyles = s
+	return o
+}
+
+// Window cursor, passed to RegisterClassEx().
+// Defaults to stock IDC_ARROW.
+func (o *_WindowCustomControlO) HCursor(h win.HCURSOR) *_WindowCustomControlO {
+	o.hCursor = h
+	return o
+}
+
+// Window styles, passed to CreateWindowEx().
+// Defaults to WS_CHILD | WS_TABSTOP | WS_GROUP | WS_VISIBLE | WS_CLIPCHILDREN | WS_CLIPSIBLINGS.
+func (o *_WindowCustomControlO) WndStyles(s co.WS) *_WindowCustomControlO { o.wndStyles = s; return o }
+
+// Extended window styles, passed to CreateWindowEx().
+// Defaults to WS_EX_NONE.
+func (o *_WindowCustomControlO) WndExStyles(s co.WS_EX) *_WindowCustomControlO {
+	o.wndExStyles = s
+	return o
+}
+
+// Position within parent's client area in pixels.
+// Defaults to 0x0. Will be adjusted to the current system DPI.
+func (o *_WindowCustomControlO) Position(p win.POINT) *_WindowCustomControlO {
+	_OwPt(&o.position, p)
+	return o
+}
+
+// Control size in pixels.
+// Defaults to 300x200. Will be adjusted to the current system DPI.
+func (o *_WindowCustomControlO) Size(s win.SIZE) *_WindowCustomControlO { _OwSz(&o.size, s); return o }
+
+// Horizontal behavior when the parent is resized.
+// Defaults to HORZ_NONE.
+func (o *_WindowCustomControlO) Horz(s HORZ) *_WindowCustomControlO { o.horz = s; return o }
+
+// Vertical behavior when the parent is resized.
+// Defaults to VERT_NONE.
+func (o *_WindowCustomControlO) Vert(s VERT) *_WindowCustomControlO { o.vert = s; return o }
+
+// Visual style class names, passed to OpenThemeData(), used by ThemeColor().
+// The theme is reopened when WM_THEMECHANGED is received.
+// Defaults to none.
+func (o *_WindowCustomControlO) ThemeClass(c string) *_WindowCustomControlO {
+	o.themeClass = c
+	return o
+}
+
+// Value returned by WM_GETDLGCODE, which defines the keyboard input the
+// control wants to process; it can also be overwritten with On().WmGetDlgCode().
+// Defaults to DLGC_WANTARROWS | DLGC_WANTCHARS.
+func (o *_WindowCustomControlO) DlgCode(c co.DLGC) *_WindowCustomControlO { o.dlgCode = c; return o }
+
+func (o *_WindowCustomControlO) lateDefaults() {
+	if o.ctrlId == 0 {
+		o.ctrlId = _NextCtrlId()
+	}
+	if o.hCursor == 0 {
+		o.hCursor = win.HINSTANCE(0).LoadCursor(win.CursorResIdc(co.IDC_ARROW))
+	}
+}
+
+// Options for NewWindowCustomControl().
+func WindowCustomControlOpts() *_WindowCustomControlO {
+	return &_WindowCustomControlO{
+		classStyles: co.CS_DBLCLKS | co.CS_HREDRAW | co.CS_VREDRAW,
+		wndStyles: co.WS_CHILD | co.WS_TABSTOP | co.WS_GROUP | co.WS_VISIBLE |
+			co.WS_CLIPCHILDREN | co.WS_CLIPSIBLINGS,
+		wndExStyles: co.WS_EX_NONE,
+		size:        win.SIZE{Cx: 300, Cy: 200},
+		horz:        HORZ_NONE,
+		vert:        VERT_NONE,
+		dlgCode:     co.DLGC_WANTARROWS | co.DLGC_WANTCHARS,
+	}
+}
@@ -13,6 +13,7 @@ type _WindowDlgMain struct {
 	_WindowDlg
 	iconId       int
 	accelTableId int
+	taskbar      _Taskbar
 }
 
 // Creates a new WindowMain by loading a dialog resource.
@@ -23,6 +24,7 @@ func NewWindowMainDlg(dialogId, iconId, accelTableId int) WindowMain {
 	me._WindowDlg.new(dialogId)
 	me.iconId = iconId
 	me.accelTableId = accelTableId
+	me.taskbar.new(me)
 
 	me.defaultMessages()
 	return me
@@ -30,6 +32,11 @@ func NewWindowMainDlg(dialogId, iconId, accelTableId int) WindowMain {
 
 // Implements WindowMain.
 func (me *_WindowDlgMain) RunAsMain() int {
+	if !_globalInstance.claim() {
+		return 0 // arguments were forwarded to the existing instance
+	}
+	defer _globalInstance.release()
+
 	_FirstMainStuff()
 	_CreateGlobalUiFont()
 	defer _globalUiFont.DeleteObject()
@@ -48,6 +55,23 @@ func (me *_WindowDlgMain) RunAsMain() int {
 	return _RunMainLoop(me.Hwnd(), hAccel)
 }
 
+// Implements WindowMain.
+func (me *_WindowDlgMain) SingleInstance(
+	instanceId string, onArgs func(args []string)) {
+
+	_SetSingleInstance(me, instanceId, onArgs)
+}
+
+// Implements WindowMain.
+func (me *_WindowDlgMain) Taskbar() *_Taskbar {
+	return &me.taskbar
+}
+
+// Implements WindowMain.
+func (me *_WindowDlgMain) WaitHandle(h win.HANDLE, fn func() bool) {
+	_AddWaitHandle(h, fn)
+}
+
 // Implements AnyParent.
 func (me *_WindowDlgMain) isDialog() bool {
 	return true
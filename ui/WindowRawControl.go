@@ -37,6 +37,11 @@ func NewWindowControl(parent AnyParent, opts *_WindowControlO) WindowControl {
 	opts.lateDefaults()
 
 	me := &_WindowRawControl{}
+	me.new(parent, opts)
+	return me
+}
+
+func (me *_WindowRawControl) new(parent AnyParent, opts *_WindowControlO) {
 	me._WindowRaw.new()
 	me.opts = opts
 	me.parent = parent
@@ -58,7 +63,6 @@ func NewWindowControl(parent AnyParent, opts *_WindowControlO) WindowControl {
 	})
 
 	me.defaultMessages()
-	return me
 }
 
 // Implements AnyControl.
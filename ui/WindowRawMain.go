@@ -13,6 +13,7 @@ type _WindowRawMain struct {
 	_WindowRaw
 	opts            *_WindowMainO
 	hChildPrevFocus win.HWND // when window is inactivated
+	taskbar         _Taskbar
 }
 
 // Creates a new WindowMain. Call WindowMainOpts() to define the options to be
@@ -39,6 +40,7 @@ func NewWindowMain(opts *_WindowMainO) WindowMain {
 	me._WindowRaw.new()
 	me.opts = opts
 	me.hChildPrevFocus = win.HWND(0)
+	me.taskbar.new(me)
 
 	me.defaultMessages()
 	return me
@@ -46,6 +48,11 @@ func NewWindowMain(opts *_WindowMainO) WindowMain {
 
 // Implements WindowMain.
 func (me *_WindowRawMain) RunAsMain() int {
+	if !_globalInstance.claim() {
+		return 0 // arguments were forwarded to the existing instance
+	}
+	defer _globalInstance.release()
+
 	_FirstMainStuff()
 	_CreateGlobalUiFont()
 	defer _globalUiFont.DeleteObject()
@@ -75,6 +82,23 @@ func (me *_WindowRawMain) RunAsMain() int {
 	return _RunMainLoop(me.Hwnd(), hAccel)
 }
 
+// Implements WindowMain.
+func (me *_WindowRawMain) SingleInstance(
+	instanceId string, onArgs func(args []string)) {
+
+	_SetSingleInstance(me, instanceId, onArgs)
+}
+
+// Implements WindowMain.
+func (me *_WindowRawMain) Taskbar() *_Taskbar {
+	return &me.taskbar
+}
+
+// Implements WindowMain.
+func (me *_WindowRawMain) WaitHandle(h win.HANDLE, fn func() bool) {
+	_AddWaitHandle(h, fn)
+}
+
 // Implements AnyParent.
 func (me *_WindowRawMain) isDialog() bool {
 	return false
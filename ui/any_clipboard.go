@@ -0,0 +1,46 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Calls onChange, in the UI thread, whenever the contents of the clipboard
+// change. The window is registered as a clipboard format listener right after
+// its creation, and unregistered when destroyed.
+//
+// Must be called before the window is created.
+//
+// Example:
+//
+//	var wnd ui.WindowMain // initialized somewhere
+//
+//	ui.ListenClipboard(wnd, func() {
+//		hClip := wnd.Hwnd().OpenClipboard()
+//		defer hClip.CloseClipboard()
+//
+//		if text, ok := hClip.ReadText(); ok {
+//			fmt.Printf("Clipboard text: %s\n", text)
+//		}
+//	})
+func ListenClipboard(parent AnyParent, onChange func()) {
+	if parent.Hwnd() != 0 {
+		panic("Cannot listen to the clipboard after the window is created.")
+	}
+
+	parent.internalOn().addMsgZero(_CreateOrInitDialog(parent), func(_ wm.Any) {
+		if err := parent.Hwnd().AddClipboardFormatListener(); err != nil {
+			panic(err)
+		}
+	})
+
+	parent.internalOn().addMsgZero(co.WM_CLIPBOARDUPDATE, func(_ wm.Any) {
+		onChange()
+	})
+
+	parent.internalOn().addMsgZero(co.WM_DESTROY, func(_ wm.Any) {
+		parent.Hwnd().RemoveClipboardFormatListener()
+	})
+}
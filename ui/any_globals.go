@@ -9,6 +9,8 @@ import (
 	"github.com/rodrigocfd/windigo/ui/wm"
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/d2d1"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
 )
 
 // Returns WM_INITDIALOG if parent is a dialog window, otherwise WM_CREATE.
@@ -41,6 +43,26 @@ func _NextCtrlId() int {
 	return _globalCtrlId
 }
 
+var _globalTrayIconMsg co.WM = co.WM_APP + 0x3e00 // callback messages of tray icons
+
+func _NextTrayIconMsg() co.WM {
+	_globalTrayIconMsg++
+	return _globalTrayIconMsg
+}
+
+//------------------------------------------------------------------------------
+
+var _globalD2dFactory d2d1.ID2D1Factory // Lazily created, lives until the program ends.
+
+// Returns the global Direct2D factory, creating it on the first call.
+func _D2dFactory() d2d1.ID2D1Factory {
+	if _globalD2dFactory == nil {
+		_globalD2dFactory = d2d1.D2D1CreateFactory(
+			d2d1co.FACTORY_TYPE_SINGLE_THREADED, d2d1co.DEBUG_LEVEL_NONE)
+	}
+	return _globalD2dFactory
+}
+
 //------------------------------------------------------------------------------
 
 var _globalDpi win.POINT // Global system DPI.
@@ -176,6 +198,34 @@ func _FirstMainStuff() {
 	}
 }
 
+//------------------------------------------------------------------------------
+
+// Kernel object handles waited for by the main loop, along with their
+// callbacks; the two slices are kept in sync.
+var (
+	_globalWaitHandles []win.HANDLE
+	_globalWaitFuncs   []func() bool
+)
+
+// Registers a handle to be waited for by the main loop.
+func _AddWaitHandle(h win.HANDLE, fn func() bool) {
+	if len(_globalWaitHandles) == 63 { // MAXIMUM_WAIT_OBJECTS - 1
+		panic("Cannot wait for more than 63 handles in the main loop.")
+	}
+	_globalWaitHandles = append(_globalWaitHandles, h)
+	_globalWaitFuncs = append(_globalWaitFuncs, fn)
+}
+
+// Runs the callback of the signaled handle, removing it if it returns false.
+func _RunWaitHandle(idx int) {
+	if !_globalWaitFuncs[idx]() {
+		_globalWaitHandles = append(_globalWaitHandles[:idx], _globalWaitHandles[idx+1:]...)
+		_globalWaitFuncs = append(_globalWaitFuncs[:idx], _globalWaitFuncs[idx+1:]...)
+	}
+}
+
+//------------------------------------------------------------------------------
+
 // Runs the main window loop synchronously.
 func _RunMainLoop(hWnd win.HWND, hAccel win.HACCEL) int {
 	hHeap := win.GetProcessHeap()
@@ -184,6 +234,31 @@ func _RunMainLoop(hWnd win.HWND, hAccel win.HACCEL) int {
 	pMsg := (*win.MSG)(unsafe.Pointer(&block[0]))
 
 	for {
+		if len(_globalWaitHandles) > 0 {
+			// Wait for kernel objects and window messages together.
+			// https://devblogs.microsoft.com/oldnewthing/20050217-00/?p=36423
+			for win.PeekMessage(pMsg, win.HWND(0), 0, 0, co.PM_REMOVE) {
+				if co.WM(pMsg.Msg) == co.WM_QUIT {
+					return int(pMsg.WParam)
+				}
+				_ProcessMainMsg(pMsg, hAccel)
+			}
+
+			ret, err := win.MsgWaitForMultipleObjectsEx(_globalWaitHandles,
+				win.NumInfInfinite(), co.QS_ALLINPUT, co.MWMO_INPUTAVAILABLE)
+			if err != nil {
+				panic(err)
+			}
+
+			numHandles := co.WAIT(len(_globalWaitHandles))
+			if ret >= co.WAIT_OBJECT_0 && ret < co.WAIT_OBJECT_0+numHandles {
+				_RunWaitHandle(int(ret - co.WAIT_OBJECT_0))
+			} else if ret >= co.WAIT_ABANDONED && ret < co.WAIT_ABANDONED+numHandles {
+				_RunWaitHandle(int(ret - co.WAIT_ABANDONED))
+			}
+			continue // new messages will be processed by PeekMessage
+		}
+
 		if res, err := win.GetMessage(pMsg, win.HWND(0), 0, 0); err != nil {
 			panic(err)
 		} else if res == 0 {
@@ -194,25 +269,30 @@ func _RunMainLoop(hWnd win.HWND, hAccel win.HACCEL) int {
 			return int(pMsg.WParam)
 		}
 
-		// Check if modeless...
+		_ProcessMainMsg(pMsg, hAccel)
+	}
+}
 
-		// If a child window, will retrieve its top-level parent.
-		// If a top-level, use itself.
-		hTopLevel := pMsg.HWnd.GetAncestor(co.GA_ROOT)
+// Translates and dispatches a message retrieved by the main loop.
+func _ProcessMainMsg(pMsg *win.MSG, hAccel win.HACCEL) {
+	// Check if modeless...
 
-		// If we have an accelerator table, try to translate the message.
-		if hAccel != 0 && hTopLevel.TranslateAccelerator(hAccel, pMsg) == nil {
-			continue // message translated, no further processing is done
-		}
+	// If a child window, will retrieve its top-level parent.
+	// If a top-level, use itself.
+	hTopLevel := pMsg.HWnd.GetAncestor(co.GA_ROOT)
 
-		if hTopLevel.IsDialogMessage(pMsg) {
-			// Processed all keyboard actions for child controls.
-			continue
-		}
+	// If we have an accelerator table, try to translate the message.
+	if hAccel != 0 && hTopLevel.TranslateAccelerator(hAccel, pMsg) == nil {
+		return // message translated, no further processing is done
+	}
 
-		win.TranslateMessage(pMsg)
-		win.DispatchMessage(pMsg)
+	if hTopLevel.IsDialogMessage(pMsg) {
+		// Processed all keyboard actions for child controls.
+		return
 	}
+
+	win.TranslateMessage(pMsg)
+	win.DispatchMessage(pMsg)
 }
 
 // Runs the modal window loop synchronously.
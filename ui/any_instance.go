@@ -0,0 +1,171 @@
+//go:build windows
+
+package ui
+
+import (
+	"os"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Identifies WM_COPYDATA messages carrying the arguments forwarded by another
+// instance of the application.
+const _INSTANCE_COPYDATA uintptr = 0x7769_6e64 // "wind"
+
+// Single-instance mode of the application, set by WindowMain.SingleInstance().
+type _Instance struct {
+	mutexName string
+	propName  string
+	hMutex    win.HMUTEX
+	onArgs    func(args []string)
+}
+
+var _globalInstance *_Instance // Nil if single-instance mode is not enabled.
+
+// Enables the single-instance mode for the given main window.
+func _SetSingleInstance(
+	parent AnyParent, instanceId string, onArgs func(args []string)) {
+
+	if parent.Hwnd() != 0 {
+		panic("Cannot set single instance after the window is created.")
+	} else if _globalInstance != nil {
+		panic("Single instance already set.")
+	}
+
+	_globalInstance = &_Instance{
+		mutexName: "Local\\windigo.instance." + instanceId,
+		propName:  "windigo.instance." + instanceId,
+		onArgs:    onArgs,
+	}
+
+	parent.internalOn().addMsgZero(_CreateOrInitDialog(parent), func(_ wm.Any) {
+		if err := parent.Hwnd().SetProp(_globalInstance.propName, 1); err != nil {
+			panic(err)
+		}
+	})
+
+	parent.internalOn().addMsgZero(co.WM_NCDESTROY, func(_ wm.Any) {
+		parent.Hwnd().RemoveProp(_globalInstance.propName)
+	})
+
+	parent.internalOn().addMsgZero(co.WM_COPYDATA, func(p wm.Any) {
+		_globalInstance.receiveArgs(parent.Hwnd(), wm.CopyData{Msg: p})
+	})
+}
+
+// Returns true if this is the first instance, which must go on and create the
+// main window. Otherwise, forwards the command line arguments to the existing
+// instance and returns false.
+//
+// Returns true right away if single-instance mode is not enabled.
+func (me *_Instance) claim() bool {
+	if me == nil {
+		return true
+	}
+
+	hMutex, alreadyExists, err := win.CreateMutex(nil, false,
+		win.StrOptSome(me.mutexName))
+	if err != nil {
+		panic(err)
+	}
+	if !alreadyExists {
+		me.hMutex = hMutex // will be owned until the application exits
+		return true
+	}
+
+	hMutex.CloseHandle()
+	me.forwardArgs(os.Args[1:])
+	return false
+}
+
+// Releases the named mutex owned by the first instance.
+func (me *_Instance) release() {
+	if me != nil && me.hMutex != 0 {
+		me.hMutex.CloseHandle()
+		me.hMutex = win.HMUTEX(0)
+	}
+}
+
+// Finds the main window of the existing instance, which may still be being
+// created, waiting for it up to 5 seconds.
+func (me *_Instance) findExisting() (win.HWND, bool) {
+	for i := 0; i < 50; i++ {
+		hFound := win.HWND(0)
+		win.EnumWindows(func(hWnd win.HWND) bool {
+			if hWnd.GetProp(me.propName) != 0 {
+				hFound = hWnd
+				return false // stop enumeration
+			}
+			return true
+		})
+
+		if hFound != 0 {
+			return hFound, true
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return win.HWND(0), false
+}
+
+// Sends the arguments to the main window of the existing instance, allowing it
+// to bring itself to the foreground.
+func (me *_Instance) forwardArgs(args []string) {
+	hWnd, found := me.findExisting()
+	if !found {
+		return // existing instance has no window, nothing can be done
+	}
+
+	_, processId := hWnd.GetWindowThreadProcessId()
+	win.AllowSetForegroundWindow(processId)
+
+	buf := make([]uint16, 0, 1) // each argument is null-terminated, even if empty
+	for _, arg := range args {
+		buf = append(buf, win.Str.ToNativeSlice(arg)...)
+	}
+	buf = append(buf, 0) // never empty, so &buf[0] is always valid
+
+	cds := win.COPYDATASTRUCT{
+		DwData: _INSTANCE_COPYDATA,
+		CbData: uint32(len(buf) * 2), // in bytes, including the extra null
+		LpData: uintptr(unsafe.Pointer(&buf[0])),
+	}
+	hWnd.SendMessageTimeout(co.WM_COPYDATA, 0, win.LPARAM(unsafe.Pointer(&cds)),
+		co.SMTO_ABORTIFHUNG, 5000)
+}
+
+// Handles the WM_COPYDATA sent by forwardArgs() of another instance.
+func (me *_Instance) receiveArgs(hWnd win.HWND, p wm.CopyData) {
+	cds := p.CopyDataStruct()
+	if cds.DwData != _INSTANCE_COPYDATA || cds.CbData < 2 {
+		return // not ours
+	}
+
+	buf := unsafe.Slice((*uint16)(unsafe.Pointer(cds.LpData)), cds.CbData/2)
+	buf = buf[:len(buf)-1] // remove the extra null
+	if len(buf) > 0 && buf[len(buf)-1] != 0 {
+		return // malformed, last argument is not null-terminated
+	}
+
+	args := make([]string, 0)
+	for len(buf) > 0 { // split at each null, so empty arguments are kept
+		idx := 0
+		for buf[idx] != 0 {
+			idx++
+		}
+		args = append(args, win.Str.FromNativeSlice(buf[:idx]))
+		buf = buf[idx+1:]
+	}
+
+	if hWnd.IsIconic() {
+		hWnd.ShowWindow(co.SW_RESTORE)
+	}
+	hWnd.SetForegroundWindow()
+
+	if me.onArgs != nil {
+		me.onArgs(args)
+	}
+}
@@ -85,6 +85,58 @@ type WindowMain interface {
 	//
 	// Will block until the window is closed.
 	RunAsMain() int
+
+	// Enables the single-instance mode, identified by instanceId, which should
+	// be unique to the application, like a GUID, and contain no backslashes.
+	//
+	// When RunAsMain() is called and another instance is already running, the
+	// command line arguments (without the program name) are forwarded to it,
+	// the existing window is brought to the foreground, and RunAsMain()
+	// returns zero without creating the window. In the existing instance,
+	// onArgs is called with the forwarded arguments, in the UI thread.
+	//
+	// Must be called before RunAsMain().
+	//
+	// Example:
+	//
+	//	var wnd ui.WindowMain // initialized somewhere
+	//
+	//	wnd.SingleInstance("a1b2c3d4-MyApp", func(args []string) {
+	//		fmt.Printf("Another instance was launched with %v\n", args)
+	//	})
+	SingleInstance(instanceId string, onArgs func(args []string))
+
+	// Exposes the taskbar button of the window, with progress bar, overlay
+	// icon and thumbnail toolbar.
+	//
+	// Depends of CoInitializeEx().
+	//
+	// Example:
+	//
+	//	var wnd ui.WindowMain // initialized somewhere
+	//
+	//	wnd.Taskbar().SetProgressValue(30, 100)
+	Taskbar() *_Taskbar
+
+	// Registers a kernel object handle to be waited for by the main loop,
+	// along with window messages. When the object is signaled, the callback
+	// is run in the UI thread; if it returns false, the handle is no longer
+	// waited for.
+	//
+	// The handle is not closed by the window; it must remain valid while
+	// registered. Up to 63 handles can be registered, and this method must be
+	// called from the UI thread.
+	//
+	// Example:
+	//
+	//	var wnd ui.WindowMain // initialized somewhere
+	//	var hEvent win.HEVENT // initialized somewhere
+	//
+	//	wnd.WaitHandle(win.HANDLE(hEvent), func() bool {
+	//		println("Event was set.")
+	//		return true // keep waiting
+	//	})
+	WaitHandle(h win.HANDLE, fn func() bool)
 }
 
 // User-custom modal window.
@@ -0,0 +1,68 @@
+//go:build windows
+
+package win
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Registry key which holds the event sources of each log.
+const _EVENTLOG_KEY = "SYSTEM\\CurrentControlSet\\Services\\EventLog\\"
+
+// Installs an event source in the classic Event Log, so Event Viewer can
+// format its messages. Requires administrative rights.
+//
+// The logName is usually "Application". The messageFile is the full path of
+// the EXE or DLL which contains the message table resource, and may contain
+// environment variables. If your program has no message table, you can use
+// "%SystemRoot%\\System32\\EventCreate.exe", whose messages 1 to 1000 simply
+// print the first insertion string.
+//
+// If categoryCount is zero, no categories are registered; otherwise the
+// categories are also loaded from messageFile.
+//
+// Example:
+//
+//	win.EventLogInstallSource("Application", "MyService",
+//		"%SystemRoot%\\System32\\EventCreate.exe", 0)
+func EventLogInstallSource(
+	logName, sourceName, messageFile string, categoryCount uint32) error {
+
+	subKey := _EVENTLOG_KEY + logName + "\\" + sourceName
+	putExpandSz := func(valueName, data string) error {
+		str16 := Str.ToNativeSlice(data)
+		return HKEY_LOCAL_MACHINE.RegSetKeyValue(subKey, valueName,
+			co.REG_EXPAND_SZ, unsafe.Pointer(&str16[0]), uint32(len(str16)*2))
+	}
+	putDword := func(valueName string, data uint32) error {
+		return HKEY_LOCAL_MACHINE.RegSetKeyValue(subKey, valueName,
+			co.REG_DWORD, unsafe.Pointer(&data), 4)
+	}
+
+	if err := putExpandSz("EventMessageFile", messageFile); err != nil {
+		return err
+	}
+	typesSupported := co.EVENTLOG_ERROR_TYPE | co.EVENTLOG_WARNING_TYPE |
+		co.EVENTLOG_INFORMATION_TYPE
+	if err := putDword("TypesSupported", uint32(typesSupported)); err != nil {
+		return err
+	}
+	if categoryCount > 0 {
+		if err := putExpandSz("CategoryMessageFile", messageFile); err != nil {
+			return err
+		}
+		if err := putDword("CategoryCount", categoryCount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Removes an event source installed with EventLogInstallSource(). Requires
+// administrative rights.
+func EventLogUninstallSource(logName, sourceName string) error {
+	return HKEY_LOCAL_MACHINE.RegDeleteKey(
+		_EVENTLOG_KEY + logName + "\\" + sourceName)
+}
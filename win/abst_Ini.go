@@ -3,9 +3,11 @@
 package win
 
 import (
+	"encoding/base64"
 	"strings"
 
 	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
 )
 
 // High-level abstraction to a .ini file.
@@ -13,6 +15,11 @@ import (
 // Contains a slice of sections, which can be freely modified.
 //
 // Created with IniLoad().
+//
+// Keys marked as IniKey.Protected are encrypted with CryptProtectData() when
+// saved, so only the current user can read them; IniLoad() transparently
+// decrypts them. A key which cannot be decrypted, like one saved by another
+// user, is loaded with its error in IniKey.Err.
 type Ini struct {
 	Sections   []IniSection // All sections of this .ini file.
 	sourcePath string
@@ -45,10 +52,19 @@ func IniLoad(filePath string) (*Ini, error) {
 
 		} else if curSection.Name != "" {
 			keyVal := strings.SplitN(line, "=", 2)
-			curSection.Values = append(curSection.Values, IniKey{
+			key := IniKey{
 				Name:  strings.TrimSpace(keyVal[0]),
 				Value: strings.TrimSpace(keyVal[1]),
-			})
+			}
+			if strings.HasPrefix(key.Value, _INI_PROTECTED_PREFIX) {
+				if plain, err := me.unprotect(key.Value); err != nil {
+					key.Err = err // keep the stored value, so it can be saved back
+				} else {
+					key.Value = plain
+				}
+				key.Protected = true
+			}
+			curSection.Values = append(curSection.Values, key)
 		}
 	}
 
@@ -60,6 +76,31 @@ func IniLoad(filePath string) (*Ini, error) {
 	return me, nil
 }
 
+// Prefix of the encrypted values, which are followed by the base64 blob.
+const _INI_PROTECTED_PREFIX = "dpapi:"
+
+func (me *Ini) protect(plain string) (string, error) {
+	blob, err := CryptProtectData([]byte(plain),
+		StrOptNone(), nil, co.CRYPTPROTECT_UI_FORBIDDEN)
+	if err != nil {
+		return "", err
+	}
+	return _INI_PROTECTED_PREFIX + base64.StdEncoding.EncodeToString(blob), nil
+}
+
+func (me *Ini) unprotect(stored string) (string, error) {
+	blob, err := base64.StdEncoding.DecodeString(
+		strings.TrimPrefix(stored, _INI_PROTECTED_PREFIX))
+	if err != nil {
+		return "", err
+	}
+	plain, _, err := CryptUnprotectData(blob, nil, co.CRYPTPROTECT_UI_FORBIDDEN)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
 func (me *Ini) loadLines(filePath string) ([]string, error) {
 	fin, err := FileMappedOpen(filePath, co.FILE_OPEN_READ_EXISTING)
 	if err != nil {
@@ -82,6 +123,9 @@ func (me *Ini) Section(name string) (*IniSection, bool) {
 }
 
 // Saves the contents to a .ini file.
+//
+// Returns errco.INVALID_DATA if a key which is not IniKey.Protected has a value
+// starting with "dpapi:", since it would be loaded as an encrypted one.
 func (me *Ini) SaveToFile(filePath string) error {
 	var serialized strings.Builder
 
@@ -90,7 +134,18 @@ func (me *Ini) SaveToFile(filePath string) error {
 		serialized.WriteString("[" + section.Name + "]\r\n")
 		for v := range section.Values {
 			value := &section.Values[v]
-			serialized.WriteString(value.Name + "=" + value.Value + "\r\n")
+			storedValue := value.Value
+			if value.Protected {
+				if value.Err == nil { // otherwise it's still the encrypted value
+					var err error
+					if storedValue, err = me.protect(value.Value); err != nil {
+						return err
+					}
+				}
+			} else if strings.HasPrefix(storedValue, _INI_PROTECTED_PREFIX) {
+				return errco.INVALID_DATA
+			}
+			serialized.WriteString(value.Name + "=" + storedValue + "\r\n")
 		}
 
 		isLast := s == len(me.Sections)-1
@@ -181,6 +236,12 @@ func (me *IniSection) Value(name string) (*IniKey, bool) {
 
 // A single key of an IniSection.
 type IniKey struct {
-	Name  string // The name of this key.
-	Value string // The value of this key.
+	Name      string // The name of this key.
+	Value     string // The value of this key, in plain text unless Err is set.
+	Protected bool   // If true, the value is encrypted with DPAPI when saved.
+
+	// Set by IniLoad() if the protected value could not be decrypted. In this
+	// case, Value holds the stored encrypted value, which is saved back as it
+	// is; set Err to nil after assigning a new plain text Value.
+	Err error
 }
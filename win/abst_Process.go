@@ -0,0 +1,466 @@
+//go:build windows
+
+package win
+
+import (
+	"context"
+	"io"
+
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// High-level abstraction to a child process, with optional redirected standard
+// input, output and error streams.
+//
+// Created with ProcessStart().
+type Process struct {
+	hProcess HPROCESS
+	pid      uint32
+	stdin    *_ProcessPipe
+	stdout   *_ProcessPipe
+	stderr   *_ProcessPipe
+}
+
+// Launches a new process. The first argument is the program name; all
+// arguments are quoted with Str.JoinArgv(). Call ProcessOpts() to define the
+// options.
+//
+// ⚠️ You must defer Process.Close().
+//
+// Example:
+//
+//	p, err := win.ProcessStart(
+//		[]string{"cmd.exe", "/c", "dir"},
+//		win.ProcessOpts().
+//			RedirectStdout(true).
+//			ShowWindow(co.SW_HIDE),
+//	)
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer p.Close()
+//
+//	output, _ := io.ReadAll(p.Stdout())
+//	exitCode, _ := p.Wait(context.Background())
+func ProcessStart(argv []string, opts *_ProcessO) (*Process, error) {
+	if len(argv) == 0 {
+		panic("ProcessStart() needs at least the program name.")
+	}
+	if opts == nil {
+		opts = ProcessOpts()
+	}
+
+	me := &Process{}
+	childEnds := make([]HPIPE, 0, 3)
+	nulEnds := make([]HFILE, 0, 2)
+	defer func() { // child ends belong to the child process now, or failed
+		for _, hPipe := range childEnds {
+			hPipe.CloseHandle()
+		}
+		for _, hNul := range nulEnds {
+			hNul.CloseHandle()
+		}
+	}()
+
+	var si STARTUPINFOEX
+	si.SetCb()
+	inheritedHandles := append([]HANDLE{}, opts.inheritHandles...)
+
+	if opts.showWindow != nil {
+		si.StartupInfo.DwFlags |= co.STARTF_USESHOWWINDOW
+		si.StartupInfo.WShowWindow = uint16(*opts.showWindow)
+	}
+
+	if opts.stdin || opts.stdout || opts.stderr {
+		si.StartupInfo.DwFlags |= co.STARTF_USESTDHANDLES
+
+		streams := []struct {
+			redirect   bool
+			childReads bool
+			dest       **_ProcessPipe
+			childSlot  *uintptr
+		}{
+			{opts.stdin, true, &me.stdin, &si.StartupInfo.HStdInput},
+			{opts.stdout, false, &me.stdout, &si.StartupInfo.HStdOutput},
+			{opts.stderr, false, &me.stderr, &si.StartupInfo.HStdError},
+		}
+		for _, stream := range streams {
+			if !stream.redirect {
+				// Only the handles in the list are inherited, so the parent's
+				// own standard handles can't be passed; NUL is used instead.
+				hNul, err := _ProcessOpenNul(stream.childReads)
+				if err != nil {
+					me.closePipes()
+					return nil, err
+				}
+				*stream.childSlot = uintptr(hNul)
+				nulEnds = append(nulEnds, hNul)
+				inheritedHandles = append(inheritedHandles, HANDLE(hNul))
+				continue
+			}
+			parentEnd, childEnd, err := _ProcessCreatePipe(stream.childReads)
+			if err != nil {
+				me.closePipes()
+				return nil, err
+			}
+			*stream.dest = &_ProcessPipe{hPipe: parentEnd}
+			*stream.childSlot = uintptr(childEnd)
+			childEnds = append(childEnds, childEnd)
+			inheritedHandles = append(inheritedHandles, HANDLE(childEnd))
+		}
+	}
+
+	numAttrs := 0
+	if len(inheritedHandles) > 0 {
+		numAttrs++
+	}
+	if opts.parentProcess != 0 {
+		numAttrs++
+	}
+
+	creationFlags := opts.creationFlags
+	if opts.job != 0 {
+		creationFlags |= co.CREATE_SUSPENDED // will be resumed after assigned to job
+	}
+	if numAttrs > 0 {
+		attrList, err := InitializeProcThreadAttributeList(numAttrs)
+		if err != nil {
+			me.closePipes()
+			return nil, err
+		}
+		defer attrList.DeleteProcThreadAttributeList()
+
+		if len(inheritedHandles) > 0 {
+			if err := attrList.UpdateHandleList(inheritedHandles); err != nil {
+				me.closePipes()
+				return nil, err
+			}
+		}
+		if opts.parentProcess != 0 {
+			if err := attrList.UpdateParentProcess(opts.parentProcess); err != nil {
+				me.closePipes()
+				return nil, err
+			}
+		}
+
+		si.SetLpAttributeList(attrList)
+		creationFlags |= co.CREATE_EXTENDED_STARTUPINFO_PRESENT
+	}
+
+	appName := StrOptNone()
+	if opts.applicationName != "" {
+		appName = StrOptSome(opts.applicationName)
+	}
+	curDir := StrOptNone()
+	if opts.dir != "" {
+		curDir = StrOptSome(opts.dir)
+	}
+
+	var pi PROCESS_INFORMATION
+	if err := CreateProcess(appName, StrOptSome(Str.JoinArgv(argv)), nil, nil,
+		len(inheritedHandles) > 0, creationFlags, opts.env, curDir,
+		&si.StartupInfo, &pi); err != nil {
+
+		me.closePipes()
+		return nil, err
+	}
+
+	defer pi.HThread.CloseHandle() // we won't need it
+	me.hProcess = pi.HProcess
+	me.pid = pi.DwProcessId
+
+	if opts.job != 0 {
+		if err := opts.job.AssignProcessToJobObject(pi.HProcess); err != nil {
+			me.hProcess.TerminateProcess(1)
+			me.Close()
+			return nil, err
+		}
+		if opts.creationFlags&co.CREATE_SUSPENDED == 0 { // user didn't ask for suspended
+			if _, err := pi.HThread.ResumeThread(); err != nil {
+				me.hProcess.TerminateProcess(1)
+				me.Close()
+				return nil, err
+			}
+		}
+	}
+	return me, nil
+}
+
+// Creates an anonymous pipe whose child end is inheritable, and whose parent
+// end is not.
+func _ProcessCreatePipe(childReads bool) (parentEnd, childEnd HPIPE, e error) {
+	var sa SECURITY_ATTRIBUTES
+	sa.SetNLength()
+	sa.SetBInheritHandle(true)
+
+	hRead, hWrite, err := CreatePipe(&sa, 0)
+	if err != nil {
+		return HPIPE(0), HPIPE(0), err
+	}
+
+	if childReads {
+		parentEnd, childEnd = hWrite, hRead
+	} else {
+		parentEnd, childEnd = hRead, hWrite
+	}
+
+	if err := parentEnd.SetHandleInformation(
+		co.HANDLE_FLAG_INHERIT, co.HANDLE_FLAG_NONE); err != nil {
+
+		hRead.CloseHandle()
+		hWrite.CloseHandle()
+		return HPIPE(0), HPIPE(0), err
+	}
+	return
+}
+
+// Opens an inheritable handle to the NUL device, used by the child process as
+// a non-redirected stream.
+func _ProcessOpenNul(childReads bool) (HFILE, error) {
+	var sa SECURITY_ATTRIBUTES
+	sa.SetNLength()
+	sa.SetBInheritHandle(true)
+
+	access := co.GENERIC_WRITE
+	if childReads {
+		access = co.GENERIC_READ
+	}
+	return CreateFile("NUL", access, co.FILE_SHARE_READ|co.FILE_SHARE_WRITE,
+		&sa, co.DISPOSITION_OPEN_EXISTING, co.FILE_ATTRIBUTE_NORMAL,
+		co.FILE_FLAG_NONE, co.SECURITY_NONE, HFILE(0))
+}
+
+// Closes the redirected streams, if any, and the process handle. The process
+// itself is not terminated.
+func (me *Process) Close() error {
+	me.closePipes()
+	var e error
+	if me.hProcess != 0 {
+		e = me.hProcess.CloseHandle()
+		me.hProcess = 0
+	}
+	return e
+}
+
+func (me *Process) closePipes() {
+	for _, pipe := range []*_ProcessPipe{me.stdin, me.stdout, me.stderr} {
+		if pipe != nil {
+			pipe.Close()
+		}
+	}
+}
+
+// Returns the underlying handle.
+func (me *Process) Hprocess() HPROCESS {
+	return me.hProcess
+}
+
+// Terminates the process with exit code 1.
+func (me *Process) Kill() error {
+	return me.hProcess.TerminateProcess(1)
+}
+
+// Returns the process ID.
+func (me *Process) Pid() uint32 {
+	return me.pid
+}
+
+// Returns the redirected standard error stream, or nil if it was not
+// redirected.
+//
+// ⚠️ If the stream is not consumed, the child process may block when the pipe
+// buffer is full.
+func (me *Process) Stderr() io.ReadCloser {
+	if me.stderr == nil {
+		return nil
+	}
+	return me.stderr
+}
+
+// Returns the redirected standard input stream, or nil if it was not
+// redirected. Close it to signal end of input to the child process.
+func (me *Process) Stdin() io.WriteCloser {
+	if me.stdin == nil {
+		return nil
+	}
+	return me.stdin
+}
+
+// Returns the redirected standard output stream, or nil if it was not
+// redirected.
+//
+// ⚠️ If the stream is not consumed, the child process may block when the pipe
+// buffer is full.
+func (me *Process) Stdout() io.ReadCloser {
+	if me.stdout == nil {
+		return nil
+	}
+	return me.stdout
+}
+
+// Waits until the process exits, returning its exit code, or until the context
+// is done, returning the context error. The process is not terminated if the
+// context is done.
+func (me *Process) Wait(ctx context.Context) (uint32, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	handles := []HANDLE{HANDLE(me.hProcess)}
+
+	if ctx.Done() != nil { // context can be cancelled
+		hCancel, _, err := CreateEvent(nil, true, false, StrOptNone())
+		if err != nil {
+			return 0, err
+		}
+		defer hCancel.CloseHandle()
+
+		waitDone := make(chan struct{})
+		goroutineDone := make(chan struct{})
+		go func() {
+			defer close(goroutineDone)
+			select {
+			case <-ctx.Done():
+				hCancel.SetEvent()
+			case <-waitDone:
+			}
+		}()
+		defer func() { // the event must outlive the goroutine
+			close(waitDone)
+			<-goroutineDone
+		}()
+		handles = append(handles, HANDLE(hCancel))
+	}
+
+	ret, err := WaitForMultipleObjects(handles, false, NumInfInfinite())
+	if err != nil {
+		return 0, err
+	} else if ret == co.WAIT_OBJECT_0 { // the process has priority if both are signaled
+		return me.hProcess.GetExitCodeProcess()
+	}
+	return 0, ctx.Err()
+}
+
+//------------------------------------------------------------------------------
+
+// Parent end of a redirected stream.
+//
+// Implements the following standard io interfaces:
+//
+//   - [io.Closer]
+//   - [io.Reader]
+//   - [io.Writer]
+type _ProcessPipe struct {
+	hPipe HPIPE
+}
+
+// Implements [io.Closer].
+func (me *_ProcessPipe) Close() error {
+	var e error
+	if me.hPipe != 0 {
+		e = me.hPipe.CloseHandle()
+		me.hPipe = 0
+	}
+	return e
+}
+
+// Implements [io.Reader].
+func (me *_ProcessPipe) Read(p []byte) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	numRead, err := me.hPipe.ReadFile(p, nil)
+	if err == errco.BROKEN_PIPE { // child closed its end
+		return int(numRead), io.EOF
+	} else if err != nil {
+		return int(numRead), err
+	}
+	return int(numRead), nil
+}
+
+// Implements [io.Writer].
+func (me *_ProcessPipe) Write(p []byte) (n int, err error) {
+	for n < len(p) {
+		numWritten, err := me.hPipe.WriteFile(p[n:], nil)
+		n += int(numWritten)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+//------------------------------------------------------------------------------
+
+type _ProcessO struct {
+	applicationName string
+	env             map[string]string
+	dir             string
+	stdin           bool
+	stdout          bool
+	stderr          bool
+	showWindow      *co.SW
+	creationFlags   co.CREATE
+	parentProcess   HPROCESS
+	inheritHandles  []HANDLE
+	job             HJOB
+}
+
+// Explicit executable path, passed to CreateProcess() as lpApplicationName.
+// Defaults to none, so the program name is searched in the PATH.
+func (o *_ProcessO) ApplicationName(n string) *_ProcessO { o.applicationName = n; return o }
+
+// Process creation flags, like CREATE_NEW_CONSOLE or CREATE_NO_WINDOW.
+// Defaults to none.
+func (o *_ProcessO) CreationFlags(f co.CREATE) *_ProcessO { o.creationFlags = f; return o }
+
+// Working directory of the new process.
+// Defaults to the current directory.
+func (o *_ProcessO) Dir(d string) *_ProcessO { o.dir = d; return o }
+
+// Environment variables of the new process. Use GetEnvironmentStrings() to
+// retrieve the current ones, if you want to extend them.
+// Defaults to nil, which inherits the environment of the current process.
+func (o *_ProcessO) Env(e map[string]string) *_ProcessO { o.env = e; return o }
+
+// Additional inheritable handles to be passed to the new process, through
+// PROC_THREAD_ATTRIBUTE_HANDLE_LIST. Only these handles, and the redirected
+// streams, are inherited.
+// Defaults to none.
+func (o *_ProcessO) InheritHandles(h ...HANDLE) *_ProcessO { o.inheritHandles = h; return o }
+
+// Job object the new process is assigned to. The process is created suspended,
+// assigned to the job, and then resumed, so any process it spawns is also in
+// the job.
+// Defaults to none.
+func (o *_ProcessO) Job(h HJOB) *_ProcessO { o.job = h; return o }
+
+// Process to be used as parent, through PROC_THREAD_ATTRIBUTE_PARENT_PROCESS.
+// The handle must have PROCESS_CREATE_PROCESS access; inherited handles must
+// be valid in that process.
+// Defaults to none.
+func (o *_ProcessO) ParentProcess(h HPROCESS) *_ProcessO { o.parentProcess = h; return o }
+
+// Redirects the standard error stream to a pipe, read with Process.Stderr().
+// If any stream is redirected, the non-redirected ones are connected to NUL.
+// Defaults to false.
+func (o *_ProcessO) RedirectStderr(r bool) *_ProcessO { o.stderr = r; return o }
+
+// Redirects the standard input stream to a pipe, written with Process.Stdin().
+// If any stream is redirected, the non-redirected ones are connected to NUL.
+// Defaults to false.
+func (o *_ProcessO) RedirectStdin(r bool) *_ProcessO { o.stdin = r; return o }
+
+// Redirects the standard output stream to a pipe, read with Process.Stdout().
+// If any stream is redirected, the non-redirected ones are connected to NUL.
+// Defaults to false.
+func (o *_ProcessO) RedirectStdout(r bool) *_ProcessO { o.stdout = r; return o }
+
+// Initial show state of the main window, like SW_HIDE.
+// Defaults to the program default.
+func (o *_ProcessO) ShowWindow(s co.SW) *_ProcessO { o.showWindow = &s; return o }
+
+// Options for ProcessStart().
+func ProcessOpts() *_ProcessO {
+	return &_ProcessO{}
+}
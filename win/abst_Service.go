@@ -0,0 +1,353 @@
+//go:build windows
+
+package win
+
+import (
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A control request sent by the Service Control Manager to a running service,
+// received by ServiceHandler.Execute().
+type ServiceRequest struct {
+	Control   co.SERVICE_CONTROL
+	EventType uint32 // co.WTS for session changes, co.PBT for power events.
+	SessionId uint32 // Session of a co.SERVICE_CONTROL_SESSIONCHANGE.
+}
+
+// A status reported by ServiceHandler.Execute() to the Service Control Manager.
+type ServiceStatus struct {
+	State    co.SERVICE_STATE
+	Accepts  co.SERVICE_ACCEPT // Ignored in start and stop pending states.
+	WaitHint time.Duration     // Estimated time of a pending operation.
+}
+
+// Implemented by a service which runs in the current process, and is started
+// with ServiceRun().
+type ServiceHandler interface {
+	// Runs the service until it's stopped, returning its service-specific exit
+	// code; zero means success.
+	//
+	// The service starts in co.SERVICE_STATE_START_PENDING, and must send
+	// co.SERVICE_STATE_RUNNING through status as soon as it's initialized.
+	// Requests arrive through requests, and each state change must be sent
+	// through status. When a pending state is sent again, its checkpoint is
+	// incremented. The co.SERVICE_STATE_STOPPED state is reported automatically
+	// when Execute() returns.
+	Execute(args []string,
+		requests <-chan ServiceRequest, status chan<- ServiceStatus) uint32
+}
+
+// Reports the status of a service to the Service Control Manager.
+//
+// Implemented by HSERVICESTATUS; a fake implementation can be passed to
+// NewServiceRunner() to test a ServiceHandler.
+type ServiceStatusReporter interface {
+	SetServiceStatus(status *SERVICE_STATUS) error
+}
+
+// Runs a ServiceHandler, delivering the control requests and reporting the
+// status changes, keeping track of the state, accepted controls and
+// checkpoints.
+//
+// Used internally by ServiceRun(); can be used directly to test a
+// ServiceHandler with a fake ServiceStatusReporter.
+//
+// Created with NewServiceRunner().
+type ServiceRunner struct {
+	handler  ServiceHandler
+	reporter ServiceStatusReporter
+	requests chan ServiceRequest
+	statuses chan ServiceStatus
+	done     chan struct{}
+	mutex    sync.Mutex
+	status   SERVICE_STATUS // last reported
+}
+
+// Creates a new ServiceRunner for a service of co.SERVICE_TYPE_WIN32_OWN_PROCESS
+// type.
+//
+// Example:
+//
+//	type FakeScm struct{ log []win.SERVICE_STATUS }
+//
+//	func (me *FakeScm) SetServiceStatus(s *win.SERVICE_STATUS) error {
+//		me.log = append(me.log, *s)
+//		return nil
+//	}
+//
+//	var handler win.ServiceHandler // initialized somewhere
+//
+//	scm := &FakeScm{}
+//	runner := win.NewServiceRunner(handler, scm)
+//	go runner.Control(co.SERVICE_CONTROL_STOP, 0, 0)
+//	exitCode := runner.Run(nil)
+func NewServiceRunner(
+	handler ServiceHandler, reporter ServiceStatusReporter) *ServiceRunner {
+
+	return &ServiceRunner{
+		handler:  handler,
+		reporter: reporter,
+		requests: make(chan ServiceRequest, 16), // arbitrary
+		statuses: make(chan ServiceStatus),
+		done:     make(chan struct{}),
+		status: SERVICE_STATUS{
+			DwServiceType:  co.SERVICE_TYPE_WIN32_OWN_PROCESS,
+			DwCurrentState: co.SERVICE_STATE_STOPPED,
+		},
+	}
+}
+
+// Delivers a control request to the ServiceHandler, returning the result to be
+// passed back to the Service Control Manager.
+//
+// Controls not accepted by the last reported status are rejected with
+// errco.CALL_NOT_IMPLEMENTED, and co.SERVICE_CONTROL_INTERROGATE is answered
+// right away. Safe to be called from any thread.
+func (me *ServiceRunner) Control(
+	control co.SERVICE_CONTROL, eventType uint32, eventData uintptr) errco.ERROR {
+
+	if control == co.SERVICE_CONTROL_INTERROGATE {
+		return errco.SUCCESS // SCM uses the last reported status
+	}
+
+	me.mutex.Lock()
+	accepts := me.status.DwControlsAccepted
+	me.mutex.Unlock()
+
+	if flag, hasFlag := _ServiceAcceptFlag(control); hasFlag && (accepts&flag) == 0 {
+		return errco.CALL_NOT_IMPLEMENTED
+	}
+
+	req := ServiceRequest{Control: control, EventType: eventType}
+	if control == co.SERVICE_CONTROL_SESSIONCHANGE && eventData != 0 {
+		req.SessionId = (*WTSSESSION_NOTIFICATION)(unsafe.Pointer(eventData)).DwSessionId
+	}
+
+	select {
+	case me.requests <- req:
+		return errco.SUCCESS
+	case <-me.done:
+		return errco.SERVICE_NOT_ACTIVE
+	}
+}
+
+// Runs the ServiceHandler synchronously, returning its exit code.
+func (me *ServiceRunner) Run(args []string) uint32 {
+	me.report(ServiceStatus{
+		State:    co.SERVICE_STATE_START_PENDING,
+		WaitHint: 3 * time.Second,
+	})
+
+	exitCodeChan := make(chan uint32, 1)
+	go func() {
+		exitCodeChan <- me.handler.Execute(args, me.requests, me.statuses)
+	}()
+
+	for {
+		select {
+		case status := <-me.statuses:
+			me.report(status)
+		case exitCode := <-exitCodeChan: // unbuffered statuses were all received
+			close(me.done)
+			me.reportStopped(exitCode)
+			return exitCode
+		}
+	}
+}
+
+// Returns the last status reported to the Service Control Manager.
+func (me *ServiceRunner) Status() SERVICE_STATUS {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	return me.status
+}
+
+func (me *ServiceRunner) report(status ServiceStatus) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	isPending := status.State == co.SERVICE_STATE_START_PENDING ||
+		status.State == co.SERVICE_STATE_STOP_PENDING ||
+		status.State == co.SERVICE_STATE_PAUSE_PENDING ||
+		status.State == co.SERVICE_STATE_CONTINUE_PENDING
+
+	if !isPending {
+		me.status.DwCheckPoint = 0
+	} else if status.State == me.status.DwCurrentState {
+		me.status.DwCheckPoint++ // same pending state, progress is being made
+	} else {
+		me.status.DwCheckPoint = 1
+	}
+
+	me.status.DwCurrentState = status.State
+	me.status.DwControlsAccepted = status.Accepts
+	if status.State == co.SERVICE_STATE_START_PENDING ||
+		status.State == co.SERVICE_STATE_STOP_PENDING {
+		me.status.DwControlsAccepted = co.SERVICE_ACCEPT_NONE
+	}
+	me.status.DwWaitHint = uint32(status.WaitHint / time.Millisecond)
+
+	me.reporter.SetServiceStatus(&me.status)
+}
+
+func (me *ServiceRunner) reportStopped(exitCode uint32) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	me.status.DwCurrentState = co.SERVICE_STATE_STOPPED
+	me.status.DwControlsAccepted = co.SERVICE_ACCEPT_NONE
+	me.status.DwCheckPoint = 0
+	me.status.DwWaitHint = 0
+	if exitCode == 0 {
+		me.status.DwWin32ExitCode = uint32(errco.SUCCESS)
+		me.status.DwServiceSpecificExitCode = 0
+	} else {
+		me.status.DwWin32ExitCode = uint32(errco.SERVICE_SPECIFIC_ERROR)
+		me.status.DwServiceSpecificExitCode = exitCode
+	}
+
+	me.reporter.SetServiceStatus(&me.status)
+}
+
+// Returns the co.SERVICE_ACCEPT flag required by the control; user-defined
+// controls don't require any.
+func _ServiceAcceptFlag(control co.SERVICE_CONTROL) (co.SERVICE_ACCEPT, bool) {
+	switch control {
+	case co.SERVICE_CONTROL_STOP:
+		return co.SERVICE_ACCEPT_STOP, true
+	case co.SERVICE_CONTROL_PAUSE, co.SERVICE_CONTROL_CONTINUE:
+		return co.SERVICE_ACCEPT_PAUSE_CONTINUE, true
+	case co.SERVICE_CONTROL_SHUTDOWN:
+		return co.SERVICE_ACCEPT_SHUTDOWN, true
+	case co.SERVICE_CONTROL_PARAMCHANGE:
+		return co.SERVICE_ACCEPT_PARAMCHANGE, true
+	case co.SERVICE_CONTROL_NETBINDADD, co.SERVICE_CONTROL_NETBINDREMOVE,
+		co.SERVICE_CONTROL_NETBINDENABLE, co.SERVICE_CONTROL_NETBINDDISABLE:
+		return co.SERVICE_ACCEPT_NETBINDCHANGE, true
+	case co.SERVICE_CONTROL_HARDWAREPROFILECHANGE:
+		return co.SERVICE_ACCEPT_HARDWAREPROFILECHANGE, true
+	case co.SERVICE_CONTROL_POWEREVENT:
+		return co.SERVICE_ACCEPT_POWEREVENT, true
+	case co.SERVICE_CONTROL_SESSIONCHANGE:
+		return co.SERVICE_ACCEPT_SESSIONCHANGE, true
+	case co.SERVICE_CONTROL_PRESHUTDOWN:
+		return co.SERVICE_ACCEPT_PRESHUTDOWN, true
+	case co.SERVICE_CONTROL_TIMECHANGE:
+		return co.SERVICE_ACCEPT_TIMECHANGE, true
+	case co.SERVICE_CONTROL_TRIGGEREVENT:
+		return co.SERVICE_ACCEPT_TRIGGEREVENT, true
+	default:
+		return co.SERVICE_ACCEPT_NONE, false
+	}
+}
+
+//------------------------------------------------------------------------------
+
+// Connects the current process to the Service Control Manager and runs the
+// service handler, blocking until the service stops. The process must have
+// been started by the Service Control Manager, as a service of
+// co.SERVICE_TYPE_WIN32_OWN_PROCESS type. Calls [StartServiceCtrlDispatcher]
+// and RegisterServiceCtrlHandlerEx() underneath.
+//
+// The args passed to ServiceHandler.Execute() don't include the service name.
+//
+// If the process was not started as a service, returns
+// errco.FAILED_SERVICE_CONTROLLER_CONNECT, which can be used to detect that
+// the program was run from the command line.
+//
+// Example:
+//
+//	type MySvc struct{}
+//
+//	func (*MySvc) Execute(args []string,
+//		requests <-chan win.ServiceRequest, status chan<- win.ServiceStatus) uint32 {
+//
+//		status <- win.ServiceStatus{State: co.SERVICE_STATE_RUNNING,
+//			Accepts: co.SERVICE_ACCEPT_STOP | co.SERVICE_ACCEPT_SHUTDOWN}
+//		for req := range requests {
+//			if req.Control == co.SERVICE_CONTROL_STOP ||
+//				req.Control == co.SERVICE_CONTROL_SHUTDOWN {
+//				status <- win.ServiceStatus{State: co.SERVICE_STATE_STOP_PENDING}
+//				return 0
+//			}
+//		}
+//		return 0
+//	}
+//
+//	func main() {
+//		err := win.ServiceRun("MySvc", &MySvc{})
+//		if err == errco.FAILED_SERVICE_CONTROLLER_CONNECT {
+//			println("Not running as a service.")
+//		}
+//	}
+//
+// [StartServiceCtrlDispatcher]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-startservicectrldispatcherw
+func ServiceRun(serviceName string, handler ServiceHandler) error {
+	_globalServiceMainMutex.Lock()
+	_globalServiceMainFunc = func(args []string) {
+		reporter := &_ServiceStatusLate{}
+		runner := NewServiceRunner(handler, reporter)
+
+		hStatus, err := RegisterServiceCtrlHandlerEx(serviceName,
+			func(control, eventType uint32, eventData uintptr) errco.ERROR {
+				return runner.Control(co.SERVICE_CONTROL(control), eventType, eventData)
+			})
+		if err != nil {
+			return // nothing can be reported, SCM will time out
+		}
+		reporter.hStatus = hStatus
+
+		runner.Run(args)
+	}
+	_globalServiceMainMutex.Unlock()
+
+	table := []_SERVICE_TABLE_ENTRY{
+		{lpServiceName: Str.ToNativePtr(serviceName), lpServiceProc: _globalServiceMainCallback},
+		{}, // terminating null entry
+	}
+
+	ret, _, err := syscall.SyscallN(proc.StartServiceCtrlDispatcher.Addr(),
+		uintptr(unsafe.Pointer(&table[0])))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// Reports to a HSERVICESTATUS which is known only after the ServiceRunner is
+// created.
+type _ServiceStatusLate struct {
+	hStatus HSERVICESTATUS
+}
+
+func (me *_ServiceStatusLate) SetServiceStatus(status *SERVICE_STATUS) error {
+	return me.hStatus.SetServiceStatus(status)
+}
+
+var (
+	_globalServiceMainFunc     func(args []string)
+	_globalServiceMainMutex    = sync.Mutex{}
+	_globalServiceMainCallback = syscall.NewCallback(
+		func(argc uint32, argv **uint16) uintptr {
+			args := make([]string, 0, argc)
+			if argc > 1 {
+				for _, pArg := range unsafe.Slice(argv, argc)[1:] { // skip service name
+					args = append(args, Str.FromNativePtr(pArg))
+				}
+			}
+
+			_globalServiceMainMutex.Lock()
+			serviceMain := _globalServiceMainFunc
+			_globalServiceMainMutex.Unlock()
+
+			serviceMain(args)
+			return 0
+		})
+)
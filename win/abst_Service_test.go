@@ -0,0 +1,210 @@
+//go:build windows
+
+package win
+
+import (
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Fake Service Control Manager, which receives the reported statuses.
+type _FakeScm struct {
+	statuses chan SERVICE_STATUS
+}
+
+func (me *_FakeScm) SetServiceStatus(status *SERVICE_STATUS) error {
+	me.statuses <- *status
+	return nil
+}
+
+// Waits for the next status reported to the fake SCM.
+func (me *_FakeScm) next(t *testing.T) SERVICE_STATUS {
+	t.Helper()
+	select {
+	case status := <-me.statuses:
+		return status
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for a status")
+		return SERVICE_STATUS{}
+	}
+}
+
+// Expects the next status to have the given state, accepted controls and
+// checkpoint.
+func (me *_FakeScm) expect(t *testing.T,
+	state co.SERVICE_STATE, accepts co.SERVICE_ACCEPT, checkPoint uint32) SERVICE_STATUS {
+
+	t.Helper()
+	status := me.next(t)
+	if status.DwCurrentState != state ||
+		status.DwControlsAccepted != accepts ||
+		status.DwCheckPoint != checkPoint {
+		t.Fatalf("got state %d, accepts %#x, checkpoint %d; want %d, %#x, %d",
+			status.DwCurrentState, status.DwControlsAccepted, status.DwCheckPoint,
+			state, accepts, checkPoint)
+	}
+	return status
+}
+
+const _TEST_SVC_ACCEPTS = co.SERVICE_ACCEPT_STOP | co.SERVICE_ACCEPT_PAUSE_CONTINUE |
+	co.SERVICE_ACCEPT_SHUTDOWN | co.SERVICE_ACCEPT_SESSIONCHANGE
+
+// Service which pauses, continues and stops, forwarding the requests it
+// receives.
+type _TestSvc struct {
+	start    chan struct{} // closed to let the service initialize
+	received chan ServiceRequest
+	exitCode uint32
+}
+
+func (me *_TestSvc) Execute(args []string,
+	requests <-chan ServiceRequest, status chan<- ServiceStatus) uint32 {
+
+	<-me.start
+	status <- ServiceStatus{State: co.SERVICE_STATE_RUNNING, Accepts: _TEST_SVC_ACCEPTS}
+
+	for req := range requests {
+		me.received <- req
+		switch req.Control {
+		case co.SERVICE_CONTROL_PAUSE:
+			status <- ServiceStatus{State: co.SERVICE_STATE_PAUSE_PENDING,
+				Accepts: _TEST_SVC_ACCEPTS, WaitHint: time.Second}
+			status <- ServiceStatus{State: co.SERVICE_STATE_PAUSED, Accepts: _TEST_SVC_ACCEPTS}
+		case co.SERVICE_CONTROL_CONTINUE:
+			status <- ServiceStatus{State: co.SERVICE_STATE_CONTINUE_PENDING,
+				Accepts: _TEST_SVC_ACCEPTS}
+			status <- ServiceStatus{State: co.SERVICE_STATE_RUNNING, Accepts: _TEST_SVC_ACCEPTS}
+		case co.SERVICE_CONTROL_STOP, co.SERVICE_CONTROL_SHUTDOWN:
+			// Accepted controls must be ignored while stopping.
+			status <- ServiceStatus{State: co.SERVICE_STATE_STOP_PENDING,
+				Accepts: _TEST_SVC_ACCEPTS}
+			status <- ServiceStatus{State: co.SERVICE_STATE_STOP_PENDING,
+				Accepts: _TEST_SVC_ACCEPTS}
+			return me.exitCode
+		}
+	}
+	return me.exitCode
+}
+
+// Starts the runner, returning the channel which receives its exit code.
+func startTestSvc(svc *_TestSvc) (*ServiceRunner, *_FakeScm, chan uint32) {
+	scm := &_FakeScm{statuses: make(chan SERVICE_STATUS, 16)}
+	runner := NewServiceRunner(svc, scm)
+	exitCode := make(chan uint32, 1)
+	go func() {
+		exitCode <- runner.Run(nil)
+	}()
+	return runner, scm, exitCode
+}
+
+func newTestSvc(exitCode uint32) *_TestSvc {
+	return &_TestSvc{
+		start:    make(chan struct{}),
+		received: make(chan ServiceRequest, 16),
+		exitCode: exitCode,
+	}
+}
+
+func (me *_TestSvc) expectReceived(t *testing.T, control co.SERVICE_CONTROL) ServiceRequest {
+	t.Helper()
+	select {
+	case req := <-me.received:
+		if req.Control != control {
+			t.Fatalf("service received control %d, want %d", req.Control, control)
+		}
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for a request")
+		return ServiceRequest{}
+	}
+}
+
+func expectControl(t *testing.T, runner *ServiceRunner,
+	control co.SERVICE_CONTROL, eventData uintptr, want errco.ERROR) {
+
+	t.Helper()
+	if got := runner.Control(control, 0, eventData); got != want {
+		t.Fatalf("Control(%d): got %d, want %d", control, got, want)
+	}
+}
+
+func TestServiceRunnerStates(t *testing.T) {
+	svc := newTestSvc(5)
+	runner, scm, exitCode := startTestSvc(svc)
+
+	start := scm.expect(t, co.SERVICE_STATE_START_PENDING, co.SERVICE_ACCEPT_NONE, 1)
+	if start.DwServiceType != co.SERVICE_TYPE_WIN32_OWN_PROCESS || start.DwWaitHint == 0 {
+		t.Errorf("start pending: got %+v", start)
+	}
+	expectControl(t, runner, co.SERVICE_CONTROL_STOP, 0, errco.CALL_NOT_IMPLEMENTED)
+
+	close(svc.start)
+	scm.expect(t, co.SERVICE_STATE_RUNNING, _TEST_SVC_ACCEPTS, 0)
+	expectControl(t, runner, co.SERVICE_CONTROL_INTERROGATE, 0, errco.SUCCESS)
+	expectControl(t, runner, co.SERVICE_CONTROL_PARAMCHANGE, 0, errco.CALL_NOT_IMPLEMENTED)
+
+	session := WTSSESSION_NOTIFICATION{DwSessionId: 3}
+	expectControl(t, runner, co.SERVICE_CONTROL_SESSIONCHANGE,
+		uintptr(unsafe.Pointer(&session)), errco.SUCCESS)
+	if req := svc.expectReceived(t, co.SERVICE_CONTROL_SESSIONCHANGE); req.SessionId != 3 {
+		t.Errorf("SessionId: got %d, want 3", req.SessionId)
+	}
+
+	expectControl(t, runner, co.SERVICE_CONTROL_PAUSE, 0, errco.SUCCESS)
+	svc.expectReceived(t, co.SERVICE_CONTROL_PAUSE)
+	pending := scm.expect(t, co.SERVICE_STATE_PAUSE_PENDING, _TEST_SVC_ACCEPTS, 1)
+	if pending.DwWaitHint != 1000 {
+		t.Errorf("DwWaitHint: got %d, want 1000", pending.DwWaitHint)
+	}
+	scm.expect(t, co.SERVICE_STATE_PAUSED, _TEST_SVC_ACCEPTS, 0)
+
+	expectControl(t, runner, co.SERVICE_CONTROL_CONTINUE, 0, errco.SUCCESS)
+	svc.expectReceived(t, co.SERVICE_CONTROL_CONTINUE)
+	scm.expect(t, co.SERVICE_STATE_CONTINUE_PENDING, _TEST_SVC_ACCEPTS, 1)
+	scm.expect(t, co.SERVICE_STATE_RUNNING, _TEST_SVC_ACCEPTS, 0)
+
+	expectControl(t, runner, co.SERVICE_CONTROL_SHUTDOWN, 0, errco.SUCCESS)
+	svc.expectReceived(t, co.SERVICE_CONTROL_SHUTDOWN)
+	scm.expect(t, co.SERVICE_STATE_STOP_PENDING, co.SERVICE_ACCEPT_NONE, 1)
+	scm.expect(t, co.SERVICE_STATE_STOP_PENDING, co.SERVICE_ACCEPT_NONE, 2)
+	stopped := scm.expect(t, co.SERVICE_STATE_STOPPED, co.SERVICE_ACCEPT_NONE, 0)
+	if stopped.DwWin32ExitCode != uint32(errco.SERVICE_SPECIFIC_ERROR) ||
+		stopped.DwServiceSpecificExitCode != 5 {
+		t.Errorf("stopped: got exit codes %d, %d",
+			stopped.DwWin32ExitCode, stopped.DwServiceSpecificExitCode)
+	}
+
+	if code := <-exitCode; code != 5 {
+		t.Errorf("Run: got exit code %d, want 5", code)
+	}
+	if status := runner.Status(); status != stopped {
+		t.Errorf("Status: got %+v, want %+v", status, stopped)
+	}
+	expectControl(t, runner, co.SERVICE_CONTROL_STOP, 0, errco.CALL_NOT_IMPLEMENTED)
+}
+
+func TestServiceRunnerStop(t *testing.T) {
+	svc := newTestSvc(0)
+	runner, scm, exitCode := startTestSvc(svc)
+	close(svc.start)
+
+	scm.expect(t, co.SERVICE_STATE_START_PENDING, co.SERVICE_ACCEPT_NONE, 1)
+	scm.expect(t, co.SERVICE_STATE_RUNNING, _TEST_SVC_ACCEPTS, 0)
+
+	expectControl(t, runner, co.SERVICE_CONTROL_STOP, 0, errco.SUCCESS)
+	svc.expectReceived(t, co.SERVICE_CONTROL_STOP)
+	scm.expect(t, co.SERVICE_STATE_STOP_PENDING, co.SERVICE_ACCEPT_NONE, 1)
+	scm.expect(t, co.SERVICE_STATE_STOP_PENDING, co.SERVICE_ACCEPT_NONE, 2)
+	stopped := scm.expect(t, co.SERVICE_STATE_STOPPED, co.SERVICE_ACCEPT_NONE, 0)
+	if stopped.DwWin32ExitCode != uint32(errco.SUCCESS) || stopped.DwServiceSpecificExitCode != 0 {
+		t.Errorf("stopped: got exit codes %d, %d",
+			stopped.DwWin32ExitCode, stopped.DwServiceSpecificExitCode)
+	}
+	if code := <-exitCode; code != 0 {
+		t.Errorf("Run: got exit code %d, want 0", code)
+	}
+}
@@ -90,6 +90,66 @@ func (_StrT) FromNativeSlice(s []uint16) string {
 	return syscall.UTF16ToString(s)
 }
 
+// Quotes and joins the arguments into a single command line, which is the
+// exact inverse of CommandLineToArgv(): the first argument is treated as the
+// program name, and the remaining ones follow the backslash and quote escaping
+// rules.
+//
+// Panics if the program name contains a quote, since it cannot be escaped.
+//
+// Example:
+//
+//	cmdLine := win.Str.JoinArgv([]string{
+//		"C:\\Program Files\\foo.exe", "a b", "say \"hi\""})
+//
+//	// "C:\Program Files\foo.exe" "a b" "say \"hi\""
+func (_StrT) JoinArgv(argv []string) string {
+	var buf strings.Builder
+
+	for i, arg := range argv {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+
+		if i == 0 { // program name: quotes only toggle, no escaping
+			if strings.ContainsRune(arg, '"') {
+				panic(fmt.Sprintf("Str.JoinArgv() failed, program name has quotes: %s", arg))
+			}
+			if arg == "" || strings.ContainsAny(arg, " \t") {
+				buf.WriteString("\"" + arg + "\"")
+			} else {
+				buf.WriteString(arg)
+			}
+			continue
+		}
+
+		if arg != "" && !strings.ContainsAny(arg, " \t\n\v\"") {
+			buf.WriteString(arg) // no quoting needed
+			continue
+		}
+
+		buf.WriteByte('"')
+		numBackslashes := 0
+		for _, ch := range arg {
+			switch ch {
+			case '\\':
+				numBackslashes++
+				continue
+			case '"':
+				buf.WriteString(strings.Repeat("\\", numBackslashes*2+1))
+			default:
+				buf.WriteString(strings.Repeat("\\", numBackslashes))
+			}
+			numBackslashes = 0
+			buf.WriteRune(ch)
+		}
+		buf.WriteString(strings.Repeat("\\", numBackslashes*2)) // backslashes before closing quote
+		buf.WriteByte('"')
+	}
+
+	return buf.String()
+}
+
 // Returns a new string with all diacritics removed.
 func (_StrT) RemoveDiacritics(s string) string {
 	diacs := []rune("ÁáÀàÃãÂâÄäÉéÈèÊêËëÍíÌìÎîÏïÓóÒòÕõÔôÖöÚúÙùÛûÜüÇçÅåÐðÑñØøÝý")
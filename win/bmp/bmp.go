@@ -0,0 +1,97 @@
+// Package bmp implements a .bmp file codec, registered with
+// image.RegisterFormat, so importing this package allows image.Decode() to
+// read .bmp files.
+//
+// Supports BITMAPCOREHEADER, BITMAPINFOHEADER and BITMAPV4/V5HEADER files with
+// 1, 4, 8, 16, 24 and 32 bits per pixel, uncompressed or with bit fields.
+// Encoding always produces a 32-bit BITMAPV5HEADER file with alpha.
+//
+// This package has no Windows dependencies, so it can be used on any OS.
+//
+// Example:
+//
+//	import (
+//		"image/png"
+//		"os"
+//
+//		"github.com/rodrigocfd/windigo/win/bmp"
+//	)
+//
+//	fin, _ := os.Open("C:\\Temp\\foo.bmp")
+//	defer fin.Close()
+//	img, _ := bmp.Decode(fin)
+//
+//	fout, _ := os.Create("C:\\Temp\\foo.png")
+//	defer fout.Close()
+//	png.Encode(fout, img)
+package bmp
+
+import (
+	"encoding/binary"
+	"image"
+	"io"
+
+	"github.com/rodrigocfd/windigo/internal/dib"
+)
+
+// Size of the BITMAPFILEHEADER struct.
+const _FILE_HEADER_SIZE = 14
+
+// Returned when the data is not a valid .bmp file.
+var ErrFormat = dib.ErrFormat
+
+func init() {
+	image.RegisterFormat("bmp", "BM", Decode, DecodeConfig)
+}
+
+// Reads the whole file, and returns the DIB and the offset of its pixels,
+// relative to the DIB.
+func readFile(r io.Reader) (packedDib []byte, bitsOffset int, e error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(data) < _FILE_HEADER_SIZE || data[0] != 'B' || data[1] != 'M' {
+		return nil, 0, ErrFormat
+	}
+	offBits := int(binary.LittleEndian.Uint32(data[10:]))
+	if offBits < _FILE_HEADER_SIZE || offBits > len(data) {
+		return nil, 0, ErrFormat
+	}
+	return data[_FILE_HEADER_SIZE:], offBits - _FILE_HEADER_SIZE, nil
+}
+
+// Decodes a .bmp file.
+func Decode(r io.Reader) (image.Image, error) {
+	packedDib, bitsOffset, err := readFile(r)
+	if err != nil {
+		return nil, err
+	}
+	return dib.Decode(packedDib, bitsOffset)
+}
+
+// Returns the dimensions and color model of a .bmp file, without decoding the
+// pixels.
+func DecodeConfig(r io.Reader) (image.Config, error) {
+	packedDib, _, err := readFile(r)
+	if err != nil {
+		return image.Config{}, err
+	}
+	return dib.DecodeConfig(packedDib)
+}
+
+// Encodes the image as a 32-bit .bmp file with alpha channel.
+func Encode(w io.Writer, img image.Image) error {
+	packedDib := dib.Encode(img)
+
+	var hdr [_FILE_HEADER_SIZE]byte
+	hdr[0], hdr[1] = 'B', 'M'
+	binary.LittleEndian.PutUint32(hdr[2:], uint32(_FILE_HEADER_SIZE+len(packedDib)))
+	binary.LittleEndian.PutUint32(hdr[10:], uint32(_FILE_HEADER_SIZE+dib.HeaderSize(packedDib)))
+
+	if _, err := w.Write(hdr[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(packedDib)
+	return err
+}
@@ -0,0 +1,61 @@
+package bmp
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestImageDecode(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 255})
+	img.SetNRGBA(2, 0, color.NRGBA{G: 255, A: 128})
+	img.SetNRGBA(1, 1, color.NRGBA{B: 255, A: 0})
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("DecodeConfig: %v", err)
+	}
+	if format != "bmp" || cfg.Width != 3 || cfg.Height != 2 {
+		t.Errorf("DecodeConfig: got %q %dx%d", format, cfg.Width, cfg.Height)
+	}
+
+	decoded, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if format != "bmp" {
+		t.Errorf("format: got %q", format)
+	}
+	if decoded.Bounds() != img.Bounds() {
+		t.Fatalf("bounds: got %v, want %v", decoded.Bounds(), img.Bounds())
+	}
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			got := color.NRGBAModel.Convert(decoded.At(x, y))
+			if want := img.NRGBAAt(x, y); got != want {
+				t.Errorf("(%d,%d): got %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"empty":      {},
+		"not BM":     []byte("PK\x03\x04 this is a zip file, not a bitmap"),
+		"bad offset": append([]byte("BM"), 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0, 0),
+	} {
+		if _, err := Decode(bytes.NewReader(data)); !errors.Is(err, ErrFormat) {
+			t.Errorf("%s: got %v, want ErrFormat", name, err)
+		}
+	}
+}
@@ -2,6 +2,87 @@
 
 package co
 
+// [EXPLICIT_ACCESS] grfAccessMode.
+//
+// [EXPLICIT_ACCESS]: https://learn.microsoft.com/en-us/windows/win32/api/accctrl/ns-accctrl-explicit_access_w
+type ACCESS_MODE uint32
+
+const (
+	ACCESS_MODE_NOT_USED          ACCESS_MODE = 0
+	ACCESS_MODE_GRANT             ACCESS_MODE = 1
+	ACCESS_MODE_SET               ACCESS_MODE = 2
+	ACCESS_MODE_DENY              ACCESS_MODE = 3
+	ACCESS_MODE_REVOKE            ACCESS_MODE = 4
+	ACCESS_MODE_SET_AUDIT_SUCCESS ACCESS_MODE = 5
+	ACCESS_MODE_SET_AUDIT_FAILURE ACCESS_MODE = 6
+)
+
+// [EXPLICIT_ACCESS] grfInheritance, and ACE inheritance flags.
+//
+// [EXPLICIT_ACCESS]: https://learn.microsoft.com/en-us/windows/win32/api/accctrl/ns-accctrl-explicit_access_w
+type ACE uint32
+
+const (
+	ACE_NO_INHERITANCE                     ACE = 0x0
+	ACE_OBJECT_INHERIT                     ACE = 0x1
+	ACE_CONTAINER_INHERIT                  ACE = 0x2
+	ACE_SUB_CONTAINERS_AND_OBJECTS_INHERIT ACE = ACE_OBJECT_INHERIT | ACE_CONTAINER_INHERIT
+	ACE_NO_PROPAGATE_INHERIT               ACE = 0x4
+	ACE_INHERIT_ONLY                       ACE = 0x8
+	ACE_INHERITED                          ACE = 0x10
+)
+
+// [CREDENTIAL] Flags.
+//
+// [CREDENTIAL]: https://learn.microsoft.com/en-us/windows/win32/api/wincred/ns-wincred-credentialw
+type CRED_FLAGS uint32
+
+const (
+	CRED_FLAGS_NONE            CRED_FLAGS = 0
+	CRED_FLAGS_PROMPT_NOW      CRED_FLAGS = 0x2
+	CRED_FLAGS_USERNAME_TARGET CRED_FLAGS = 0x4
+)
+
+// [CREDENTIAL] Persist.
+//
+// [CREDENTIAL]: https://learn.microsoft.com/en-us/windows/win32/api/wincred/ns-wincred-credentialw
+type CRED_PERSIST uint32
+
+const (
+	CRED_PERSIST_NONE          CRED_PERSIST = 0
+	CRED_PERSIST_SESSION       CRED_PERSIST = 1
+	CRED_PERSIST_LOCAL_MACHINE CRED_PERSIST = 2
+	CRED_PERSIST_ENTERPRISE    CRED_PERSIST = 3
+)
+
+// [CREDENTIAL] Type.
+//
+// [CREDENTIAL]: https://learn.microsoft.com/en-us/windows/win32/api/wincred/ns-wincred-credentialw
+type CRED_TYPE uint32
+
+const (
+	CRED_TYPE_GENERIC                 CRED_TYPE = 1
+	CRED_TYPE_DOMAIN_PASSWORD         CRED_TYPE = 2
+	CRED_TYPE_DOMAIN_CERTIFICATE      CRED_TYPE = 3
+	CRED_TYPE_DOMAIN_VISIBLE_PASSWORD CRED_TYPE = 4
+	CRED_TYPE_GENERIC_CERTIFICATE     CRED_TYPE = 5
+	CRED_TYPE_DOMAIN_EXTENDED         CRED_TYPE = 6
+)
+
+// [ReportEvent] wType, the event levels of the classic Event Log.
+//
+// [ReportEvent]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-reporteventw
+type EVENTLOG uint16
+
+const (
+	EVENTLOG_SUCCESS          EVENTLOG = 0x0000
+	EVENTLOG_ERROR_TYPE       EVENTLOG = 0x0001
+	EVENTLOG_WARNING_TYPE     EVENTLOG = 0x0002
+	EVENTLOG_INFORMATION_TYPE EVENTLOG = 0x0004
+	EVENTLOG_AUDIT_SUCCESS    EVENTLOG = 0x0008
+	EVENTLOG_AUDIT_FAILURE    EVENTLOG = 0x0010
+)
+
 // Registry key security and access rights
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/sysinfo/registry-key-security-and-access-rights
@@ -101,3 +182,460 @@ const (
 	RRF_NOEXPAND          RRF = 0x1000_0000
 	RRF_ZEROONFAILURE     RRF = 0x2000_0000
 )
+
+// OpenSCManager() dwDesiredAccess.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/services/service-security-and-access-rights
+type SC_MANAGER uint32
+
+const (
+	SC_MANAGER_CONNECT            SC_MANAGER = 0x0001
+	SC_MANAGER_CREATE_SERVICE     SC_MANAGER = 0x0002
+	SC_MANAGER_ENUMERATE_SERVICE  SC_MANAGER = 0x0004
+	SC_MANAGER_LOCK               SC_MANAGER = 0x0008
+	SC_MANAGER_QUERY_LOCK_STATUS  SC_MANAGER = 0x0010
+	SC_MANAGER_MODIFY_BOOT_CONFIG SC_MANAGER = 0x0020
+	SC_MANAGER_ALL_ACCESS         SC_MANAGER = SC_MANAGER(STANDARD_RIGHTS_REQUIRED) | SC_MANAGER_CONNECT | SC_MANAGER_CREATE_SERVICE | SC_MANAGER_ENUMERATE_SERVICE | SC_MANAGER_LOCK | SC_MANAGER_QUERY_LOCK_STATUS | SC_MANAGER_MODIFY_BOOT_CONFIG
+)
+
+// [SC_ACTION] Type.
+//
+// [SC_ACTION]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-sc_action
+type SC_ACTION_TYPE uint32
+
+const (
+	SC_ACTION_NONE        SC_ACTION_TYPE = 0
+	SC_ACTION_RESTART     SC_ACTION_TYPE = 1
+	SC_ACTION_REBOOT      SC_ACTION_TYPE = 2
+	SC_ACTION_RUN_COMMAND SC_ACTION_TYPE = 3
+)
+
+// [SECURITY_INFORMATION] flags.
+//
+// [SECURITY_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/secauthz/security-information
+type SECURITY_INFORMATION uint32
+
+const (
+	SECURITY_INFORMATION_OWNER            SECURITY_INFORMATION = 0x0000_0001
+	SECURITY_INFORMATION_GROUP            SECURITY_INFORMATION = 0x0000_0002
+	SECURITY_INFORMATION_DACL             SECURITY_INFORMATION = 0x0000_0004
+	SECURITY_INFORMATION_SACL             SECURITY_INFORMATION = 0x0000_0008
+	SECURITY_INFORMATION_LABEL            SECURITY_INFORMATION = 0x0000_0010
+	SECURITY_INFORMATION_ATTRIBUTE        SECURITY_INFORMATION = 0x0000_0020
+	SECURITY_INFORMATION_SCOPE            SECURITY_INFORMATION = 0x0000_0040
+	SECURITY_INFORMATION_BACKUP           SECURITY_INFORMATION = 0x0001_0000
+	SECURITY_INFORMATION_UNPROTECTED_SACL SECURITY_INFORMATION = 0x1000_0000
+	SECURITY_INFORMATION_UNPROTECTED_DACL SECURITY_INFORMATION = 0x2000_0000
+	SECURITY_INFORMATION_PROTECTED_SACL   SECURITY_INFORMATION = 0x4000_0000
+	SECURITY_INFORMATION_PROTECTED_DACL   SECURITY_INFORMATION = 0x8000_0000
+)
+
+// Mandatory integrity level, the last subauthority of the integrity level SID.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/secauthz/mandatory-integrity-control
+type SECURITY_MANDATORY uint32
+
+const (
+	SECURITY_MANDATORY_UNTRUSTED         SECURITY_MANDATORY = 0x0000
+	SECURITY_MANDATORY_LOW               SECURITY_MANDATORY = 0x1000
+	SECURITY_MANDATORY_MEDIUM            SECURITY_MANDATORY = 0x2000
+	SECURITY_MANDATORY_MEDIUM_PLUS       SECURITY_MANDATORY = 0x2100
+	SECURITY_MANDATORY_HIGH              SECURITY_MANDATORY = 0x3000
+	SECURITY_MANDATORY_SYSTEM            SECURITY_MANDATORY = 0x4000
+	SECURITY_MANDATORY_PROTECTED_PROCESS SECURITY_MANDATORY = 0x5000
+)
+
+// [SID_AND_ATTRIBUTES] Attributes of token groups.
+//
+// [SID_AND_ATTRIBUTES]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-sid_and_attributes
+type SE_GROUP uint32
+
+const (
+	SE_GROUP_MANDATORY          SE_GROUP = 0x0000_0001
+	SE_GROUP_ENABLED_BY_DEFAULT SE_GROUP = 0x0000_0002
+	SE_GROUP_ENABLED            SE_GROUP = 0x0000_0004
+	SE_GROUP_OWNER              SE_GROUP = 0x0000_0008
+	SE_GROUP_USE_FOR_DENY_ONLY  SE_GROUP = 0x0000_0010
+	SE_GROUP_INTEGRITY          SE_GROUP = 0x0000_0020
+	SE_GROUP_INTEGRITY_ENABLED  SE_GROUP = 0x0000_0040
+	SE_GROUP_RESOURCE           SE_GROUP = 0x2000_0000
+	SE_GROUP_LOGON_ID           SE_GROUP = 0xc000_0000
+)
+
+// [SE_OBJECT_TYPE] enumeration.
+//
+// [SE_OBJECT_TYPE]: https://learn.microsoft.com/en-us/windows/win32/api/accctrl/ne-accctrl-se_object_type
+type SE_OBJECT uint32
+
+const (
+	SE_OBJECT_UNKNOWN              SE_OBJECT = 0
+	SE_OBJECT_FILE                 SE_OBJECT = 1
+	SE_OBJECT_SERVICE              SE_OBJECT = 2
+	SE_OBJECT_PRINTER              SE_OBJECT = 3
+	SE_OBJECT_REGISTRY_KEY         SE_OBJECT = 4
+	SE_OBJECT_LMSHARE              SE_OBJECT = 5
+	SE_OBJECT_KERNEL_OBJECT        SE_OBJECT = 6
+	SE_OBJECT_WINDOW_OBJECT        SE_OBJECT = 7
+	SE_OBJECT_DS_OBJECT            SE_OBJECT = 8
+	SE_OBJECT_DS_OBJECT_ALL        SE_OBJECT = 9
+	SE_OBJECT_PROVIDER             SE_OBJECT = 10
+	SE_OBJECT_WMIGUID_OBJECT       SE_OBJECT = 11
+	SE_OBJECT_REGISTRY_WOW64_32KEY SE_OBJECT = 12
+	SE_OBJECT_REGISTRY_WOW64_64KEY SE_OBJECT = 13
+)
+
+// [Privilege constants], used with LookupPrivilegeValue().
+//
+// [Privilege constants]: https://learn.microsoft.com/en-us/windows/win32/secauthz/privilege-constants
+type SE_PRIV string
+
+const (
+	SE_PRIV_BACKUP               SE_PRIV = "SeBackupPrivilege"
+	SE_PRIV_CHANGE_NOTIFY        SE_PRIV = "SeChangeNotifyPrivilege"
+	SE_PRIV_CREATE_GLOBAL        SE_PRIV = "SeCreateGlobalPrivilege"
+	SE_PRIV_CREATE_SYMBOLIC_LINK SE_PRIV = "SeCreateSymbolicLinkPrivilege"
+	SE_PRIV_DEBUG                SE_PRIV = "SeDebugPrivilege"
+	SE_PRIV_IMPERSONATE          SE_PRIV = "SeImpersonatePrivilege"
+	SE_PRIV_INC_WORKING_SET      SE_PRIV = "SeIncreaseWorkingSetPrivilege"
+	SE_PRIV_LOAD_DRIVER          SE_PRIV = "SeLoadDriverPrivilege"
+	SE_PRIV_LOCK_MEMORY          SE_PRIV = "SeLockMemoryPrivilege"
+	SE_PRIV_MANAGE_VOLUME        SE_PRIV = "SeManageVolumePrivilege"
+	SE_PRIV_RESTORE              SE_PRIV = "SeRestorePrivilege"
+	SE_PRIV_SECURITY             SE_PRIV = "SeSecurityPrivilege"
+	SE_PRIV_SHUTDOWN             SE_PRIV = "SeShutdownPrivilege"
+	SE_PRIV_SYSTEMTIME           SE_PRIV = "SeSystemtimePrivilege"
+	SE_PRIV_TAKE_OWNERSHIP       SE_PRIV = "SeTakeOwnershipPrivilege"
+	SE_PRIV_TIME_ZONE            SE_PRIV = "SeTimeZonePrivilege"
+	SE_PRIV_UNDOCK               SE_PRIV = "SeUndockPrivilege"
+)
+
+// [LUID_AND_ATTRIBUTES] Attributes of token privileges.
+//
+// [LUID_AND_ATTRIBUTES]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-luid_and_attributes
+type SE_PRIVILEGE uint32
+
+const (
+	SE_PRIVILEGE_NONE               SE_PRIVILEGE = 0
+	SE_PRIVILEGE_ENABLED_BY_DEFAULT SE_PRIVILEGE = 0x0000_0001
+	SE_PRIVILEGE_ENABLED            SE_PRIVILEGE = 0x0000_0002
+	SE_PRIVILEGE_REMOVED            SE_PRIVILEGE = 0x0000_0004
+	SE_PRIVILEGE_USED_FOR_ACCESS    SE_PRIVILEGE = 0x8000_0000
+)
+
+// Service security and access rights.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/services/service-security-and-access-rights
+type SERVICE uint32
+
+const (
+	SERVICE_QUERY_CONFIG         SERVICE = 0x0001
+	SERVICE_CHANGE_CONFIG        SERVICE = 0x0002
+	SERVICE_QUERY_STATUS         SERVICE = 0x0004
+	SERVICE_ENUMERATE_DEPENDENTS SERVICE = 0x0008
+	SERVICE_START                SERVICE = 0x0010
+	SERVICE_STOP                 SERVICE = 0x0020
+	SERVICE_PAUSE_CONTINUE       SERVICE = 0x0040
+	SERVICE_INTERROGATE          SERVICE = 0x0080
+	SERVICE_USER_DEFINED_CONTROL SERVICE = 0x0100
+	SERVICE_DELETE               SERVICE = SERVICE(STANDARD_RIGHTS_DELETE)
+	SERVICE_ALL_ACCESS           SERVICE = SERVICE(STANDARD_RIGHTS_REQUIRED) | 0x01ff
+)
+
+// [SERVICE_STATUS] dwControlsAccepted.
+//
+// [SERVICE_STATUS]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_status
+type SERVICE_ACCEPT uint32
+
+const (
+	SERVICE_ACCEPT_NONE                  SERVICE_ACCEPT = 0
+	SERVICE_ACCEPT_STOP                  SERVICE_ACCEPT = 0x0000_0001
+	SERVICE_ACCEPT_PAUSE_CONTINUE        SERVICE_ACCEPT = 0x0000_0002
+	SERVICE_ACCEPT_SHUTDOWN              SERVICE_ACCEPT = 0x0000_0004
+	SERVICE_ACCEPT_PARAMCHANGE           SERVICE_ACCEPT = 0x0000_0008
+	SERVICE_ACCEPT_NETBINDCHANGE         SERVICE_ACCEPT = 0x0000_0010
+	SERVICE_ACCEPT_HARDWAREPROFILECHANGE SERVICE_ACCEPT = 0x0000_0020
+	SERVICE_ACCEPT_POWEREVENT            SERVICE_ACCEPT = 0x0000_0040
+	SERVICE_ACCEPT_SESSIONCHANGE         SERVICE_ACCEPT = 0x0000_0080
+	SERVICE_ACCEPT_PRESHUTDOWN           SERVICE_ACCEPT = 0x0000_0100
+	SERVICE_ACCEPT_TIMECHANGE            SERVICE_ACCEPT = 0x0000_0200
+	SERVICE_ACCEPT_TRIGGEREVENT          SERVICE_ACCEPT = 0x0000_0400
+)
+
+// ChangeServiceConfig2() and QueryServiceConfig2() dwInfoLevel.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-changeserviceconfig2w
+type SERVICE_CONFIG uint32
+
+const (
+	SERVICE_CONFIG_DESCRIPTION              SERVICE_CONFIG = 1
+	SERVICE_CONFIG_FAILURE_ACTIONS          SERVICE_CONFIG = 2
+	SERVICE_CONFIG_DELAYED_AUTO_START_INFO  SERVICE_CONFIG = 3
+	SERVICE_CONFIG_FAILURE_ACTIONS_FLAG     SERVICE_CONFIG = 4
+	SERVICE_CONFIG_SERVICE_SID_INFO         SERVICE_CONFIG = 5
+	SERVICE_CONFIG_REQUIRED_PRIVILEGES_INFO SERVICE_CONFIG = 6
+	SERVICE_CONFIG_PRESHUTDOWN_INFO         SERVICE_CONFIG = 7
+)
+
+// ControlService() and HandlerEx() dwControl.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nc-winsvc-lphandler_function_ex
+type SERVICE_CONTROL uint32
+
+const (
+	SERVICE_CONTROL_STOP                  SERVICE_CONTROL = 0x0000_0001
+	SERVICE_CONTROL_PAUSE                 SERVICE_CONTROL = 0x0000_0002
+	SERVICE_CONTROL_CONTINUE              SERVICE_CONTROL = 0x0000_0003
+	SERVICE_CONTROL_INTERROGATE           SERVICE_CONTROL = 0x0000_0004
+	SERVICE_CONTROL_SHUTDOWN              SERVICE_CONTROL = 0x0000_0005
+	SERVICE_CONTROL_PARAMCHANGE           SERVICE_CONTROL = 0x0000_0006
+	SERVICE_CONTROL_NETBINDADD            SERVICE_CONTROL = 0x0000_0007
+	SERVICE_CONTROL_NETBINDREMOVE         SERVICE_CONTROL = 0x0000_0008
+	SERVICE_CONTROL_NETBINDENABLE         SERVICE_CONTROL = 0x0000_0009
+	SERVICE_CONTROL_NETBINDDISABLE        SERVICE_CONTROL = 0x0000_000a
+	SERVICE_CONTROL_DEVICEEVENT           SERVICE_CONTROL = 0x0000_000b
+	SERVICE_CONTROL_HARDWAREPROFILECHANGE SERVICE_CONTROL = 0x0000_000c
+	SERVICE_CONTROL_POWEREVENT            SERVICE_CONTROL = 0x0000_000d
+	SERVICE_CONTROL_SESSIONCHANGE         SERVICE_CONTROL = 0x0000_000e
+	SERVICE_CONTROL_PRESHUTDOWN           SERVICE_CONTROL = 0x0000_000f
+	SERVICE_CONTROL_TIMECHANGE            SERVICE_CONTROL = 0x0000_0010
+	SERVICE_CONTROL_TRIGGEREVENT          SERVICE_CONTROL = 0x0000_0020
+)
+
+// CreateService() and ChangeServiceConfig() dwErrorControl.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-createservicew
+type SERVICE_ERROR uint32
+
+const (
+	SERVICE_ERROR_IGNORE   SERVICE_ERROR = 0x0000_0000
+	SERVICE_ERROR_NORMAL   SERVICE_ERROR = 0x0000_0001
+	SERVICE_ERROR_SEVERE   SERVICE_ERROR = 0x0000_0002
+	SERVICE_ERROR_CRITICAL SERVICE_ERROR = 0x0000_0003
+	SERVICE_ERROR_NOCHANGE SERVICE_ERROR = 0xffff_ffff // Only for ChangeServiceConfig().
+)
+
+// CreateService() and ChangeServiceConfig() dwStartType.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-createservicew
+type SERVICE_START_TYPE uint32
+
+const (
+	SERVICE_START_TYPE_BOOT     SERVICE_START_TYPE = 0x0000_0000
+	SERVICE_START_TYPE_SYSTEM   SERVICE_START_TYPE = 0x0000_0001
+	SERVICE_START_TYPE_AUTO     SERVICE_START_TYPE = 0x0000_0002
+	SERVICE_START_TYPE_DEMAND   SERVICE_START_TYPE = 0x0000_0003
+	SERVICE_START_TYPE_DISABLED SERVICE_START_TYPE = 0x0000_0004
+	SERVICE_START_TYPE_NOCHANGE SERVICE_START_TYPE = 0xffff_ffff // Only for ChangeServiceConfig().
+)
+
+// [SERVICE_STATUS] dwCurrentState.
+//
+// [SERVICE_STATUS]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_status
+type SERVICE_STATE uint32
+
+const (
+	SERVICE_STATE_STOPPED          SERVICE_STATE = 0x0000_0001
+	SERVICE_STATE_START_PENDING    SERVICE_STATE = 0x0000_0002
+	SERVICE_STATE_STOP_PENDING     SERVICE_STATE = 0x0000_0003
+	SERVICE_STATE_RUNNING          SERVICE_STATE = 0x0000_0004
+	SERVICE_STATE_CONTINUE_PENDING SERVICE_STATE = 0x0000_0005
+	SERVICE_STATE_PAUSE_PENDING    SERVICE_STATE = 0x0000_0006
+	SERVICE_STATE_PAUSED           SERVICE_STATE = 0x0000_0007
+)
+
+// [SERVICE_STATUS] dwServiceType, and also CreateService() and
+// ChangeServiceConfig() dwServiceType.
+//
+// [SERVICE_STATUS]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_status
+type SERVICE_TYPE uint32
+
+const (
+	SERVICE_TYPE_KERNEL_DRIVER       SERVICE_TYPE = 0x0000_0001
+	SERVICE_TYPE_FILE_SYSTEM_DRIVER  SERVICE_TYPE = 0x0000_0002
+	SERVICE_TYPE_WIN32_OWN_PROCESS   SERVICE_TYPE = 0x0000_0010
+	SERVICE_TYPE_WIN32_SHARE_PROCESS SERVICE_TYPE = 0x0000_0020
+	SERVICE_TYPE_INTERACTIVE_PROCESS SERVICE_TYPE = 0x0000_0100
+	SERVICE_TYPE_NOCHANGE            SERVICE_TYPE = 0xffff_ffff // Only for ChangeServiceConfig().
+)
+
+// [SID_NAME_USE] enumeration.
+//
+// [SID_NAME_USE]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ne-winnt-sid_name_use
+type SID_NAME_USE uint32
+
+const (
+	SID_NAME_USE_USER             SID_NAME_USE = 1
+	SID_NAME_USE_GROUP            SID_NAME_USE = 2
+	SID_NAME_USE_DOMAIN           SID_NAME_USE = 3
+	SID_NAME_USE_ALIAS            SID_NAME_USE = 4
+	SID_NAME_USE_WELL_KNOWN_GROUP SID_NAME_USE = 5
+	SID_NAME_USE_DELETED_ACCOUNT  SID_NAME_USE = 6
+	SID_NAME_USE_INVALID          SID_NAME_USE = 7
+	SID_NAME_USE_UNKNOWN          SID_NAME_USE = 8
+	SID_NAME_USE_COMPUTER         SID_NAME_USE = 9
+	SID_NAME_USE_LABEL            SID_NAME_USE = 10
+	SID_NAME_USE_LOGON_SESSION    SID_NAME_USE = 11
+)
+
+// Access token [access rights].
+//
+// [access rights]: https://learn.microsoft.com/en-us/windows/win32/secauthz/access-rights-for-access-token-objects
+type TOKEN uint32
+
+const (
+	TOKEN_ASSIGN_PRIMARY    TOKEN = 0x0001
+	TOKEN_DUPLICATE         TOKEN = 0x0002
+	TOKEN_IMPERSONATE       TOKEN = 0x0004
+	TOKEN_QUERY             TOKEN = 0x0008
+	TOKEN_QUERY_SOURCE      TOKEN = 0x0010
+	TOKEN_ADJUST_PRIVILEGES TOKEN = 0x0020
+	TOKEN_ADJUST_GROUPS     TOKEN = 0x0040
+	TOKEN_ADJUST_DEFAULT    TOKEN = 0x0080
+	TOKEN_ADJUST_SESSIONID  TOKEN = 0x0100
+	TOKEN_READ              TOKEN = TOKEN(STANDARD_RIGHTS_READ) | TOKEN_QUERY
+	TOKEN_WRITE             TOKEN = TOKEN(STANDARD_RIGHTS_WRITE) | TOKEN_ADJUST_PRIVILEGES | TOKEN_ADJUST_GROUPS | TOKEN_ADJUST_DEFAULT
+	TOKEN_ALL_ACCESS        TOKEN = TOKEN(STANDARD_RIGHTS_REQUIRED) | 0x01ff
+)
+
+// [TOKEN_ELEVATION_TYPE] enumeration.
+//
+// [TOKEN_ELEVATION_TYPE]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ne-winnt-token_elevation_type
+type TOKEN_ELEVATION_TYPE uint32
+
+const (
+	TOKEN_ELEVATION_TYPE_DEFAULT TOKEN_ELEVATION_TYPE = 1
+	TOKEN_ELEVATION_TYPE_FULL    TOKEN_ELEVATION_TYPE = 2
+	TOKEN_ELEVATION_TYPE_LIMITED TOKEN_ELEVATION_TYPE = 3
+)
+
+// [TOKEN_INFORMATION_CLASS] enumeration.
+//
+// [TOKEN_INFORMATION_CLASS]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ne-winnt-token_information_class
+type TOKEN_INFO uint32
+
+const (
+	TOKEN_INFO_USER                   TOKEN_INFO = 1
+	TOKEN_INFO_GROUPS                 TOKEN_INFO = 2
+	TOKEN_INFO_PRIVILEGES             TOKEN_INFO = 3
+	TOKEN_INFO_OWNER                  TOKEN_INFO = 4
+	TOKEN_INFO_PRIMARY_GROUP          TOKEN_INFO = 5
+	TOKEN_INFO_DEFAULT_DACL           TOKEN_INFO = 6
+	TOKEN_INFO_SOURCE                 TOKEN_INFO = 7
+	TOKEN_INFO_TYPE                   TOKEN_INFO = 8
+	TOKEN_INFO_IMPERSONATION_LEVEL    TOKEN_INFO = 9
+	TOKEN_INFO_STATISTICS             TOKEN_INFO = 10
+	TOKEN_INFO_RESTRICTED_SIDS        TOKEN_INFO = 11
+	TOKEN_INFO_SESSION_ID             TOKEN_INFO = 12
+	TOKEN_INFO_GROUPS_AND_PRIVILEGES  TOKEN_INFO = 13
+	TOKEN_INFO_SESSION_REFERENCE      TOKEN_INFO = 14
+	TOKEN_INFO_SANDBOX_INERT          TOKEN_INFO = 15
+	TOKEN_INFO_AUDIT_POLICY           TOKEN_INFO = 16
+	TOKEN_INFO_ORIGIN                 TOKEN_INFO = 17
+	TOKEN_INFO_ELEVATION_TYPE         TOKEN_INFO = 18
+	TOKEN_INFO_LINKED_TOKEN           TOKEN_INFO = 19
+	TOKEN_INFO_ELEVATION              TOKEN_INFO = 20
+	TOKEN_INFO_HAS_RESTRICTIONS       TOKEN_INFO = 21
+	TOKEN_INFO_ACCESS_INFORMATION     TOKEN_INFO = 22
+	TOKEN_INFO_VIRTUALIZATION_ALLOWED TOKEN_INFO = 23
+	TOKEN_INFO_VIRTUALIZATION_ENABLED TOKEN_INFO = 24
+	TOKEN_INFO_INTEGRITY_LEVEL        TOKEN_INFO = 25
+	TOKEN_INFO_UI_ACCESS              TOKEN_INFO = 26
+	TOKEN_INFO_MANDATORY_POLICY       TOKEN_INFO = 27
+	TOKEN_INFO_LOGON_SID              TOKEN_INFO = 28
+	TOKEN_INFO_IS_APP_CONTAINER       TOKEN_INFO = 29
+)
+
+// [TRUSTEE_FORM] enumeration.
+//
+// [TRUSTEE_FORM]: https://learn.microsoft.com/en-us/windows/win32/api/accctrl/ne-accctrl-trustee_form
+type TRUSTEE_FORM uint32
+
+const (
+	TRUSTEE_FORM_IS_SID              TRUSTEE_FORM = 0
+	TRUSTEE_FORM_IS_NAME             TRUSTEE_FORM = 1
+	TRUSTEE_FORM_BAD_FORM            TRUSTEE_FORM = 2
+	TRUSTEE_FORM_IS_OBJECTS_AND_SID  TRUSTEE_FORM = 3
+	TRUSTEE_FORM_IS_OBJECTS_AND_NAME TRUSTEE_FORM = 4
+)
+
+// [TRUSTEE_TYPE] enumeration.
+//
+// [TRUSTEE_TYPE]: https://learn.microsoft.com/en-us/windows/win32/api/accctrl/ne-accctrl-trustee_type
+type TRUSTEE_TYPE uint32
+
+const (
+	TRUSTEE_TYPE_IS_UNKNOWN          TRUSTEE_TYPE = 0
+	TRUSTEE_TYPE_IS_USER             TRUSTEE_TYPE = 1
+	TRUSTEE_TYPE_IS_GROUP            TRUSTEE_TYPE = 2
+	TRUSTEE_TYPE_IS_DOMAIN           TRUSTEE_TYPE = 3
+	TRUSTEE_TYPE_IS_ALIAS            TRUSTEE_TYPE = 4
+	TRUSTEE_TYPE_IS_WELL_KNOWN_GROUP TRUSTEE_TYPE = 5
+	TRUSTEE_TYPE_IS_DELETED          TRUSTEE_TYPE = 6
+	TRUSTEE_TYPE_IS_INVALID          TRUSTEE_TYPE = 7
+	TRUSTEE_TYPE_IS_COMPUTER         TRUSTEE_TYPE = 8
+)
+
+// [WELL_KNOWN_SID_TYPE] enumeration, used with CreateWellKnownSid().
+//
+// [WELL_KNOWN_SID_TYPE]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ne-winnt-well_known_sid_type
+type WELL_KNOWN_SID uint32
+
+const (
+	WELL_KNOWN_SID_NULL                   WELL_KNOWN_SID = 0
+	WELL_KNOWN_SID_WORLD                  WELL_KNOWN_SID = 1
+	WELL_KNOWN_SID_LOCAL                  WELL_KNOWN_SID = 2
+	WELL_KNOWN_SID_CREATOR_OWNER          WELL_KNOWN_SID = 3
+	WELL_KNOWN_SID_CREATOR_GROUP          WELL_KNOWN_SID = 4
+	WELL_KNOWN_SID_CREATOR_OWNER_SERVER   WELL_KNOWN_SID = 5
+	WELL_KNOWN_SID_CREATOR_GROUP_SERVER   WELL_KNOWN_SID = 6
+	WELL_KNOWN_SID_NT_AUTHORITY           WELL_KNOWN_SID = 7
+	WELL_KNOWN_SID_DIALUP                 WELL_KNOWN_SID = 8
+	WELL_KNOWN_SID_NETWORK                WELL_KNOWN_SID = 9
+	WELL_KNOWN_SID_BATCH                  WELL_KNOWN_SID = 10
+	WELL_KNOWN_SID_INTERACTIVE            WELL_KNOWN_SID = 11
+	WELL_KNOWN_SID_SERVICE                WELL_KNOWN_SID = 12
+	WELL_KNOWN_SID_ANONYMOUS              WELL_KNOWN_SID = 13
+	WELL_KNOWN_SID_PROXY                  WELL_KNOWN_SID = 14
+	WELL_KNOWN_SID_ENTERPRISE_CONTROLLERS WELL_KNOWN_SID = 15
+	WELL_KNOWN_SID_SELF                   WELL_KNOWN_SID = 16
+	WELL_KNOWN_SID_AUTHENTICATED_USER     WELL_KNOWN_SID = 17
+	WELL_KNOWN_SID_RESTRICTED_CODE        WELL_KNOWN_SID = 18
+	WELL_KNOWN_SID_TERMINAL_SERVER        WELL_KNOWN_SID = 19
+	WELL_KNOWN_SID_REMOTE_LOGON_ID        WELL_KNOWN_SID = 20
+	WELL_KNOWN_SID_LOGON_IDS              WELL_KNOWN_SID = 21
+	WELL_KNOWN_SID_LOCAL_SYSTEM           WELL_KNOWN_SID = 22
+	WELL_KNOWN_SID_LOCAL_SERVICE          WELL_KNOWN_SID = 23
+	WELL_KNOWN_SID_NETWORK_SERVICE        WELL_KNOWN_SID = 24
+	WELL_KNOWN_SID_BUILTIN_DOMAIN         WELL_KNOWN_SID = 25
+	WELL_KNOWN_SID_BUILTIN_ADMINISTRATORS WELL_KNOWN_SID = 26
+	WELL_KNOWN_SID_BUILTIN_USERS          WELL_KNOWN_SID = 27
+	WELL_KNOWN_SID_BUILTIN_GUESTS         WELL_KNOWN_SID = 28
+	WELL_KNOWN_SID_BUILTIN_POWER_USERS    WELL_KNOWN_SID = 29
+	WELL_KNOWN_SID_UNTRUSTED_LABEL        WELL_KNOWN_SID = 65
+	WELL_KNOWN_SID_LOW_LABEL              WELL_KNOWN_SID = 66
+	WELL_KNOWN_SID_MEDIUM_LABEL           WELL_KNOWN_SID = 67
+	WELL_KNOWN_SID_HIGH_LABEL             WELL_KNOWN_SID = 68
+	WELL_KNOWN_SID_SYSTEM_LABEL           WELL_KNOWN_SID = 69
+	WELL_KNOWN_SID_WRITE_RESTRICTED_CODE  WELL_KNOWN_SID = 70
+	WELL_KNOWN_SID_CREATOR_OWNER_RIGHTS   WELL_KNOWN_SID = 71
+	WELL_KNOWN_SID_BUILTIN_ANY_PACKAGE    WELL_KNOWN_SID = 84
+)
+
+// SERVICE_CONTROL_SESSIONCHANGE event type, also WM_WTSSESSION_CHANGE wParam.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/termserv/wm-wtssession-change
+type WTS uint32
+
+const (
+	WTS_CONSOLE_CONNECT        WTS = 0x1
+	WTS_CONSOLE_DISCONNECT     WTS = 0x2
+	WTS_REMOTE_CONNECT         WTS = 0x3
+	WTS_REMOTE_DISCONNECT      WTS = 0x4
+	WTS_SESSION_LOGON          WTS = 0x5
+	WTS_SESSION_LOGOFF         WTS = 0x6
+	WTS_SESSION_LOCK           WTS = 0x7
+	WTS_SESSION_UNLOCK         WTS = 0x8
+	WTS_SESSION_REMOTE_CONTROL WTS = 0x9
+	WTS_SESSION_CREATE         WTS = 0xa
+	WTS_SESSION_TERMINATE      WTS = 0xb
+)
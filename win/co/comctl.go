@@ -178,6 +178,18 @@ const (
 	HICF_TOGGLEDROPDOWN HICF = 0x0000_0100
 )
 
+// HKM_SETHOTKEY modifiers, also used in IShellLink hotkeys.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/controls/hkm-sethotkey
+type HOTKEYF uint8
+
+const (
+	HOTKEYF_SHIFT   HOTKEYF = 0x01
+	HOTKEYF_CONTROL HOTKEYF = 0x02
+	HOTKEYF_ALT     HOTKEYF = 0x04
+	HOTKEYF_EXT     HOTKEYF = 0x08
+)
+
 // INITCOMMONCONTROLSEX dwIcc.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-initcommoncontrolsex
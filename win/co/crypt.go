@@ -0,0 +1,17 @@
+//go:build windows
+
+package co
+
+// CryptProtectData() and CryptUnprotectData() dwFlags.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/dpapi/nf-dpapi-cryptprotectdata
+type CRYPTPROTECT uint32
+
+const (
+	CRYPTPROTECT_NONE              CRYPTPROTECT = 0
+	CRYPTPROTECT_UI_FORBIDDEN      CRYPTPROTECT = 0x1
+	CRYPTPROTECT_LOCAL_MACHINE     CRYPTPROTECT = 0x4
+	CRYPTPROTECT_CRED_SYNC         CRYPTPROTECT = 0x8
+	CRYPTPROTECT_AUDIT             CRYPTPROTECT = 0x10
+	CRYPTPROTECT_VERIFY_PROTECTION CRYPTPROTECT = 0x40
+)
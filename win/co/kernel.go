@@ -142,6 +142,17 @@ const (
 	ENDSESSION_LOGOFF            ENDSESSION = 0x8000_0000
 )
 
+// Event object access rights.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/sync/synchronization-object-security-and-access-rights
+type EVENT uint32
+
+const (
+	EVENT_MODIFY_STATE EVENT = 0x0002
+	EVENT_SYNCHRONIZE  EVENT = EVENT(STANDARD_RIGHTS_SYNCHRONIZE)
+	EVENT_ALL_ACCESS   EVENT = EVENT(STANDARD_RIGHTS_REQUIRED|STANDARD_RIGHTS_SYNCHRONIZE) | 0x3
+)
+
 // CreateFile() dwFlagsAndAttributes.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/fileapi/nf-fileapi-createfilew
@@ -304,6 +315,17 @@ const (
 	GMEM_GPTR     GMEM = GMEM_FIXED | GMEM_ZEROINIT
 )
 
+// SetHandleInformation() dwMask and dwFlags.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-sethandleinformation
+type HANDLE_FLAG uint32
+
+const (
+	HANDLE_FLAG_NONE               HANDLE_FLAG = 0
+	HANDLE_FLAG_INHERIT            HANDLE_FLAG = 0x0000_0001
+	HANDLE_FLAG_PROTECT_FROM_CLOSE HANDLE_FLAG = 0x0000_0002
+)
+
 // HeapAlloc() flags.
 type HEAP_ALLOC uint32
 
@@ -339,6 +361,91 @@ const (
 	HEAP_REALLOC_ZERO_MEMORY           HEAP_REALLOC = 0x0000_0008
 )
 
+// Job object access rights.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/procthread/job-object-security-and-access-rights
+type JOB_OBJECT uint32
+
+const (
+	JOB_OBJECT_ASSIGN_PROCESS          JOB_OBJECT = 0x0001
+	JOB_OBJECT_SET_ATTRIBUTES          JOB_OBJECT = 0x0002
+	JOB_OBJECT_QUERY                   JOB_OBJECT = 0x0004
+	JOB_OBJECT_TERMINATE               JOB_OBJECT = 0x0008
+	JOB_OBJECT_SET_SECURITY_ATTRIBUTES JOB_OBJECT = 0x0010
+	JOB_OBJECT_IMPERSONATE             JOB_OBJECT = 0x0020
+	JOB_OBJECT_ALL_ACCESS              JOB_OBJECT = JOB_OBJECT(STANDARD_RIGHTS_REQUIRED|STANDARD_RIGHTS_SYNCHRONIZE) | 0x3f
+)
+
+// JOBOBJECT_CPU_RATE_CONTROL_INFORMATION ControlFlags.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_cpu_rate_control_information
+type JOB_OBJECT_CPU_RATE_CONTROL uint32
+
+const (
+	JOB_OBJECT_CPU_RATE_CONTROL_ENABLE       JOB_OBJECT_CPU_RATE_CONTROL = 0x1
+	JOB_OBJECT_CPU_RATE_CONTROL_WEIGHT_BASED JOB_OBJECT_CPU_RATE_CONTROL = 0x2
+	JOB_OBJECT_CPU_RATE_CONTROL_HARD_CAP     JOB_OBJECT_CPU_RATE_CONTROL = 0x4
+	JOB_OBJECT_CPU_RATE_CONTROL_NOTIFY       JOB_OBJECT_CPU_RATE_CONTROL = 0x8
+	JOB_OBJECT_CPU_RATE_CONTROL_MIN_MAX_RATE JOB_OBJECT_CPU_RATE_CONTROL = 0x10
+)
+
+// JOBOBJECT_BASIC_LIMIT_INFORMATION LimitFlags.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_basic_limit_information
+type JOB_OBJECT_LIMIT uint32
+
+const (
+	JOB_OBJECT_LIMIT_WORKINGSET                 JOB_OBJECT_LIMIT = 0x0000_0001
+	JOB_OBJECT_LIMIT_PROCESS_TIME               JOB_OBJECT_LIMIT = 0x0000_0002
+	JOB_OBJECT_LIMIT_JOB_TIME                   JOB_OBJECT_LIMIT = 0x0000_0004
+	JOB_OBJECT_LIMIT_ACTIVE_PROCESS             JOB_OBJECT_LIMIT = 0x0000_0008
+	JOB_OBJECT_LIMIT_AFFINITY                   JOB_OBJECT_LIMIT = 0x0000_0010
+	JOB_OBJECT_LIMIT_PRIORITY_CLASS             JOB_OBJECT_LIMIT = 0x0000_0020
+	JOB_OBJECT_LIMIT_PRESERVE_JOB_TIME          JOB_OBJECT_LIMIT = 0x0000_0040
+	JOB_OBJECT_LIMIT_SCHEDULING_CLASS           JOB_OBJECT_LIMIT = 0x0000_0080
+	JOB_OBJECT_LIMIT_PROCESS_MEMORY             JOB_OBJECT_LIMIT = 0x0000_0100
+	JOB_OBJECT_LIMIT_JOB_MEMORY                 JOB_OBJECT_LIMIT = 0x0000_0200
+	JOB_OBJECT_LIMIT_DIE_ON_UNHANDLED_EXCEPTION JOB_OBJECT_LIMIT = 0x0000_0400
+	JOB_OBJECT_LIMIT_BREAKAWAY_OK               JOB_OBJECT_LIMIT = 0x0000_0800
+	JOB_OBJECT_LIMIT_SILENT_BREAKAWAY_OK        JOB_OBJECT_LIMIT = 0x0000_1000
+	JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE          JOB_OBJECT_LIMIT = 0x0000_2000
+	JOB_OBJECT_LIMIT_SUBSET_AFFINITY            JOB_OBJECT_LIMIT = 0x0000_4000
+)
+
+// Job object completion port messages, received with
+// GetQueuedCompletionStatus().
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_associate_completion_port
+type JOB_OBJECT_MSG uint32
+
+const (
+	JOB_OBJECT_MSG_END_OF_JOB_TIME       JOB_OBJECT_MSG = 1
+	JOB_OBJECT_MSG_END_OF_PROCESS_TIME   JOB_OBJECT_MSG = 2
+	JOB_OBJECT_MSG_ACTIVE_PROCESS_LIMIT  JOB_OBJECT_MSG = 3
+	JOB_OBJECT_MSG_ACTIVE_PROCESS_ZERO   JOB_OBJECT_MSG = 4
+	JOB_OBJECT_MSG_NEW_PROCESS           JOB_OBJECT_MSG = 6
+	JOB_OBJECT_MSG_EXIT_PROCESS          JOB_OBJECT_MSG = 7
+	JOB_OBJECT_MSG_ABNORMAL_EXIT_PROCESS JOB_OBJECT_MSG = 8
+	JOB_OBJECT_MSG_PROCESS_MEMORY_LIMIT  JOB_OBJECT_MSG = 9
+	JOB_OBJECT_MSG_JOB_MEMORY_LIMIT      JOB_OBJECT_MSG = 10
+	JOB_OBJECT_MSG_NOTIFICATION_LIMIT    JOB_OBJECT_MSG = 11
+)
+
+// QueryInformationJobObject() and SetInformationJobObject() JobObjectInfoClass.
+// Originally with JobObject prefix and Information suffix.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-setinformationjobobject
+type JOBOBJECTINFOCLASS uint32
+
+const (
+	JOBOBJECTINFOCLASS_BASIC_ACCOUNTING          JOBOBJECTINFOCLASS = 1
+	JOBOBJECTINFOCLASS_BASIC_LIMIT               JOBOBJECTINFOCLASS = 2
+	JOBOBJECTINFOCLASS_BASIC_PROCESS_ID_LIST     JOBOBJECTINFOCLASS = 3
+	JOBOBJECTINFOCLASS_ASSOCIATE_COMPLETION_PORT JOBOBJECTINFOCLASS = 7
+	JOBOBJECTINFOCLASS_EXTENDED_LIMIT            JOBOBJECTINFOCLASS = 9
+	JOBOBJECTINFOCLASS_CPU_RATE_CONTROL          JOBOBJECTINFOCLASS = 15
+)
+
 // Language identifier.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/intl/language-identifier-constants-and-strings
@@ -512,6 +619,17 @@ const (
 	MOVEFILE_WRITE_THROUGH         MOVEFILE = 0x8
 )
 
+// Mutex object access rights.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/sync/synchronization-object-security-and-access-rights
+type MUTEX uint32
+
+const (
+	MUTEX_MODIFY_STATE MUTEX = 0x0001
+	MUTEX_SYNCHRONIZE  MUTEX = MUTEX(STANDARD_RIGHTS_SYNCHRONIZE)
+	MUTEX_ALL_ACCESS   MUTEX = MUTEX(STANDARD_RIGHTS_REQUIRED|STANDARD_RIGHTS_SYNCHRONIZE) | 0x1
+)
+
 // CreateFileMapping() flProtect.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/memoryapi/nf-memoryapi-createfilemappingw
@@ -662,6 +780,29 @@ const (
 	PROCESSOR_ARCHITECTURE_UNKNOWN        PROCESSOR_ARCHITECTURE = 0xffff
 )
 
+// UpdateProcThreadAttribute() attribute.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-updateprocthreadattribute
+type PROC_THREAD_ATTRIBUTE uintptr
+
+const (
+	PROC_THREAD_ATTRIBUTE_PARENT_PROCESS                  PROC_THREAD_ATTRIBUTE = 0x0002_0000
+	PROC_THREAD_ATTRIBUTE_HANDLE_LIST                     PROC_THREAD_ATTRIBUTE = 0x0002_0002
+	PROC_THREAD_ATTRIBUTE_GROUP_AFFINITY                  PROC_THREAD_ATTRIBUTE = 0x0003_0003
+	PROC_THREAD_ATTRIBUTE_PREFERRED_NODE                  PROC_THREAD_ATTRIBUTE = 0x0002_0004
+	PROC_THREAD_ATTRIBUTE_IDEAL_PROCESSOR                 PROC_THREAD_ATTRIBUTE = 0x0003_0005
+	PROC_THREAD_ATTRIBUTE_UMS_THREAD                      PROC_THREAD_ATTRIBUTE = 0x0003_0006
+	PROC_THREAD_ATTRIBUTE_MITIGATION_POLICY               PROC_THREAD_ATTRIBUTE = 0x0002_0007
+	PROC_THREAD_ATTRIBUTE_SECURITY_CAPABILITIES           PROC_THREAD_ATTRIBUTE = 0x0002_0009
+	PROC_THREAD_ATTRIBUTE_PROTECTION_LEVEL                PROC_THREAD_ATTRIBUTE = 0x0002_000b
+	PROC_THREAD_ATTRIBUTE_JOB_LIST                        PROC_THREAD_ATTRIBUTE = 0x0002_000d
+	PROC_THREAD_ATTRIBUTE_CHILD_PROCESS_POLICY            PROC_THREAD_ATTRIBUTE = 0x0002_000e
+	PROC_THREAD_ATTRIBUTE_ALL_APPLICATION_PACKAGES_POLICY PROC_THREAD_ATTRIBUTE = 0x0002_000f
+	PROC_THREAD_ATTRIBUTE_WIN32K_FILTER                   PROC_THREAD_ATTRIBUTE = 0x0002_0010
+	PROC_THREAD_ATTRIBUTE_DESKTOP_APP_POLICY              PROC_THREAD_ATTRIBUTE = 0x0002_0012
+	PROC_THREAD_ATTRIBUTE_PSEUDOCONSOLE                   PROC_THREAD_ATTRIBUTE = 0x0002_0016
+)
+
 // ReplaceFile() dwReplaceFlags.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-replacefilew
@@ -738,6 +879,17 @@ const (
 	SECURITY_EFFECTIVE_ONLY   SECURITY = 0x0008_0000
 )
 
+// Semaphore object access rights.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/sync/synchronization-object-security-and-access-rights
+type SEMAPHORE uint32
+
+const (
+	SEMAPHORE_MODIFY_STATE SEMAPHORE = 0x0002
+	SEMAPHORE_SYNCHRONIZE  SEMAPHORE = SEMAPHORE(STANDARD_RIGHTS_SYNCHRONIZE)
+	SEMAPHORE_ALL_ACCESS   SEMAPHORE = SEMAPHORE(STANDARD_RIGHTS_REQUIRED|STANDARD_RIGHTS_SYNCHRONIZE) | 0x3
+)
+
 // Sort order identifier for locales.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/intl/sort-order-identifiers
@@ -1154,7 +1306,19 @@ const (
 	VER_SUITE_NT_WORKSTATION       VER_SUITE = 0x000_0001
 )
 
-// WaitForSingleObject() return value.
+// Waitable timer object access rights.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/sync/synchronization-object-security-and-access-rights
+type TIMER uint32
+
+const (
+	TIMER_QUERY_STATE  TIMER = 0x0001
+	TIMER_MODIFY_STATE TIMER = 0x0002
+	TIMER_SYNCHRONIZE  TIMER = TIMER(STANDARD_RIGHTS_SYNCHRONIZE)
+	TIMER_ALL_ACCESS   TIMER = TIMER(STANDARD_RIGHTS_REQUIRED|STANDARD_RIGHTS_SYNCHRONIZE) | 0x3
+)
+
+// WaitForSingleObject() and WaitForMultipleObjects() return value.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitforsingleobject
 type WAIT uint32
@@ -2,6 +2,61 @@
 
 package co
 
+// AssocQueryString() flags.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/shell/assocf_str
+type ASSOCF uint32
+
+const (
+	ASSOCF_NONE                 ASSOCF = 0x0000_0000
+	ASSOCF_INIT_NOREMAPCLSID    ASSOCF = 0x0000_0001
+	ASSOCF_INIT_BYEXENAME       ASSOCF = 0x0000_0002
+	ASSOCF_OPEN_BYEXENAME       ASSOCF = 0x0000_0002
+	ASSOCF_INIT_DEFAULTTOSTAR   ASSOCF = 0x0000_0004
+	ASSOCF_INIT_DEFAULTTOFOLDER ASSOCF = 0x0000_0008
+	ASSOCF_NOUSERSETTINGS       ASSOCF = 0x0000_0010
+	ASSOCF_NOTRUNCATE           ASSOCF = 0x0000_0020
+	ASSOCF_VERIFY               ASSOCF = 0x0000_0040
+	ASSOCF_REMAPRUNDLL          ASSOCF = 0x0000_0080
+	ASSOCF_NOFIXUPS             ASSOCF = 0x0000_0100
+	ASSOCF_IGNOREBASECLASS      ASSOCF = 0x0000_0200
+	ASSOCF_INIT_IGNOREUNKNOWN   ASSOCF = 0x0000_0400
+	ASSOCF_INIT_FIXED_PROGID    ASSOCF = 0x0000_0800
+	ASSOCF_IS_PROTOCOL          ASSOCF = 0x0000_1000
+	ASSOCF_INIT_FOR_FILE        ASSOCF = 0x0000_2000
+)
+
+// AssocQueryString() str.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlwapi/ne-shlwapi-assocstr
+type ASSOCSTR uint32
+
+const (
+	ASSOCSTR_COMMAND                 ASSOCSTR = 1
+	ASSOCSTR_EXECUTABLE              ASSOCSTR = 2
+	ASSOCSTR_FRIENDLYDOCNAME         ASSOCSTR = 3
+	ASSOCSTR_FRIENDLYAPPNAME         ASSOCSTR = 4
+	ASSOCSTR_NOOPEN                  ASSOCSTR = 5
+	ASSOCSTR_SHELLNEWVALUE           ASSOCSTR = 6
+	ASSOCSTR_DDECOMMAND              ASSOCSTR = 7
+	ASSOCSTR_DDEIFEXEC               ASSOCSTR = 8
+	ASSOCSTR_DDEAPPLICATION          ASSOCSTR = 9
+	ASSOCSTR_DDETOPIC                ASSOCSTR = 10
+	ASSOCSTR_INFOTIP                 ASSOCSTR = 11
+	ASSOCSTR_QUICKTIP                ASSOCSTR = 12
+	ASSOCSTR_TILEINFO                ASSOCSTR = 13
+	ASSOCSTR_CONTENTTYPE             ASSOCSTR = 14
+	ASSOCSTR_DEFAULTICON             ASSOCSTR = 15
+	ASSOCSTR_SHELLEXTENSION          ASSOCSTR = 16
+	ASSOCSTR_DROPTARGET              ASSOCSTR = 17
+	ASSOCSTR_DELEGATEEXECUTE         ASSOCSTR = 18
+	ASSOCSTR_SUPPORTED_URI_PROTOCOLS ASSOCSTR = 19
+	ASSOCSTR_PROGID                  ASSOCSTR = 20
+	ASSOCSTR_APPID                   ASSOCSTR = 21
+	ASSOCSTR_APPPUBLISHER            ASSOCSTR = 22
+	ASSOCSTR_APPICONREFERENCE        ASSOCSTR = 23
+)
+
 // NOTIFYICONDATA uFlags.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-notifyicondataw
@@ -47,6 +102,23 @@ const (
 	NIM_SETVERSION NIM = 0x0000_0004
 )
 
+// Notification area icon callback events, sent in LOWORD(lParam) when
+// NOTIFYICON_VERSION_4 is set.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-notifyicondataw
+type NIN uint32
+
+const (
+	NIN_SELECT           NIN = NIN(WM_USER + 0)
+	NIN_KEYSELECT        NIN = NIN_SELECT | 0x1 // NINF_KEY
+	NIN_BALLOONSHOW      NIN = NIN(WM_USER + 2)
+	NIN_BALLOONHIDE      NIN = NIN(WM_USER + 3)
+	NIN_BALLOONTIMEOUT   NIN = NIN(WM_USER + 4)
+	NIN_BALLOONUSERCLICK NIN = NIN(WM_USER + 5)
+	NIN_POPUPOPEN        NIN = NIN(WM_USER + 6)
+	NIN_POPUPCLOSE       NIN = NIN(WM_USER + 7)
+)
+
 // NOTIFYICONDATA dwState and dwStateMask.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-notifyicondataw
@@ -57,6 +129,36 @@ const (
 	NIS_SHAREDICON NIS = 0x0000_0002
 )
 
+// SHELLEXECUTEINFO fMask.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shellexecuteinfow
+type SEE_MASK uint32
+
+const (
+	SEE_MASK_DEFAULT            SEE_MASK = 0x0000_0000
+	SEE_MASK_CLASSNAME          SEE_MASK = 0x0000_0001
+	SEE_MASK_CLASSKEY           SEE_MASK = 0x0000_0003
+	SEE_MASK_IDLIST             SEE_MASK = 0x0000_0004
+	SEE_MASK_INVOKEIDLIST       SEE_MASK = 0x0000_000c
+	SEE_MASK_ICON               SEE_MASK = 0x0000_0010
+	SEE_MASK_HOTKEY             SEE_MASK = 0x0000_0020
+	SEE_MASK_NOCLOSEPROCESS     SEE_MASK = 0x0000_0040
+	SEE_MASK_CONNECTNETDRV      SEE_MASK = 0x0000_0080
+	SEE_MASK_NOASYNC            SEE_MASK = 0x0000_0100
+	SEE_MASK_FLAG_DDEWAIT       SEE_MASK = 0x0000_0100
+	SEE_MASK_DOENVSUBST         SEE_MASK = 0x0000_0200
+	SEE_MASK_FLAG_NO_UI         SEE_MASK = 0x0000_0400
+	SEE_MASK_UNICODE            SEE_MASK = 0x0000_4000
+	SEE_MASK_NO_CONSOLE         SEE_MASK = 0x0000_8000
+	SEE_MASK_ASYNCOK            SEE_MASK = 0x0010_0000
+	SEE_MASK_HMONITOR           SEE_MASK = 0x0020_0000
+	SEE_MASK_NOZONECHECKS       SEE_MASK = 0x0080_0000
+	SEE_MASK_NOQUERYCLASSSTORE  SEE_MASK = 0x0100_0000
+	SEE_MASK_WAITFORINPUTIDLE   SEE_MASK = 0x0200_0000
+	SEE_MASK_FLAG_LOG_USAGE     SEE_MASK = 0x0400_0000
+	SEE_MASK_FLAG_HINST_IS_SITE SEE_MASK = 0x0800_0000
+)
+
 // SHFILEINFO dwAttributes.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shfileinfow
@@ -107,6 +209,55 @@ const (
 	SFGAO_PKEYSFGAOMASK   SFGAO = 0x8104_4000
 )
 
+// SHChangeNotify() wEventId.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shchangenotify
+type SHCNE uint32
+
+const (
+	SHCNE_RENAMEITEM       SHCNE = 0x0000_0001
+	SHCNE_CREATE           SHCNE = 0x0000_0002
+	SHCNE_DELETE           SHCNE = 0x0000_0004
+	SHCNE_MKDIR            SHCNE = 0x0000_0008
+	SHCNE_RMDIR            SHCNE = 0x0000_0010
+	SHCNE_MEDIAINSERTED    SHCNE = 0x0000_0020
+	SHCNE_MEDIAREMOVED     SHCNE = 0x0000_0040
+	SHCNE_DRIVEREMOVED     SHCNE = 0x0000_0080
+	SHCNE_DRIVEADD         SHCNE = 0x0000_0100
+	SHCNE_NETSHARE         SHCNE = 0x0000_0200
+	SHCNE_NETUNSHARE       SHCNE = 0x0000_0400
+	SHCNE_ATTRIBUTES       SHCNE = 0x0000_0800
+	SHCNE_UPDATEDIR        SHCNE = 0x0000_1000
+	SHCNE_UPDATEITEM       SHCNE = 0x0000_2000
+	SHCNE_SERVERDISCONNECT SHCNE = 0x0000_4000
+	SHCNE_UPDATEIMAGE      SHCNE = 0x0000_8000
+	SHCNE_DRIVEADDGUI      SHCNE = 0x0001_0000
+	SHCNE_RENAMEFOLDER     SHCNE = 0x0002_0000
+	SHCNE_FREESPACE        SHCNE = 0x0004_0000
+	SHCNE_EXTENDED_EVENT   SHCNE = 0x0400_0000
+	SHCNE_ASSOCCHANGED     SHCNE = 0x0800_0000
+	SHCNE_DISKEVENTS       SHCNE = 0x0002_381f
+	SHCNE_GLOBALEVENTS     SHCNE = 0x0c05_81e0
+	SHCNE_ALLEVENTS        SHCNE = 0x7fff_ffff
+	SHCNE_INTERRUPT        SHCNE = 0x8000_0000
+)
+
+// SHChangeNotify() uFlags.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shchangenotify
+type SHCNF uint32
+
+const (
+	SHCNF_IDLIST          SHCNF = 0x0000
+	SHCNF_PATH            SHCNF = 0x0005 // SHCNF_PATHW
+	SHCNF_PRINTER         SHCNF = 0x0006 // SHCNF_PRINTERW
+	SHCNF_DWORD           SHCNF = 0x0003
+	SHCNF_TYPE            SHCNF = 0x00ff
+	SHCNF_FLUSH           SHCNF = 0x1000
+	SHCNF_FLUSHNOWAIT     SHCNF = 0x3000
+	SHCNF_NOTIFYRECURSIVE SHCNF = 0x1_0000
+)
+
 // SHGetFileInfo() uFlags.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shgetfileinfow
@@ -979,6 +979,40 @@ const (
 	MSGF_MENU      MSGF = 2
 )
 
+// MsgWaitForMultipleObjectsEx() dwFlags.
+//
+// 📑 https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-msgwaitformultipleobjectsex
+type MWMO uint32
+
+const (
+	MWMO_NONE           MWMO = 0
+	MWMO_WAITALL        MWMO = 0x0001
+	MWMO_ALERTABLE      MWMO = 0x0002
+	MWMO_INPUTAVAILABLE MWMO = 0x0004
+)
+
+// NotifyWinEvent() idObject. Also used by WM_GETOBJECT.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/winauto/object-identifiers
+type OBJID int32
+
+const (
+	OBJID_WINDOW            OBJID = 0
+	OBJID_SYSMENU           OBJID = -1
+	OBJID_TITLEBAR          OBJID = -2
+	OBJID_MENU              OBJID = -3
+	OBJID_CLIENT            OBJID = -4
+	OBJID_VSCROLL           OBJID = -5
+	OBJID_HSCROLL           OBJID = -6
+	OBJID_SIZEGRIP          OBJID = -7
+	OBJID_CARET             OBJID = -8
+	OBJID_CURSOR            OBJID = -9
+	OBJID_ALERT             OBJID = -10
+	OBJID_SOUND             OBJID = -11
+	OBJID_QUERYCLASSNAMEIDX OBJID = -12
+	OBJID_NATIVEOM          OBJID = -16
+)
+
 // DRAWITEMSTRUCT itemAction.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-drawitemstruct
@@ -1591,6 +1625,19 @@ const (
 	TB_REQ_ENDTRACK      TB_REQ = 8
 )
 
+// TRACKMOUSEEVENT dwFlags.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-trackmouseevent
+type TME uint32
+
+const (
+	TME_CANCEL    TME = 0x8000_0000
+	TME_HOVER     TME = 0x0000_0001
+	TME_LEAVE     TME = 0x0000_0002
+	TME_NONCLIENT TME = 0x0000_0010
+	TME_QUERY     TME = 0x4000_0000
+)
+
 // SetUserObjectInformation() nIndex.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setuserobjectinformationw
@@ -1814,6 +1861,48 @@ const (
 	WH_MOUSE_LL        WH = 14
 )
 
+// NotifyWinEvent() event. Originally with EVENT prefix.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/winauto/event-constants
+type WINEVENT uint32
+
+const (
+	WINEVENT_SYSTEM_SOUND                WINEVENT = 0x0001
+	WINEVENT_SYSTEM_ALERT                WINEVENT = 0x0002
+	WINEVENT_SYSTEM_FOREGROUND           WINEVENT = 0x0003
+	WINEVENT_SYSTEM_MENUSTART            WINEVENT = 0x0004
+	WINEVENT_SYSTEM_MENUEND              WINEVENT = 0x0005
+	WINEVENT_SYSTEM_MENUPOPUPSTART       WINEVENT = 0x0006
+	WINEVENT_SYSTEM_MENUPOPUPEND         WINEVENT = 0x0007
+	WINEVENT_SYSTEM_CAPTURESTART         WINEVENT = 0x0008
+	WINEVENT_SYSTEM_CAPTUREEND           WINEVENT = 0x0009
+	WINEVENT_SYSTEM_DIALOGSTART          WINEVENT = 0x0010
+	WINEVENT_SYSTEM_DIALOGEND            WINEVENT = 0x0011
+	WINEVENT_OBJECT_CREATE               WINEVENT = 0x8000
+	WINEVENT_OBJECT_DESTROY              WINEVENT = 0x8001
+	WINEVENT_OBJECT_SHOW                 WINEVENT = 0x8002
+	WINEVENT_OBJECT_HIDE                 WINEVENT = 0x8003
+	WINEVENT_OBJECT_REORDER              WINEVENT = 0x8004
+	WINEVENT_OBJECT_FOCUS                WINEVENT = 0x8005
+	WINEVENT_OBJECT_SELECTION            WINEVENT = 0x8006
+	WINEVENT_OBJECT_SELECTIONADD         WINEVENT = 0x8007
+	WINEVENT_OBJECT_SELECTIONREMOVE      WINEVENT = 0x8008
+	WINEVENT_OBJECT_SELECTIONWITHIN      WINEVENT = 0x8009
+	WINEVENT_OBJECT_STATECHANGE          WINEVENT = 0x800a
+	WINEVENT_OBJECT_LOCATIONCHANGE       WINEVENT = 0x800b
+	WINEVENT_OBJECT_NAMECHANGE           WINEVENT = 0x800c
+	WINEVENT_OBJECT_DESCRIPTIONCHANGE    WINEVENT = 0x800d
+	WINEVENT_OBJECT_VALUECHANGE          WINEVENT = 0x800e
+	WINEVENT_OBJECT_PARENTCHANGE         WINEVENT = 0x800f
+	WINEVENT_OBJECT_HELPCHANGE           WINEVENT = 0x8010
+	WINEVENT_OBJECT_DEFACTIONCHANGE      WINEVENT = 0x8011
+	WINEVENT_OBJECT_ACCELERATORCHANGE    WINEVENT = 0x8012
+	WINEVENT_OBJECT_INVOKED              WINEVENT = 0x8013
+	WINEVENT_OBJECT_TEXTSELECTIONCHANGED WINEVENT = 0x8014
+	WINEVENT_OBJECT_CONTENTSCROLLED      WINEVENT = 0x8015
+	WINEVENT_OBJECT_LIVEREGIONCHANGED    WINEVENT = 0x8019
+)
+
 // WM_SIZING window edge.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/winmsg/wm-sizing
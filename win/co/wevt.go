@@ -0,0 +1,115 @@
+//go:build windows
+
+package co
+
+// [EvtQuery] flags.
+//
+// [EvtQuery]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/ne-winevt-evt_query_flags
+type EVT_QUERY uint32
+
+const (
+	EVT_QUERY_CHANNEL_PATH          EVT_QUERY = 0x1
+	EVT_QUERY_FILE_PATH             EVT_QUERY = 0x2
+	EVT_QUERY_FORWARD_DIRECTION     EVT_QUERY = 0x100
+	EVT_QUERY_REVERSE_DIRECTION     EVT_QUERY = 0x200
+	EVT_QUERY_TOLERATE_QUERY_ERRORS EVT_QUERY = 0x1000
+)
+
+// [EVT_SYSTEM_PROPERTY_ID] enumeration, the indexes of the values rendered
+// with EVT_RENDER_CONTEXT_SYSTEM.
+//
+// [EVT_SYSTEM_PROPERTY_ID]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/ne-winevt-evt_system_property_id
+type EVT_SYSTEM uint32
+
+const (
+	EVT_SYSTEM_PROVIDER_NAME       EVT_SYSTEM = 0
+	EVT_SYSTEM_PROVIDER_GUID       EVT_SYSTEM = 1
+	EVT_SYSTEM_EVENT_ID            EVT_SYSTEM = 2
+	EVT_SYSTEM_QUALIFIERS          EVT_SYSTEM = 3
+	EVT_SYSTEM_LEVEL               EVT_SYSTEM = 4
+	EVT_SYSTEM_TASK                EVT_SYSTEM = 5
+	EVT_SYSTEM_OPCODE              EVT_SYSTEM = 6
+	EVT_SYSTEM_KEYWORDS            EVT_SYSTEM = 7
+	EVT_SYSTEM_TIME_CREATED        EVT_SYSTEM = 8
+	EVT_SYSTEM_EVENT_RECORD_ID     EVT_SYSTEM = 9
+	EVT_SYSTEM_ACTIVITY_ID         EVT_SYSTEM = 10
+	EVT_SYSTEM_RELATED_ACTIVITY_ID EVT_SYSTEM = 11
+	EVT_SYSTEM_PROCESS_ID          EVT_SYSTEM = 12
+	EVT_SYSTEM_THREAD_ID           EVT_SYSTEM = 13
+	EVT_SYSTEM_CHANNEL             EVT_SYSTEM = 14
+	EVT_SYSTEM_COMPUTER            EVT_SYSTEM = 15
+	EVT_SYSTEM_USER_ID             EVT_SYSTEM = 16
+	EVT_SYSTEM_VERSION             EVT_SYSTEM = 17
+	EVT_SYSTEM_PROPERTY_ID_END     EVT_SYSTEM = 18
+)
+
+// [EVT_LEVEL] of an event, as stored in the System section.
+//
+// [EVT_LEVEL]: https://learn.microsoft.com/en-us/windows/win32/wes/eventmanifestschema-leveltype-complextype
+type EVT_LEVEL uint8
+
+const (
+	EVT_LEVEL_LOG_ALWAYS  EVT_LEVEL = 0
+	EVT_LEVEL_CRITICAL    EVT_LEVEL = 1
+	EVT_LEVEL_ERROR       EVT_LEVEL = 2
+	EVT_LEVEL_WARNING     EVT_LEVEL = 3
+	EVT_LEVEL_INFORMATION EVT_LEVEL = 4
+	EVT_LEVEL_VERBOSE     EVT_LEVEL = 5
+)
+
+// [EvtRender] flags.
+//
+// [EvtRender]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/ne-winevt-evt_render_flags
+type EVT_RENDER uint32
+
+const (
+	EVT_RENDER_EVENT_VALUES EVT_RENDER = 0
+	EVT_RENDER_EVENT_XML    EVT_RENDER = 1
+	EVT_RENDER_BOOKMARK     EVT_RENDER = 2
+)
+
+// [EvtCreateRenderContext] flags.
+//
+// [EvtCreateRenderContext]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/ne-winevt-evt_render_context_flags
+type EVT_RENDER_CONTEXT uint32
+
+const (
+	EVT_RENDER_CONTEXT_VALUES EVT_RENDER_CONTEXT = 0
+	EVT_RENDER_CONTEXT_SYSTEM EVT_RENDER_CONTEXT = 1
+	EVT_RENDER_CONTEXT_USER   EVT_RENDER_CONTEXT = 2
+)
+
+// [EVT_VARIANT_TYPE] enumeration.
+//
+// [EVT_VARIANT_TYPE]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/ne-winevt-evt_variant_type
+type EVT_VAR_TYPE uint32
+
+const (
+	EVT_VAR_TYPE_NULL        EVT_VAR_TYPE = 0
+	EVT_VAR_TYPE_STRING      EVT_VAR_TYPE = 1
+	EVT_VAR_TYPE_ANSI_STRING EVT_VAR_TYPE = 2
+	EVT_VAR_TYPE_SBYTE       EVT_VAR_TYPE = 3
+	EVT_VAR_TYPE_BYTE        EVT_VAR_TYPE = 4
+	EVT_VAR_TYPE_INT16       EVT_VAR_TYPE = 5
+	EVT_VAR_TYPE_UINT16      EVT_VAR_TYPE = 6
+	EVT_VAR_TYPE_INT32       EVT_VAR_TYPE = 7
+	EVT_VAR_TYPE_UINT32      EVT_VAR_TYPE = 8
+	EVT_VAR_TYPE_INT64       EVT_VAR_TYPE = 9
+	EVT_VAR_TYPE_UINT64      EVT_VAR_TYPE = 10
+	EVT_VAR_TYPE_SINGLE      EVT_VAR_TYPE = 11
+	EVT_VAR_TYPE_DOUBLE      EVT_VAR_TYPE = 12
+	EVT_VAR_TYPE_BOOLEAN     EVT_VAR_TYPE = 13
+	EVT_VAR_TYPE_BINARY      EVT_VAR_TYPE = 14
+	EVT_VAR_TYPE_GUID        EVT_VAR_TYPE = 15
+	EVT_VAR_TYPE_SIZET       EVT_VAR_TYPE = 16
+	EVT_VAR_TYPE_FILETIME    EVT_VAR_TYPE = 17
+	EVT_VAR_TYPE_SYSTIME     EVT_VAR_TYPE = 18
+	EVT_VAR_TYPE_SID         EVT_VAR_TYPE = 19
+	EVT_VAR_TYPE_HEX_INT32   EVT_VAR_TYPE = 20
+	EVT_VAR_TYPE_HEX_INT64   EVT_VAR_TYPE = 21
+	EVT_VAR_TYPE_EVT_HANDLE  EVT_VAR_TYPE = 32
+	EVT_VAR_TYPE_EVT_XML     EVT_VAR_TYPE = 35
+
+	EVT_VAR_TYPE_MASK  EVT_VAR_TYPE = 0x7f
+	EVT_VAR_TYPE_ARRAY EVT_VAR_TYPE = 0x80
+)
@@ -300,6 +300,13 @@ const (
 	TCN_FOCUSCHANGE NM = _TCN_FIRST - 4
 )
 
+// Taskbar thumbnail toolbar notifications (THBN), sent in WM_COMMAND.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-itaskbarlist3-thumbbaraddbuttons
+const (
+	THBN_CLICKED CMD = 0x1800
+)
+
 // Trackbar control notifications (TRBN).
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/controls/bumper-trackbar-control-reference-notifications
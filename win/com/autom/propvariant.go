@@ -0,0 +1,283 @@
+//go:build windows
+
+package autom
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/autom/automco"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+)
+
+// Structured storage PROPVARIANT type, returned by property stores and
+// metadata readers.
+//
+// Unlike VARIANT, it can hold counted vectors, ANSI and wide strings, blobs
+// and FILETIME values. Can be created with one of the NewPropVariant*()
+// functions, and values can be retrieved with PROPVARIANT.Value().
+//
+// ⚠️ You must defer PROPVARIANT.PropVariantClear().
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/propidlbase/ns-propidlbase-propvariant
+type PROPVARIANT struct {
+	vt         automco.VT
+	wReserved1 uint16
+	wReserved2 uint16
+	wReserved3 uint16
+	data       [2]uintptr // 8 bytes on 32-bit, 16 bytes on 64-bit
+}
+
+// Counted array, like BLOB and the CA* structs of the vectors.
+type _PropVariantArray struct {
+	count uint32
+	elems unsafe.Pointer
+}
+
+// Frees the internal data of the PROPVARIANT, and sets its type to VT_EMPTY.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/combaseapi/nf-combaseapi-propvariantclear
+func (pv *PROPVARIANT) PropVariantClear() {
+	syscall.SyscallN(proc.PropVariantClear.Addr(),
+		uintptr(unsafe.Pointer(pv)))
+}
+
+// Returns the type of the PROPVARIANT.
+func (pv *PROPVARIANT) Type() automco.VT {
+	return pv.vt
+}
+
+//------------------------------------------------------------------------------
+
+// Creates a new PROPVARIANT of type VT_EMPTY.
+//
+// ⚠️ You must defer PROPVARIANT.PropVariantClear().
+func NewPropVariantEmpty() PROPVARIANT {
+	return PROPVARIANT{vt: automco.VT_EMPTY}
+}
+
+// Creates a new PROPVARIANT of type VT_BOOL.
+//
+// ⚠️ You must defer PROPVARIANT.PropVariantClear().
+//
+// Example:
+//
+//	pv := autom.NewPropVariantBool(true)
+//	defer pv.PropVariantClear()
+func NewPropVariantBool(v bool) PROPVARIANT {
+	pv := PROPVARIANT{vt: automco.VT_BOOL}
+	*(*int16)(unsafe.Pointer(&pv.data[0])) = util.Iif(v, int16(-1), int16(0)).(int16)
+	return pv
+}
+
+// Creates a new PROPVARIANT of type VT_CLSID, with the GUID allocated with
+// CoTaskMemAlloc().
+//
+// ⚠️ You must defer PROPVARIANT.PropVariantClear().
+//
+// Example:
+//
+//	pv := autom.NewPropVariantClsid("0d9d5b6a-1d2b-4c84-9b4c-3ffb1c2a5f21")
+//	defer pv.PropVariantClear()
+func NewPropVariantClsid(v co.CLSID) PROPVARIANT {
+	hMem := win.CoTaskMemAlloc(int(unsafe.Sizeof(win.GUID{}))) // will be owned by the PROPVARIANT
+	*(*win.GUID)(unsafe.Pointer(hMem)) = *win.GuidFromClsid(v)
+
+	pv := PROPVARIANT{vt: automco.VT_CLSID}
+	pv.data[0] = uintptr(hMem)
+	return pv
+}
+
+// Creates a new PROPVARIANT of type VT_LPWSTR, with the string allocated
+// with CoTaskMemAlloc().
+//
+// ⚠️ You must defer PROPVARIANT.PropVariantClear().
+//
+// Example:
+//
+//	pv := autom.NewPropVariantStr("foo")
+//	defer pv.PropVariantClear()
+func NewPropVariantStr(v string) PROPVARIANT {
+	str16 := win.Str.ToNativeSlice(v)
+	hMem := win.CoTaskMemAlloc(len(str16) * 2) // will be owned by the PROPVARIANT
+	copy(unsafe.Slice((*uint16)(unsafe.Pointer(hMem)), len(str16)), str16)
+
+	pv := PROPVARIANT{vt: automco.VT_LPWSTR}
+	pv.data[0] = uintptr(hMem)
+	return pv
+}
+
+// Creates a new PROPVARIANT of type VT_UI4.
+//
+// ⚠️ You must defer PROPVARIANT.PropVariantClear().
+func NewPropVariantUint32(v uint32) PROPVARIANT {
+	pv := PROPVARIANT{vt: automco.VT_UI4}
+	*(*uint32)(unsafe.Pointer(&pv.data[0])) = v
+	return pv
+}
+
+//------------------------------------------------------------------------------
+
+// Returns a copy of the value held by the PROPVARIANT, converted to a Go type:
+//
+//   - VT_EMPTY, VT_NULL: nil;
+//   - VT_I1, VT_I2, VT_I4, VT_INT, VT_I8: int8, int16, int32, int32, int64;
+//   - VT_UI1, VT_UI2, VT_UI4, VT_UINT, VT_UI8: uint8, uint16, uint32, uint32, uint64;
+//   - VT_R4, VT_R8: float32, float64;
+//   - VT_BOOL: bool;
+//   - VT_ERROR: uint32;
+//   - VT_LPSTR, VT_LPWSTR, VT_BSTR: string;
+//   - VT_FILETIME: time.Time;
+//   - VT_CLSID: win.GUID;
+//   - VT_BLOB: []byte;
+//   - VT_UNKNOWN: com.IUnknown, which must be released;
+//   - VT_VECTOR|VT_UI1: []byte;
+//   - VT_VECTOR|VT_LPSTR, VT_VECTOR|VT_LPWSTR, VT_VECTOR|VT_BSTR: []string;
+//   - other VT_VECTOR types: []any, with the element types above.
+//
+// Other types return nil.
+//
+// ⚠️ If the value is a com.IUnknown, you must defer IUnknown.Release() on it.
+//
+// Example:
+//
+//	var pv autom.PROPVARIANT // retrieved somewhere
+//	defer pv.PropVariantClear()
+//
+//	if str, ok := pv.Value().(string); ok {
+//		println(str)
+//	}
+func (pv *PROPVARIANT) Value() any {
+	p := unsafe.Pointer(&pv.data[0])
+
+	if pv.vt&automco.VT_VECTOR == 0 {
+		switch pv.vt {
+		case automco.VT_CLSID:
+			if guid := *(**win.GUID)(p); guid != nil {
+				return *guid
+			}
+			return nil
+		case automco.VT_BLOB:
+			arr := (*_PropVariantArray)(p)
+			return append([]byte{},
+				unsafe.Slice((*byte)(arr.elems), arr.count)...)
+		default:
+			return _PropVariantScalar(pv.vt, p)
+		}
+	}
+
+	arr := (*_PropVariantArray)(p)
+	elemVt := pv.vt &^ automco.VT_VECTOR
+	elemSize := _PropVariantElemSize(elemVt)
+	if elemSize == 0 || (arr.count > 0 && arr.elems == nil) {
+		return nil
+	}
+
+	switch elemVt {
+	case automco.VT_UI1:
+		return append([]byte{},
+			unsafe.Slice((*byte)(arr.elems), arr.count)...)
+	case automco.VT_LPSTR, automco.VT_LPWSTR, automco.VT_BSTR:
+		strs := make([]string, 0, arr.count)
+		for i := uintptr(0); i < uintptr(arr.count); i++ {
+			strs = append(strs,
+				_PropVariantScalar(elemVt, unsafe.Add(arr.elems, i*elemSize)).(string))
+		}
+		return strs
+	default:
+		vals := make([]any, 0, arr.count)
+		for i := uintptr(0); i < uintptr(arr.count); i++ {
+			pElem := unsafe.Add(arr.elems, i*elemSize)
+			switch elemVt {
+			case automco.VT_CLSID: // the vector holds the GUIDs themselves
+				vals = append(vals, *(*win.GUID)(pElem))
+			case automco.VT_VARIANT:
+				vals = append(vals, (*PROPVARIANT)(pElem).Value())
+			default:
+				vals = append(vals, _PropVariantScalar(elemVt, pElem))
+			}
+		}
+		return vals
+	}
+}
+
+// Size of each element of a vector of the given type, or zero if unsupported.
+func _PropVariantElemSize(vt automco.VT) uintptr {
+	switch vt {
+	case automco.VT_I1, automco.VT_UI1:
+		return 1
+	case automco.VT_I2, automco.VT_UI2, automco.VT_BOOL:
+		return 2
+	case automco.VT_I4, automco.VT_UI4, automco.VT_INT, automco.VT_UINT,
+		automco.VT_R4, automco.VT_ERROR:
+		return 4
+	case automco.VT_I8, automco.VT_UI8, automco.VT_R8, automco.VT_FILETIME:
+		return 8
+	case automco.VT_LPSTR, automco.VT_LPWSTR, automco.VT_BSTR:
+		return unsafe.Sizeof(uintptr(0))
+	case automco.VT_CLSID:
+		return unsafe.Sizeof(win.GUID{})
+	case automco.VT_VARIANT:
+		return unsafe.Sizeof(PROPVARIANT{})
+	default:
+		return 0
+	}
+}
+
+// Reads a single value of the given type, stored at p.
+func _PropVariantScalar(vt automco.VT, p unsafe.Pointer) any {
+	switch vt {
+	case automco.VT_I1:
+		return *(*int8)(p)
+	case automco.VT_UI1:
+		return *(*uint8)(p)
+	case automco.VT_I2:
+		return *(*int16)(p)
+	case automco.VT_UI2:
+		return *(*uint16)(p)
+	case automco.VT_I4, automco.VT_INT:
+		return *(*int32)(p)
+	case automco.VT_UI4, automco.VT_UINT, automco.VT_ERROR:
+		return *(*uint32)(p)
+	case automco.VT_I8:
+		return *(*int64)(p)
+	case automco.VT_UI8:
+		return *(*uint64)(p)
+	case automco.VT_R4:
+		return math.Float32frombits(*(*uint32)(p))
+	case automco.VT_R8:
+		return math.Float64frombits(*(*uint64)(p))
+	case automco.VT_BOOL:
+		return *(*int16)(p) != 0
+	case automco.VT_LPWSTR:
+		return win.Str.FromNativePtr(*(**uint16)(p))
+	case automco.VT_BSTR:
+		return (*(*BSTR)(p)).String()
+	case automco.VT_LPSTR:
+		pStr := *(**byte)(p)
+		if pStr == nil {
+			return ""
+		}
+		n := 0
+		for *(*byte)(unsafe.Add(unsafe.Pointer(pStr), n)) != 0 {
+			n++
+		}
+		return string(unsafe.Slice(pStr, n))
+	case automco.VT_FILETIME:
+		return (*win.FILETIME)(p).ToTime()
+	case automco.VT_UNKNOWN:
+		ppv := *(***comvt.IUnknown)(p)
+		if ppv == nil {
+			return nil
+		}
+		return com.NewIUnknown(ppv).AddRef()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,123 @@
+//go:build windows
+
+package com
+
+import (
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/comobj"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com/comco"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Go implementation of IClassFactory, which creates the objects of a COM
+// server implemented in Go. It's usually registered with
+// CoRegisterClassObject(), so other processes can instantiate the objects.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/unknwn/nn-unknwn-iclassfactory
+type IClassFactory interface {
+	IUnknown
+
+	// Defines the closure which returns a new COM object each time
+	// CreateInstance() is called by a client. The factory queries the
+	// requested interface from it, and releases the returned reference.
+	//
+	// Aggregation is not supported.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/unknwn/nf-unknwn-iclassfactory-createinstance
+	OnCreateInstance(fun func() IUnknown)
+}
+
+type _IClassFactory struct {
+	IUnknown
+	mutex  sync.Mutex
+	events struct {
+		createInstance func() IUnknown
+	}
+}
+
+// Creates a new IClassFactory implemented in Go.
+//
+// ⚠️ You must defer IClassFactory.Release().
+//
+// Example:
+//
+//	factory := com.NewIClassFactoryImpl()
+//	defer factory.Release()
+//
+//	factory.OnCreateInstance(func() com.IUnknown {
+//		return shell.NewINotificationActivationCallbackImpl()
+//	})
+//
+//	cookie, _ := com.CoRegisterClassObject(clsid, factory,
+//		comco.CLSCTX_LOCAL_SERVER, comco.REGCLS_MULTIPLEUSE)
+//	defer com.CoRevokeClassObject(cookie)
+func NewIClassFactoryImpl() IClassFactory {
+	me := &_IClassFactory{}
+	me.IUnknown = NewIUnknown(
+		comobj.New(unsafe.Pointer(&_globalClassFactoryVt), me,
+			comco.IID_IClassFactory),
+	)
+	return me
+}
+
+func (me *_IClassFactory) OnCreateInstance(fun func() IUnknown) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.createInstance = fun
+}
+
+// Returns the Go object of the COM pointer received by the callbacks.
+func _ClassFactoryFrom(pObj uintptr) (*_IClassFactory, bool) {
+	me, ok := comobj.Impl(pObj).(*_IClassFactory)
+	return me, ok
+}
+
+var _globalClassFactoryVt = comvt.IClassFactory{
+	IUnknown: comvt.IUnknown{
+		QueryInterface: comobj.QueryInterface,
+		AddRef:         comobj.AddRef,
+		Release:        comobj.Release,
+	},
+	CreateInstance: syscall.NewCallback(
+		func(pObj, pUnkOuter uintptr, riid *win.GUID, ppv *uintptr) uintptr {
+			if ppv == nil {
+				return uintptr(errco.E_POINTER)
+			}
+			*ppv = 0
+			if pUnkOuter != 0 {
+				return uintptr(errco.CLASS_E_NOAGGREGATION)
+			}
+
+			me, ok := _ClassFactoryFrom(pObj)
+			if !ok {
+				return uintptr(errco.E_UNEXPECTED)
+			}
+			me.mutex.Lock()
+			fun := me.events.createInstance
+			me.mutex.Unlock()
+			if fun == nil {
+				return uintptr(errco.CLASS_E_CLASSNOTAVAILABLE)
+			}
+
+			obj := fun()
+			if !IsObj(obj) {
+				return uintptr(errco.E_OUTOFMEMORY)
+			}
+			defer obj.Release() // the client keeps the queried reference
+
+			ret, _, _ := syscall.SyscallN((*obj.Ptr()).QueryInterface,
+				uintptr(unsafe.Pointer(obj.Ptr())),
+				uintptr(unsafe.Pointer(riid)),
+				uintptr(unsafe.Pointer(ppv)))
+			return ret
+		}),
+	LockServer: syscall.NewCallback(
+		func(pObj uintptr, fLock int32) uintptr {
+			return uintptr(errco.S_OK) // the process lifetime is up to the application
+		}),
+}
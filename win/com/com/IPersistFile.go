@@ -0,0 +1,122 @@
+//go:build windows
+
+package com
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com/comco"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/nn-objidl-ipersistfile
+type IPersistFile interface {
+	IPersist
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-ipersistfile-getcurfile
+	GetCurFile() string
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-ipersistfile-isdirty
+	IsDirty() bool
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-ipersistfile-load
+	Load(fileName string, mode comco.STGM) error
+
+	// If fileName is none, saves to the current file.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-ipersistfile-save
+	Save(fileName win.StrOpt, remember bool) error
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-ipersistfile-savecompleted
+	SaveCompleted(fileName string)
+}
+
+type _IPersistFile struct{ IPersist }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IPersistFile.Release().
+//
+// Example:
+//
+//	var lnk shell.IShellLink // initialized somewhere
+//
+//	pf := com.NewIPersistFile(
+//		lnk.QueryInterface(comco.IID_IPersistFile),
+//	)
+//	defer pf.Release()
+//
+//	pf.Save(win.StrOptSome("C:\\Temp\\foo.lnk"), true)
+func NewIPersistFile(base IUnknown) IPersistFile {
+	return &_IPersistFile{IPersist: NewIPersist(base)}
+}
+
+func (me *_IPersistFile) GetCurFile() string {
+	var pv *uint16
+	ret, _, _ := syscall.SyscallN(
+		(*comvt.IPersistFile)(unsafe.Pointer(*me.Ptr())).GetCurFile,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pv)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK || hr == errco.S_FALSE {
+		defer win.HTASKMEM(unsafe.Pointer(pv)).CoTaskMemFree()
+		return win.Str.FromNativePtr(pv) // S_FALSE returns the default file prompt
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IPersistFile) IsDirty() bool {
+	ret, _, _ := syscall.SyscallN(
+		(*comvt.IPersistFile)(unsafe.Pointer(*me.Ptr())).IsDirty,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return true
+	} else if hr == errco.S_FALSE {
+		return false
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IPersistFile) Load(fileName string, mode comco.STGM) error {
+	ret, _, _ := syscall.SyscallN(
+		(*comvt.IPersistFile)(unsafe.Pointer(*me.Ptr())).Load,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(fileName))), uintptr(mode))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else {
+		return hr
+	}
+}
+
+func (me *_IPersistFile) Save(fileName win.StrOpt, remember bool) error {
+	ret, _, _ := syscall.SyscallN(
+		(*comvt.IPersistFile)(unsafe.Pointer(*me.Ptr())).Save,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(fileName.Raw()), util.BoolToUintptr(remember))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else {
+		return hr
+	}
+}
+
+func (me *_IPersistFile) SaveCompleted(fileName string) {
+	ret, _, _ := syscall.SyscallN(
+		(*comvt.IPersistFile)(unsafe.Pointer(*me.Ptr())).SaveCompleted,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(fileName))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
@@ -16,11 +16,31 @@ import (
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/unknwn/nn-unknwn-iunknown
 type IUnknown interface {
+	// Panics if the interface is not implemented; use
+	// IUnknown.QueryInterfaceErr() to query an optional interface.
+	//
 	// ⚠️ You must defer IUnknown.Release() on the returned COM object.
 	//
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/unknwn/nf-unknwn-iunknown-queryinterface(refiid_void)
 	QueryInterface(riid co.IID) IUnknown
 
+	// Same as IUnknown.QueryInterface(), but returns an error instead of
+	// panicking, usually errco.E_NOINTERFACE.
+	//
+	// ⚠️ You must defer IUnknown.Release() on the returned COM object.
+	//
+	// Example:
+	//
+	//	var cm shell.IContextMenu // initialized somewhere
+	//
+	//	if obj, err := cm.QueryInterfaceErr(shellco.IID_IContextMenu3); err == nil {
+	//		cm3 := shell.NewIContextMenu3(obj)
+	//		defer cm3.Release()
+	//	}
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/unknwn/nf-unknwn-iunknown-queryinterface(refiid_void)
+	QueryInterfaceErr(riid co.IID) (IUnknown, error)
+
 	// Creates a clone of the COM object.
 	//
 	// ⚠️ You must defer IUnknown.Release() on the returned COM object.
@@ -70,6 +90,14 @@ func NewIUnknown(ppv **comvt.IUnknown) IUnknown {
 }
 
 func (me *_IUnknown) QueryInterface(riid co.IID) IUnknown {
+	obj, err := me.QueryInterfaceErr(riid)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+func (me *_IUnknown) QueryInterfaceErr(riid co.IID) (IUnknown, error) {
 	var ppvQueried **comvt.IUnknown
 	ret, _, _ := syscall.SyscallN((*me.ppv).QueryInterface,
 		uintptr(unsafe.Pointer(me.ppv)),
@@ -77,9 +105,9 @@ func (me *_IUnknown) QueryInterface(riid co.IID) IUnknown {
 		uintptr(unsafe.Pointer(&ppvQueried)))
 
 	if hr := errco.ERROR(ret); hr == errco.S_OK {
-		return NewIUnknown(ppvQueried)
+		return NewIUnknown(ppvQueried), nil
 	} else {
-		panic(hr)
+		return nil, hr
 	}
 }
 
@@ -45,7 +45,8 @@ func CLSIDFromProgID(progId string) (co.CLSID, error) {
 
 // Creates a COM object from its CLSID + IID. The iUnkOuter is usually nil.
 //
-// Panics if the COM object cannot be created.
+// Panics if the COM object cannot be created; use CoCreateInstanceErr() to
+// handle the error.
 //
 // ⚠️ You must defer IUnknown.Release() on the returned COM object. If iUnkOuter
 // is not null, you must defer IUnknown.Release() on it too.
@@ -65,6 +66,24 @@ func CoCreateInstance(
 	rclsid co.CLSID, iUnkOuter *IUnknown,
 	dwClsContext comco.CLSCTX, riid co.IID) IUnknown {
 
+	obj, err := CoCreateInstanceErr(rclsid, iUnkOuter, dwClsContext, riid)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Same as CoCreateInstance(), but returns an error instead of panicking, like
+// CO_E_NOTINITIALIZED if CoInitializeEx() was not called.
+//
+// ⚠️ You must defer IUnknown.Release() on the returned COM object. If iUnkOuter
+// is not null, you must defer IUnknown.Release() on it too.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/combaseapi/nf-combaseapi-cocreateinstance
+func CoCreateInstanceErr(
+	rclsid co.CLSID, iUnkOuter *IUnknown,
+	dwClsContext comco.CLSCTX, riid co.IID) (IUnknown, error) {
+
 	var ppvQueried **comvt.IUnknown
 
 	var pppvOuter ***comvt.IUnknown
@@ -85,9 +104,9 @@ func CoCreateInstance(
 		if iUnkOuter != nil {
 			*iUnkOuter = NewIUnknown(*pppvOuter)
 		}
-		return NewIUnknown(ppvQueried)
+		return NewIUnknown(ppvQueried), nil
 	} else {
-		panic(hr)
+		return nil, hr
 	}
 }
 
@@ -105,6 +124,50 @@ func CoInitializeEx(coInit comco.COINIT) {
 	}
 }
 
+// Registers a class factory, so other processes can create objects of the
+// given CLSID, served by this process. Returns the cookie to be passed to
+// CoRevokeClassObject().
+//
+// Example:
+//
+//	factory := com.NewIClassFactoryImpl()
+//	defer factory.Release()
+//
+//	cookie, _ := com.CoRegisterClassObject(clsid, factory,
+//		comco.CLSCTX_LOCAL_SERVER, comco.REGCLS_MULTIPLEUSE)
+//	defer com.CoRevokeClassObject(cookie)
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/combaseapi/nf-combaseapi-coregisterclassobject
+func CoRegisterClassObject(
+	rclsid co.CLSID, unk IUnknown,
+	dwClsContext comco.CLSCTX, flags comco.REGCLS) (uint32, error) {
+
+	var cookie uint32
+	ret, _, _ := syscall.SyscallN(proc.CoRegisterClassObject.Addr(),
+		uintptr(unsafe.Pointer(win.GuidFromClsid(rclsid))),
+		uintptr(unsafe.Pointer(unk.Ptr())),
+		uintptr(dwClsContext), uintptr(flags),
+		uintptr(unsafe.Pointer(&cookie)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return cookie, nil
+	} else {
+		return 0, hr
+	}
+}
+
+// Unregisters a class factory registered with CoRegisterClassObject().
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/combaseapi/nf-combaseapi-corevokeclassobject
+func CoRevokeClassObject(cookie uint32) error {
+	ret, _, _ := syscall.SyscallN(proc.CoRevokeClassObject.Addr(),
+		uintptr(cookie))
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		return hr
+	}
+	return nil
+}
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/combaseapi/nf-combaseapi-couninitialize
 func CoUninitialize() {
 	syscall.SyscallN(proc.CoUninitialize.Addr())
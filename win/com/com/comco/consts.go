@@ -74,6 +74,20 @@ const (
 	PICTYPE_ENHMETAFILE   PICTYPE = 4
 )
 
+// CoRegisterClassObject() flags.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/combaseapi/ne-combaseapi-regcls
+type REGCLS uint32
+
+const (
+	REGCLS_SINGLEUSE      REGCLS = 0
+	REGCLS_MULTIPLEUSE    REGCLS = 1
+	REGCLS_MULTI_SEPARATE REGCLS = 2
+	REGCLS_SUSPENDED      REGCLS = 4
+	REGCLS_SURROGATE      REGCLS = 8
+	REGCLS_AGILE          REGCLS = 0x10
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/wtypes/ne-wtypes-stgc
 type STGC uint32
 
@@ -85,6 +99,32 @@ const (
 	STGC_CONSOLIDATE                        STGC = 8
 )
 
+// STGM constants, used in IPersistFile.Load() and others.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/stg/stgm-constants
+type STGM uint32
+
+const (
+	STGM_READ             STGM = 0x0000_0000
+	STGM_WRITE            STGM = 0x0000_0001
+	STGM_READWRITE        STGM = 0x0000_0002
+	STGM_SHARE_DENY_NONE  STGM = 0x0000_0040
+	STGM_SHARE_DENY_READ  STGM = 0x0000_0030
+	STGM_SHARE_DENY_WRITE STGM = 0x0000_0020
+	STGM_SHARE_EXCLUSIVE  STGM = 0x0000_0010
+	STGM_PRIORITY         STGM = 0x0004_0000
+	STGM_CREATE           STGM = 0x0000_1000
+	STGM_CONVERT          STGM = 0x0002_0000
+	STGM_FAILIFTHERE      STGM = 0x0000_0000
+	STGM_DIRECT           STGM = 0x0000_0000
+	STGM_TRANSACTED       STGM = 0x0001_0000
+	STGM_NOSCRATCH        STGM = 0x0010_0000
+	STGM_NOSNAPSHOT       STGM = 0x0020_0000
+	STGM_SIMPLE           STGM = 0x0800_0000
+	STGM_DIRECT_SWMR      STGM = 0x0040_0000
+	STGM_DELETEONRELEASE  STGM = 0x0400_0000
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/ne-objidl-stream_seek
 type STREAM_SEEK uint32
 
@@ -19,6 +19,15 @@ type IBindCtx struct {
 	RevokeObjectParam     uintptr
 }
 
+// IClassFactory virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/unknwn/nn-unknwn-iclassfactory
+type IClassFactory struct {
+	IUnknown
+	CreateInstance uintptr
+	LockServer     uintptr
+}
+
 // IPersist virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/nn-objidl-ipersist
@@ -27,6 +36,18 @@ type IPersist struct {
 	GetClassID uintptr
 }
 
+// IPersistFile virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/nn-objidl-ipersistfile
+type IPersistFile struct {
+	IPersist
+	IsDirty       uintptr
+	Load          uintptr
+	Save          uintptr
+	SaveCompleted uintptr
+	GetCurFile    uintptr
+}
+
 // IPicture virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/ocidl/nn-ocidl-ipicture
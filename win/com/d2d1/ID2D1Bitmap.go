@@ -0,0 +1,62 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1bitmap
+type ID2D1Bitmap interface {
+	ID2D1Image
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1bitmap-getdpi
+	GetDpi() (dpiX, dpiY float32)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1bitmap-getpixelsize
+	GetPixelSize() SIZE_U
+
+	// Returns the size in device-independent pixels.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1bitmap-getsize
+	GetSize() SIZE_F
+}
+
+type _ID2D1Bitmap struct{ ID2D1Image }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1Bitmap.Release().
+func NewID2D1Bitmap(base com.IUnknown) ID2D1Bitmap {
+	return &_ID2D1Bitmap{ID2D1Image: NewID2D1Image(base)}
+}
+
+func (me *_ID2D1Bitmap) GetDpi() (dpiX, dpiY float32) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1Bitmap)(unsafe.Pointer(*me.Ptr())).GetDpi,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&dpiX)), uintptr(unsafe.Pointer(&dpiY)))
+	return
+}
+
+func (me *_ID2D1Bitmap) GetPixelSize() SIZE_U {
+	var sz SIZE_U
+	syscall.SyscallN( // structs are returned through a hidden pointer
+		(*d2d1vt.ID2D1Bitmap)(unsafe.Pointer(*me.Ptr())).GetPixelSize,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&sz)))
+	return sz
+}
+
+func (me *_ID2D1Bitmap) GetSize() SIZE_F {
+	var sz SIZE_F
+	syscall.SyscallN( // structs are returned through a hidden pointer
+		(*d2d1vt.ID2D1Bitmap)(unsafe.Pointer(*me.Ptr())).GetSize,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&sz)))
+	return sz
+}
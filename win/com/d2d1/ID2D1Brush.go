@@ -0,0 +1,58 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1brush
+type ID2D1Brush interface {
+	ID2D1Resource
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1brush-gettransform
+	GetTransform() MATRIX_3X2_F
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1brush-setopacity
+	SetOpacity(opacity float32)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1brush-settransform(constd2d1_matrix_3x2_f_)
+	SetTransform(transform *MATRIX_3X2_F)
+}
+
+type _ID2D1Brush struct{ ID2D1Resource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1Brush.Release().
+func NewID2D1Brush(base com.IUnknown) ID2D1Brush {
+	return &_ID2D1Brush{ID2D1Resource: NewID2D1Resource(base)}
+}
+
+func (me *_ID2D1Brush) GetTransform() MATRIX_3X2_F {
+	var transform MATRIX_3X2_F
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1Brush)(unsafe.Pointer(*me.Ptr())).GetTransform,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&transform)))
+	return transform
+}
+
+func (me *_ID2D1Brush) SetOpacity(opacity float32) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1Brush)(unsafe.Pointer(*me.Ptr())).SetOpacity,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(math.Float32bits(opacity)))
+}
+
+func (me *_ID2D1Brush) SetTransform(transform *MATRIX_3X2_F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1Brush)(unsafe.Pointer(*me.Ptr())).SetTransform,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(transform)))
+}
@@ -0,0 +1,37 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1ellipsegeometry
+type ID2D1EllipseGeometry interface {
+	ID2D1Geometry
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1ellipsegeometry-getellipse
+	GetEllipse() ELLIPSE
+}
+
+type _ID2D1EllipseGeometry struct{ ID2D1Geometry }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1EllipseGeometry.Release().
+func NewID2D1EllipseGeometry(base com.IUnknown) ID2D1EllipseGeometry {
+	return &_ID2D1EllipseGeometry{ID2D1Geometry: NewID2D1Geometry(base)}
+}
+
+func (me *_ID2D1EllipseGeometry) GetEllipse() ELLIPSE {
+	var ellipse ELLIPSE
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1EllipseGeometry)(unsafe.Pointer(*me.Ptr())).GetEllipse,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ellipse)))
+	return ellipse
+}
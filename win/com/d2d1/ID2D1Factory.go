@@ -19,12 +19,40 @@ import (
 type ID2D1Factory interface {
 	com.IUnknown
 
+	// ⚠️ You must defer ID2D1EllipseGeometry.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-createellipsegeometry(constd2d1_ellipse_id2d1ellipsegeometry)
+	CreateEllipseGeometry(ellipse *ELLIPSE) ID2D1EllipseGeometry
+
 	// ⚠️ You must defer ID2D1HwndRenderTarget.Release() on the returned object.
 	//
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-createhwndrendertarget(constd2d1_render_target_properties_constd2d1_hwnd_render_target_properties_id2d1hwndrendertarget)
 	CreateHwndRenderTarget(targetProps *RENDER_TARGET_PROPERTIES,
 		hwndTargetProps *HWND_RENDER_TARGET_PROPERTIES) ID2D1HwndRenderTarget
 
+	// ⚠️ You must defer ID2D1PathGeometry.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-createpathgeometry
+	CreatePathGeometry() ID2D1PathGeometry
+
+	// ⚠️ You must defer ID2D1RectangleGeometry.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-createrectanglegeometry(constd2d1_rect_f_id2d1rectanglegeometry)
+	CreateRectangleGeometry(rectangle *RECT_F) ID2D1RectangleGeometry
+
+	// ⚠️ You must defer ID2D1RoundedRectangleGeometry.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-createroundedrectanglegeometry(constd2d1_rounded_rect_id2d1roundedrectanglegeometry)
+	CreateRoundedRectangleGeometry(roundedRectangle *ROUNDED_RECT) ID2D1RoundedRectangleGeometry
+
+	// The dashes are used only if props.DashStyle is DASH_STYLE_CUSTOM,
+	// otherwise it can be nil.
+	//
+	// ⚠️ You must defer ID2D1StrokeStyle.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-createstrokestyle(constd2d1_stroke_style_properties_constfloat_uint32_id2d1strokestyle)
+	CreateStrokeStyle(props *STROKE_STYLE_PROPERTIES, dashes []float32) ID2D1StrokeStyle
+
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-reloadsystemmetrics
 	ReloadSystemMetrics()
 }
@@ -63,6 +91,21 @@ func D2D1CreateFactory(
 	}
 }
 
+func (me *_ID2D1Factory) CreateEllipseGeometry(ellipse *ELLIPSE) ID2D1EllipseGeometry {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Factory)(unsafe.Pointer(*me.Ptr())).CreateEllipseGeometry,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(ellipse)),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1EllipseGeometry(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
 func (me *_ID2D1Factory) CreateHwndRenderTarget(
 	targetProps *RENDER_TARGET_PROPERTIES,
 	hwndTargetProps *HWND_RENDER_TARGET_PROPERTIES) ID2D1HwndRenderTarget {
@@ -82,6 +125,75 @@ func (me *_ID2D1Factory) CreateHwndRenderTarget(
 	}
 }
 
+func (me *_ID2D1Factory) CreatePathGeometry() ID2D1PathGeometry {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Factory)(unsafe.Pointer(*me.Ptr())).CreatePathGeometry,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1PathGeometry(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Factory) CreateRectangleGeometry(rectangle *RECT_F) ID2D1RectangleGeometry {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Factory)(unsafe.Pointer(*me.Ptr())).CreateRectangleGeometry,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(rectangle)),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1RectangleGeometry(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Factory) CreateRoundedRectangleGeometry(
+	roundedRectangle *ROUNDED_RECT) ID2D1RoundedRectangleGeometry {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Factory)(unsafe.Pointer(*me.Ptr())).CreateRoundedRectangleGeometry,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(roundedRectangle)),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1RoundedRectangleGeometry(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Factory) CreateStrokeStyle(
+	props *STROKE_STYLE_PROPERTIES, dashes []float32) ID2D1StrokeStyle {
+
+	var pDashes *float32
+	if len(dashes) > 0 {
+		pDashes = &dashes[0]
+	}
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Factory)(unsafe.Pointer(*me.Ptr())).CreateStrokeStyle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(props)),
+		uintptr(unsafe.Pointer(pDashes)), uintptr(len(dashes)),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1StrokeStyle(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
 func (me *_ID2D1Factory) ReloadSystemMetrics() {
 	ret, _, _ := syscall.SyscallN(
 		(*d2d1vt.ID2D1Factory)(unsafe.Pointer(*me.Ptr())).ReloadSystemMetrics,
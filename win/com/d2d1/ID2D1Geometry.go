@@ -0,0 +1,149 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Default tolerance used when flattening geometries, D2D1_DEFAULT_FLATTENING_TOLERANCE.
+const _DEFAULT_FLATTENING_TOLERANCE float32 = 0.25
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1geometry
+type ID2D1Geometry interface {
+	ID2D1Resource
+
+	// If worldTransform is nil, no transform is applied.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-computearea(constd2d1_matrix_3x2_f__float_float)
+	ComputeArea(worldTransform *MATRIX_3X2_F) float32
+
+	// If worldTransform is nil, no transform is applied.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-computelength(constd2d1_matrix_3x2_f__float_float)
+	ComputeLength(worldTransform *MATRIX_3X2_F) float32
+
+	// Tells whether the area filled by the geometry contains the point. If
+	// worldTransform is nil, no transform is applied.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-fillcontainspoint(d2d1_point_2f_constd2d1_matrix_3x2_f__float_bool)
+	FillContainsPoint(point POINT_2F, worldTransform *MATRIX_3X2_F) bool
+
+	// If worldTransform is nil, no transform is applied.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-getbounds(constd2d1_matrix_3x2_f__d2d1_rect_f)
+	GetBounds(worldTransform *MATRIX_3X2_F) RECT_F
+
+	// Tells whether the stroke of the geometry contains the point. Both
+	// strokeStyle and worldTransform can be nil.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-strokecontainspoint(d2d1_point_2f_float_id2d1strokestyle_constd2d1_matrix_3x2_f__float_bool)
+	StrokeContainsPoint(point POINT_2F, strokeWidth float32,
+		strokeStyle ID2D1StrokeStyle, worldTransform *MATRIX_3X2_F) bool
+}
+
+type _ID2D1Geometry struct{ ID2D1Resource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1Geometry.Release().
+func NewID2D1Geometry(base com.IUnknown) ID2D1Geometry {
+	return &_ID2D1Geometry{ID2D1Resource: NewID2D1Resource(base)}
+}
+
+func (me *_ID2D1Geometry) ComputeArea(worldTransform *MATRIX_3X2_F) float32 {
+	var area float32
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).ComputeArea,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(worldTransform)),
+		uintptr(math.Float32bits(_DEFAULT_FLATTENING_TOLERANCE)),
+		uintptr(unsafe.Pointer(&area)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return area
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Geometry) ComputeLength(worldTransform *MATRIX_3X2_F) float32 {
+	var length float32
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).ComputeLength,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(worldTransform)),
+		uintptr(math.Float32bits(_DEFAULT_FLATTENING_TOLERANCE)),
+		uintptr(unsafe.Pointer(&length)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return length
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Geometry) FillContainsPoint(
+	point POINT_2F, worldTransform *MATRIX_3X2_F) bool {
+
+	var contains int32 // BOOL
+	args := append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, point.args()...)
+	args = append(args,
+		uintptr(unsafe.Pointer(worldTransform)),
+		uintptr(math.Float32bits(_DEFAULT_FLATTENING_TOLERANCE)),
+		uintptr(unsafe.Pointer(&contains)))
+
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).FillContainsPoint,
+		args...)
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return contains != 0
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Geometry) GetBounds(worldTransform *MATRIX_3X2_F) RECT_F {
+	var bounds RECT_F
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).GetBounds,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(worldTransform)),
+		uintptr(unsafe.Pointer(&bounds)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return bounds
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Geometry) StrokeContainsPoint(point POINT_2F, strokeWidth float32,
+	strokeStyle ID2D1StrokeStyle, worldTransform *MATRIX_3X2_F) bool {
+
+	var contains int32 // BOOL
+	args := append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, point.args()...)
+	args = append(args,
+		uintptr(math.Float32bits(strokeWidth)),
+		_OptPtr(strokeStyle),
+		uintptr(unsafe.Pointer(worldTransform)),
+		uintptr(math.Float32bits(_DEFAULT_FLATTENING_TOLERANCE)),
+		uintptr(unsafe.Pointer(&contains)))
+
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).StrokeContainsPoint,
+		args...)
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return contains != 0
+	} else {
+		panic(hr)
+	}
+}
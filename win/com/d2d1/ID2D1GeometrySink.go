@@ -0,0 +1,76 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1geometrysink
+type ID2D1GeometrySink interface {
+	ID2D1SimplifiedGeometrySink
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometrysink-addarc(constd2d1_arc_segment_)
+	AddArc(arc *ARC_SEGMENT)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometrysink-addbezier(constd2d1_bezier_segment_)
+	AddBezier(bezier *BEZIER_SEGMENT)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometrysink-addline
+	AddLine(point POINT_2F)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometrysink-addquadraticbezier(constd2d1_quadratic_bezier_segment_)
+	AddQuadraticBezier(bezier *QUADRATIC_BEZIER_SEGMENT)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometrysink-addquadraticbeziers
+	AddQuadraticBeziers(beziers []QUADRATIC_BEZIER_SEGMENT)
+}
+
+type _ID2D1GeometrySink struct{ ID2D1SimplifiedGeometrySink }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1GeometrySink.Release().
+func NewID2D1GeometrySink(base com.IUnknown) ID2D1GeometrySink {
+	return &_ID2D1GeometrySink{ID2D1SimplifiedGeometrySink: NewID2D1SimplifiedGeometrySink(base)}
+}
+
+func (me *_ID2D1GeometrySink) AddArc(arc *ARC_SEGMENT) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1GeometrySink)(unsafe.Pointer(*me.Ptr())).AddArc,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(arc)))
+}
+
+func (me *_ID2D1GeometrySink) AddBezier(bezier *BEZIER_SEGMENT) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1GeometrySink)(unsafe.Pointer(*me.Ptr())).AddBezier,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(bezier)))
+}
+
+func (me *_ID2D1GeometrySink) AddLine(point POINT_2F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1GeometrySink)(unsafe.Pointer(*me.Ptr())).AddLine,
+		append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, point.args()...)...)
+}
+
+func (me *_ID2D1GeometrySink) AddQuadraticBezier(bezier *QUADRATIC_BEZIER_SEGMENT) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1GeometrySink)(unsafe.Pointer(*me.Ptr())).AddQuadraticBezier,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(bezier)))
+}
+
+func (me *_ID2D1GeometrySink) AddQuadraticBeziers(beziers []QUADRATIC_BEZIER_SEGMENT) {
+	if len(beziers) > 0 {
+		syscall.SyscallN(
+			(*d2d1vt.ID2D1GeometrySink)(unsafe.Pointer(*me.Ptr())).AddQuadraticBeziers,
+			uintptr(unsafe.Pointer(me.Ptr())),
+			uintptr(unsafe.Pointer(&beziers[0])), uintptr(len(beziers)))
+	}
+}
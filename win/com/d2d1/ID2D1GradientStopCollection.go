@@ -0,0 +1,65 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1gradientstopcollection
+type ID2D1GradientStopCollection interface {
+	ID2D1Resource
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1gradientstopcollection-getcolorinterpolationgamma
+	GetColorInterpolationGamma() d2d1co.GAMMA
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1gradientstopcollection-getextendmode
+	GetExtendMode() d2d1co.EXTEND_MODE
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1gradientstopcollection-getgradientstops
+	GetGradientStops() []GRADIENT_STOP
+}
+
+type _ID2D1GradientStopCollection struct{ ID2D1Resource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1GradientStopCollection.Release().
+func NewID2D1GradientStopCollection(base com.IUnknown) ID2D1GradientStopCollection {
+	return &_ID2D1GradientStopCollection{ID2D1Resource: NewID2D1Resource(base)}
+}
+
+func (me *_ID2D1GradientStopCollection) GetColorInterpolationGamma() d2d1co.GAMMA {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1GradientStopCollection)(unsafe.Pointer(*me.Ptr())).GetColorInterpolationGamma,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.GAMMA(ret)
+}
+
+func (me *_ID2D1GradientStopCollection) GetExtendMode() d2d1co.EXTEND_MODE {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1GradientStopCollection)(unsafe.Pointer(*me.Ptr())).GetExtendMode,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.EXTEND_MODE(ret)
+}
+
+func (me *_ID2D1GradientStopCollection) GetGradientStops() []GRADIENT_STOP {
+	count, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1GradientStopCollection)(unsafe.Pointer(*me.Ptr())).GetGradientStopCount,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	if uint32(count) == 0 {
+		return []GRADIENT_STOP{}
+	}
+
+	stops := make([]GRADIENT_STOP, uint32(count))
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1GradientStopCollection)(unsafe.Pointer(*me.Ptr())).GetGradientStops,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&stops[0])), uintptr(len(stops)))
+	return stops
+}
@@ -38,7 +38,7 @@ func NewID2D1HwndRenderTarget(base com.IUnknown) ID2D1HwndRenderTarget {
 
 func (me *_ID2D1HwndRenderTarget) CheckWindowState() d2d1co.WINDOW_STATE {
 	ret, _, _ := syscall.SyscallN(
-		(*d2d1vt.ID2D1HwndRenderTarget)(unsafe.Pointer(*me.Ptr())).GetHwnd,
+		(*d2d1vt.ID2D1HwndRenderTarget)(unsafe.Pointer(*me.Ptr())).CheckWindowState,
 		uintptr(unsafe.Pointer(me.Ptr())))
 	return d2d1co.WINDOW_STATE(ret)
 }
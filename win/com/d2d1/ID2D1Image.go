@@ -0,0 +1,21 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/com"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1image
+type ID2D1Image interface {
+	ID2D1Resource
+}
+
+type _ID2D1Image struct{ ID2D1Resource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1Image.Release().
+func NewID2D1Image(base com.IUnknown) ID2D1Image {
+	return &_ID2D1Image{ID2D1Resource: NewID2D1Resource(base)}
+}
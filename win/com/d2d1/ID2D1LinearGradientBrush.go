@@ -0,0 +1,82 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1lineargradientbrush
+type ID2D1LinearGradientBrush interface {
+	ID2D1Brush
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-getendpoint
+	GetEndPoint() POINT_2F
+
+	// ⚠️ You must defer ID2D1GradientStopCollection.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-getgradientstopcollection
+	GetGradientStopCollection() ID2D1GradientStopCollection
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-getstartpoint
+	GetStartPoint() POINT_2F
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-setendpoint
+	SetEndPoint(endPoint POINT_2F)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-setstartpoint
+	SetStartPoint(startPoint POINT_2F)
+}
+
+type _ID2D1LinearGradientBrush struct{ ID2D1Brush }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1LinearGradientBrush.Release().
+func NewID2D1LinearGradientBrush(base com.IUnknown) ID2D1LinearGradientBrush {
+	return &_ID2D1LinearGradientBrush{ID2D1Brush: NewID2D1Brush(base)}
+}
+
+func (me *_ID2D1LinearGradientBrush) GetEndPoint() POINT_2F {
+	var pt POINT_2F
+	syscall.SyscallN( // structs are returned through a hidden pointer
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).GetEndPoint,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pt)))
+	return pt
+}
+
+func (me *_ID2D1LinearGradientBrush) GetGradientStopCollection() ID2D1GradientStopCollection {
+	var ppvQueried **comvt.IUnknown
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).GetGradientStopCollection,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+	return NewID2D1GradientStopCollection(com.NewIUnknown(ppvQueried))
+}
+
+func (me *_ID2D1LinearGradientBrush) GetStartPoint() POINT_2F {
+	var pt POINT_2F
+	syscall.SyscallN( // structs are returned through a hidden pointer
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).GetStartPoint,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pt)))
+	return pt
+}
+
+func (me *_ID2D1LinearGradientBrush) SetEndPoint(endPoint POINT_2F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).SetEndPoint,
+		append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, endPoint.args()...)...)
+}
+
+func (me *_ID2D1LinearGradientBrush) SetStartPoint(startPoint POINT_2F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).SetStartPoint,
+		append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, startPoint.args()...)...)
+}
@@ -0,0 +1,99 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1pathgeometry
+type ID2D1PathGeometry interface {
+	ID2D1Geometry
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1pathgeometry-getfigurecount
+	GetFigureCount() uint
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1pathgeometry-getsegmentcount
+	GetSegmentCount() uint
+
+	// Returns the sink used to populate the path geometry, which can be opened
+	// only once.
+	//
+	// ⚠️ You must defer ID2D1GeometrySink.Release() on the returned object.
+	//
+	// Example:
+	//
+	//	var factory d2d1.ID2D1Factory // initialized somewhere
+	//
+	//	path := factory.CreatePathGeometry()
+	//	defer path.Release()
+	//
+	//	sink := path.Open()
+	//	defer sink.Release()
+	//
+	//	sink.BeginFigure(d2d1.POINT_2F{X: 10, Y: 10}, d2d1co.FIGURE_BEGIN_FILLED)
+	//	sink.AddLine(d2d1.POINT_2F{X: 50, Y: 90})
+	//	sink.AddLine(d2d1.POINT_2F{X: 90, Y: 10})
+	//	sink.EndFigure(d2d1co.FIGURE_END_CLOSED)
+	//	sink.Close()
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1pathgeometry-open
+	Open() ID2D1GeometrySink
+}
+
+type _ID2D1PathGeometry struct{ ID2D1Geometry }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1PathGeometry.Release().
+func NewID2D1PathGeometry(base com.IUnknown) ID2D1PathGeometry {
+	return &_ID2D1PathGeometry{ID2D1Geometry: NewID2D1Geometry(base)}
+}
+
+func (me *_ID2D1PathGeometry) GetFigureCount() uint {
+	var count uint32
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1PathGeometry)(unsafe.Pointer(*me.Ptr())).GetFigureCount,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&count)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return uint(count)
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1PathGeometry) GetSegmentCount() uint {
+	var count uint32
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1PathGeometry)(unsafe.Pointer(*me.Ptr())).GetSegmentCount,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&count)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return uint(count)
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1PathGeometry) Open() ID2D1GeometrySink {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1PathGeometry)(unsafe.Pointer(*me.Ptr())).Open,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1GeometrySink(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
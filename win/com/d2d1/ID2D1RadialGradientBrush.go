@@ -0,0 +1,103 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1radialgradientbrush
+type ID2D1RadialGradientBrush interface {
+	ID2D1Brush
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1radialgradientbrush-getcenter
+	GetCenter() POINT_2F
+
+	// ⚠️ You must defer ID2D1GradientStopCollection.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1radialgradientbrush-getgradientstopcollection
+	GetGradientStopCollection() ID2D1GradientStopCollection
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1radialgradientbrush-getgradientoriginoffset
+	GetGradientOriginOffset() POINT_2F
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1radialgradientbrush-setcenter
+	SetCenter(center POINT_2F)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1radialgradientbrush-setgradientoriginoffset
+	SetGradientOriginOffset(gradientOriginOffset POINT_2F)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1radialgradientbrush-setradiusx
+	SetRadiusX(radiusX float32)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1radialgradientbrush-setradiusy
+	SetRadiusY(radiusY float32)
+}
+
+type _ID2D1RadialGradientBrush struct{ ID2D1Brush }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1RadialGradientBrush.Release().
+func NewID2D1RadialGradientBrush(base com.IUnknown) ID2D1RadialGradientBrush {
+	return &_ID2D1RadialGradientBrush{ID2D1Brush: NewID2D1Brush(base)}
+}
+
+func (me *_ID2D1RadialGradientBrush) GetCenter() POINT_2F {
+	var pt POINT_2F
+	syscall.SyscallN( // structs are returned through a hidden pointer
+		(*d2d1vt.ID2D1RadialGradientBrush)(unsafe.Pointer(*me.Ptr())).GetCenter,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pt)))
+	return pt
+}
+
+func (me *_ID2D1RadialGradientBrush) GetGradientStopCollection() ID2D1GradientStopCollection {
+	var ppvQueried **comvt.IUnknown
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RadialGradientBrush)(unsafe.Pointer(*me.Ptr())).GetGradientStopCollection,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+	return NewID2D1GradientStopCollection(com.NewIUnknown(ppvQueried))
+}
+
+func (me *_ID2D1RadialGradientBrush) GetGradientOriginOffset() POINT_2F {
+	var pt POINT_2F
+	syscall.SyscallN( // structs are returned through a hidden pointer
+		(*d2d1vt.ID2D1RadialGradientBrush)(unsafe.Pointer(*me.Ptr())).GetGradientOriginOffset,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pt)))
+	return pt
+}
+
+func (me *_ID2D1RadialGradientBrush) SetCenter(center POINT_2F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RadialGradientBrush)(unsafe.Pointer(*me.Ptr())).SetCenter,
+		append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, center.args()...)...)
+}
+
+func (me *_ID2D1RadialGradientBrush) SetGradientOriginOffset(gradientOriginOffset POINT_2F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RadialGradientBrush)(unsafe.Pointer(*me.Ptr())).SetGradientOriginOffset,
+		append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, gradientOriginOffset.args()...)...)
+}
+
+func (me *_ID2D1RadialGradientBrush) SetRadiusX(radiusX float32) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RadialGradientBrush)(unsafe.Pointer(*me.Ptr())).SetRadiusX,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(math.Float32bits(radiusX)))
+}
+
+func (me *_ID2D1RadialGradientBrush) SetRadiusY(radiusY float32) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RadialGradientBrush)(unsafe.Pointer(*me.Ptr())).SetRadiusY,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(math.Float32bits(radiusY)))
+}
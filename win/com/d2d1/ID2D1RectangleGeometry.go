@@ -0,0 +1,37 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1rectanglegeometry
+type ID2D1RectangleGeometry interface {
+	ID2D1Geometry
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rectanglegeometry-getrect
+	GetRect() RECT_F
+}
+
+type _ID2D1RectangleGeometry struct{ ID2D1Geometry }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1RectangleGeometry.Release().
+func NewID2D1RectangleGeometry(base com.IUnknown) ID2D1RectangleGeometry {
+	return &_ID2D1RectangleGeometry{ID2D1Geometry: NewID2D1Geometry(base)}
+}
+
+func (me *_ID2D1RectangleGeometry) GetRect() RECT_F {
+	var rc RECT_F
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RectangleGeometry)(unsafe.Pointer(*me.Ptr())).GetRect,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&rc)))
+	return rc
+}
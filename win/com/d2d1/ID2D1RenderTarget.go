@@ -3,11 +3,19 @@
 package d2d1
 
 import (
+	"math"
 	"syscall"
 	"unsafe"
 
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
 	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+	"github.com/rodrigocfd/windigo/win/com/dwrite"
+	"github.com/rodrigocfd/windigo/win/com/dwrite/dwriteco"
+	"github.com/rodrigocfd/windigo/win/com/wic"
 	"github.com/rodrigocfd/windigo/win/errco"
 )
 
@@ -15,16 +23,228 @@ import (
 type ID2D1RenderTarget interface {
 	ID2D1Resource
 
-	// ⚠️ You must defer ID2D1RenderTarget.EndDraw().
+	// ⚠️ You must call ID2D1RenderTarget.EndDraw() after drawing.
 	//
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-begindraw
 	BeginDraw()
 
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-clear(constd2d1_color_f_)
+	Clear(clearColor *COLOR_F)
+
+	// Creates a bitmap, optionally with its initial pixels. If srcData is nil,
+	// the bitmap is left uninitialized, and pitch is ignored.
+	//
+	// ⚠️ You must defer ID2D1Bitmap.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createbitmap(d2d1_size_u_constvoid_uint32_constd2d1_bitmap_properties__id2d1bitmap)
+	CreateBitmap(size SIZE_U, srcData []byte, pitch uint,
+		props *BITMAP_PROPERTIES) ID2D1Bitmap
+
+	// Creates a bitmap with a copy of the WIC bitmap source, which must have
+	// a pixel format supported by Direct2D, like
+	// wicco.PIXEL_FORMAT_32bppPBGRA. If props is nil, the pixel format and
+	// DPI of the source are used.
+	//
+	// ⚠️ You must defer ID2D1Bitmap.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createbitmapfromwicbitmap(iwicbitmapsource_constd2d1_bitmap_properties_id2d1bitmap)
+	CreateBitmapFromWicBitmap(
+		source wic.IWICBitmapSource, props *BITMAP_PROPERTIES) ID2D1Bitmap
+
+	// ⚠️ You must defer ID2D1GradientStopCollection.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-creategradientstopcollection(constd2d1_gradient_stop_uint32_d2d1_gamma_d2d1_extend_mode_id2d1gradientstopcollection)
+	CreateGradientStopCollection(stops []GRADIENT_STOP,
+		colorInterpolationGamma d2d1co.GAMMA,
+		extendMode d2d1co.EXTEND_MODE) ID2D1GradientStopCollection
+
+	// If size is nil, the layer grows as needed.
+	//
+	// ⚠️ You must defer ID2D1Layer.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createlayer(constd2d1_size_f_id2d1layer)
+	CreateLayer(size *SIZE_F) ID2D1Layer
+
+	// If brushProps is nil, the brush is opaque with no transform.
+	//
+	// ⚠️ You must defer ID2D1LinearGradientBrush.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createlineargradientbrush(constd2d1_linear_gradient_brush_properties__constd2d1_brush_properties__id2d1gradientstopcollection_id2d1lineargradientbrush)
+	CreateLinearGradientBrush(props *LINEAR_GRADIENT_BRUSH_PROPERTIES,
+		brushProps *BRUSH_PROPERTIES,
+		stops ID2D1GradientStopCollection) ID2D1LinearGradientBrush
+
+	// If brushProps is nil, the brush is opaque with no transform.
+	//
+	// ⚠️ You must defer ID2D1RadialGradientBrush.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createradialgradientbrush(constd2d1_radial_gradient_brush_properties__constd2d1_brush_properties__id2d1gradientstopcollection_id2d1radialgradientbrush)
+	CreateRadialGradientBrush(props *RADIAL_GRADIENT_BRUSH_PROPERTIES,
+		brushProps *BRUSH_PROPERTIES,
+		stops ID2D1GradientStopCollection) ID2D1RadialGradientBrush
+
+	// If brushProps is nil, the brush is opaque with no transform.
+	//
+	// ⚠️ You must defer ID2D1SolidColorBrush.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createsolidcolorbrush(constd2d1_color_f__constd2d1_brush_properties__id2d1solidcolorbrush)
+	CreateSolidColorBrush(color *COLOR_F,
+		brushProps *BRUSH_PROPERTIES) ID2D1SolidColorBrush
+
+	// If destRect is nil, the bitmap is drawn at the origin, with its own
+	// size. If srcRect is nil, the whole bitmap is drawn.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawbitmap(id2d1bitmap_constd2d1_rect_f__float_d2d1_bitmap_interpolation_mode_constd2d1_rect_f_)
+	DrawBitmap(bitmap ID2D1Bitmap, destRect *RECT_F, opacity float32,
+		interpolationMode d2d1co.BITMAP_INTERPOLATION_MODE, srcRect *RECT_F)
+
+	// The strokeStyle can be nil.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawellipse(constd2d1_ellipse__id2d1brush_float_id2d1strokestyle)
+	DrawEllipse(ellipse *ELLIPSE, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
+	// The strokeStyle can be nil.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawgeometry
+	DrawGeometry(geometry ID2D1Geometry, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
+	// The strokeStyle can be nil.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawline
+	DrawLine(point0, point1 POINT_2F, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
+	// The strokeStyle can be nil.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawrectangle(constd2d1_rect_f__id2d1brush_float_id2d1strokestyle)
+	DrawRectangle(rect *RECT_F, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
+	// The strokeStyle can be nil.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawroundedrectangle(constd2d1_rounded_rect__id2d1brush_float_id2d1strokestyle)
+	DrawRoundedRectangle(roundedRect *ROUNDED_RECT, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
+	// Draws the text within the given rectangle. For text which must be
+	// measured or hit-tested, prefer DrawTextLayout().
+	//
+	// Example:
+	//
+	//	var rt d2d1.ID2D1HwndRenderTarget // initialized somewhere
+	//	var format dwrite.IDWriteTextFormat
+	//	var brush d2d1.ID2D1SolidColorBrush
+	//
+	//	rt.DrawText("Hello", format,
+	//		&d2d1.RECT_F{Left: 10, Top: 10, Right: 200, Bottom: 40},
+	//		brush, d2d1co.DRAW_TEXT_OPTIONS_NONE,
+	//		dwriteco.MEASURING_MODE_NATURAL)
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawtext(constwchar_uint32_idwritetextformat_constd2d1_rect_f__id2d1brush_d2d1_draw_text_options_dwrite_measuring_mode)
+	DrawText(text string, textFormat dwrite.IDWriteTextFormat,
+		layoutRect *RECT_F, defaultFillBrush ID2D1Brush,
+		options d2d1co.DRAW_TEXT_OPTIONS,
+		measuringMode dwriteco.MEASURING_MODE)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawtextlayout
+	DrawTextLayout(origin POINT_2F, textLayout dwrite.IDWriteTextLayout,
+		defaultFillBrush ID2D1Brush, options d2d1co.DRAW_TEXT_OPTIONS)
+
+	// Panics on error, including errco.D2DERR_RECREATE_TARGET; prefer
+	// ID2D1RenderTarget.EndDrawErr() to handle device loss.
+	//
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-enddraw
 	EndDraw() (tag1, tag2 uint64)
 
+	// Same as ID2D1RenderTarget.EndDraw(), but returns
+	// errco.D2DERR_RECREATE_TARGET if the device was lost; in this case, the
+	// render target and all its device-dependent resources must be released
+	// and created again. Any other error will panic.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-enddraw
+	EndDrawErr() (tag1, tag2 uint64, e error)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-fillellipse(constd2d1_ellipse__id2d1brush)
+	FillEllipse(ellipse *ELLIPSE, brush ID2D1Brush)
+
+	// The opacityBrush can be nil.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-fillgeometry
+	FillGeometry(geometry ID2D1Geometry, brush, opacityBrush ID2D1Brush)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-fillrectangle(constd2d1_rect_f__id2d1brush)
+	FillRectangle(rect *RECT_F, brush ID2D1Brush)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-fillroundedrectangle(constd2d1_rounded_rect__id2d1brush)
+	FillRoundedRectangle(roundedRect *ROUNDED_RECT, brush ID2D1Brush)
+
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-flush
 	Flush() (tag1, tag2 uint64)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-getantialiasmode
+	GetAntialiasMode() d2d1co.ANTIALIAS_MODE
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-getdpi
+	GetDpi() (dpiX, dpiY float32)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-getpixelsize
+	GetPixelSize() SIZE_U
+
+	// Returns the size in device-independent pixels.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-getsize
+	GetSize() SIZE_F
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-gettextantialiasmode
+	GetTextAntialiasMode() d2d1co.TEXT_ANTIALIAS_MODE
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-gettransform
+	GetTransform() MATRIX_3X2_F
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-popaxisalignedclip
+	PopAxisAlignedClip()
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-poplayer
+	PopLayer()
+
+	// ⚠️ You must defer ID2D1RenderTarget.PopAxisAlignedClip().
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-pushaxisalignedclip(constd2d1_rect_f__d2d1_antialias_mode)
+	PushAxisAlignedClip(clipRect *RECT_F, antialiasMode d2d1co.ANTIALIAS_MODE)
+
+	// ⚠️ You must defer ID2D1RenderTarget.PopLayer().
+	//
+	// Example:
+	//
+	//	var rt d2d1.ID2D1HwndRenderTarget // initialized somewhere
+	//	var ellipse d2d1.ID2D1EllipseGeometry
+	//
+	//	layer := rt.CreateLayer(nil)
+	//	defer layer.Release()
+	//
+	//	params := d2d1.LayerParametersDefault()
+	//	params.SetGeometricMask(ellipse)
+	//	params.Opacity = 0.5
+	//
+	//	rt.PushLayer(&params, layer)
+	//	defer rt.PopLayer()
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-pushlayer(constd2d1_layer_parameters__id2d1layer)
+	PushLayer(layerParameters *LAYER_PARAMETERS, layer ID2D1Layer)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-setantialiasmode
+	SetAntialiasMode(antialiasMode d2d1co.ANTIALIAS_MODE)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-setdpi
+	SetDpi(dpiX, dpiY float32)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-settextantialiasmode
+	SetTextAntialiasMode(textAntialiasMode d2d1co.TEXT_ANTIALIAS_MODE)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-settransform(constd2d1_matrix_3x2_f_)
+	SetTransform(transform *MATRIX_3X2_F)
 }
 
 type _ID2D1RenderTarget struct{ ID2D1Resource }
@@ -46,6 +266,266 @@ func (me *_ID2D1RenderTarget) BeginDraw() {
 	}
 }
 
+func (me *_ID2D1RenderTarget) Clear(clearColor *COLOR_F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).Clear,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(clearColor)))
+}
+
+func (me *_ID2D1RenderTarget) CreateBitmap(size SIZE_U,
+	srcData []byte, pitch uint, props *BITMAP_PROPERTIES) ID2D1Bitmap {
+
+	var pSrcData *byte
+	if len(srcData) > 0 {
+		pSrcData = &srcData[0]
+	}
+
+	var ppvQueried **comvt.IUnknown
+	args := append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))},
+		util.Arg64(*(*uint64)(unsafe.Pointer(&size)))...) // SIZE_U passed by value
+	args = append(args,
+		uintptr(unsafe.Pointer(pSrcData)), uintptr(pitch),
+		uintptr(unsafe.Pointer(props)),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateBitmap,
+		args...)
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1Bitmap(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1RenderTarget) CreateBitmapFromWicBitmap(
+	source wic.IWICBitmapSource, props *BITMAP_PROPERTIES) ID2D1Bitmap {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateBitmapFromWicBitmap,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(source.Ptr())),
+		uintptr(unsafe.Pointer(props)),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1Bitmap(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1RenderTarget) CreateGradientStopCollection(
+	stops []GRADIENT_STOP,
+	colorInterpolationGamma d2d1co.GAMMA,
+	extendMode d2d1co.EXTEND_MODE) ID2D1GradientStopCollection {
+
+	var pStops *GRADIENT_STOP
+	if len(stops) > 0 {
+		pStops = &stops[0]
+	}
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateGradientStopCollection,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(pStops)), uintptr(len(stops)),
+		uintptr(colorInterpolationGamma), uintptr(extendMode),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1GradientStopCollection(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1RenderTarget) CreateLayer(size *SIZE_F) ID2D1Layer {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateLayer,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(size)),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1Layer(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1RenderTarget) CreateLinearGradientBrush(
+	props *LINEAR_GRADIENT_BRUSH_PROPERTIES,
+	brushProps *BRUSH_PROPERTIES,
+	stops ID2D1GradientStopCollection) ID2D1LinearGradientBrush {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateLinearGradientBrush,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(props)),
+		uintptr(unsafe.Pointer(brushProps)),
+		uintptr(unsafe.Pointer(stops.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1LinearGradientBrush(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1RenderTarget) CreateRadialGradientBrush(
+	props *RADIAL_GRADIENT_BRUSH_PROPERTIES,
+	brushProps *BRUSH_PROPERTIES,
+	stops ID2D1GradientStopCollection) ID2D1RadialGradientBrush {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateRadialGradientBrush,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(props)),
+		uintptr(unsafe.Pointer(brushProps)),
+		uintptr(unsafe.Pointer(stops.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1RadialGradientBrush(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1RenderTarget) CreateSolidColorBrush(
+	color *COLOR_F, brushProps *BRUSH_PROPERTIES) ID2D1SolidColorBrush {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateSolidColorBrush,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(color)),
+		uintptr(unsafe.Pointer(brushProps)),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1SolidColorBrush(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1RenderTarget) DrawBitmap(
+	bitmap ID2D1Bitmap, destRect *RECT_F, opacity float32,
+	interpolationMode d2d1co.BITMAP_INTERPOLATION_MODE, srcRect *RECT_F) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawBitmap,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(bitmap.Ptr())),
+		uintptr(unsafe.Pointer(destRect)),
+		uintptr(math.Float32bits(opacity)),
+		uintptr(interpolationMode),
+		uintptr(unsafe.Pointer(srcRect)))
+}
+
+func (me *_ID2D1RenderTarget) DrawEllipse(ellipse *ELLIPSE,
+	brush ID2D1Brush, strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawEllipse,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(ellipse)),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		uintptr(math.Float32bits(strokeWidth)),
+		_OptPtr(strokeStyle))
+}
+
+func (me *_ID2D1RenderTarget) DrawGeometry(geometry ID2D1Geometry,
+	brush ID2D1Brush, strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawGeometry,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(geometry.Ptr())),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		uintptr(math.Float32bits(strokeWidth)),
+		_OptPtr(strokeStyle))
+}
+
+func (me *_ID2D1RenderTarget) DrawLine(point0, point1 POINT_2F,
+	brush ID2D1Brush, strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	args := append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, point0.args()...)
+	args = append(args, point1.args()...)
+	args = append(args,
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		uintptr(math.Float32bits(strokeWidth)),
+		_OptPtr(strokeStyle))
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawLine,
+		args...)
+}
+
+func (me *_ID2D1RenderTarget) DrawRectangle(rect *RECT_F,
+	brush ID2D1Brush, strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawRectangle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(rect)),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		uintptr(math.Float32bits(strokeWidth)),
+		_OptPtr(strokeStyle))
+}
+
+func (me *_ID2D1RenderTarget) DrawRoundedRectangle(roundedRect *ROUNDED_RECT,
+	brush ID2D1Brush, strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawRoundedRectangle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(roundedRect)),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		uintptr(math.Float32bits(strokeWidth)),
+		_OptPtr(strokeStyle))
+}
+
+func (me *_ID2D1RenderTarget) DrawText(text string,
+	textFormat dwrite.IDWriteTextFormat, layoutRect *RECT_F,
+	defaultFillBrush ID2D1Brush, options d2d1co.DRAW_TEXT_OPTIONS,
+	measuringMode dwriteco.MEASURING_MODE) {
+
+	text16 := win.Str.ToNativeSlice(text)
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawText,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&text16[0])), uintptr(len(text16)-1), // without terminating null
+		uintptr(unsafe.Pointer(textFormat.Ptr())),
+		uintptr(unsafe.Pointer(layoutRect)),
+		uintptr(unsafe.Pointer(defaultFillBrush.Ptr())),
+		uintptr(options), uintptr(measuringMode))
+}
+
+func (me *_ID2D1RenderTarget) DrawTextLayout(origin POINT_2F,
+	textLayout dwrite.IDWriteTextLayout,
+	defaultFillBrush ID2D1Brush, options d2d1co.DRAW_TEXT_OPTIONS) {
+
+	args := append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, origin.args()...)
+	args = append(args,
+		uintptr(unsafe.Pointer(textLayout.Ptr())),
+		uintptr(unsafe.Pointer(defaultFillBrush.Ptr())),
+		uintptr(options))
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawTextLayout,
+		args...)
+}
+
 func (me *_ID2D1RenderTarget) EndDraw() (tag1, tag2 uint64) {
 	ret, _, _ := syscall.SyscallN(
 		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).EndDraw,
@@ -59,6 +539,58 @@ func (me *_ID2D1RenderTarget) EndDraw() (tag1, tag2 uint64) {
 	}
 }
 
+func (me *_ID2D1RenderTarget) EndDrawErr() (tag1, tag2 uint64, e error) {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).EndDraw,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&tag1)), uintptr(unsafe.Pointer(&tag2)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return tag1, tag2, nil
+	} else if hr == errco.D2DERR_RECREATE_TARGET {
+		return tag1, tag2, hr
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1RenderTarget) FillEllipse(ellipse *ELLIPSE, brush ID2D1Brush) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).FillEllipse,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(ellipse)),
+		uintptr(unsafe.Pointer(brush.Ptr())))
+}
+
+func (me *_ID2D1RenderTarget) FillGeometry(
+	geometry ID2D1Geometry, brush, opacityBrush ID2D1Brush) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).FillGeometry,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(geometry.Ptr())),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		_OptPtr(opacityBrush))
+}
+
+func (me *_ID2D1RenderTarget) FillRectangle(rect *RECT_F, brush ID2D1Brush) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).FillRectangle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(rect)),
+		uintptr(unsafe.Pointer(brush.Ptr())))
+}
+
+func (me *_ID2D1RenderTarget) FillRoundedRectangle(
+	roundedRect *ROUNDED_RECT, brush ID2D1Brush) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).FillRoundedRectangle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(roundedRect)),
+		uintptr(unsafe.Pointer(brush.Ptr())))
+}
+
 func (me *_ID2D1RenderTarget) Flush() (tag1, tag2 uint64) {
 	ret, _, _ := syscall.SyscallN(
 		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).Flush,
@@ -71,3 +603,114 @@ func (me *_ID2D1RenderTarget) Flush() (tag1, tag2 uint64) {
 		return
 	}
 }
+
+func (me *_ID2D1RenderTarget) GetAntialiasMode() d2d1co.ANTIALIAS_MODE {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).GetAntialiasMode,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.ANTIALIAS_MODE(ret)
+}
+
+func (me *_ID2D1RenderTarget) GetDpi() (dpiX, dpiY float32) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).GetDpi,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&dpiX)), uintptr(unsafe.Pointer(&dpiY)))
+	return
+}
+
+func (me *_ID2D1RenderTarget) GetPixelSize() SIZE_U {
+	var sz SIZE_U
+	syscall.SyscallN( // structs are returned through a hidden pointer
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).GetPixelSize,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&sz)))
+	return sz
+}
+
+func (me *_ID2D1RenderTarget) GetSize() SIZE_F {
+	var sz SIZE_F
+	syscall.SyscallN( // structs are returned through a hidden pointer
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).GetSize,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&sz)))
+	return sz
+}
+
+func (me *_ID2D1RenderTarget) GetTextAntialiasMode() d2d1co.TEXT_ANTIALIAS_MODE {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).GetTextAntialiasMode,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.TEXT_ANTIALIAS_MODE(ret)
+}
+
+func (me *_ID2D1RenderTarget) GetTransform() MATRIX_3X2_F {
+	var transform MATRIX_3X2_F
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).GetTransform,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&transform)))
+	return transform
+}
+
+func (me *_ID2D1RenderTarget) PopAxisAlignedClip() {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).PopAxisAlignedClip,
+		uintptr(unsafe.Pointer(me.Ptr())))
+}
+
+func (me *_ID2D1RenderTarget) PopLayer() {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).PopLayer,
+		uintptr(unsafe.Pointer(me.Ptr())))
+}
+
+func (me *_ID2D1RenderTarget) PushAxisAlignedClip(
+	clipRect *RECT_F, antialiasMode d2d1co.ANTIALIAS_MODE) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).PushAxisAlignedClip,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(clipRect)),
+		uintptr(antialiasMode))
+}
+
+func (me *_ID2D1RenderTarget) PushLayer(
+	layerParameters *LAYER_PARAMETERS, layer ID2D1Layer) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).PushLayer,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(layerParameters)),
+		uintptr(unsafe.Pointer(layer.Ptr())))
+}
+
+func (me *_ID2D1RenderTarget) SetAntialiasMode(antialiasMode d2d1co.ANTIALIAS_MODE) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).SetAntialiasMode,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(antialiasMode))
+}
+
+func (me *_ID2D1RenderTarget) SetDpi(dpiX, dpiY float32) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).SetDpi,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(math.Float32bits(dpiX)), uintptr(math.Float32bits(dpiY)))
+}
+
+func (me *_ID2D1RenderTarget) SetTextAntialiasMode(
+	textAntialiasMode d2d1co.TEXT_ANTIALIAS_MODE) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).SetTextAntialiasMode,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(textAntialiasMode))
+}
+
+func (me *_ID2D1RenderTarget) SetTransform(transform *MATRIX_3X2_F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).SetTransform,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(transform)))
+}
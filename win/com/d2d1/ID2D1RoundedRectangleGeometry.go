@@ -0,0 +1,37 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1roundedrectanglegeometry
+type ID2D1RoundedRectangleGeometry interface {
+	ID2D1Geometry
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1roundedrectanglegeometry-getroundedrect
+	GetRoundedRect() ROUNDED_RECT
+}
+
+type _ID2D1RoundedRectangleGeometry struct{ ID2D1Geometry }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1RoundedRectangleGeometry.Release().
+func NewID2D1RoundedRectangleGeometry(base com.IUnknown) ID2D1RoundedRectangleGeometry {
+	return &_ID2D1RoundedRectangleGeometry{ID2D1Geometry: NewID2D1Geometry(base)}
+}
+
+func (me *_ID2D1RoundedRectangleGeometry) GetRoundedRect() ROUNDED_RECT {
+	var rr ROUNDED_RECT
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RoundedRectangleGeometry)(unsafe.Pointer(*me.Ptr())).GetRoundedRect,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&rr)))
+	return rr
+}
@@ -0,0 +1,109 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1simplifiedgeometrysink
+type ID2D1SimplifiedGeometrySink interface {
+	com.IUnknown
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1simplifiedgeometrysink-addbeziers
+	AddBeziers(beziers []BEZIER_SEGMENT)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1simplifiedgeometrysink-addlines
+	AddLines(points []POINT_2F)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1simplifiedgeometrysink-beginfigure
+	BeginFigure(startPoint POINT_2F, figureBegin d2d1co.FIGURE_BEGIN)
+
+	// Closes the sink, finishing the geometry. Must be called after all
+	// figures were ended.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1simplifiedgeometrysink-close
+	Close()
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1simplifiedgeometrysink-endfigure
+	EndFigure(figureEnd d2d1co.FIGURE_END)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1simplifiedgeometrysink-setfillmode
+	SetFillMode(fillMode d2d1co.FILL_MODE)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1simplifiedgeometrysink-setsegmentflags
+	SetSegmentFlags(vertexFlags d2d1co.PATH_SEGMENT)
+}
+
+type _ID2D1SimplifiedGeometrySink struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1SimplifiedGeometrySink.Release().
+func NewID2D1SimplifiedGeometrySink(base com.IUnknown) ID2D1SimplifiedGeometrySink {
+	return &_ID2D1SimplifiedGeometrySink{IUnknown: base}
+}
+
+func (me *_ID2D1SimplifiedGeometrySink) AddBeziers(beziers []BEZIER_SEGMENT) {
+	if len(beziers) > 0 {
+		syscall.SyscallN(
+			(*d2d1vt.ID2D1SimplifiedGeometrySink)(unsafe.Pointer(*me.Ptr())).AddBeziers,
+			uintptr(unsafe.Pointer(me.Ptr())),
+			uintptr(unsafe.Pointer(&beziers[0])), uintptr(len(beziers)))
+	}
+}
+
+func (me *_ID2D1SimplifiedGeometrySink) AddLines(points []POINT_2F) {
+	if len(points) > 0 {
+		syscall.SyscallN(
+			(*d2d1vt.ID2D1SimplifiedGeometrySink)(unsafe.Pointer(*me.Ptr())).AddLines,
+			uintptr(unsafe.Pointer(me.Ptr())),
+			uintptr(unsafe.Pointer(&points[0])), uintptr(len(points)))
+	}
+}
+
+func (me *_ID2D1SimplifiedGeometrySink) BeginFigure(
+	startPoint POINT_2F, figureBegin d2d1co.FIGURE_BEGIN) {
+
+	args := append([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, startPoint.args()...)
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1SimplifiedGeometrySink)(unsafe.Pointer(*me.Ptr())).BeginFigure,
+		append(args, uintptr(figureBegin))...)
+}
+
+func (me *_ID2D1SimplifiedGeometrySink) Close() {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1SimplifiedGeometrySink)(unsafe.Pointer(*me.Ptr())).Close,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1SimplifiedGeometrySink) EndFigure(figureEnd d2d1co.FIGURE_END) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1SimplifiedGeometrySink)(unsafe.Pointer(*me.Ptr())).EndFigure,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(figureEnd))
+}
+
+func (me *_ID2D1SimplifiedGeometrySink) SetFillMode(fillMode d2d1co.FILL_MODE) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1SimplifiedGeometrySink)(unsafe.Pointer(*me.Ptr())).SetFillMode,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(fillMode))
+}
+
+func (me *_ID2D1SimplifiedGeometrySink) SetSegmentFlags(vertexFlags d2d1co.PATH_SEGMENT) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1SimplifiedGeometrySink)(unsafe.Pointer(*me.Ptr())).SetSegmentFlags,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(vertexFlags))
+}
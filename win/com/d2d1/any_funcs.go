@@ -0,0 +1,48 @@
+//go:build windows
+
+package d2d1
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/wic"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+)
+
+// Decodes the first frame of an image file with WIC, and creates a bitmap for
+// the render target. Any format with an installed WIC codec is supported, like
+// PNG, JPEG, TIFF, GIF, WebP and HEIF.
+//
+// ⚠️ You must defer ID2D1Bitmap.Release() on the returned object.
+//
+// Example:
+//
+//	var factory wic.IWICImagingFactory // initialized somewhere
+//	var rt d2d1.ID2D1HwndRenderTarget
+//
+//	bmp, err := d2d1.NewBitmapFromFile(rt, factory, "C:\\Temp\\foo.png")
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer bmp.Release()
+func NewBitmapFromFile(rt ID2D1RenderTarget,
+	factory wic.IWICImagingFactory, filePath string) (ID2D1Bitmap, error) {
+
+	src, err := wic.NewBitmapSourceFromFile(factory,
+		filePath, wicco.PIXEL_FORMAT_32bppPBGRA) // format required by Direct2D
+	if err != nil {
+		return nil, err
+	}
+	defer src.Release()
+
+	return rt.CreateBitmapFromWicBitmap(src, nil), nil
+}
+
+// Returns the pointer of an optional COM object, or zero if nil.
+func _OptPtr(obj com.IUnknown) uintptr {
+	if obj == nil {
+		return 0
+	}
+	return uintptr(unsafe.Pointer(obj.Ptr()))
+}
@@ -3,15 +3,76 @@
 package d2d1
 
 import (
+	"math"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
 )
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_arc_segment
+type ARC_SEGMENT struct {
+	Point          POINT_2F
+	Size           SIZE_F
+	RotationAngle  float32
+	SweepDirection d2d1co.SWEEP_DIRECTION
+	ArcSize        d2d1co.ARC_SIZE
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_bezier_segment
+type BEZIER_SEGMENT struct {
+	Point1 POINT_2F
+	Point2 POINT_2F
+	Point3 POINT_2F
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_bitmap_properties
+type BITMAP_PROPERTIES struct {
+	PixelFormat PIXEL_FORMAT
+	DpiX        float32
+	DpiY        float32
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_brush_properties
+type BRUSH_PROPERTIES struct {
+	Opacity   float32
+	Transform MATRIX_3X2_F
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/direct2d/d2d1-color-f
+type COLOR_F struct {
+	R, G, B, A float32
+}
+
+// Converts a COLORREF into an opaque COLOR_F.
+func ColorFFromColorref(color win.COLORREF) COLOR_F {
+	return COLOR_F{
+		R: float32(color.Red()) / 255,
+		G: float32(color.Green()) / 255,
+		B: float32(color.Blue()) / 255,
+		A: 1,
+	}
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_ellipse
+type ELLIPSE struct {
+	Point   POINT_2F
+	RadiusX float32
+	RadiusY float32
+}
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_factory_options
 type FACTORY_OPTIONS struct {
 	DebugLevel d2d1co.DEBUG_LEVEL
 }
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_gradient_stop
+type GRADIENT_STOP struct {
+	Position float32
+	Color    COLOR_F
+}
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_hwnd_render_target_properties
 type HWND_RENDER_TARGET_PROPERTIES struct {
 	Hwnd           win.HWND
@@ -19,12 +80,157 @@ type HWND_RENDER_TARGET_PROPERTIES struct {
 	PresentOptions d2d1co.PRESENT_OPTIONS
 }
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_layer_parameters
+type LAYER_PARAMETERS struct {
+	ContentBounds     RECT_F
+	geometricMask     uintptr // *ID2D1Geometry
+	MaskAntialiasMode d2d1co.ANTIALIAS_MODE
+	MaskTransform     MATRIX_3X2_F
+	Opacity           float32
+	opacityBrush      uintptr // *ID2D1Brush
+	LayerOptions      d2d1co.LAYER_OPTIONS
+}
+
+// Returns the parameters of a layer with infinite content bounds, no mask,
+// identity transform and full opacity, like D2D1::LayerParameters().
+func LayerParametersDefault() LAYER_PARAMETERS {
+	return LAYER_PARAMETERS{
+		ContentBounds:     RectFInfinite(),
+		MaskAntialiasMode: d2d1co.ANTIALIAS_MODE_PER_PRIMITIVE,
+		MaskTransform:     Matrix3x2FIdentity(),
+		Opacity:           1,
+		LayerOptions:      d2d1co.LAYER_OPTIONS_NONE,
+	}
+}
+
+// The geometry is not AddRef'd, so it must outlive the layer push. Pass nil to
+// clear it.
+func (lp *LAYER_PARAMETERS) SetGeometricMask(geometry ID2D1Geometry) {
+	if geometry == nil {
+		lp.geometricMask = 0
+	} else {
+		lp.geometricMask = uintptr(unsafe.Pointer(geometry.Ptr()))
+	}
+}
+
+// The brush is not AddRef'd, so it must outlive the layer push. Pass nil to
+// clear it.
+func (lp *LAYER_PARAMETERS) SetOpacityBrush(brush ID2D1Brush) {
+	if brush == nil {
+		lp.opacityBrush = 0
+	} else {
+		lp.opacityBrush = uintptr(unsafe.Pointer(brush.Ptr()))
+	}
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_linear_gradient_brush_properties
+type LINEAR_GRADIENT_BRUSH_PROPERTIES struct {
+	StartPoint POINT_2F
+	EndPoint   POINT_2F
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dcommon/ns-dcommon-d2d_matrix_3x2_f
+type MATRIX_3X2_F struct {
+	M11, M12 float32
+	M21, M22 float32
+	M31, M32 float32
+}
+
+// Returns the identity matrix.
+func Matrix3x2FIdentity() MATRIX_3X2_F {
+	return MATRIX_3X2_F{M11: 1, M22: 1}
+}
+
+// Returns a rotation matrix, with the angle in degrees, clockwise, around the
+// given center point.
+func Matrix3x2FRotation(angle float32, center POINT_2F) MATRIX_3X2_F {
+	rad := float64(angle) * math.Pi / 180
+	sin, cos := float32(math.Sin(rad)), float32(math.Cos(rad))
+	return MATRIX_3X2_F{
+		M11: cos, M12: sin,
+		M21: -sin, M22: cos,
+		M31: center.X - center.X*cos + center.Y*sin,
+		M32: center.Y - center.X*sin - center.Y*cos,
+	}
+}
+
+// Returns a scale matrix around the given center point.
+func Matrix3x2FScale(scaleX, scaleY float32, center POINT_2F) MATRIX_3X2_F {
+	return MATRIX_3X2_F{
+		M11: scaleX, M22: scaleY,
+		M31: center.X - scaleX*center.X,
+		M32: center.Y - scaleY*center.Y,
+	}
+}
+
+// Returns a translation matrix.
+func Matrix3x2FTranslation(x, y float32) MATRIX_3X2_F {
+	return MATRIX_3X2_F{M11: 1, M22: 1, M31: x, M32: y}
+}
+
+// Returns the product of the two matrices, which applies m first, then other.
+func (m *MATRIX_3X2_F) Multiply(other *MATRIX_3X2_F) MATRIX_3X2_F {
+	return MATRIX_3X2_F{
+		M11: m.M11*other.M11 + m.M12*other.M21,
+		M12: m.M11*other.M12 + m.M12*other.M22,
+		M21: m.M21*other.M11 + m.M22*other.M21,
+		M22: m.M21*other.M12 + m.M22*other.M22,
+		M31: m.M31*other.M11 + m.M32*other.M21 + other.M31,
+		M32: m.M31*other.M12 + m.M32*other.M22 + other.M32,
+	}
+}
+
+// Applies the matrix to the point.
+func (m *MATRIX_3X2_F) TransformPoint(pt POINT_2F) POINT_2F {
+	return POINT_2F{
+		X: pt.X*m.M11 + pt.Y*m.M21 + m.M31,
+		Y: pt.X*m.M12 + pt.Y*m.M22 + m.M32,
+	}
+}
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/dcommon/ns-dcommon-d2d1_pixel_format
 type PIXEL_FORMAT struct {
 	Format    d2d1co.DXGI_FORMAT
 	AlphaMode d2d1co.ALPHA_MODE
 }
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/direct2d/d2d1-point-2f
+type POINT_2F struct {
+	X, Y float32
+}
+
+// Syscall arguments of a POINT_2F passed by value.
+func (pt POINT_2F) args() []uintptr {
+	return util.Arg64(*(*uint64)(unsafe.Pointer(&pt)))
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_quadratic_bezier_segment
+type QUADRATIC_BEZIER_SEGMENT struct {
+	Point1 POINT_2F
+	Point2 POINT_2F
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_radial_gradient_brush_properties
+type RADIAL_GRADIENT_BRUSH_PROPERTIES struct {
+	Center               POINT_2F
+	GradientOriginOffset POINT_2F
+	RadiusX              float32
+	RadiusY              float32
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/direct2d/d2d1-rect-f
+type RECT_F struct {
+	Left, Top, Right, Bottom float32
+}
+
+// Returns a rectangle which covers the whole plane, like D2D1::InfiniteRect().
+func RectFInfinite() RECT_F {
+	return RECT_F{
+		Left: -math.MaxFloat32, Top: -math.MaxFloat32,
+		Right: math.MaxFloat32, Bottom: math.MaxFloat32,
+	}
+}
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_render_target_properties
 type RENDER_TARGET_PROPERTIES struct {
 	Type        d2d1co.RENDER_TARGET_TYPE
@@ -35,7 +241,30 @@ type RENDER_TARGET_PROPERTIES struct {
 	MinLevel    d2d1co.FEATURE_LEVEL
 }
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_rounded_rect
+type ROUNDED_RECT struct {
+	Rect    RECT_F
+	RadiusX float32
+	RadiusY float32
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/direct2d/d2d1-size-f
+type SIZE_F struct {
+	Width, Height float32
+}
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/direct2d/d2d1-size-u
 type SIZE_U struct {
 	Width, Height uint32
 }
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_stroke_style_properties
+type STROKE_STYLE_PROPERTIES struct {
+	StartCap   d2d1co.CAP_STYLE
+	EndCap     d2d1co.CAP_STYLE
+	DashCap    d2d1co.CAP_STYLE
+	LineJoin   d2d1co.LINE_JOIN
+	MiterLimit float32
+	DashStyle  d2d1co.DASH_STYLE
+	DashOffset float32
+}
@@ -12,6 +12,52 @@ const (
 	ALPHA_MODE_IGNORE        ALPHA_MODE = 3
 )
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_antialias_mode
+type ANTIALIAS_MODE uint32
+
+const (
+	ANTIALIAS_MODE_PER_PRIMITIVE ANTIALIAS_MODE = 0
+	ANTIALIAS_MODE_ALIASED       ANTIALIAS_MODE = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_arc_size
+type ARC_SIZE uint32
+
+const (
+	ARC_SIZE_SMALL ARC_SIZE = 0
+	ARC_SIZE_LARGE ARC_SIZE = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_bitmap_interpolation_mode
+type BITMAP_INTERPOLATION_MODE uint32
+
+const (
+	BITMAP_INTERPOLATION_MODE_NEAREST_NEIGHBOR BITMAP_INTERPOLATION_MODE = 0
+	BITMAP_INTERPOLATION_MODE_LINEAR           BITMAP_INTERPOLATION_MODE = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_cap_style
+type CAP_STYLE uint32
+
+const (
+	CAP_STYLE_FLAT     CAP_STYLE = 0
+	CAP_STYLE_SQUARE   CAP_STYLE = 1
+	CAP_STYLE_ROUND    CAP_STYLE = 2
+	CAP_STYLE_TRIANGLE CAP_STYLE = 3
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_dash_style
+type DASH_STYLE uint32
+
+const (
+	DASH_STYLE_SOLID        DASH_STYLE = 0
+	DASH_STYLE_DASH         DASH_STYLE = 1
+	DASH_STYLE_DOT          DASH_STYLE = 2
+	DASH_STYLE_DASH_DOT     DASH_STYLE = 3
+	DASH_STYLE_DASH_DOT_DOT DASH_STYLE = 4
+	DASH_STYLE_CUSTOM       DASH_STYLE = 5
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_debug_level
 type DEBUG_LEVEL uint32
 
@@ -22,6 +68,16 @@ const (
 	DEBUG_LEVEL_INFORMATION DEBUG_LEVEL = 3
 )
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_draw_text_options
+type DRAW_TEXT_OPTIONS uint32
+
+const (
+	DRAW_TEXT_OPTIONS_NONE              DRAW_TEXT_OPTIONS = 0x0000_0000
+	DRAW_TEXT_OPTIONS_NO_SNAP           DRAW_TEXT_OPTIONS = 0x0000_0001
+	DRAW_TEXT_OPTIONS_CLIP              DRAW_TEXT_OPTIONS = 0x0000_0002
+	DRAW_TEXT_OPTIONS_ENABLE_COLOR_FONT DRAW_TEXT_OPTIONS = 0x0000_0004
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/dxgiformat/ne-dxgiformat-dxgi_format
 type DXGI_FORMAT uint32
 
@@ -147,6 +203,15 @@ const (
 	DXGI_FORMAT_V408                       DXGI_FORMAT = 132
 )
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_extend_mode
+type EXTEND_MODE uint32
+
+const (
+	EXTEND_MODE_CLAMP  EXTEND_MODE = 0
+	EXTEND_MODE_WRAP   EXTEND_MODE = 1
+	EXTEND_MODE_MIRROR EXTEND_MODE = 2
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_factory_type
 type FACTORY_TYPE uint32
 
@@ -164,6 +229,65 @@ const (
 	FEATURE_LEVEL_10      FEATURE_LEVEL = 0xa000 // The video card must support DirectX 10.
 )
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_figure_begin
+type FIGURE_BEGIN uint32
+
+const (
+	FIGURE_BEGIN_FILLED FIGURE_BEGIN = 0
+	FIGURE_BEGIN_HOLLOW FIGURE_BEGIN = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_figure_end
+type FIGURE_END uint32
+
+const (
+	FIGURE_END_OPEN   FIGURE_END = 0
+	FIGURE_END_CLOSED FIGURE_END = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_fill_mode
+type FILL_MODE uint32
+
+const (
+	FILL_MODE_ALTERNATE FILL_MODE = 0
+	FILL_MODE_WINDING   FILL_MODE = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_gamma
+type GAMMA uint32
+
+const (
+	GAMMA_2_2 GAMMA = 0
+	GAMMA_1_0 GAMMA = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_layer_options
+type LAYER_OPTIONS uint32
+
+const (
+	LAYER_OPTIONS_NONE                     LAYER_OPTIONS = 0x0000_0000
+	LAYER_OPTIONS_INITIALIZE_FOR_CLEARTYPE LAYER_OPTIONS = 0x0000_0001
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_line_join
+type LINE_JOIN uint32
+
+const (
+	LINE_JOIN_MITER          LINE_JOIN = 0
+	LINE_JOIN_BEVEL          LINE_JOIN = 1
+	LINE_JOIN_ROUND          LINE_JOIN = 2
+	LINE_JOIN_MITER_OR_BEVEL LINE_JOIN = 3
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_path_segment
+type PATH_SEGMENT uint32
+
+const (
+	PATH_SEGMENT_NONE                  PATH_SEGMENT = 0x0000_0000
+	PATH_SEGMENT_FORCE_UNSTROKED       PATH_SEGMENT = 0x0000_0001
+	PATH_SEGMENT_FORCE_ROUND_LINE_JOIN PATH_SEGMENT = 0x0000_0002
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_present_options
 type PRESENT_OPTIONS uint32
 
@@ -191,6 +315,24 @@ const (
 	RENDER_TARGET_USAGE_GDI_COMPATIBLE        RENDER_TARGET_USAGE = 0x0000_0002
 )
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_sweep_direction
+type SWEEP_DIRECTION uint32
+
+const (
+	SWEEP_DIRECTION_COUNTER_CLOCKWISE SWEEP_DIRECTION = 0
+	SWEEP_DIRECTION_CLOCKWISE         SWEEP_DIRECTION = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_text_antialias_mode
+type TEXT_ANTIALIAS_MODE uint32
+
+const (
+	TEXT_ANTIALIAS_MODE_DEFAULT   TEXT_ANTIALIAS_MODE = 0
+	TEXT_ANTIALIAS_MODE_CLEARTYPE TEXT_ANTIALIAS_MODE = 1
+	TEXT_ANTIALIAS_MODE_GRAYSCALE TEXT_ANTIALIAS_MODE = 2
+	TEXT_ANTIALIAS_MODE_ALIASED   TEXT_ANTIALIAS_MODE = 3
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_window_state
 type WINDOW_STATE uint32
 
@@ -8,8 +8,24 @@ import (
 
 // Direct2D COM IIDs.
 const (
-	IID_ID2D1Factory          co.IID = "06152247-6f50-465a-9245-118bfd3b6007"
-	IID_ID2D1HwndRenderTarget co.IID = "2cd90698-12e2-11dc-9fed-001143a055f9"
-	IID_ID2D1RenderTarget     co.IID = "2cd90694-12e2-11dc-9fed-001143a055f9"
-	IID_ID2D1Resource         co.IID = "2cd90691-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1Bitmap                   co.IID = "a2296057-ea42-4099-983b-539fb6505426"
+	IID_ID2D1Brush                    co.IID = "2cd906a8-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1EllipseGeometry          co.IID = "2cd906a4-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1Factory                  co.IID = "06152247-6f50-465a-9245-118bfd3b6007"
+	IID_ID2D1Geometry                 co.IID = "2cd906a1-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1GeometrySink             co.IID = "2cd9069f-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1GradientStopCollection   co.IID = "2cd906a7-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1HwndRenderTarget         co.IID = "2cd90698-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1Image                    co.IID = "65019f75-8da2-497c-b32c-dfa34e48ede6"
+	IID_ID2D1Layer                    co.IID = "2cd9069b-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1LinearGradientBrush      co.IID = "2cd906ab-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1PathGeometry             co.IID = "2cd906a5-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1RadialGradientBrush      co.IID = "2cd906ac-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1RectangleGeometry        co.IID = "2cd906a2-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1RenderTarget             co.IID = "2cd90694-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1Resource                 co.IID = "2cd90691-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1RoundedRectangleGeometry co.IID = "2cd906a3-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1SimplifiedGeometrySink   co.IID = "2cd9069e-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1SolidColorBrush          co.IID = "2cd906a9-12e2-11dc-9fed-001143a055f9"
+	IID_ID2D1StrokeStyle              co.IID = "2cd9069d-12e2-11dc-9fed-001143a055f9"
 )
@@ -6,6 +6,39 @@ import (
 	"github.com/rodrigocfd/windigo/win/com/com/comvt"
 )
 
+// ID2D1Bitmap virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1bitmap
+type ID2D1Bitmap struct {
+	ID2D1Image
+	GetSize              uintptr
+	GetPixelSize         uintptr
+	GetPixelFormat       uintptr
+	GetDpi               uintptr
+	CopyFromBitmap       uintptr
+	CopyFromRenderTarget uintptr
+	CopyFromMemory       uintptr
+}
+
+// ID2D1Brush virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1brush
+type ID2D1Brush struct {
+	ID2D1Resource
+	SetOpacity   uintptr
+	SetTransform uintptr
+	GetOpacity   uintptr
+	GetTransform uintptr
+}
+
+// ID2D1EllipseGeometry virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1ellipsegeometry
+type ID2D1EllipseGeometry struct {
+	ID2D1Geometry
+	GetEllipse uintptr
+}
+
 // ID2D1Factory virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1factory
@@ -25,17 +58,49 @@ type ID2D1Factory struct {
 	CreateHwndRenderTarget         uintptr
 	CreateDxgiSurfaceRenderTarget  uintptr
 	CreateDCRenderTarget           uintptr
+}
 
-	CreateRectangleGeometry2        uintptr
-	CreateRoundedRectangleGeometry2 uintptr
-	CreateEllipseGeometry2          uintptr
-	CreateTransformedGeometry2      uintptr
-	CreateStrokeStyle2              uintptr
-	CreateDrawingStateBlock2        uintptr
-	CreateDrawingStateBlock3        uintptr
-	CreateWicBitmapRenderTarget2    uintptr
-	CreateHwndRenderTarget2         uintptr
-	CreateDxgiSurfaceRenderTarget2  uintptr
+// ID2D1Geometry virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1geometry
+type ID2D1Geometry struct {
+	ID2D1Resource
+	GetBounds            uintptr
+	GetWidenedBounds     uintptr
+	StrokeContainsPoint  uintptr
+	FillContainsPoint    uintptr
+	CompareWithGeometry  uintptr
+	Simplify             uintptr
+	Tessellate           uintptr
+	CombineWithGeometry  uintptr
+	Outline              uintptr
+	ComputeArea          uintptr
+	ComputeLength        uintptr
+	ComputePointAtLength uintptr
+	Widen                uintptr
+}
+
+// ID2D1GeometrySink virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1geometrysink
+type ID2D1GeometrySink struct {
+	ID2D1SimplifiedGeometrySink
+	AddLine             uintptr
+	AddBezier           uintptr
+	AddQuadraticBezier  uintptr
+	AddQuadraticBeziers uintptr
+	AddArc              uintptr
+}
+
+// ID2D1GradientStopCollection virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1gradientstopcollection
+type ID2D1GradientStopCollection struct {
+	ID2D1Resource
+	GetGradientStopCount       uintptr
+	GetGradientStops           uintptr
+	GetColorInterpolationGamma uintptr
+	GetExtendMode              uintptr
 }
 
 // ID2D1HwndRenderTarget virtual table.
@@ -46,8 +111,68 @@ type ID2D1HwndRenderTarget struct {
 	CheckWindowState uintptr
 	Resize           uintptr
 	GetHwnd          uintptr
+}
+
+// ID2D1Image virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1image
+type ID2D1Image struct {
+	ID2D1Resource
+}
+
+// ID2D1Layer virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1layer
+type ID2D1Layer struct {
+	ID2D1Resource
+	GetSize uintptr
+}
+
+// ID2D1LinearGradientBrush virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1lineargradientbrush
+type ID2D1LinearGradientBrush struct {
+	ID2D1Brush
+	SetStartPoint             uintptr
+	SetEndPoint               uintptr
+	GetStartPoint             uintptr
+	GetEndPoint               uintptr
+	GetGradientStopCollection uintptr
+}
+
+// ID2D1PathGeometry virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1pathgeometry
+type ID2D1PathGeometry struct {
+	ID2D1Geometry
+	Open            uintptr
+	Stream          uintptr
+	GetSegmentCount uintptr
+	GetFigureCount  uintptr
+}
 
-	Resize2 uintptr
+// ID2D1RadialGradientBrush virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1radialgradientbrush
+type ID2D1RadialGradientBrush struct {
+	ID2D1Brush
+	SetCenter                 uintptr
+	SetGradientOriginOffset   uintptr
+	SetRadiusX                uintptr
+	SetRadiusY                uintptr
+	GetCenter                 uintptr
+	GetGradientOriginOffset   uintptr
+	GetRadiusX                uintptr
+	GetRadiusY                uintptr
+	GetGradientStopCollection uintptr
+}
+
+// ID2D1RectangleGeometry virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1rectanglegeometry
+type ID2D1RectangleGeometry struct {
+	ID2D1Geometry
+	GetRect uintptr
 }
 
 // ID2D1RenderTarget virtual table.
@@ -108,43 +233,6 @@ type ID2D1RenderTarget struct {
 	GetPixelSize                 uintptr
 	GetMaximumBitmapSize         uintptr
 	IsSupported                  uintptr
-
-	CreateBitmap2                 uintptr
-	CreateBitmap3                 uintptr
-	CreateBitmapFromWicBitmap2    uintptr
-	CreateBitmapFromWicBitmap3    uintptr
-	CreateBitmapBrush2            uintptr
-	CreateBitmapBrush3            uintptr
-	CreateBitmapBrush4            uintptr
-	CreateSolidColorBrush2        uintptr
-	CreateSolidColorBrush3        uintptr
-	CreateGradientStopCollection2 uintptr
-	CreateLinearGradientBrush2    uintptr
-	CreateLinearGradientBrush3    uintptr
-	CreateRadialGradientBrush2    uintptr
-	CreateRadialGradientBrush3    uintptr
-	CreateCompatibleRenderTarget2 uintptr
-	CreateCompatibleRenderTarget3 uintptr
-	CreateCompatibleRenderTarget4 uintptr
-	CreateCompatibleRenderTarget5 uintptr
-	CreateCompatibleRenderTarget6 uintptr
-	CreateLayer2                  uintptr
-	CreateLayer3                  uintptr
-	DrawRectangle2                uintptr
-	FillRectangle2                uintptr
-	DrawRoundedRectangle2         uintptr
-	FillRoundedRectangle2         uintptr
-	DrawEllipse2                  uintptr
-	FillEllipse2                  uintptr
-	FillOpacityMask2              uintptr
-	DrawBitmap2                   uintptr
-	DrawBitmap3                   uintptr
-	SetTransform2                 uintptr
-	PushLayer2                    uintptr
-	PushAxisAlignedClip2          uintptr
-	Clear2                        uintptr
-	DrawText2                     uintptr
-	IsSupported2                  uintptr
 }
 
 // ID2D1Resource virtual table.
@@ -154,3 +242,50 @@ type ID2D1Resource struct {
 	comvt.IUnknown
 	GetFactory uintptr
 }
+
+// ID2D1RoundedRectangleGeometry virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1roundedrectanglegeometry
+type ID2D1RoundedRectangleGeometry struct {
+	ID2D1Geometry
+	GetRoundedRect uintptr
+}
+
+// ID2D1SimplifiedGeometrySink virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1simplifiedgeometrysink
+type ID2D1SimplifiedGeometrySink struct {
+	comvt.IUnknown
+	SetFillMode     uintptr
+	SetSegmentFlags uintptr
+	BeginFigure     uintptr
+	AddLines        uintptr
+	AddBeziers      uintptr
+	EndFigure       uintptr
+	Close           uintptr
+}
+
+// ID2D1SolidColorBrush virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1solidcolorbrush
+type ID2D1SolidColorBrush struct {
+	ID2D1Brush
+	SetColor uintptr
+	GetColor uintptr
+}
+
+// ID2D1StrokeStyle virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1strokestyle
+type ID2D1StrokeStyle struct {
+	ID2D1Resource
+	GetStartCap    uintptr
+	GetEndCap      uintptr
+	GetDashCap     uintptr
+	GetMiterLimit  uintptr
+	GetLineJoin    uintptr
+	GetDashOffset  uintptr
+	GetDashStyle   uintptr
+	GetDashesCount uintptr
+	GetDashes      uintptr
+}
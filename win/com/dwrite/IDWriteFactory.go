@@ -0,0 +1,153 @@
+//go:build windows
+
+package dwrite
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/dwrite/dwriteco"
+	"github.com/rodrigocfd/windigo/win/com/dwrite/dwritevt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nn-dwrite-idwritefactory
+type IDWriteFactory interface {
+	com.IUnknown
+
+	// Creates an ellipsis sign to be used with IDWriteTextFormat.SetTrimming().
+	//
+	// ⚠️ You must defer IDWriteInlineObject.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritefactory-createellipsistrimmingsign
+	CreateEllipsisTrimmingSign(format IDWriteTextFormat) IDWriteInlineObject
+
+	// Creates a text format with the system font collection. The font size is
+	// given in device-independent pixels, 1/96 inch. If localeName is empty,
+	// the user default locale is used.
+	//
+	// ⚠️ You must defer IDWriteTextFormat.Release() on the returned object.
+	//
+	// Example:
+	//
+	//	var factory dwrite.IDWriteFactory // initialized somewhere
+	//
+	//	format := factory.CreateTextFormat("Segoe UI",
+	//		dwriteco.FONT_WEIGHT_NORMAL, dwriteco.FONT_STYLE_NORMAL,
+	//		dwriteco.FONT_STRETCH_NORMAL, 12, "")
+	//	defer format.Release()
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritefactory-createtextformat
+	CreateTextFormat(familyName string,
+		weight dwriteco.FONT_WEIGHT, style dwriteco.FONT_STYLE,
+		stretch dwriteco.FONT_STRETCH, size float32,
+		localeName string) IDWriteTextFormat
+
+	// Creates a text layout, which can be measured, hit-tested and drawn with
+	// ID2D1RenderTarget.DrawTextLayout().
+	//
+	// ⚠️ You must defer IDWriteTextLayout.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritefactory-createtextlayout
+	CreateTextLayout(text string, format IDWriteTextFormat,
+		maxWidth, maxHeight float32) IDWriteTextLayout
+}
+
+type _IDWriteFactory struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IDWriteFactory.Release().
+func NewIDWriteFactory(base com.IUnknown) IDWriteFactory {
+	return &_IDWriteFactory{IUnknown: base}
+}
+
+// Creates a new IDWriteFactory. A shared factory caches font data across the
+// process, and should be preferred.
+//
+// ⚠️ You must defer IDWriteFactory.Release().
+//
+// Example:
+//
+//	factory := dwrite.DWriteCreateFactory(dwriteco.FACTORY_TYPE_SHARED)
+//	defer factory.Release()
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-dwritecreatefactory
+func DWriteCreateFactory(factoryType dwriteco.FACTORY_TYPE) IDWriteFactory {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(proc.DWriteCreateFactory.Addr(),
+		uintptr(factoryType),
+		uintptr(unsafe.Pointer(win.GuidFromIid(dwriteco.IID_IDWriteFactory))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIDWriteFactory(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteFactory) CreateEllipsisTrimmingSign(
+	format IDWriteTextFormat) IDWriteInlineObject {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteFactory)(unsafe.Pointer(*me.Ptr())).CreateEllipsisTrimmingSign,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(format.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIDWriteInlineObject(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteFactory) CreateTextFormat(familyName string,
+	weight dwriteco.FONT_WEIGHT, style dwriteco.FONT_STYLE,
+	stretch dwriteco.FONT_STRETCH, size float32,
+	localeName string) IDWriteTextFormat {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteFactory)(unsafe.Pointer(*me.Ptr())).CreateTextFormat,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(familyName))),
+		0, // IDWriteFontCollection: system fonts
+		uintptr(weight), uintptr(style), uintptr(stretch),
+		uintptr(math.Float32bits(size)),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(localeName))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIDWriteTextFormat(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteFactory) CreateTextLayout(text string,
+	format IDWriteTextFormat, maxWidth, maxHeight float32) IDWriteTextLayout {
+
+	text16 := win.Str.ToNativeSlice(text)
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteFactory)(unsafe.Pointer(*me.Ptr())).CreateTextLayout,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&text16[0])), uintptr(len(text16)-1), // without terminating null
+		uintptr(unsafe.Pointer(format.Ptr())),
+		uintptr(math.Float32bits(maxWidth)), uintptr(math.Float32bits(maxHeight)),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIDWriteTextLayout(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
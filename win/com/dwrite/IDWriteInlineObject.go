@@ -0,0 +1,21 @@
+//go:build windows
+
+package dwrite
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/com"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nn-dwrite-idwriteinlineobject
+type IDWriteInlineObject interface {
+	com.IUnknown
+}
+
+type _IDWriteInlineObject struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IDWriteInlineObject.Release().
+func NewIDWriteInlineObject(base com.IUnknown) IDWriteInlineObject {
+	return &_IDWriteInlineObject{IUnknown: base}
+}
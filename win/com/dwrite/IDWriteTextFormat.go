@@ -0,0 +1,232 @@
+//go:build windows
+
+package dwrite
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/dwrite/dwriteco"
+	"github.com/rodrigocfd/windigo/win/com/dwrite/dwritevt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nn-dwrite-idwritetextformat
+type IDWriteTextFormat interface {
+	com.IUnknown
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-getfontfamilyname
+	GetFontFamilyName() string
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-getfontstretch
+	GetFontStretch() dwriteco.FONT_STRETCH
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-getfontstyle
+	GetFontStyle() dwriteco.FONT_STYLE
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-getfontweight
+	GetFontWeight() dwriteco.FONT_WEIGHT
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-getlocalename
+	GetLocaleName() string
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-getparagraphalignment
+	GetParagraphAlignment() dwriteco.PARAGRAPH_ALIGNMENT
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-gettextalignment
+	GetTextAlignment() dwriteco.TEXT_ALIGNMENT
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-getwordwrapping
+	GetWordWrapping() dwriteco.WORD_WRAPPING
+
+	// Sets the vertical alignment of the text within the layout box.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-setparagraphalignment
+	SetParagraphAlignment(alignment dwriteco.PARAGRAPH_ALIGNMENT)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-setreadingdirection
+	SetReadingDirection(direction dwriteco.READING_DIRECTION)
+
+	// Sets the horizontal alignment of the text within the layout box.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-settextalignment
+	SetTextAlignment(alignment dwriteco.TEXT_ALIGNMENT)
+
+	// Sets how the text is trimmed when it overflows the layout box. The sign
+	// is optional, usually created with
+	// IDWriteFactory.CreateEllipsisTrimmingSign().
+	//
+	// Example:
+	//
+	//	var factory dwrite.IDWriteFactory // initialized somewhere
+	//	var format dwrite.IDWriteTextFormat
+	//
+	//	ellipsis := factory.CreateEllipsisTrimmingSign(format)
+	//	defer ellipsis.Release()
+	//
+	//	format.SetWordWrapping(dwriteco.WORD_WRAPPING_NO_WRAP)
+	//	format.SetTrimming(&dwrite.TRIMMING{
+	//		Granularity: dwriteco.TRIMMING_GRANULARITY_CHARACTER,
+	//	}, ellipsis)
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-settrimming
+	SetTrimming(trimming *TRIMMING, sign IDWriteInlineObject)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextformat-setwordwrapping
+	SetWordWrapping(wrapping dwriteco.WORD_WRAPPING)
+}
+
+type _IDWriteTextFormat struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IDWriteTextFormat.Release().
+func NewIDWriteTextFormat(base com.IUnknown) IDWriteTextFormat {
+	return &_IDWriteTextFormat{IUnknown: base}
+}
+
+func (me *_IDWriteTextFormat) GetFontFamilyName() string {
+	vt := (*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr()))
+	numChars, _, _ := syscall.SyscallN(vt.GetFontFamilyNameLength,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	buf := make([]uint16, uint32(numChars)+1) // plus terminating null
+	ret, _, _ := syscall.SyscallN(vt.GetFontFamilyName,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return win.Str.FromNativeSlice(buf)
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextFormat) GetFontStretch() dwriteco.FONT_STRETCH {
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).GetFontStretch,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return dwriteco.FONT_STRETCH(ret)
+}
+
+func (me *_IDWriteTextFormat) GetFontStyle() dwriteco.FONT_STYLE {
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).GetFontStyle,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return dwriteco.FONT_STYLE(ret)
+}
+
+func (me *_IDWriteTextFormat) GetFontWeight() dwriteco.FONT_WEIGHT {
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).GetFontWeight,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return dwriteco.FONT_WEIGHT(ret)
+}
+
+func (me *_IDWriteTextFormat) GetLocaleName() string {
+	vt := (*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr()))
+	numChars, _, _ := syscall.SyscallN(vt.GetLocaleNameLength,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	buf := make([]uint16, uint32(numChars)+1) // plus terminating null
+	ret, _, _ := syscall.SyscallN(vt.GetLocaleName,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return win.Str.FromNativeSlice(buf)
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextFormat) GetParagraphAlignment() dwriteco.PARAGRAPH_ALIGNMENT {
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).GetParagraphAlignment,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return dwriteco.PARAGRAPH_ALIGNMENT(ret)
+}
+
+func (me *_IDWriteTextFormat) GetTextAlignment() dwriteco.TEXT_ALIGNMENT {
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).GetTextAlignment,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return dwriteco.TEXT_ALIGNMENT(ret)
+}
+
+func (me *_IDWriteTextFormat) GetWordWrapping() dwriteco.WORD_WRAPPING {
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).GetWordWrapping,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return dwriteco.WORD_WRAPPING(ret)
+}
+
+func (me *_IDWriteTextFormat) SetParagraphAlignment(
+	alignment dwriteco.PARAGRAPH_ALIGNMENT) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).SetParagraphAlignment,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(alignment))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextFormat) SetReadingDirection(
+	direction dwriteco.READING_DIRECTION) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).SetReadingDirection,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(direction))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextFormat) SetTextAlignment(
+	alignment dwriteco.TEXT_ALIGNMENT) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).SetTextAlignment,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(alignment))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextFormat) SetTrimming(
+	trimming *TRIMMING, sign IDWriteInlineObject) {
+
+	var pSign uintptr
+	if sign != nil {
+		pSign = uintptr(unsafe.Pointer(sign.Ptr()))
+	}
+
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).SetTrimming,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(trimming)), pSign)
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextFormat) SetWordWrapping(wrapping dwriteco.WORD_WRAPPING) {
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextFormat)(unsafe.Pointer(*me.Ptr())).SetWordWrapping,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(wrapping))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
@@ -0,0 +1,311 @@
+//go:build windows
+
+package dwrite
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/dwrite/dwriteco"
+	"github.com/rodrigocfd/windigo/win/com/dwrite/dwritevt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nn-dwrite-idwritetextlayout
+type IDWriteTextLayout interface {
+	IDWriteTextFormat
+
+	// Returns the minimum width of the layout which doesn't break words.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-determineminwidth
+	DetermineMinWidth() float32
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-getlinemetrics
+	GetLineMetrics() []LINE_METRICS
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-getmetrics
+	GetMetrics() TEXT_METRICS
+
+	// Returns the character under the given point, relative to the layout
+	// origin, like a mouse click.
+	//
+	// Example:
+	//
+	//	var layout dwrite.IDWriteTextLayout // initialized somewhere
+	//
+	//	isTrailing, isInside, m := layout.HitTestPoint(40, 10)
+	//	if isInside {
+	//		caretPos := m.TextPosition
+	//		if isTrailing {
+	//			caretPos += m.Length
+	//		}
+	//		println(caretPos)
+	//	}
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-hittestpoint
+	HitTestPoint(x, y float32) (isTrailingHit, isInside bool, metrics HIT_TEST_METRICS)
+
+	// Returns the position of the given character, relative to the layout
+	// origin, like a caret.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-hittesttextposition
+	HitTestTextPosition(textPosition uint, isTrailingHit bool) (x, y float32, metrics HIT_TEST_METRICS)
+
+	// Returns the rectangles covering the given range of characters, like a
+	// selection.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-hittesttextrange
+	HitTestTextRange(textPosition, textLength uint, originX, originY float32) []HIT_TEST_METRICS
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-setfontfamilyname
+	SetFontFamilyName(familyName string, textRange TEXT_RANGE)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-setfontsize
+	SetFontSize(size float32, textRange TEXT_RANGE)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-setfontstyle
+	SetFontStyle(style dwriteco.FONT_STYLE, textRange TEXT_RANGE)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-setfontweight
+	SetFontWeight(weight dwriteco.FONT_WEIGHT, textRange TEXT_RANGE)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-setmaxheight
+	SetMaxHeight(maxHeight float32)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-setmaxwidth
+	SetMaxWidth(maxWidth float32)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-setstrikethrough
+	SetStrikethrough(hasStrikethrough bool, textRange TEXT_RANGE)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nf-dwrite-idwritetextlayout-setunderline
+	SetUnderline(hasUnderline bool, textRange TEXT_RANGE)
+}
+
+type _IDWriteTextLayout struct{ IDWriteTextFormat }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IDWriteTextLayout.Release().
+func NewIDWriteTextLayout(base com.IUnknown) IDWriteTextLayout {
+	return &_IDWriteTextLayout{IDWriteTextFormat: NewIDWriteTextFormat(base)}
+}
+
+func (me *_IDWriteTextLayout) DetermineMinWidth() float32 {
+	var minWidth float32
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).DetermineMinWidth,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&minWidth)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return minWidth
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextLayout) GetLineMetrics() []LINE_METRICS {
+	var actualCount uint32
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).GetLineMetrics,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		0, 0, uintptr(unsafe.Pointer(&actualCount))) // retrieve count first
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK && hr != errco.E_NOT_SUFFICIENT_BUFFER {
+		panic(hr)
+	} else if actualCount == 0 {
+		return []LINE_METRICS{}
+	}
+
+	metrics := make([]LINE_METRICS, actualCount)
+	ret, _, _ = syscall.SyscallN(
+		(*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).GetLineMetrics,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&metrics[0])), uintptr(len(metrics)),
+		uintptr(unsafe.Pointer(&actualCount)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return metrics[:actualCount]
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextLayout) GetMetrics() TEXT_METRICS {
+	var metrics TEXT_METRICS
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).GetMetrics,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&metrics)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return metrics
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextLayout) HitTestPoint(
+	x, y float32) (isTrailingHit, isInside bool, metrics HIT_TEST_METRICS) {
+
+	var bTrailing, bInside int32 // BOOL
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).HitTestPoint,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(math.Float32bits(x)), uintptr(math.Float32bits(y)),
+		uintptr(unsafe.Pointer(&bTrailing)), uintptr(unsafe.Pointer(&bInside)),
+		uintptr(unsafe.Pointer(&metrics)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return bTrailing != 0, bInside != 0, metrics
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextLayout) HitTestTextPosition(
+	textPosition uint, isTrailingHit bool) (x, y float32, metrics HIT_TEST_METRICS) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).HitTestTextPosition,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(textPosition), util.BoolToUintptr(isTrailingHit),
+		uintptr(unsafe.Pointer(&x)), uintptr(unsafe.Pointer(&y)),
+		uintptr(unsafe.Pointer(&metrics)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextLayout) HitTestTextRange(
+	textPosition, textLength uint, originX, originY float32) []HIT_TEST_METRICS {
+
+	call := func(metrics []HIT_TEST_METRICS, actualCount *uint32) errco.ERROR {
+		var pMetrics *HIT_TEST_METRICS
+		if len(metrics) > 0 {
+			pMetrics = &metrics[0]
+		}
+		ret, _, _ := syscall.SyscallN(
+			(*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).HitTestTextRange,
+			uintptr(unsafe.Pointer(me.Ptr())),
+			uintptr(textPosition), uintptr(textLength),
+			uintptr(math.Float32bits(originX)), uintptr(math.Float32bits(originY)),
+			uintptr(unsafe.Pointer(pMetrics)), uintptr(len(metrics)),
+			uintptr(unsafe.Pointer(actualCount)))
+		return errco.ERROR(ret)
+	}
+
+	var actualCount uint32
+	if hr := call(nil, &actualCount); hr != errco.S_OK && hr != errco.E_NOT_SUFFICIENT_BUFFER {
+		panic(hr)
+	} else if actualCount == 0 {
+		return []HIT_TEST_METRICS{}
+	}
+
+	metrics := make([]HIT_TEST_METRICS, actualCount)
+	if hr := call(metrics, &actualCount); hr != errco.S_OK {
+		panic(hr)
+	}
+	return metrics[:actualCount]
+}
+
+func (me *_IDWriteTextLayout) SetFontFamilyName(
+	familyName string, textRange TEXT_RANGE) {
+
+	args := []uintptr{
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(familyName))),
+	}
+	me.setWithRange((*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).SetFontFamilyName,
+		args, textRange)
+}
+
+func (me *_IDWriteTextLayout) SetFontSize(size float32, textRange TEXT_RANGE) {
+	args := []uintptr{
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(math.Float32bits(size)),
+	}
+	me.setWithRange((*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).SetFontSize,
+		args, textRange)
+}
+
+func (me *_IDWriteTextLayout) SetFontStyle(
+	style dwriteco.FONT_STYLE, textRange TEXT_RANGE) {
+
+	args := []uintptr{uintptr(unsafe.Pointer(me.Ptr())), uintptr(style)}
+	me.setWithRange((*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).SetFontStyle,
+		args, textRange)
+}
+
+func (me *_IDWriteTextLayout) SetFontWeight(
+	weight dwriteco.FONT_WEIGHT, textRange TEXT_RANGE) {
+
+	args := []uintptr{uintptr(unsafe.Pointer(me.Ptr())), uintptr(weight)}
+	me.setWithRange((*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).SetFontWeight,
+		args, textRange)
+}
+
+func (me *_IDWriteTextLayout) SetMaxHeight(maxHeight float32) {
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).SetMaxHeight,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(math.Float32bits(maxHeight)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextLayout) SetMaxWidth(maxWidth float32) {
+	ret, _, _ := syscall.SyscallN(
+		(*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).SetMaxWidth,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(math.Float32bits(maxWidth)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IDWriteTextLayout) SetStrikethrough(
+	hasStrikethrough bool, textRange TEXT_RANGE) {
+
+	args := []uintptr{
+		uintptr(unsafe.Pointer(me.Ptr())),
+		util.BoolToUintptr(hasStrikethrough),
+	}
+	me.setWithRange((*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).SetStrikethrough,
+		args, textRange)
+}
+
+func (me *_IDWriteTextLayout) SetUnderline(
+	hasUnderline bool, textRange TEXT_RANGE) {
+
+	args := []uintptr{
+		uintptr(unsafe.Pointer(me.Ptr())),
+		util.BoolToUintptr(hasUnderline),
+	}
+	me.setWithRange((*dwritevt.IDWriteTextLayout)(unsafe.Pointer(*me.Ptr())).SetUnderline,
+		args, textRange)
+}
+
+// Calls one of the Set*() methods which receive a DWRITE_TEXT_RANGE by value
+// as the last argument.
+func (me *_IDWriteTextLayout) setWithRange(
+	fun uintptr, args []uintptr, textRange TEXT_RANGE) {
+
+	args = append(args, util.Arg64(*(*uint64)(unsafe.Pointer(&textRange)))...)
+	ret, _, _ := syscall.SyscallN(fun, args...)
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
@@ -0,0 +1,63 @@
+//go:build windows
+
+package dwrite
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/dwrite/dwriteco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ns-dwrite-dwrite_hit_test_metrics
+type HIT_TEST_METRICS struct {
+	TextPosition uint32
+	Length       uint32
+	Left         float32
+	Top          float32
+	Width        float32
+	Height       float32
+	BidiLevel    uint32
+	isText       int32 // BOOL
+	isTrimmed    int32 // BOOL
+}
+
+func (m *HIT_TEST_METRICS) IsText() bool    { return m.isText != 0 }
+func (m *HIT_TEST_METRICS) IsTrimmed() bool { return m.isTrimmed != 0 }
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ns-dwrite-dwrite_line_metrics
+type LINE_METRICS struct {
+	Length                   uint32
+	TrailingWhitespaceLength uint32
+	NewlineLength            uint32
+	Height                   float32
+	Baseline                 float32
+	isTrimmed                int32 // BOOL
+}
+
+func (m *LINE_METRICS) IsTrimmed() bool { return m.isTrimmed != 0 }
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ns-dwrite-dwrite_text_metrics
+type TEXT_METRICS struct {
+	Left                             float32
+	Top                              float32
+	Width                            float32
+	WidthIncludingTrailingWhitespace float32
+	Height                           float32
+	LayoutWidth                      float32
+	LayoutHeight                     float32
+	MaxBidiReorderingDepth           uint32
+	LineCount                        uint32
+}
+
+// Range of characters in a text layout, in UTF-16 code units.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ns-dwrite-dwrite_text_range
+type TEXT_RANGE struct {
+	StartPosition uint32
+	Length        uint32
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ns-dwrite-dwrite_trimming
+type TRIMMING struct {
+	Granularity    dwriteco.TRIMMING_GRANULARITY
+	Delimiter      uint32
+	DelimiterCount uint32
+}
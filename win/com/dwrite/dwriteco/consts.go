@@ -0,0 +1,116 @@
+//go:build windows
+
+package dwriteco
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ne-dwrite-dwrite_factory_type
+type FACTORY_TYPE uint32
+
+const (
+	FACTORY_TYPE_SHARED   FACTORY_TYPE = 0
+	FACTORY_TYPE_ISOLATED FACTORY_TYPE = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ne-dwrite-dwrite_font_stretch
+type FONT_STRETCH uint32
+
+const (
+	FONT_STRETCH_UNDEFINED       FONT_STRETCH = 0
+	FONT_STRETCH_ULTRA_CONDENSED FONT_STRETCH = 1
+	FONT_STRETCH_EXTRA_CONDENSED FONT_STRETCH = 2
+	FONT_STRETCH_CONDENSED       FONT_STRETCH = 3
+	FONT_STRETCH_SEMI_CONDENSED  FONT_STRETCH = 4
+	FONT_STRETCH_NORMAL          FONT_STRETCH = 5
+	FONT_STRETCH_MEDIUM          FONT_STRETCH = 5
+	FONT_STRETCH_SEMI_EXPANDED   FONT_STRETCH = 6
+	FONT_STRETCH_EXPANDED        FONT_STRETCH = 7
+	FONT_STRETCH_EXTRA_EXPANDED  FONT_STRETCH = 8
+	FONT_STRETCH_ULTRA_EXPANDED  FONT_STRETCH = 9
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ne-dwrite-dwrite_font_style
+type FONT_STYLE uint32
+
+const (
+	FONT_STYLE_NORMAL  FONT_STYLE = 0
+	FONT_STYLE_OBLIQUE FONT_STYLE = 1
+	FONT_STYLE_ITALIC  FONT_STYLE = 2
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ne-dwrite-dwrite_font_weight
+type FONT_WEIGHT uint32
+
+const (
+	FONT_WEIGHT_THIN        FONT_WEIGHT = 100
+	FONT_WEIGHT_EXTRA_LIGHT FONT_WEIGHT = 200
+	FONT_WEIGHT_ULTRA_LIGHT FONT_WEIGHT = 200
+	FONT_WEIGHT_LIGHT       FONT_WEIGHT = 300
+	FONT_WEIGHT_SEMI_LIGHT  FONT_WEIGHT = 350
+	FONT_WEIGHT_NORMAL      FONT_WEIGHT = 400
+	FONT_WEIGHT_REGULAR     FONT_WEIGHT = 400
+	FONT_WEIGHT_MEDIUM      FONT_WEIGHT = 500
+	FONT_WEIGHT_DEMI_BOLD   FONT_WEIGHT = 600
+	FONT_WEIGHT_SEMI_BOLD   FONT_WEIGHT = 600
+	FONT_WEIGHT_BOLD        FONT_WEIGHT = 700
+	FONT_WEIGHT_EXTRA_BOLD  FONT_WEIGHT = 800
+	FONT_WEIGHT_ULTRA_BOLD  FONT_WEIGHT = 800
+	FONT_WEIGHT_BLACK       FONT_WEIGHT = 900
+	FONT_WEIGHT_HEAVY       FONT_WEIGHT = 900
+	FONT_WEIGHT_EXTRA_BLACK FONT_WEIGHT = 950
+	FONT_WEIGHT_ULTRA_BLACK FONT_WEIGHT = 950
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dcommon/ne-dcommon-dwrite_measuring_mode
+type MEASURING_MODE uint32
+
+const (
+	MEASURING_MODE_NATURAL     MEASURING_MODE = 0
+	MEASURING_MODE_GDI_CLASSIC MEASURING_MODE = 1
+	MEASURING_MODE_GDI_NATURAL MEASURING_MODE = 2
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ne-dwrite-dwrite_paragraph_alignment
+type PARAGRAPH_ALIGNMENT uint32
+
+const (
+	PARAGRAPH_ALIGNMENT_NEAR   PARAGRAPH_ALIGNMENT = 0
+	PARAGRAPH_ALIGNMENT_FAR    PARAGRAPH_ALIGNMENT = 1
+	PARAGRAPH_ALIGNMENT_CENTER PARAGRAPH_ALIGNMENT = 2
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ne-dwrite-dwrite_reading_direction
+type READING_DIRECTION uint32
+
+const (
+	READING_DIRECTION_LEFT_TO_RIGHT READING_DIRECTION = 0
+	READING_DIRECTION_RIGHT_TO_LEFT READING_DIRECTION = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ne-dwrite-dwrite_text_alignment
+type TEXT_ALIGNMENT uint32
+
+const (
+	TEXT_ALIGNMENT_LEADING   TEXT_ALIGNMENT = 0
+	TEXT_ALIGNMENT_TRAILING  TEXT_ALIGNMENT = 1
+	TEXT_ALIGNMENT_CENTER    TEXT_ALIGNMENT = 2
+	TEXT_ALIGNMENT_JUSTIFIED TEXT_ALIGNMENT = 3
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ne-dwrite-dwrite_trimming_granularity
+type TRIMMING_GRANULARITY uint32
+
+const (
+	TRIMMING_GRANULARITY_NONE      TRIMMING_GRANULARITY = 0
+	TRIMMING_GRANULARITY_CHARACTER TRIMMING_GRANULARITY = 1
+	TRIMMING_GRANULARITY_WORD      TRIMMING_GRANULARITY = 2
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/ne-dwrite-dwrite_word_wrapping
+type WORD_WRAPPING uint32
+
+const (
+	WORD_WRAPPING_WRAP            WORD_WRAPPING = 0
+	WORD_WRAPPING_NO_WRAP         WORD_WRAPPING = 1
+	WORD_WRAPPING_EMERGENCY_BREAK WORD_WRAPPING = 2
+	WORD_WRAPPING_WHOLE_WORD      WORD_WRAPPING = 3
+	WORD_WRAPPING_CHARACTER       WORD_WRAPPING = 4
+)
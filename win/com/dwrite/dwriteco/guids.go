@@ -0,0 +1,15 @@
+//go:build windows
+
+package dwriteco
+
+import (
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// DirectWrite COM IIDs.
+const (
+	IID_IDWriteFactory      co.IID = "b859ee5a-d838-4b5b-a2e8-1adc7d93db48"
+	IID_IDWriteInlineObject co.IID = "8339fde3-106f-47ab-8373-1c6295eb10b3"
+	IID_IDWriteTextFormat   co.IID = "9c906818-31d7-4fd3-a151-7c5e225db55c"
+	IID_IDWriteTextLayout   co.IID = "53737037-6d14-410b-9bfe-0b182bb70961"
+)
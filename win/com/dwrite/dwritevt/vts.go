@@ -0,0 +1,124 @@
+//go:build windows
+
+package dwritevt
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+)
+
+// IDWriteFactory virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nn-dwrite-idwritefactory
+type IDWriteFactory struct {
+	comvt.IUnknown
+	GetSystemFontCollection        uintptr
+	CreateCustomFontCollection     uintptr
+	RegisterFontCollectionLoader   uintptr
+	UnregisterFontCollectionLoader uintptr
+	CreateFontFileReference        uintptr
+	CreateCustomFontFileReference  uintptr
+	CreateFontFace                 uintptr
+	CreateRenderingParams          uintptr
+	CreateMonitorRenderingParams   uintptr
+	CreateCustomRenderingParams    uintptr
+	RegisterFontFileLoader         uintptr
+	UnregisterFontFileLoader       uintptr
+	CreateTextFormat               uintptr
+	CreateTypography               uintptr
+	GetGdiInterop                  uintptr
+	CreateTextLayout               uintptr
+	CreateGdiCompatibleTextLayout  uintptr
+	CreateEllipsisTrimmingSign     uintptr
+	CreateTextAnalyzer             uintptr
+	CreateNumberSubstitution       uintptr
+	CreateGlyphRunAnalysis         uintptr
+}
+
+// IDWriteInlineObject virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nn-dwrite-idwriteinlineobject
+type IDWriteInlineObject struct {
+	comvt.IUnknown
+	Draw               uintptr
+	GetMetrics         uintptr
+	GetOverhangMetrics uintptr
+	GetBreakConditions uintptr
+}
+
+// IDWriteTextFormat virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nn-dwrite-idwritetextformat
+type IDWriteTextFormat struct {
+	comvt.IUnknown
+	SetTextAlignment        uintptr
+	SetParagraphAlignment   uintptr
+	SetWordWrapping         uintptr
+	SetReadingDirection     uintptr
+	SetFlowDirection        uintptr
+	SetIncrementalTabStop   uintptr
+	SetTrimming             uintptr
+	SetLineSpacing          uintptr
+	GetTextAlignment        uintptr
+	GetParagraphAlignment   uintptr
+	GetWordWrapping         uintptr
+	GetReadingDirection     uintptr
+	GetFlowDirection        uintptr
+	GetIncrementalTabStop   uintptr
+	GetTrimming             uintptr
+	GetLineSpacing          uintptr
+	GetFontCollection       uintptr
+	GetFontFamilyNameLength uintptr
+	GetFontFamilyName       uintptr
+	GetFontWeight           uintptr
+	GetFontStyle            uintptr
+	GetFontStretch          uintptr
+	GetFontSize             uintptr
+	GetLocaleNameLength     uintptr
+	GetLocaleName           uintptr
+}
+
+// IDWriteTextLayout virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/dwrite/nn-dwrite-idwritetextlayout
+type IDWriteTextLayout struct {
+	IDWriteTextFormat
+	SetMaxWidth               uintptr
+	SetMaxHeight              uintptr
+	SetFontCollection         uintptr
+	SetFontFamilyName         uintptr
+	SetFontWeight             uintptr
+	SetFontStyle              uintptr
+	SetFontStretch            uintptr
+	SetFontSize               uintptr
+	SetUnderline              uintptr
+	SetStrikethrough          uintptr
+	SetDrawingEffect          uintptr
+	SetInlineObject           uintptr
+	SetTypography             uintptr
+	SetLocaleName             uintptr
+	GetMaxWidth               uintptr
+	GetMaxHeight              uintptr
+	GetFontCollectionAt       uintptr
+	GetFontFamilyNameLengthAt uintptr
+	GetFontFamilyNameAt       uintptr
+	GetFontWeightAt           uintptr
+	GetFontStyleAt            uintptr
+	GetFontStretchAt          uintptr
+	GetFontSizeAt             uintptr
+	GetUnderline              uintptr
+	GetStrikethrough          uintptr
+	GetDrawingEffect          uintptr
+	GetInlineObject           uintptr
+	GetTypography             uintptr
+	GetLocaleNameLengthAt     uintptr
+	GetLocaleNameAt           uintptr
+	Draw                      uintptr
+	GetLineMetrics            uintptr
+	GetMetrics                uintptr
+	GetOverhangMetrics        uintptr
+	GetClusterMetrics         uintptr
+	DetermineMinWidth         uintptr
+	HitTestPoint              uintptr
+	HitTestTextPosition       uintptr
+	HitTestTextRange          uintptr
+}
@@ -0,0 +1,186 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-iapplicationassociationregistration
+type IApplicationAssociationRegistration interface {
+	com.IUnknown
+
+	// On Windows 8 and later, this method has no effect.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-iapplicationassociationregistration-clearuserassociations
+	ClearUserAssociations() error
+
+	// Returns an error if the application isn't registered under the
+	// RegisteredApplications key.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-iapplicationassociationregistration-queryappisdefault
+	QueryAppIsDefault(query string,
+		queryType shellco.AT, level shellco.AL,
+		appRegistryName string) (bool, error)
+
+	// Returns an error if the application isn't registered under the
+	// RegisteredApplications key.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-iapplicationassociationregistration-queryappisdefaultall
+	QueryAppIsDefaultAll(
+		level shellco.AL, appRegistryName string) (bool, error)
+
+	// Returns the ProgID or application ID currently associated to the given
+	// extension, protocol or MIME type. Returns an error if there is no
+	// association.
+	//
+	// Example:
+	//
+	//	var aar shell.IApplicationAssociationRegistration // initialized somewhere
+	//
+	//	progId, err := aar.QueryCurrentDefault(".txt",
+	//		shellco.AT_FILEEXTENSION, shellco.AL_EFFECTIVE)
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-iapplicationassociationregistration-querycurrentdefault
+	QueryCurrentDefault(query string,
+		queryType shellco.AT, level shellco.AL) (string, error)
+
+	// On Windows 8 and later, this method has no effect.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-iapplicationassociationregistration-setappasdefault
+	SetAppAsDefault(appRegistryName, set string, setType shellco.AT) error
+
+	// On Windows 8 and later, this method has no effect.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-iapplicationassociationregistration-setappasdefaultall
+	SetAppAsDefaultAll(appRegistryName string) error
+}
+
+type _IApplicationAssociationRegistration struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IApplicationAssociationRegistration.Release().
+//
+// Example:
+//
+//	aar := shell.NewIApplicationAssociationRegistration(
+//		com.CoCreateInstance(
+//			shellco.CLSID_ApplicationAssociationRegistration, nil,
+//			comco.CLSCTX_INPROC_SERVER,
+//			shellco.IID_IApplicationAssociationRegistration),
+//	)
+//	defer aar.Release()
+func NewIApplicationAssociationRegistration(
+	base com.IUnknown) IApplicationAssociationRegistration {
+
+	return &_IApplicationAssociationRegistration{IUnknown: base}
+}
+
+func (me *_IApplicationAssociationRegistration) ClearUserAssociations() error {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IApplicationAssociationRegistration)(unsafe.Pointer(*me.Ptr())).ClearUserAssociations,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else {
+		return hr
+	}
+}
+
+func (me *_IApplicationAssociationRegistration) QueryAppIsDefault(
+	query string, queryType shellco.AT, level shellco.AL,
+	appRegistryName string) (bool, error) {
+
+	var isDefault int32 // BOOL
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IApplicationAssociationRegistration)(unsafe.Pointer(*me.Ptr())).QueryAppIsDefault,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(query))),
+		uintptr(queryType), uintptr(level),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(appRegistryName))),
+		uintptr(unsafe.Pointer(&isDefault)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return isDefault != 0, nil
+	} else {
+		return false, hr
+	}
+}
+
+func (me *_IApplicationAssociationRegistration) QueryAppIsDefaultAll(
+	level shellco.AL, appRegistryName string) (bool, error) {
+
+	var isDefault int32 // BOOL
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IApplicationAssociationRegistration)(unsafe.Pointer(*me.Ptr())).QueryAppIsDefaultAll,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(level),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(appRegistryName))),
+		uintptr(unsafe.Pointer(&isDefault)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return isDefault != 0, nil
+	} else {
+		return false, hr
+	}
+}
+
+func (me *_IApplicationAssociationRegistration) QueryCurrentDefault(
+	query string, queryType shellco.AT, level shellco.AL) (string, error) {
+
+	var pv uintptr
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IApplicationAssociationRegistration)(unsafe.Pointer(*me.Ptr())).QueryCurrentDefault,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(query))),
+		uintptr(queryType), uintptr(level),
+		uintptr(unsafe.Pointer(&pv)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		defer win.HTASKMEM(pv).CoTaskMemFree()
+		return win.Str.FromNativePtr((*uint16)(unsafe.Pointer(pv))), nil
+	} else {
+		return "", hr
+	}
+}
+
+func (me *_IApplicationAssociationRegistration) SetAppAsDefault(
+	appRegistryName, set string, setType shellco.AT) error {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IApplicationAssociationRegistration)(unsafe.Pointer(*me.Ptr())).SetAppAsDefault,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(appRegistryName))),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(set))),
+		uintptr(setType))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else {
+		return hr
+	}
+}
+
+func (me *_IApplicationAssociationRegistration) SetAppAsDefaultAll(
+	appRegistryName string) error {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IApplicationAssociationRegistration)(unsafe.Pointer(*me.Ptr())).SetAppAsDefaultAll,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(appRegistryName))))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else {
+		return hr
+	}
+}
@@ -0,0 +1,116 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-icontextmenu
+type IContextMenu interface {
+	com.IUnknown
+
+	// The cmdOffset is the offset of the command ID from the first ID passed to
+	// IContextMenu.QueryContextMenu().
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icontextmenu-getcommandstring
+	GetCommandString(cmdOffset int, flags shellco.GCS) (string, error)
+
+	// Example running the command chosen by the user:
+	//
+	//	var cm shell.IContextMenu // initialized somewhere
+	//	var hWnd win.HWND
+	//	var cmdOffset int
+	//
+	//	cmi := shell.CMINVOKECOMMANDINFOEX{}
+	//	cmi.SetCbSize()
+	//	cmi.FMask = shellco.CMIC_MASK_UNICODE
+	//	cmi.Hwnd = hWnd
+	//	cmi.LpVerb = uintptr(cmdOffset)
+	//	cmi.LpVerbW = uintptr(cmdOffset)
+	//	cmi.NShow = co.SW_SHOWNORMAL
+	//
+	//	err := cm.InvokeCommand(&cmi)
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icontextmenu-invokecommand
+	InvokeCommand(info *CMINVOKECOMMANDINFOEX) error
+
+	// Adds the commands to the menu, returning how many command IDs were used.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icontextmenu-querycontextmenu
+	QueryContextMenu(hMenu win.HMENU,
+		index, idFirst, idLast int, flags shellco.CMF) (int, error)
+}
+
+type _IContextMenu struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IContextMenu.Release().
+//
+// Example:
+//
+//	var folder shell.IShellFolder // initialized somewhere
+//	var children []shell.PIDL
+//
+//	obj, _ := folder.GetUIObjectOf(
+//		win.HWND(0), children, shellco.IID_IContextMenu)
+//	cm := shell.NewIContextMenu(obj)
+//	defer cm.Release()
+func NewIContextMenu(base com.IUnknown) IContextMenu {
+	return &_IContextMenu{IUnknown: base}
+}
+
+func (me *_IContextMenu) GetCommandString(
+	cmdOffset int, flags shellco.GCS) (string, error) {
+
+	buf := make([]uint16, 260) // arbitrary
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IContextMenu)(unsafe.Pointer(*me.Ptr())).GetCommandString,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(cmdOffset), uintptr(flags), 0,
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return win.Str.FromNativeSlice(buf), nil
+	} else {
+		return "", hr
+	}
+}
+
+func (me *_IContextMenu) InvokeCommand(info *CMINVOKECOMMANDINFOEX) error {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IContextMenu)(unsafe.Pointer(*me.Ptr())).InvokeCommand,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(info)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else {
+		return hr
+	}
+}
+
+func (me *_IContextMenu) QueryContextMenu(
+	hMenu win.HMENU,
+	index, idFirst, idLast int, flags shellco.CMF) (int, error) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IContextMenu)(unsafe.Pointer(*me.Ptr())).QueryContextMenu,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(hMenu), uintptr(index), uintptr(idFirst), uintptr(idLast),
+		uintptr(flags))
+
+	if hr := errco.ERROR(ret); int32(hr) >= 0 { // SUCCEEDED
+		return int(hr & 0xffff), nil // SCODE_CODE
+	} else {
+		return 0, hr
+	}
+}
@@ -0,0 +1,59 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-icontextmenu2
+type IContextMenu2 interface {
+	IContextMenu
+
+	// Must be called with WM_INITMENUPOPUP, WM_DRAWITEM and WM_MEASUREITEM
+	// received by the menu owner while the menu is shown, so owner-drawn and
+	// dynamic submenus work.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icontextmenu2-handlemenumsg
+	HandleMenuMsg(msg co.WM, wParam win.WPARAM, lParam win.LPARAM) error
+}
+
+type _IContextMenu2 struct{ IContextMenu }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IContextMenu2.Release().
+//
+// Example:
+//
+//	var cm shell.IContextMenu // initialized somewhere
+//
+//	cm2 := shell.NewIContextMenu2(
+//		cm.QueryInterface(shellco.IID_IContextMenu2),
+//	)
+//	defer cm2.Release()
+func NewIContextMenu2(base com.IUnknown) IContextMenu2 {
+	return &_IContextMenu2{IContextMenu: NewIContextMenu(base)}
+}
+
+func (me *_IContextMenu2) HandleMenuMsg(
+	msg co.WM, wParam win.WPARAM, lParam win.LPARAM) error {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IContextMenu2)(unsafe.Pointer(*me.Ptr())).HandleMenuMsg,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(msg), uintptr(wParam), uintptr(lParam))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else {
+		return hr
+	}
+}
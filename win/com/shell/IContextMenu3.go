@@ -0,0 +1,61 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-icontextmenu3
+type IContextMenu3 interface {
+	IContextMenu2
+
+	// Like IContextMenu2.HandleMenuMsg(), but also handles WM_MENUCHAR, and
+	// returns the value to be returned by the window procedure.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icontextmenu3-handlemenumsg2
+	HandleMenuMsg2(msg co.WM,
+		wParam win.WPARAM, lParam win.LPARAM) (uintptr, error)
+}
+
+type _IContextMenu3 struct{ IContextMenu2 }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IContextMenu3.Release().
+//
+// Example:
+//
+//	var cm shell.IContextMenu // initialized somewhere
+//
+//	cm3 := shell.NewIContextMenu3(
+//		cm.QueryInterface(shellco.IID_IContextMenu3),
+//	)
+//	defer cm3.Release()
+func NewIContextMenu3(base com.IUnknown) IContextMenu3 {
+	return &_IContextMenu3{IContextMenu2: NewIContextMenu2(base)}
+}
+
+func (me *_IContextMenu3) HandleMenuMsg2(
+	msg co.WM, wParam win.WPARAM, lParam win.LPARAM) (uintptr, error) {
+
+	var result uintptr // LRESULT
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IContextMenu3)(unsafe.Pointer(*me.Ptr())).HandleMenuMsg2,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(msg), uintptr(wParam), uintptr(lParam),
+		uintptr(unsafe.Pointer(&result)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return result, nil
+	} else {
+		return 0, hr
+	}
+}
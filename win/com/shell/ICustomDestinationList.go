@@ -0,0 +1,212 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-icustomdestinationlist
+type ICustomDestinationList interface {
+	com.IUnknown
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icustomdestinationlist-abortlist
+	AbortList()
+
+	// Adds the tasks, usually IShellLink objects, to the Tasks category.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icustomdestinationlist-addusertasks
+	AddUserTasks(items IObjectArray)
+
+	// Returns E_ACCESSDENIED if the user disabled the recent items tracking.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icustomdestinationlist-appendcategory
+	AppendCategory(category string, items IObjectArray) error
+
+	// Returns E_ACCESSDENIED if the user disabled the recent items tracking.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icustomdestinationlist-appendknowncategory
+	AppendKnownCategory(category shellco.KDC) error
+
+	// Starts a new list, returning the maximum number of items that can be
+	// displayed, and the items removed by the user since the last list.
+	//
+	// ⚠️ You must defer IObjectArray.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icustomdestinationlist-beginlist
+	BeginList() (maxSlots uint, removed IObjectArray)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icustomdestinationlist-commitlist
+	CommitList()
+
+	// If appId is none, deletes the list of the current application.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icustomdestinationlist-deletelist
+	DeleteList(appId win.StrOpt)
+
+	// ⚠️ You must defer IObjectArray.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icustomdestinationlist-getremoveddestinations
+	GetRemovedDestinations() IObjectArray
+
+	// Must be called before BeginList(), if the application has an explicit
+	// AppUserModelID.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icustomdestinationlist-setappid
+	SetAppID(appId string)
+}
+
+type _ICustomDestinationList struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ICustomDestinationList.Release().
+//
+// Example:
+//
+//	cdl := shell.NewICustomDestinationList(
+//		com.CoCreateInstance(
+//			shellco.CLSID_DestinationList, nil,
+//			comco.CLSCTX_INPROC_SERVER,
+//			shellco.IID_ICustomDestinationList),
+//	)
+//	defer cdl.Release()
+//
+//	_, removed := cdl.BeginList()
+//	defer removed.Release()
+//
+//	cdl.AppendKnownCategory(shellco.KDC_RECENT)
+//	cdl.CommitList()
+func NewICustomDestinationList(base com.IUnknown) ICustomDestinationList {
+	return &_ICustomDestinationList{IUnknown: base}
+}
+
+func (me *_ICustomDestinationList) AbortList() {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.ICustomDestinationList)(unsafe.Pointer(*me.Ptr())).AbortList,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_ICustomDestinationList) AddUserTasks(items IObjectArray) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.ICustomDestinationList)(unsafe.Pointer(*me.Ptr())).AddUserTasks,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(items.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_ICustomDestinationList) AppendCategory(
+	category string, items IObjectArray) error {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.ICustomDestinationList)(unsafe.Pointer(*me.Ptr())).AppendCategory,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(category))),
+		uintptr(unsafe.Pointer(items.Ptr())))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else if hr == errco.E_ACCESSDENIED {
+		return hr
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ICustomDestinationList) AppendKnownCategory(
+	category shellco.KDC) error {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.ICustomDestinationList)(unsafe.Pointer(*me.Ptr())).AppendKnownCategory,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(category))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else if hr == errco.E_ACCESSDENIED {
+		return hr
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ICustomDestinationList) BeginList() (
+	maxSlots uint, removed IObjectArray) {
+
+	var cMinSlots uint32
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.ICustomDestinationList)(unsafe.Pointer(*me.Ptr())).BeginList,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&cMinSlots)),
+		uintptr(unsafe.Pointer(win.GuidFromIid(shellco.IID_IObjectArray))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return uint(cMinSlots), NewIObjectArray(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ICustomDestinationList) CommitList() {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.ICustomDestinationList)(unsafe.Pointer(*me.Ptr())).CommitList,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_ICustomDestinationList) DeleteList(appId win.StrOpt) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.ICustomDestinationList)(unsafe.Pointer(*me.Ptr())).DeleteList,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(appId.Raw()))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_ICustomDestinationList) GetRemovedDestinations() IObjectArray {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.ICustomDestinationList)(unsafe.Pointer(*me.Ptr())).GetRemovedDestinations,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.GuidFromIid(shellco.IID_IObjectArray))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIObjectArray(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ICustomDestinationList) SetAppID(appId string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.ICustomDestinationList)(unsafe.Pointer(*me.Ptr())).SetAppID,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(appId))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
@@ -0,0 +1,139 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ienumshellitems
+type IEnumShellItems interface {
+	com.IUnknown
+
+	// ⚠️ You must defer IEnumShellItems.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ienumshellitems-clone
+	Clone() IEnumShellItems
+
+	// This helper method calls IEnumShellItems.Skip() until the end of the enum
+	// to retrieve the actual number of items, then calls
+	// IEnumShellItems.Reset().
+	Count() int
+
+	// Calls Next() to retrieve all items, then calls Reset().
+	//
+	// ⚠️ You must defer IShellItem.Release() on each returned object.
+	GetAll() []IShellItem
+
+	// ⚠️ You must defer IShellItem.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ienumshellitems-next
+	Next() (IShellItem, bool)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ienumshellitems-reset
+	Reset()
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ienumshellitems-skip
+	Skip(numItems int) bool
+}
+
+type _IEnumShellItems struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IEnumShellItems.Release().
+//
+// Example:
+//
+//	var folder shell.IShellItem // initialized somewhere
+//
+//	obj, _ := folder.BindToHandler(nil,
+//		shellco.BHID_EnumItems, shellco.IID_IEnumShellItems)
+//	enumItems := shell.NewIEnumShellItems(obj)
+//	defer enumItems.Release()
+func NewIEnumShellItems(base com.IUnknown) IEnumShellItems {
+	return &_IEnumShellItems{IUnknown: base}
+}
+
+func (me *_IEnumShellItems) Clone() IEnumShellItems {
+	var ppQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IEnumShellItems)(unsafe.Pointer(*me.Ptr())).Clone,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIEnumShellItems(com.NewIUnknown(ppQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IEnumShellItems) Count() int {
+	count := int(0)
+	for {
+		gotOne := me.Skip(1)
+		if gotOne {
+			count++
+		} else {
+			me.Reset()
+			return count
+		}
+	}
+}
+
+func (me *_IEnumShellItems) GetAll() []IShellItem {
+	items := make([]IShellItem, 0, 10) // arbitrary
+	for {
+		item, gotOne := me.Next()
+		if gotOne {
+			items = append(items, item)
+		} else {
+			me.Reset()
+			return items
+		}
+	}
+}
+
+func (me *_IEnumShellItems) Next() (IShellItem, bool) {
+	var ppQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IEnumShellItems)(unsafe.Pointer(*me.Ptr())).Next,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		1, uintptr(unsafe.Pointer(&ppQueried)), 0)
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIShellItem(com.NewIUnknown(ppQueried)), true
+	} else if hr == errco.S_FALSE {
+		return nil, false
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IEnumShellItems) Reset() {
+	syscall.SyscallN(
+		(*shellvt.IEnumShellItems)(unsafe.Pointer(*me.Ptr())).Reset,
+		uintptr(unsafe.Pointer(me.Ptr())))
+}
+
+func (me *_IEnumShellItems) Skip(numItems int) bool {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IEnumShellItems)(unsafe.Pointer(*me.Ptr())).Skip,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(numItems)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return true
+	} else if hr == errco.S_FALSE {
+		return false
+	} else {
+		panic(hr)
+	}
+}
@@ -18,17 +18,36 @@ import (
 type IFileDialog interface {
 	IModalWindow
 
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-addplace
+	AddPlace(si IShellItem, fdap shellco.FDAP)
+
+	// Returns a cookie to be passed to Unadvise().
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-advise
+	Advise(events IFileDialogEvents) uint32
+
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-clearclientdata
 	ClearClientData()
 
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-close
 	Close(hr errco.ERROR)
 
+	// Panics if nothing is selected; use IFileDialog.GetCurrentSelectionErr()
+	// to handle this case.
+	//
 	// ⚠️ You must defer IShellItem.Release() on the returned object.
 	//
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-getcurrentselection
 	GetCurrentSelection() IShellItem
 
+	// Same as IFileDialog.GetCurrentSelection(), but returns an error instead
+	// of panicking, like when nothing is selected.
+	//
+	// ⚠️ You must defer IShellItem.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-getcurrentselection
+	GetCurrentSelectionErr() (IShellItem, error)
+
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-getfilename
 	GetFileName() string
 
@@ -65,6 +84,16 @@ type IFileDialog interface {
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-setclientguid
 	SetClientGuid(guid *win.GUID)
 
+	// The extension must not have the leading dot, like "txt".
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-setdefaultextension
+	SetDefaultExtension(ext string)
+
+	// Sets the folder used when there is no recently used folder.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-setdefaultfolder
+	SetDefaultFolder(si IShellItem)
+
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-setfilename
 	SetFileName(name string)
 
@@ -108,6 +137,9 @@ type IFileDialog interface {
 
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-settitle
 	SetTitle(title string)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-unadvise
+	Unadvise(cookie uint32)
 }
 
 type _IFileDialog struct{ IModalWindow }
@@ -119,6 +151,32 @@ func NewIFileDialog(base com.IUnknown) IFileDialog {
 	return &_IFileDialog{IModalWindow: NewIModalWindow(base)}
 }
 
+func (me *_IFileDialog) AddPlace(si IShellItem, fdap shellco.FDAP) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).AddPlace,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(si.Ptr())), uintptr(fdap))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialog) Advise(events IFileDialogEvents) uint32 {
+	var cookie uint32
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).Advise,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(events.Ptr())),
+		uintptr(unsafe.Pointer(&cookie)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return cookie
+	} else {
+		panic(hr)
+	}
+}
+
 func (me *_IFileDialog) ClearClientData() {
 	ret, _, _ := syscall.SyscallN(
 		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).ClearClientData,
@@ -141,6 +199,14 @@ func (me *_IFileDialog) Close(hr errco.ERROR) {
 }
 
 func (me *_IFileDialog) GetCurrentSelection() IShellItem {
+	item, err := me.GetCurrentSelectionErr()
+	if err != nil {
+		panic(err)
+	}
+	return item
+}
+
+func (me *_IFileDialog) GetCurrentSelectionErr() (IShellItem, error) {
 	var ppvQueried **comvt.IUnknown
 	ret, _, _ := syscall.SyscallN(
 		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).GetCurrentSelection,
@@ -148,9 +214,9 @@ func (me *_IFileDialog) GetCurrentSelection() IShellItem {
 		uintptr(unsafe.Pointer(&ppvQueried)))
 
 	if hr := errco.ERROR(ret); hr == errco.S_OK {
-		return NewIShellItem(com.NewIUnknown(ppvQueried))
+		return NewIShellItem(com.NewIUnknown(ppvQueried)), nil
 	} else {
-		panic(hr)
+		return nil, hr
 	}
 }
 
@@ -244,6 +310,28 @@ func (me *_IFileDialog) SetClientGuid(guid *win.GUID) {
 	}
 }
 
+func (me *_IFileDialog) SetDefaultExtension(ext string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).SetDefaultExtension,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(ext))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialog) SetDefaultFolder(si IShellItem) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).SetDefaultFolder,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(si.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
 func (me *_IFileDialog) SetFileName(name string) {
 	ret, _, _ := syscall.SyscallN(
 		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).SetFileName,
@@ -341,3 +429,14 @@ func (me *_IFileDialog) SetTitle(title string) {
 		panic(hr)
 	}
 }
+
+func (me *_IFileDialog) Unadvise(cookie uint32) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).Unadvise,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(cookie))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
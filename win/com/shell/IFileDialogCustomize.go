@@ -0,0 +1,461 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// The controls must be added before IModalWindow.Show(), and their values can
+// be read after it returns.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifiledialogcustomize
+type IFileDialogCustomize interface {
+	com.IUnknown
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-addcheckbutton
+	AddCheckButton(id int, label string, checked bool)
+
+	// Items are added with AddControlItem().
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-addcombobox
+	AddComboBox(id int)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-addcontrolitem
+	AddControlItem(id, itemId int, label string)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-addeditbox
+	AddEditBox(id int, text string)
+
+	// Items are added with AddControlItem().
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-addmenu
+	AddMenu(id int, label string)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-addpushbutton
+	AddPushButton(id int, label string)
+
+	// Items are added with AddControlItem().
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-addradiobuttonlist
+	AddRadioButtonList(id int)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-addseparator
+	AddSeparator(id int)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-addtext
+	AddText(id int, text string)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-enableopendropdown
+	EnableOpenDropDown(id int)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-endvisualgroup
+	EndVisualGroup()
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-getcheckbuttonstate
+	GetCheckButtonState(id int) bool
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-getcontrolitemstate
+	GetControlItemState(id, itemId int) shellco.CDCS
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-getcontrolstate
+	GetControlState(id int) shellco.CDCS
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-geteditboxtext
+	GetEditBoxText(id int) string
+
+	// Returns false if no item is selected.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-getselectedcontrolitem
+	GetSelectedControlItem(id int) (int, bool)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-makeprominent
+	MakeProminent(id int)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-removeallcontrolitems
+	RemoveAllControlItems(id int)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-removecontrolitem
+	RemoveControlItem(id, itemId int)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-setcheckbuttonstate
+	SetCheckButtonState(id int, checked bool)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-setcontrolitemstate
+	SetControlItemState(id, itemId int, state shellco.CDCS)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-setcontrolitemtext
+	SetControlItemText(id, itemId int, label string)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-setcontrollabel
+	SetControlLabel(id int, label string)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-setcontrolstate
+	SetControlState(id int, state shellco.CDCS)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-seteditboxtext
+	SetEditBoxText(id int, text string)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-setselectedcontrolitem
+	SetSelectedControlItem(id, itemId int)
+
+	// All controls added until EndVisualGroup() are placed in the group.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogcustomize-startvisualgroup
+	StartVisualGroup(id int, label string)
+}
+
+type _IFileDialogCustomize struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IFileDialogCustomize.Release().
+//
+// Example:
+//
+//	var fod shell.IFileOpenDialog // initialized somewhere
+//
+//	fdc := shell.NewIFileDialogCustomize(
+//		fod.QueryInterface(shellco.IID_IFileDialogCustomize),
+//	)
+//	defer fdc.Release()
+//
+//	fdc.AddCheckButton(1001, "Open as read-only", false)
+func NewIFileDialogCustomize(base com.IUnknown) IFileDialogCustomize {
+	return &_IFileDialogCustomize{IUnknown: base}
+}
+
+func (me *_IFileDialogCustomize) AddCheckButton(
+	id int, label string, checked bool) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).AddCheckButton,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(label))),
+		util.BoolToUintptr(checked))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) AddComboBox(id int) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).AddComboBox,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) AddControlItem(id, itemId int, label string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).AddControlItem,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(uint32(itemId)),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(label))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) AddEditBox(id int, text string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).AddEditBox,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(unsafe.Pointer(win.Str.ToNativePtr(text))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) AddMenu(id int, label string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).AddMenu,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(label))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) AddPushButton(id int, label string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).AddPushButton,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(label))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) AddRadioButtonList(id int) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).AddRadioButtonList,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) AddSeparator(id int) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).AddSeparator,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) AddText(id int, text string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).AddText,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(unsafe.Pointer(win.Str.ToNativePtr(text))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) EnableOpenDropDown(id int) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).EnableOpenDropDown,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) EndVisualGroup() {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).EndVisualGroup,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) GetCheckButtonState(id int) bool {
+	var checked int32 // BOOL
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).GetCheckButtonState,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(unsafe.Pointer(&checked)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return checked != 0
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) GetControlItemState(id, itemId int) shellco.CDCS {
+	var state shellco.CDCS
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).GetControlItemState,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(uint32(itemId)),
+		uintptr(unsafe.Pointer(&state)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return state
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) GetControlState(id int) shellco.CDCS {
+	var state shellco.CDCS
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).GetControlState,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(unsafe.Pointer(&state)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return state
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) GetEditBoxText(id int) string {
+	var pv uintptr
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).GetEditBoxText,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(unsafe.Pointer(&pv)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		defer win.HTASKMEM(pv).CoTaskMemFree()
+		return win.Str.FromNativePtr((*uint16)(unsafe.Pointer(pv)))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) GetSelectedControlItem(id int) (int, bool) {
+	var itemId uint32
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).GetSelectedControlItem,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(unsafe.Pointer(&itemId)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return int(itemId), true
+	} else {
+		return 0, false
+	}
+}
+
+func (me *_IFileDialogCustomize) MakeProminent(id int) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).MakeProminent,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) RemoveAllControlItems(id int) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).RemoveAllControlItems,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) RemoveControlItem(id, itemId int) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).RemoveControlItem,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(uint32(itemId)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) SetCheckButtonState(id int, checked bool) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).SetCheckButtonState,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), util.BoolToUintptr(checked))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) SetControlItemState(
+	id, itemId int, state shellco.CDCS) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).SetControlItemState,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(uint32(itemId)), uintptr(state))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) SetControlItemText(
+	id, itemId int, label string) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).SetControlItemText,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(uint32(itemId)),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(label))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) SetControlLabel(id int, label string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).SetControlLabel,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(label))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) SetControlState(id int, state shellco.CDCS) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).SetControlState,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(state))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) SetEditBoxText(id int, text string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).SetEditBoxText,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(unsafe.Pointer(win.Str.ToNativePtr(text))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) SetSelectedControlItem(id, itemId int) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).SetSelectedControlItem,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)), uintptr(uint32(itemId)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileDialogCustomize) StartVisualGroup(id int, label string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialogCustomize)(unsafe.Pointer(*me.Ptr())).StartVisualGroup,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(id)),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(label))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
@@ -0,0 +1,252 @@
+//go:build windows
+
+package shell
+
+import (
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/comobj"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Go implementation of IFileDialogEvents, which calls the closures you define
+// for each notification. Notifications without a closure have the default
+// behavior.
+//
+// The IFileDialog and IShellItem objects passed to the closures are owned by
+// the caller, and are valid only during the closure; don't Release() them, but
+// call AddRef() if you need to keep them.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifiledialogevents
+type IFileDialogEvents interface {
+	com.IUnknown
+
+	// Return false to keep the dialog open.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogevents-onfileok
+	OnFileOk(fun func(fd IFileDialog) bool)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogevents-onfolderchange
+	OnFolderChange(fun func(fd IFileDialog))
+
+	// Return false to prevent the navigation.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogevents-onfolderchanging
+	OnFolderChanging(fun func(fd IFileDialog, folder IShellItem) bool)
+
+	// Called only by the save dialog, when the chosen file already exists.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogevents-onoverwrite
+	OnOverwrite(fun func(fd IFileDialog, item IShellItem) shellco.FDEOR)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogevents-onselectionchange
+	OnSelectionChange(fun func(fd IFileDialog))
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogevents-onshareviolation
+	OnShareViolation(fun func(fd IFileDialog, item IShellItem) shellco.FDESVR)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialogevents-ontypechange
+	OnTypeChange(fun func(fd IFileDialog))
+}
+
+type _IFileDialogEvents struct {
+	com.IUnknown
+	mutex  sync.Mutex
+	events struct {
+		fileOk          func(IFileDialog) bool
+		folderChange    func(IFileDialog)
+		folderChanging  func(IFileDialog, IShellItem) bool
+		overwrite       func(IFileDialog, IShellItem) shellco.FDEOR
+		selectionChange func(IFileDialog)
+		shareViolation  func(IFileDialog, IShellItem) shellco.FDESVR
+		typeChange      func(IFileDialog)
+	}
+}
+
+// Creates a new IFileDialogEvents implemented in Go, to be passed to
+// IFileDialog.Advise().
+//
+// ⚠️ You must defer IFileDialogEvents.Release().
+//
+// Example:
+//
+//	var fd shell.IFileDialog // initialized somewhere
+//
+//	events := shell.NewIFileDialogEventsImpl()
+//	defer events.Release()
+//
+//	events.OnSelectionChange(func(fd shell.IFileDialog) {
+//		println("Selection changed")
+//	})
+//
+//	cookie := fd.Advise(events)
+//	defer fd.Unadvise(cookie)
+func NewIFileDialogEventsImpl() IFileDialogEvents {
+	me := &_IFileDialogEvents{}
+	me.IUnknown = com.NewIUnknown(
+		comobj.New(unsafe.Pointer(&_globalFileDlgEventsVt), me,
+			shellco.IID_IFileDialogEvents),
+	)
+	return me
+}
+
+func (me *_IFileDialogEvents) OnFileOk(fun func(fd IFileDialog) bool) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.fileOk = fun
+}
+
+func (me *_IFileDialogEvents) OnFolderChange(fun func(fd IFileDialog)) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.folderChange = fun
+}
+
+func (me *_IFileDialogEvents) OnFolderChanging(
+	fun func(fd IFileDialog, folder IShellItem) bool) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.folderChanging = fun
+}
+
+func (me *_IFileDialogEvents) OnOverwrite(
+	fun func(fd IFileDialog, item IShellItem) shellco.FDEOR) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.overwrite = fun
+}
+
+func (me *_IFileDialogEvents) OnSelectionChange(fun func(fd IFileDialog)) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.selectionChange = fun
+}
+
+func (me *_IFileDialogEvents) OnShareViolation(
+	fun func(fd IFileDialog, item IShellItem) shellco.FDESVR) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.shareViolation = fun
+}
+
+func (me *_IFileDialogEvents) OnTypeChange(fun func(fd IFileDialog)) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.typeChange = fun
+}
+
+// Returns the Go object of the COM pointer received by the callbacks.
+func _FileDlgEventsFrom(pObj uintptr) (*_IFileDialogEvents, bool) {
+	me, ok := comobj.Impl(pObj).(*_IFileDialogEvents)
+	return me, ok
+}
+
+var _globalFileDlgEventsVt = shellvt.IFileDialogEvents{
+	IUnknown: comvt.IUnknown{
+		QueryInterface: comobj.QueryInterface,
+		AddRef:         comobj.AddRef,
+		Release:        comobj.Release,
+	},
+	OnFileOk: syscall.NewCallback(
+		func(pObj uintptr, pfd **comvt.IUnknown) uintptr {
+			if me, ok := _FileDlgEventsFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.fileOk
+				me.mutex.Unlock()
+				if fun != nil && !fun(NewIFileDialog(com.NewIUnknown(pfd))) {
+					return uintptr(errco.S_FALSE) // keep the dialog open
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	OnFolderChanging: syscall.NewCallback(
+		func(pObj uintptr, pfd, folder **comvt.IUnknown) uintptr {
+			if me, ok := _FileDlgEventsFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.folderChanging
+				me.mutex.Unlock()
+				if fun != nil && !fun(NewIFileDialog(com.NewIUnknown(pfd)),
+					_BorrowedShellItem(folder)) {
+					return uintptr(errco.E_ABORT) // prevent the navigation
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	OnFolderChange: syscall.NewCallback(
+		func(pObj uintptr, pfd **comvt.IUnknown) uintptr {
+			if me, ok := _FileDlgEventsFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.folderChange
+				me.mutex.Unlock()
+				if fun != nil {
+					fun(NewIFileDialog(com.NewIUnknown(pfd)))
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	OnSelectionChange: syscall.NewCallback(
+		func(pObj uintptr, pfd **comvt.IUnknown) uintptr {
+			if me, ok := _FileDlgEventsFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.selectionChange
+				me.mutex.Unlock()
+				if fun != nil {
+					fun(NewIFileDialog(com.NewIUnknown(pfd)))
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	OnShareViolation: syscall.NewCallback(
+		func(pObj uintptr, pfd, item **comvt.IUnknown,
+			pResponse *shellco.FDESVR) uintptr {
+
+			if me, ok := _FileDlgEventsFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.shareViolation
+				me.mutex.Unlock()
+				if fun != nil {
+					*pResponse = fun(NewIFileDialog(com.NewIUnknown(pfd)),
+						_BorrowedShellItem(item))
+					return uintptr(errco.S_OK)
+				}
+			}
+			return uintptr(errco.E_NOTIMPL) // default behavior
+		}),
+	OnTypeChange: syscall.NewCallback(
+		func(pObj uintptr, pfd **comvt.IUnknown) uintptr {
+			if me, ok := _FileDlgEventsFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.typeChange
+				me.mutex.Unlock()
+				if fun != nil {
+					fun(NewIFileDialog(com.NewIUnknown(pfd)))
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	OnOverwrite: syscall.NewCallback(
+		func(pObj uintptr, pfd, item **comvt.IUnknown,
+			pResponse *shellco.FDEOR) uintptr {
+
+			if me, ok := _FileDlgEventsFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.overwrite
+				me.mutex.Unlock()
+				if fun != nil {
+					*pResponse = fun(NewIFileDialog(com.NewIUnknown(pfd)),
+						_BorrowedShellItem(item))
+					return uintptr(errco.S_OK)
+				}
+			}
+			return uintptr(errco.E_NOTIMPL) // default behavior
+		}),
+}
@@ -0,0 +1,343 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// The operations are queued by the Copy, Delete, Move, New and Rename methods,
+// and executed only when PerformOperations() is called.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifileoperation
+type IFileOperation interface {
+	com.IUnknown
+
+	// Returns a cookie to be passed to Unadvise().
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-advise
+	Advise(sink IFileOperationProgressSink) uint32
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-copyitem
+	CopyItem(item, destFolder IShellItem, copyName win.StrOpt,
+		sink IFileOperationProgressSink)
+
+	// The items can be an IShellItemArray, an IDataObject or an
+	// IEnumShellItems.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-copyitems
+	CopyItems(items com.IUnknown, destFolder IShellItem)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-deleteitem
+	DeleteItem(item IShellItem, sink IFileOperationProgressSink)
+
+	// The items can be an IShellItemArray, an IDataObject or an
+	// IEnumShellItems.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-deleteitems
+	DeleteItems(items com.IUnknown)
+
+	// Returns true if any operation was aborted by the user, or canceled by
+	// the progress sink.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-getanyoperationsaborted
+	GetAnyOperationsAborted() bool
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-moveitem
+	MoveItem(item, destFolder IShellItem, newName win.StrOpt,
+		sink IFileOperationProgressSink)
+
+	// The items can be an IShellItemArray, an IDataObject or an
+	// IEnumShellItems.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-moveitems
+	MoveItems(items com.IUnknown, destFolder IShellItem)
+
+	// Creates a new file or folder; pass co.FILE_ATTRIBUTE_DIRECTORY to
+	// create a folder.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-newitem
+	NewItem(destFolder IShellItem, fileAttributes co.FILE_ATTRIBUTE,
+		name string, templateName win.StrOpt, sink IFileOperationProgressSink)
+
+	// Executes all the queued operations. Returns an error if the operations
+	// failed or were canceled.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-performoperations
+	PerformOperations() error
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-renameitem
+	RenameItem(item IShellItem, newName string, sink IFileOperationProgressSink)
+
+	// The items can be an IShellItemArray, an IDataObject or an
+	// IEnumShellItems.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-renameitems
+	RenameItems(items com.IUnknown, newName string)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-setoperationflags
+	SetOperationFlags(flags shellco.FOF)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-setownerwindow
+	SetOwnerWindow(hOwner win.HWND)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-setprogressmessage
+	SetProgressMessage(message string)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-unadvise
+	Unadvise(cookie uint32)
+}
+
+type _IFileOperation struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IFileOperation.Release().
+//
+// Example:
+//
+//	fo := shell.NewIFileOperation(
+//		com.CoCreateInstance(
+//			shellco.CLSID_FileOperation, nil,
+//			comco.CLSCTX_ALL,
+//			shellco.IID_IFileOperation),
+//	)
+//	defer fo.Release()
+//
+//	fo.SetOperationFlags(shellco.FOF_ALLOWUNDO | shellco.FOFX_RECYCLEONDELETE)
+//
+//	file, _ := shell.NewShellItemFromPath("C:\\Temp\\foo.txt")
+//	defer file.Release()
+//
+//	fo.DeleteItem(file, nil)
+//	if err := fo.PerformOperations(); err != nil {
+//		println(err.Error())
+//	}
+func NewIFileOperation(base com.IUnknown) IFileOperation {
+	return &_IFileOperation{IUnknown: base}
+}
+
+func (me *_IFileOperation) Advise(sink IFileOperationProgressSink) uint32 {
+	var cookie uint32
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).Advise,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(sink.Ptr())),
+		uintptr(unsafe.Pointer(&cookie)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return cookie
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) CopyItem(
+	item, destFolder IShellItem, copyName win.StrOpt,
+	sink IFileOperationProgressSink) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).CopyItem,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(item.Ptr())),
+		uintptr(unsafe.Pointer(destFolder.Ptr())),
+		uintptr(copyName.Raw()), _ComPtrOrNil(sink))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) CopyItems(items com.IUnknown, destFolder IShellItem) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).CopyItems,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(items.Ptr())),
+		uintptr(unsafe.Pointer(destFolder.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) DeleteItem(
+	item IShellItem, sink IFileOperationProgressSink) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).DeleteItem,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(item.Ptr())), _ComPtrOrNil(sink))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) DeleteItems(items com.IUnknown) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).DeleteItems,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(items.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) GetAnyOperationsAborted() bool {
+	var aborted int32 // BOOL
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).GetAnyOperationsAborted,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&aborted)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return aborted != 0
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) MoveItem(
+	item, destFolder IShellItem, newName win.StrOpt,
+	sink IFileOperationProgressSink) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).MoveItem,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(item.Ptr())),
+		uintptr(unsafe.Pointer(destFolder.Ptr())),
+		uintptr(newName.Raw()), _ComPtrOrNil(sink))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) MoveItems(items com.IUnknown, destFolder IShellItem) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).MoveItems,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(items.Ptr())),
+		uintptr(unsafe.Pointer(destFolder.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) NewItem(
+	destFolder IShellItem, fileAttributes co.FILE_ATTRIBUTE,
+	name string, templateName win.StrOpt, sink IFileOperationProgressSink) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).NewItem,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(destFolder.Ptr())),
+		uintptr(fileAttributes),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(name))),
+		uintptr(templateName.Raw()), _ComPtrOrNil(sink))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) PerformOperations() error {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).PerformOperations,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else {
+		return hr
+	}
+}
+
+func (me *_IFileOperation) RenameItem(
+	item IShellItem, newName string, sink IFileOperationProgressSink) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).RenameItem,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(item.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(newName))),
+		_ComPtrOrNil(sink))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) RenameItems(items com.IUnknown, newName string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).RenameItems,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(items.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(newName))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) SetOperationFlags(flags shellco.FOF) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).SetOperationFlags,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(flags))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) SetOwnerWindow(hOwner win.HWND) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).SetOwnerWindow,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(hOwner))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) SetProgressMessage(message string) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).SetProgressMessage,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(message))))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IFileOperation) Unadvise(cookie uint32) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileOperation)(unsafe.Pointer(*me.Ptr())).Unadvise,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(cookie))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+// Returns the pointer of the COM object, or zero if it's nil.
+func _ComPtrOrNil(obj com.IUnknown) uintptr {
+	if com.IsObj(obj) {
+		return uintptr(unsafe.Pointer(obj.Ptr()))
+	}
+	return 0
+}
@@ -0,0 +1,590 @@
+//go:build windows
+
+package shell
+
+import (
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/comobj"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Go implementation of IFileOperationProgressSink, which calls the closures
+// you define for each notification. Notifications without a closure are
+// ignored.
+//
+// The IShellItem objects passed to the closures are owned by the caller, and
+// are valid only during the closure; don't Release() them, but call AddRef()
+// if you need to keep them.
+//
+// The closures are called in the same thread which called
+// IFileOperation.PerformOperations(), which blocks until all operations are
+// done. To update the UI, perform the operations in a separated goroutine, and
+// use RunUiThread().
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifileoperationprogresssink
+type IFileOperationProgressSink interface {
+	com.IUnknown
+
+	// Cancels the current operation and all pending ones. Can be called from
+	// any goroutine.
+	Cancel()
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-finishoperations
+	OnFinishOperations(fun func(hr errco.ERROR))
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-pausetimer
+	OnPauseTimer(fun func())
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-postcopyitem
+	OnPostCopyItem(fun func(flags shellco.TSF, item, destFolder IShellItem,
+		newName string, hr errco.ERROR, newItem IShellItem))
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-postdeleteitem
+	OnPostDeleteItem(fun func(flags shellco.TSF, item IShellItem,
+		hr errco.ERROR, newItem IShellItem))
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-postmoveitem
+	OnPostMoveItem(fun func(flags shellco.TSF, item, destFolder IShellItem,
+		newName string, hr errco.ERROR, newItem IShellItem))
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-postnewitem
+	OnPostNewItem(fun func(flags shellco.TSF, destFolder IShellItem,
+		newName, templateName string, fileAttributes co.FILE_ATTRIBUTE,
+		hr errco.ERROR, newItem IShellItem))
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-postrenameitem
+	OnPostRenameItem(fun func(flags shellco.TSF, item IShellItem,
+		newName string, hr errco.ERROR, newItem IShellItem))
+
+	// Return false to cancel the operation and all pending ones.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-precopyitem
+	OnPreCopyItem(fun func(flags shellco.TSF, item, destFolder IShellItem,
+		newName string) bool)
+
+	// Return false to cancel the operation and all pending ones.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-predeleteitem
+	OnPreDeleteItem(fun func(flags shellco.TSF, item IShellItem) bool)
+
+	// Return false to cancel the operation and all pending ones.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-premoveitem
+	OnPreMoveItem(fun func(flags shellco.TSF, item, destFolder IShellItem,
+		newName string) bool)
+
+	// Return false to cancel the operation and all pending ones.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-prenewitem
+	OnPreNewItem(fun func(flags shellco.TSF, destFolder IShellItem,
+		newName string) bool)
+
+	// Return false to cancel the operation and all pending ones.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-prerenameitem
+	OnPreRenameItem(fun func(flags shellco.TSF, item IShellItem,
+		newName string) bool)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-resettimer
+	OnResetTimer(fun func())
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-resumetimer
+	OnResumeTimer(fun func())
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-startoperations
+	OnStartOperations(fun func())
+
+	// The work is measured in arbitrary units. Return false to cancel the
+	// operation and all pending ones.
+	//
+	// Example:
+	//
+	//	var wnd ui.WindowMain // initialized somewhere
+	//	var pb ui.ProgressBar // initialized somewhere
+	//	var sink shell.IFileOperationProgressSink // initialized somewhere
+	//
+	//	sink.OnUpdateProgress(func(workTotal, workSoFar uint) bool {
+	//		wnd.RunUiThread(func() {
+	//			pb.SetRange(0, int(workTotal))
+	//			pb.SetPos(int(workSoFar))
+	//		})
+	//		return true
+	//	})
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-updateprogress
+	OnUpdateProgress(fun func(workTotal, workSoFar uint) bool)
+}
+
+type _IFileOperationProgressSink struct {
+	com.IUnknown
+	canceled atomic.Bool
+	mutex    sync.Mutex
+	events   struct {
+		finishOperations func(hr errco.ERROR)
+		pauseTimer       func()
+		postCopyItem     func(shellco.TSF, IShellItem, IShellItem, string, errco.ERROR, IShellItem)
+		postDeleteItem   func(shellco.TSF, IShellItem, errco.ERROR, IShellItem)
+		postMoveItem     func(shellco.TSF, IShellItem, IShellItem, string, errco.ERROR, IShellItem)
+		postNewItem      func(shellco.TSF, IShellItem, string, string, co.FILE_ATTRIBUTE, errco.ERROR, IShellItem)
+		postRenameItem   func(shellco.TSF, IShellItem, string, errco.ERROR, IShellItem)
+		preCopyItem      func(shellco.TSF, IShellItem, IShellItem, string) bool
+		preDeleteItem    func(shellco.TSF, IShellItem) bool
+		preMoveItem      func(shellco.TSF, IShellItem, IShellItem, string) bool
+		preNewItem       func(shellco.TSF, IShellItem, string) bool
+		preRenameItem    func(shellco.TSF, IShellItem, string) bool
+		resetTimer       func()
+		resumeTimer      func()
+		startOperations  func()
+		updateProgress   func(uint, uint) bool
+	}
+}
+
+// Creates a new IFileOperationProgressSink implemented in Go, to be passed to
+// IFileOperation.Advise() or to the individual operations.
+//
+// ⚠️ You must defer IFileOperationProgressSink.Release().
+//
+// Example:
+//
+//	var fo shell.IFileOperation // initialized somewhere
+//
+//	sink := shell.NewIFileOperationProgressSinkImpl()
+//	defer sink.Release()
+//
+//	sink.OnPreDeleteItem(func(flags shellco.TSF, item shell.IShellItem) bool {
+//		println("Deleting", item.GetDisplayName(shellco.SIGDN_FILESYSPATH))
+//		return true
+//	})
+//
+//	cookie := fo.Advise(sink)
+//	defer fo.Unadvise(cookie)
+func NewIFileOperationProgressSinkImpl() IFileOperationProgressSink {
+	me := &_IFileOperationProgressSink{}
+	me.IUnknown = com.NewIUnknown(
+		comobj.New(unsafe.Pointer(&_globalFileOpSinkVt), me,
+			shellco.IID_IFileOperationProgressSink),
+	)
+	return me
+}
+
+func (me *_IFileOperationProgressSink) Cancel() {
+	me.canceled.Store(true)
+}
+
+func (me *_IFileOperationProgressSink) OnFinishOperations(fun func(hr errco.ERROR)) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.finishOperations = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPauseTimer(fun func()) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.pauseTimer = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPostCopyItem(
+	fun func(flags shellco.TSF, item, destFolder IShellItem,
+		newName string, hr errco.ERROR, newItem IShellItem)) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.postCopyItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPostDeleteItem(
+	fun func(flags shellco.TSF, item IShellItem,
+		hr errco.ERROR, newItem IShellItem)) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.postDeleteItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPostMoveItem(
+	fun func(flags shellco.TSF, item, destFolder IShellItem,
+		newName string, hr errco.ERROR, newItem IShellItem)) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.postMoveItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPostNewItem(
+	fun func(flags shellco.TSF, destFolder IShellItem,
+		newName, templateName string, fileAttributes co.FILE_ATTRIBUTE,
+		hr errco.ERROR, newItem IShellItem)) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.postNewItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPostRenameItem(
+	fun func(flags shellco.TSF, item IShellItem,
+		newName string, hr errco.ERROR, newItem IShellItem)) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.postRenameItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPreCopyItem(
+	fun func(flags shellco.TSF, item, destFolder IShellItem,
+		newName string) bool) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.preCopyItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPreDeleteItem(
+	fun func(flags shellco.TSF, item IShellItem) bool) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.preDeleteItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPreMoveItem(
+	fun func(flags shellco.TSF, item, destFolder IShellItem,
+		newName string) bool) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.preMoveItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPreNewItem(
+	fun func(flags shellco.TSF, destFolder IShellItem,
+		newName string) bool) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.preNewItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnPreRenameItem(
+	fun func(flags shellco.TSF, item IShellItem, newName string) bool) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.preRenameItem = fun
+}
+
+func (me *_IFileOperationProgressSink) OnResetTimer(fun func()) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.resetTimer = fun
+}
+
+func (me *_IFileOperationProgressSink) OnResumeTimer(fun func()) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.resumeTimer = fun
+}
+
+func (me *_IFileOperationProgressSink) OnStartOperations(fun func()) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.startOperations = fun
+}
+
+func (me *_IFileOperationProgressSink) OnUpdateProgress(
+	fun func(workTotal, workSoFar uint) bool) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.updateProgress = fun
+}
+
+// Returns the Go object of the COM pointer received by the callbacks.
+func _FileOpSinkFrom(pObj uintptr) (*_IFileOperationProgressSink, bool) {
+	me, ok := comobj.Impl(pObj).(*_IFileOperationProgressSink)
+	return me, ok
+}
+
+// Returns S_OK, or the cancellation HRESULT if Cancel() was called or the
+// closure returned false.
+func (me *_IFileOperationProgressSink) continueOrCancel(proceed bool) uintptr {
+	if !proceed {
+		me.canceled.Store(true)
+	}
+	if me.canceled.Load() {
+		return uintptr(_HRESULT_FROM_WIN32(errco.CANCELLED))
+	}
+	return uintptr(errco.S_OK)
+}
+
+// Wraps a borrowed IShellItem pointer received by a callback, which can be
+// null.
+func _BorrowedShellItem(ppv **comvt.IUnknown) IShellItem {
+	if ppv == nil {
+		return nil
+	}
+	return NewIShellItem(com.NewIUnknown(ppv))
+}
+
+// Converts a Win32 error code into an HRESULT.
+func _HRESULT_FROM_WIN32(err errco.ERROR) errco.ERROR {
+	return errco.ERROR(0x8007_0000 | (uint32(err) & 0xffff))
+}
+
+var _globalFileOpSinkVt = shellvt.IFileOperationProgressSink{
+	IUnknown: comvt.IUnknown{
+		QueryInterface: comobj.QueryInterface,
+		AddRef:         comobj.AddRef,
+		Release:        comobj.Release,
+	},
+	StartOperations: syscall.NewCallback(
+		func(pObj uintptr) uintptr {
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.startOperations
+				me.mutex.Unlock()
+				if fun != nil {
+					fun()
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	FinishOperations: syscall.NewCallback(
+		func(pObj uintptr, hr uintptr) uintptr {
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.finishOperations
+				me.mutex.Unlock()
+				if fun != nil {
+					fun(errco.ERROR(hr))
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PreRenameItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, item **comvt.IUnknown,
+			newName *uint16) uintptr {
+
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.preRenameItem
+				me.mutex.Unlock()
+				proceed := true
+				if fun != nil {
+					proceed = fun(shellco.TSF(flags), _BorrowedShellItem(item),
+						win.Str.FromNativePtr(newName))
+				}
+				return me.continueOrCancel(proceed)
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PostRenameItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, item **comvt.IUnknown,
+			newName *uint16, hr uintptr, newItem **comvt.IUnknown) uintptr {
+
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.postRenameItem
+				me.mutex.Unlock()
+				if fun != nil {
+					fun(shellco.TSF(flags), _BorrowedShellItem(item),
+						win.Str.FromNativePtr(newName), errco.ERROR(hr),
+						_BorrowedShellItem(newItem))
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PreMoveItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, item, destFolder **comvt.IUnknown,
+			newName *uint16) uintptr {
+
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.preMoveItem
+				me.mutex.Unlock()
+				proceed := true
+				if fun != nil {
+					proceed = fun(shellco.TSF(flags), _BorrowedShellItem(item),
+						_BorrowedShellItem(destFolder), win.Str.FromNativePtr(newName))
+				}
+				return me.continueOrCancel(proceed)
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PostMoveItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, item, destFolder **comvt.IUnknown,
+			newName *uint16, hr uintptr, newItem **comvt.IUnknown) uintptr {
+
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.postMoveItem
+				me.mutex.Unlock()
+				if fun != nil {
+					fun(shellco.TSF(flags), _BorrowedShellItem(item),
+						_BorrowedShellItem(destFolder), win.Str.FromNativePtr(newName),
+						errco.ERROR(hr), _BorrowedShellItem(newItem))
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PreCopyItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, item, destFolder **comvt.IUnknown,
+			newName *uint16) uintptr {
+
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.preCopyItem
+				me.mutex.Unlock()
+				proceed := true
+				if fun != nil {
+					proceed = fun(shellco.TSF(flags), _BorrowedShellItem(item),
+						_BorrowedShellItem(destFolder), win.Str.FromNativePtr(newName))
+				}
+				return me.continueOrCancel(proceed)
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PostCopyItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, item, destFolder **comvt.IUnknown,
+			newName *uint16, hr uintptr, newItem **comvt.IUnknown) uintptr {
+
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.postCopyItem
+				me.mutex.Unlock()
+				if fun != nil {
+					fun(shellco.TSF(flags), _BorrowedShellItem(item),
+						_BorrowedShellItem(destFolder), win.Str.FromNativePtr(newName),
+						errco.ERROR(hr), _BorrowedShellItem(newItem))
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PreDeleteItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, item **comvt.IUnknown) uintptr {
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.preDeleteItem
+				me.mutex.Unlock()
+				proceed := true
+				if fun != nil {
+					proceed = fun(shellco.TSF(flags), _BorrowedShellItem(item))
+				}
+				return me.continueOrCancel(proceed)
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PostDeleteItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, item **comvt.IUnknown,
+			hr uintptr, newItem **comvt.IUnknown) uintptr {
+
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.postDeleteItem
+				me.mutex.Unlock()
+				if fun != nil {
+					fun(shellco.TSF(flags), _BorrowedShellItem(item),
+						errco.ERROR(hr), _BorrowedShellItem(newItem))
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PreNewItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, destFolder **comvt.IUnknown,
+			newName *uint16) uintptr {
+
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.preNewItem
+				me.mutex.Unlock()
+				proceed := true
+				if fun != nil {
+					proceed = fun(shellco.TSF(flags), _BorrowedShellItem(destFolder),
+						win.Str.FromNativePtr(newName))
+				}
+				return me.continueOrCancel(proceed)
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PostNewItem: syscall.NewCallback(
+		func(pObj uintptr, flags uintptr, destFolder **comvt.IUnknown,
+			newName, templateName *uint16, fileAttributes uintptr,
+			hr uintptr, newItem **comvt.IUnknown) uintptr {
+
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.postNewItem
+				me.mutex.Unlock()
+				if fun != nil {
+					var template string
+					if templateName != nil {
+						template = win.Str.FromNativePtr(templateName)
+					}
+					fun(shellco.TSF(flags), _BorrowedShellItem(destFolder),
+						win.Str.FromNativePtr(newName), template,
+						co.FILE_ATTRIBUTE(fileAttributes), errco.ERROR(hr),
+						_BorrowedShellItem(newItem))
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	UpdateProgress: syscall.NewCallback(
+		func(pObj uintptr, workTotal, workSoFar uintptr) uintptr {
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.updateProgress
+				me.mutex.Unlock()
+				proceed := true
+				if fun != nil {
+					proceed = fun(uint(uint32(workTotal)), uint(uint32(workSoFar)))
+				}
+				return me.continueOrCancel(proceed)
+			}
+			return uintptr(errco.S_OK)
+		}),
+	ResetTimer: syscall.NewCallback(
+		func(pObj uintptr) uintptr {
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.resetTimer
+				me.mutex.Unlock()
+				if fun != nil {
+					fun()
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	PauseTimer: syscall.NewCallback(
+		func(pObj uintptr) uintptr {
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.pauseTimer
+				me.mutex.Unlock()
+				if fun != nil {
+					fun()
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+	ResumeTimer: syscall.NewCallback(
+		func(pObj uintptr) uintptr {
+			if me, ok := _FileOpSinkFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.resumeTimer
+				me.mutex.Unlock()
+				if fun != nil {
+					fun()
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+}
@@ -0,0 +1,115 @@
+//go:build windows
+
+package shell
+
+import (
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/comobj"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Go implementation of INotificationActivationCallback, called by the system
+// when the user clicks a toast notification, or one of its buttons.
+//
+// Usually it's created by an IClassFactory registered with
+// com.CoRegisterClassObject(), under the CLSID set in the
+// PKEY_AppUserModel_ToastActivatorCLSID property of the application shortcut.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/notificationactivationcallback/nn-notificationactivationcallback-inotificationactivationcallback
+type INotificationActivationCallback interface {
+	com.IUnknown
+
+	// Called with the arguments of the clicked toast or button, and the values
+	// of the toast inputs, keyed by their IDs.
+	//
+	// ⚠️ If the object was registered from a single-threaded apartment
+	// (COINIT_APARTMENTTHREADED), the closure runs in the registering thread,
+	// dispatched by its message loop. If it was registered from the
+	// multithreaded apartment (COINIT_MULTITHREADED), the closure runs in an
+	// arbitrary COM thread, not in the UI thread.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/notificationactivationcallback/nf-notificationactivationcallback-inotificationactivationcallback-activate
+	OnActivate(fun func(appUserModelId, invokedArgs string, inputs map[string]string))
+}
+
+type _INotificationActivationCallback struct {
+	com.IUnknown
+	mutex  sync.Mutex
+	events struct {
+		activate func(string, string, map[string]string)
+	}
+}
+
+// Creates a new INotificationActivationCallback implemented in Go.
+//
+// ⚠️ You must defer INotificationActivationCallback.Release().
+//
+// Example:
+//
+//	callback := shell.NewINotificationActivationCallbackImpl()
+//	defer callback.Release()
+//
+//	callback.OnActivate(func(appId, args string, inputs map[string]string) {
+//		println(args, inputs["reply"])
+//	})
+func NewINotificationActivationCallbackImpl() INotificationActivationCallback {
+	me := &_INotificationActivationCallback{}
+	me.IUnknown = com.NewIUnknown(
+		comobj.New(unsafe.Pointer(&_globalNotifActivationCallbackVt), me,
+			shellco.IID_INotificationActivationCallback),
+	)
+	return me
+}
+
+func (me *_INotificationActivationCallback) OnActivate(
+	fun func(appUserModelId, invokedArgs string, inputs map[string]string)) {
+
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.events.activate = fun
+}
+
+// Returns the Go object of the COM pointer received by the callbacks.
+func _NotifActivationCallbackFrom(
+	pObj uintptr) (*_INotificationActivationCallback, bool) {
+
+	me, ok := comobj.Impl(pObj).(*_INotificationActivationCallback)
+	return me, ok
+}
+
+var _globalNotifActivationCallbackVt = shellvt.INotificationActivationCallback{
+	IUnknown: comvt.IUnknown{
+		QueryInterface: comobj.QueryInterface,
+		AddRef:         comobj.AddRef,
+		Release:        comobj.Release,
+	},
+	Activate: syscall.NewCallback(
+		func(pObj uintptr, appUserModelId, invokedArgs *uint16,
+			data *NOTIFICATION_USER_INPUT_DATA, count uint32) uintptr {
+
+			if me, ok := _NotifActivationCallbackFrom(pObj); ok {
+				me.mutex.Lock()
+				fun := me.events.activate
+				me.mutex.Unlock()
+				if fun != nil {
+					inputs := make(map[string]string, count)
+					if count > 0 {
+						for _, d := range unsafe.Slice(data, count) {
+							inputs[win.Str.FromNativePtr(d.Key)] = win.Str.FromNativePtr(d.Value)
+						}
+					}
+					fun(win.Str.FromNativePtr(appUserModelId),
+						win.Str.FromNativePtr(invokedArgs), inputs)
+				}
+			}
+			return uintptr(errco.S_OK)
+		}),
+}
@@ -0,0 +1,75 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nn-objectarray-iobjectarray
+type IObjectArray interface {
+	com.IUnknown
+
+	// Returns the object at the given index, queried for the given interface.
+	//
+	// ⚠️ You must defer IUnknown.Release() on the returned object.
+	//
+	// Example:
+	//
+	//	var arr shell.IObjectArray // initialized somewhere
+	//
+	//	lnk := shell.NewIShellLink(arr.GetAt(0, shellco.IID_IShellLink))
+	//	defer lnk.Release()
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nf-objectarray-iobjectarray-getat
+	GetAt(index int, riid co.IID) com.IUnknown
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nf-objectarray-iobjectarray-getcount
+	GetCount() int
+}
+
+type _IObjectArray struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IObjectArray.Release().
+func NewIObjectArray(base com.IUnknown) IObjectArray {
+	return &_IObjectArray{IUnknown: base}
+}
+
+func (me *_IObjectArray) GetAt(index int, riid co.IID) com.IUnknown {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IObjectArray)(unsafe.Pointer(*me.Ptr())).GetAt,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(index), uintptr(unsafe.Pointer(win.GuidFromIid(riid))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return com.NewIUnknown(ppvQueried)
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IObjectArray) GetCount() int {
+	var count uint32
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IObjectArray)(unsafe.Pointer(*me.Ptr())).GetCount,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&count)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return int(count)
+	} else {
+		panic(hr)
+	}
+}
@@ -0,0 +1,91 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nn-objectarray-iobjectcollection
+type IObjectCollection interface {
+	IObjectArray
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nf-objectarray-iobjectcollection-addfromarray
+	AddFromArray(source IObjectArray)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nf-objectarray-iobjectcollection-addobject
+	AddObject(obj com.IUnknown)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nf-objectarray-iobjectcollection-clear
+	Clear()
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nf-objectarray-iobjectcollection-removeobjectat
+	RemoveObjectAt(index int)
+}
+
+type _IObjectCollection struct{ IObjectArray }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IObjectCollection.Release().
+//
+// Example:
+//
+//	coll := shell.NewIObjectCollection(
+//		com.CoCreateInstance(
+//			shellco.CLSID_EnumerableObjectCollection, nil,
+//			comco.CLSCTX_INPROC_SERVER,
+//			shellco.IID_IObjectCollection),
+//	)
+//	defer coll.Release()
+func NewIObjectCollection(base com.IUnknown) IObjectCollection {
+	return &_IObjectCollection{IObjectArray: NewIObjectArray(base)}
+}
+
+func (me *_IObjectCollection) AddFromArray(source IObjectArray) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IObjectCollection)(unsafe.Pointer(*me.Ptr())).AddFromArray,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(source.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IObjectCollection) AddObject(obj com.IUnknown) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IObjectCollection)(unsafe.Pointer(*me.Ptr())).AddObject,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(obj.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IObjectCollection) Clear() {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IObjectCollection)(unsafe.Pointer(*me.Ptr())).Clear,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IObjectCollection) RemoveObjectAt(index int) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IObjectCollection)(unsafe.Pointer(*me.Ptr())).RemoveObjectAt,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(index))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
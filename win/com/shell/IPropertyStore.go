@@ -0,0 +1,142 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/autom"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/propsys/nn-propsys-ipropertystore
+type IPropertyStore interface {
+	com.IUnknown
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/propsys/nf-propsys-ipropertystore-commit
+	Commit()
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/propsys/nf-propsys-ipropertystore-getat
+	GetAt(index int) shellco.PKEY
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/propsys/nf-propsys-ipropertystore-getcount
+	GetCount() int
+
+	// ⚠️ You must defer PROPVARIANT.PropVariantClear() on the returned value.
+	//
+	// Example:
+	//
+	//	var ps shell.IPropertyStore // initialized somewhere
+	//
+	//	pv := ps.GetValue(shellco.PKEY_Title)
+	//	defer pv.PropVariantClear()
+	//
+	//	if title, ok := pv.Value().(string); ok {
+	//		println(title)
+	//	}
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/propsys/nf-propsys-ipropertystore-getvalue
+	GetValue(key shellco.PKEY) autom.PROPVARIANT
+
+	// Example:
+	//
+	//	var ps shell.IPropertyStore // initialized somewhere
+	//
+	//	pv := autom.NewPropVariantStr("My title")
+	//	defer pv.PropVariantClear()
+	//
+	//	ps.SetValue(shellco.PKEY_Title, &pv)
+	//	ps.Commit()
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/propsys/nf-propsys-ipropertystore-setvalue
+	SetValue(key shellco.PKEY, value *autom.PROPVARIANT)
+}
+
+type _IPropertyStore struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IPropertyStore.Release().
+//
+// Example:
+//
+//	var lnk shell.IShellLink // initialized somewhere
+//
+//	ps := shell.NewIPropertyStore(
+//		lnk.QueryInterface(shellco.IID_IPropertyStore),
+//	)
+//	defer ps.Release()
+func NewIPropertyStore(base com.IUnknown) IPropertyStore {
+	return &_IPropertyStore{IUnknown: base}
+}
+
+func (me *_IPropertyStore) Commit() {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IPropertyStore)(unsafe.Pointer(*me.Ptr())).Commit,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IPropertyStore) GetAt(index int) shellco.PKEY {
+	var key PROPERTYKEY
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IPropertyStore)(unsafe.Pointer(*me.Ptr())).GetAt,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(index), uintptr(unsafe.Pointer(&key)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return key.PKey()
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IPropertyStore) GetCount() int {
+	var count uint32
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IPropertyStore)(unsafe.Pointer(*me.Ptr())).GetCount,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&count)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return int(count)
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IPropertyStore) GetValue(key shellco.PKEY) autom.PROPVARIANT {
+	pk := PropertyKeyFrom(key)
+	var pv autom.PROPVARIANT
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IPropertyStore)(unsafe.Pointer(*me.Ptr())).GetValue,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(&pv)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return pv
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IPropertyStore) SetValue(
+	key shellco.PKEY, value *autom.PROPVARIANT) {
+
+	pk := PropertyKeyFrom(key)
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IPropertyStore)(unsafe.Pointer(*me.Ptr())).SetValue,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(value)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
@@ -0,0 +1,166 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ishellfolder
+type IShellFolder interface {
+	com.IUnknown
+
+	// ⚠️ The returned object must be released.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellfolder-bindtoobject
+	BindToObject(pidl PIDL, bc com.IBindCtx, riid co.IID) (com.IUnknown, error)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellfolder-getattributesof
+	GetAttributesOf(children []PIDL, mask co.SFGAO) co.SFGAO
+
+	// Retrieves an object which can be used to carry out actions upon the
+	// given children, like IContextMenu or IDataObject.
+	//
+	// ⚠️ The returned object must be released.
+	//
+	// Example:
+	//
+	//	var folder shell.IShellFolder // initialized somewhere
+	//	var children []shell.PIDL
+	//
+	//	obj, err := folder.GetUIObjectOf(
+	//		win.HWND(0), children, shellco.IID_IContextMenu)
+	//	if err != nil {
+	//		panic(err)
+	//	}
+	//	cm := shell.NewIContextMenu(obj)
+	//	defer cm.Release()
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellfolder-getuiobjectof
+	GetUIObjectOf(hwndOwner win.HWND,
+		children []PIDL, riid co.IID) (com.IUnknown, error)
+
+	// Returns a PIDL relative to the folder.
+	//
+	// ⚠️ You must defer PIDL.ILFree() on the returned PIDL.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellfolder-parsedisplayname
+	ParseDisplayName(hWnd win.HWND,
+		bc com.IBindCtx, displayName string) (PIDL, error)
+}
+
+type _IShellFolder struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IShellFolder.Release().
+//
+// Example:
+//
+//	pidl, _ := shell.SHParseDisplayName("C:\\Temp\\foo.txt")
+//	defer pidl.ILFree()
+//
+//	folder, child, _ := shell.SHBindToParent(pidl)
+//	defer folder.Release()
+func NewIShellFolder(base com.IUnknown) IShellFolder {
+	return &_IShellFolder{IUnknown: base}
+}
+
+func (me *_IShellFolder) BindToObject(
+	pidl PIDL, bc com.IBindCtx, riid co.IID) (com.IUnknown, error) {
+
+	var pBc uintptr
+	if bc != nil {
+		pBc = uintptr(unsafe.Pointer(bc.Ptr()))
+	}
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellFolder)(unsafe.Pointer(*me.Ptr())).BindToObject,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(pidl), pBc,
+		uintptr(unsafe.Pointer(win.GuidFromIid(riid))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return com.NewIUnknown(ppvQueried), nil
+	} else {
+		return nil, hr
+	}
+}
+
+func (me *_IShellFolder) GetAttributesOf(
+	children []PIDL, mask co.SFGAO) co.SFGAO {
+
+	var pChildren uintptr
+	if len(children) > 0 {
+		pChildren = uintptr(unsafe.Pointer(&children[0]))
+	}
+
+	attrs := mask
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellFolder)(unsafe.Pointer(*me.Ptr())).GetAttributesOf,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(len(children)), pChildren,
+		uintptr(unsafe.Pointer(&attrs)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return attrs
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IShellFolder) GetUIObjectOf(
+	hwndOwner win.HWND, children []PIDL, riid co.IID) (com.IUnknown, error) {
+
+	var pChildren uintptr
+	if len(children) > 0 {
+		pChildren = uintptr(unsafe.Pointer(&children[0]))
+	}
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellFolder)(unsafe.Pointer(*me.Ptr())).GetUIObjectOf,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(hwndOwner), uintptr(len(children)), pChildren,
+		uintptr(unsafe.Pointer(win.GuidFromIid(riid))), 0,
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return com.NewIUnknown(ppvQueried), nil
+	} else {
+		return nil, hr
+	}
+}
+
+func (me *_IShellFolder) ParseDisplayName(
+	hWnd win.HWND, bc com.IBindCtx, displayName string) (PIDL, error) {
+
+	var pBc uintptr
+	if bc != nil {
+		pBc = uintptr(unsafe.Pointer(bc.Ptr()))
+	}
+
+	var pidl PIDL
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellFolder)(unsafe.Pointer(*me.Ptr())).ParseDisplayName,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(hWnd), pBc,
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(displayName))),
+		0, uintptr(unsafe.Pointer(&pidl)), 0)
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return pidl, nil
+	} else {
+		return PIDL(0), hr
+	}
+}
@@ -20,6 +20,35 @@ import (
 type IShellItem interface {
 	com.IUnknown
 
+	// Returns an error if the item has no handler of the given kind, like
+	// BHID_EnumItems on a file.
+	//
+	// ⚠️ You must defer Release() on the returned object.
+	//
+	// Example:
+	//
+	//	var shi shell.IShellItem // initialized somewhere
+	//
+	//	obj, err := shi.BindToHandler(nil,
+	//		shellco.BHID_EnumItems, shellco.IID_IEnumShellItems)
+	//	if err != nil {
+	//		panic(err)
+	//	}
+	//	enumItems := shell.NewIEnumShellItems(obj)
+	//	defer enumItems.Release()
+	//
+	//	for {
+	//		child, gotOne := enumItems.Next()
+	//		if !gotOne {
+	//			break
+	//		}
+	//		println(child.GetDisplayName(shellco.SIGDN_FILESYSPATH))
+	//		child.Release()
+	//	}
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem-bindtohandler
+	BindToHandler(bc com.IBindCtx, bhid shellco.BHID, riid co.IID) (com.IUnknown, error)
+
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem-compare
 	Compare(si IShellItem, hint shellco.SICHINT) bool
 
@@ -39,6 +68,13 @@ type IShellItem interface {
 	//
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem-getdisplayname
 	GetDisplayName(sigdnName shellco.SIGDN) string
+
+	// Same as IShellItem.GetDisplayName(), but returns an error instead of
+	// panicking, like when SIGDN_FILESYSPATH is requested for an item which is
+	// not in the file system.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem-getdisplayname
+	GetDisplayNameErr(sigdnName shellco.SIGDN) (string, error)
 }
 
 type _IShellItem struct{ com.IUnknown }
@@ -75,6 +111,29 @@ func NewShellItemFromPath(folderOrFilePath string) (IShellItem, error) {
 	}
 }
 
+func (me *_IShellItem) BindToHandler(
+	bc com.IBindCtx, bhid shellco.BHID, riid co.IID) (com.IUnknown, error) {
+
+	var pBc uintptr
+	if bc != nil {
+		pBc = uintptr(unsafe.Pointer(bc.Ptr()))
+	}
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem)(unsafe.Pointer(*me.Ptr())).BindToHandler,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		pBc, uintptr(unsafe.Pointer(win.GuidFromClsid(co.CLSID(bhid)))),
+		uintptr(unsafe.Pointer(win.GuidFromIid(riid))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return com.NewIUnknown(ppvQueried), nil
+	} else {
+		return nil, hr
+	}
+}
+
 func (me *_IShellItem) Compare(si IShellItem, hint shellco.SICHINT) bool {
 	var piOrder uint32
 	ret, _, _ := syscall.SyscallN(
@@ -123,6 +182,14 @@ func (me *_IShellItem) GetParent() IShellItem {
 }
 
 func (me *_IShellItem) GetDisplayName(sigdnName shellco.SIGDN) string {
+	name, err := me.GetDisplayNameErr(sigdnName)
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
+
+func (me *_IShellItem) GetDisplayNameErr(sigdnName shellco.SIGDN) (string, error) {
 	var pv uintptr
 	ret, _, _ := syscall.SyscallN(
 		(*shellvt.IShellItem)(unsafe.Pointer(*me.Ptr())).GetDisplayName,
@@ -132,8 +199,8 @@ func (me *_IShellItem) GetDisplayName(sigdnName shellco.SIGDN) string {
 	if hr := errco.ERROR(ret); hr == errco.S_OK {
 		defer win.HTASKMEM(pv).CoTaskMemFree()
 		name := win.Str.FromNativePtr((*uint16)(unsafe.Pointer(pv)))
-		return name
+		return name, nil
 	} else {
-		panic(hr)
+		return "", hr
 	}
 }
@@ -0,0 +1,252 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/autom"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// The typed getters return an error if the property is not present in the
+// item, or if it cannot be converted to the requested type.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ishellitem2
+type IShellItem2 interface {
+	IShellItem
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-getbool
+	GetBool(key shellco.PKEY) (bool, error)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-getclsid
+	GetCLSID(key shellco.PKEY) (co.CLSID, error)
+
+	// Example:
+	//
+	//	var shi2 shell.IShellItem2 // initialized somewhere
+	//
+	//	modified, _ := shi2.GetFileTime(shellco.PKEY_DateModified)
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-getfiletime
+	GetFileTime(key shellco.PKEY) (time.Time, error)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-getint32
+	GetInt32(key shellco.PKEY) (int32, error)
+
+	// If the property is not present in the item, returns a VT_EMPTY value.
+	//
+	// ⚠️ You must defer PROPVARIANT.PropVariantClear() on the returned value.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-getproperty
+	GetProperty(key shellco.PKEY) autom.PROPVARIANT
+
+	// ⚠️ You must defer IPropertyStore.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-getpropertystore
+	GetPropertyStore(flags shellco.GPS) IPropertyStore
+
+	// Example:
+	//
+	//	var shi2 shell.IShellItem2 // initialized somewhere
+	//
+	//	artist, _ := shi2.GetString(shellco.PKEY_Music_Artist)
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-getstring
+	GetString(key shellco.PKEY) (string, error)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-getuint32
+	GetUInt32(key shellco.PKEY) (uint32, error)
+
+	// Example:
+	//
+	//	var shi2 shell.IShellItem2 // initialized somewhere
+	//
+	//	size, _ := shi2.GetUInt64(shellco.PKEY_Size)
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-getuint64
+	GetUInt64(key shellco.PKEY) (uint64, error)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem2-update
+	Update(bc com.IBindCtx)
+}
+
+type _IShellItem2 struct{ IShellItem }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IShellItem2.Release().
+//
+// Example:
+//
+//	shi, _ := shell.NewShellItemFromPath("C:\\Temp\\song.mp3")
+//	defer shi.Release()
+//
+//	shi2 := shell.NewIShellItem2(
+//		shi.QueryInterface(shellco.IID_IShellItem2),
+//	)
+//	defer shi2.Release()
+func NewIShellItem2(base com.IUnknown) IShellItem2 {
+	return &_IShellItem2{IShellItem: NewIShellItem(base)}
+}
+
+func (me *_IShellItem2) GetBool(key shellco.PKEY) (bool, error) {
+	pk := PropertyKeyFrom(key)
+	var pf int32 // BOOL
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).GetBool,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(&pf)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return pf != 0, nil
+	} else {
+		return false, hr
+	}
+}
+
+func (me *_IShellItem2) GetCLSID(key shellco.PKEY) (co.CLSID, error) {
+	pk := PropertyKeyFrom(key)
+	var guid win.GUID
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).GetCLSID,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(&guid)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return co.CLSID(guid.String()), nil
+	} else {
+		return "", hr
+	}
+}
+
+func (me *_IShellItem2) GetFileTime(key shellco.PKEY) (time.Time, error) {
+	pk := PropertyKeyFrom(key)
+	var ft win.FILETIME
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).GetFileTime,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(&ft)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return ft.ToTime(), nil
+	} else {
+		return time.Time{}, hr
+	}
+}
+
+func (me *_IShellItem2) GetInt32(key shellco.PKEY) (int32, error) {
+	pk := PropertyKeyFrom(key)
+	var val int32
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).GetInt32,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(&val)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return val, nil
+	} else {
+		return 0, hr
+	}
+}
+
+func (me *_IShellItem2) GetProperty(key shellco.PKEY) autom.PROPVARIANT {
+	pk := PropertyKeyFrom(key)
+	var pv autom.PROPVARIANT
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).GetProperty,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(&pv)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return pv
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IShellItem2) GetPropertyStore(flags shellco.GPS) IPropertyStore {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).GetPropertyStore,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(flags),
+		uintptr(unsafe.Pointer(win.GuidFromIid(shellco.IID_IPropertyStore))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIPropertyStore(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IShellItem2) GetString(key shellco.PKEY) (string, error) {
+	pk := PropertyKeyFrom(key)
+	var pv uintptr
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).GetString,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(&pv)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		defer win.HTASKMEM(pv).CoTaskMemFree()
+		return win.Str.FromNativePtr((*uint16)(unsafe.Pointer(pv))), nil
+	} else {
+		return "", hr
+	}
+}
+
+func (me *_IShellItem2) GetUInt32(key shellco.PKEY) (uint32, error) {
+	pk := PropertyKeyFrom(key)
+	var val uint32
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).GetUInt32,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(&val)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return val, nil
+	} else {
+		return 0, hr
+	}
+}
+
+func (me *_IShellItem2) GetUInt64(key shellco.PKEY) (uint64, error) {
+	pk := PropertyKeyFrom(key)
+	var val uint64
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).GetUInt64,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pk)), uintptr(unsafe.Pointer(&val)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return val, nil
+	} else {
+		return 0, hr
+	}
+}
+
+func (me *_IShellItem2) Update(bc com.IBindCtx) {
+	var pBc uintptr
+	if bc != nil {
+		pBc = uintptr(unsafe.Pointer(bc.Ptr()))
+	}
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellItem2)(unsafe.Pointer(*me.Ptr())).Update,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		pBc)
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
@@ -8,10 +8,13 @@ import (
 
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/autom"
 	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comco"
 	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
 	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
 	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/lnk"
 )
 
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ishelllinkw
@@ -24,6 +27,9 @@ type IShellLink interface {
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishelllinkw-getdescription
 	GetDescription() string
 
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishelllinkw-gethotkey
+	GetHotkey() (vk co.VK, modifiers co.HOTKEYF)
+
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishelllinkw-geticonlocation
 	GetIconLocation() (path string, index int32)
 
@@ -45,6 +51,9 @@ type IShellLink interface {
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishelllinkw-setdescription
 	SetDescription(descr string)
 
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishelllinkw-sethotkey
+	SetHotkey(vk co.VK, modifiers co.HOTKEYF)
+
 	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishelllinkw-seticonlocation
 	SetIconLocation(path string, index int32)
 
@@ -80,6 +89,144 @@ func NewIShellLink(base com.IUnknown) IShellLink {
 	return &_IShellLink{IUnknown: base}
 }
 
+// Creates a new shell link object with the contents of the .lnk file, applied
+// through the IShellLink setters. The properties with string, uint32 and bool
+// values are also applied, through IPropertyStore.
+//
+// ⚠️ You must defer IShellLink.Release().
+//
+// Example:
+//
+//	fin, _ := os.Open("C:\\Temp\\generated.lnk")
+//	defer fin.Close()
+//	lnkFile, _ := lnk.Read(fin)
+//
+//	sl := shell.ShellLinkFromLnk(lnkFile)
+//	defer sl.Release()
+//
+//	pf := com.NewIPersistFile(sl.QueryInterface(comco.IID_IPersistFile))
+//	defer pf.Release()
+//	pf.Save(win.StrOptSome("C:\\Temp\\resaved.lnk"), true)
+func ShellLinkFromLnk(lnkFile *lnk.File) IShellLink {
+	sl := NewIShellLink(
+		com.CoCreateInstance(
+			shellco.CLSID_ShellLink, nil,
+			comco.CLSCTX_INPROC_SERVER,
+			shellco.IID_IShellLink),
+	)
+
+	if target := lnkFile.TargetPath(); target != "" {
+		sl.SetPath(target)
+	}
+	if lnkFile.RelativePath != "" {
+		sl.SetRelativePath(lnkFile.RelativePath)
+	}
+	if lnkFile.Arguments != "" {
+		sl.SetArguments(lnkFile.Arguments)
+	}
+	if lnkFile.Name != "" {
+		sl.SetDescription(lnkFile.Name)
+	}
+	if lnkFile.WorkingDir != "" {
+		sl.SetWorkingDirectory(lnkFile.WorkingDir)
+	}
+
+	iconPath := lnkFile.IconLocation
+	if lnkFile.IconEnvironment != "" {
+		iconPath = lnkFile.IconEnvironment
+	}
+	if iconPath != "" {
+		sl.SetIconLocation(iconPath, lnkFile.IconIndex)
+	}
+
+	if lnkFile.ShowCmd != 0 {
+		sl.SetShowCmd(co.SW(lnkFile.ShowCmd))
+	}
+	if lnkFile.Hotkey != 0 {
+		sl.SetHotkey(co.VK(lnkFile.Hotkey&0xff), co.HOTKEYF(lnkFile.Hotkey>>8))
+	}
+
+	if len(lnkFile.Properties) > 0 {
+		ps := NewIPropertyStore(sl.QueryInterface(shellco.IID_IPropertyStore))
+		defer ps.Release()
+
+		for i := range lnkFile.Properties {
+			prop := &lnkFile.Properties[i]
+			var pv autom.PROPVARIANT
+			if prop.Name != "" {
+				continue // named properties cannot be set through a PKEY
+			} else if str, ok := prop.Str(); ok {
+				pv = autom.NewPropVariantStr(str)
+			} else if num, ok := prop.Uint32(); ok {
+				pv = autom.NewPropVariantUint32(num)
+			} else if b, ok := prop.Bool(); ok {
+				pv = autom.NewPropVariantBool(b)
+			} else {
+				continue
+			}
+			ps.SetValue(shellco.PKEY(prop.Key()), &pv)
+			pv.PropVariantClear()
+		}
+		ps.Commit()
+	}
+
+	return sl
+}
+
+// Creates a new .lnk file with the contents of the shell link object, read
+// through the IShellLink getters. The properties with string, uint32 and bool
+// values are also read, through IPropertyStore.
+//
+// Example:
+//
+//	var sl shell.IShellLink // initialized somewhere
+//
+//	lnkFile := shell.LnkFromShellLink(sl)
+//	println(lnkFile.TargetPath())
+func LnkFromShellLink(sl IShellLink) *lnk.File {
+	var fd win.WIN32_FIND_DATA
+	f := lnk.New(sl.GetPath(&fd, shellco.SLGP_RAWPATH))
+
+	f.FileAttributes = uint32(fd.DwFileAttributes)
+	if fd.FtCreationTime.EpochNano100() != 0 {
+		f.CreationTime = fd.FtCreationTime.ToTime()
+	}
+	if fd.FtLastAccessTime.EpochNano100() != 0 {
+		f.AccessTime = fd.FtLastAccessTime.ToTime()
+	}
+	if fd.FtLastWriteTime.EpochNano100() != 0 {
+		f.WriteTime = fd.FtLastWriteTime.ToTime()
+	}
+	f.FileSize = fd.NFileSizeLow
+
+	f.Arguments = sl.GetArguments()
+	f.Name = sl.GetDescription()
+	f.WorkingDir = sl.GetWorkingDirectory()
+	f.IconLocation, f.IconIndex = sl.GetIconLocation()
+	f.ShowCmd = int(sl.GetShowCmd())
+	vk, modifiers := sl.GetHotkey()
+	f.Hotkey = uint16(vk)&0xff | uint16(modifiers)<<8
+
+	ps := NewIPropertyStore(sl.QueryInterface(shellco.IID_IPropertyStore))
+	defer ps.Release()
+
+	for i := 0; i < ps.GetCount(); i++ {
+		pkey := ps.GetAt(i)
+		pv := ps.GetValue(pkey)
+		switch v := pv.Value().(type) {
+		case string:
+			f.SetProperty(lnk.NewPropertyStr(string(pkey), v))
+		case uint32:
+			f.SetProperty(lnk.NewPropertyUint32(string(pkey), v))
+		case bool:
+			f.SetProperty(lnk.NewPropertyBool(string(pkey), v))
+		}
+		pv.PropVariantClear()
+	}
+
+	return f
+}
+
 func (me *_IShellLink) GetArguments() string {
 	buf := make([]uint16, 1024) // arbitrary
 	ret, _, _ := syscall.SyscallN(
@@ -108,6 +255,20 @@ func (me *_IShellLink) GetDescription() string {
 	}
 }
 
+func (me *_IShellLink) GetHotkey() (vk co.VK, modifiers co.HOTKEYF) {
+	var hotkey uint16
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellLink)(unsafe.Pointer(*me.Ptr())).GetHotkey,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&hotkey)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return co.VK(hotkey & 0xff), co.HOTKEYF(hotkey >> 8)
+	} else {
+		panic(hr)
+	}
+}
+
 func (me *_IShellLink) GetIconLocation() (path string, index int32) {
 	buf := make([]uint16, 256) // arbitrary
 	iconIndex := int32(0)
@@ -203,9 +364,20 @@ func (me *_IShellLink) SetDescription(descr string) {
 	}
 }
 
+func (me *_IShellLink) SetHotkey(vk co.VK, modifiers co.HOTKEYF) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IShellLink)(unsafe.Pointer(*me.Ptr())).SetHotkey,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint16(vk)&0xff|uint16(modifiers)<<8))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
 func (me *_IShellLink) SetIconLocation(path string, index int32) {
 	ret, _, _ := syscall.SyscallN(
-		(*shellvt.IShellLink)(unsafe.Pointer(*me.Ptr())).SetDescription,
+		(*shellvt.IShellLink)(unsafe.Pointer(*me.Ptr())).SetIconLocation,
 		uintptr(unsafe.Pointer(me.Ptr())),
 		uintptr(unsafe.Pointer(win.Str.ToNativePtr(path))),
 		uintptr(index))
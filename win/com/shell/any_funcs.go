@@ -3,11 +3,16 @@
 package shell
 
 import (
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/internal/proc"
 	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
 	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
 	"github.com/rodrigocfd/windigo/win/errco"
 )
@@ -22,6 +27,89 @@ func RegisterDragDrop(hWnd win.HWND, dropTarget *shellvt.IDropTarget) {
 	}
 }
 
+// Registers a ProgID for the current user, under
+// HKEY_CURRENT_USER\Software\Classes, along with its icon, its verbs and the
+// file extensions it handles. Then calls SHChangeNotify() with
+// SHCNE_ASSOCCHANGED.
+//
+// Each extension has the ProgID added to its OpenWithProgids key, and set as
+// its default, although the default chosen by the user still takes
+// precedence.
+//
+// Example:
+//
+//	err := shell.RegisterProgId(&shell.ProgId{
+//		Id:           "MyCompany.MyApp.Document.1",
+//		FriendlyName: "MyApp Document",
+//		DefaultIcon:  "C:\\MyApp\\myapp.exe,0",
+//		Extensions:   []string{".mydoc"},
+//		Verbs: []shell.Verb{
+//			{Name: "open", Command: `"C:\MyApp\myapp.exe" "%1"`},
+//			{Name: "print", Command: `"C:\MyApp\myapp.exe" /p "%1"`},
+//		},
+//	})
+func RegisterProgId(progId *ProgId) error {
+	classKey := _REG_CLASSES + progId.Id
+
+	if err := _RegPutStr(classKey, "", progId.FriendlyName); err != nil {
+		return err
+	}
+	if progId.DefaultIcon != "" {
+		err := _RegPutStr(classKey+"\\DefaultIcon", "", progId.DefaultIcon)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range progId.Verbs {
+		if err := _RegPutVerb(progId.Id, &progId.Verbs[i]); err != nil {
+			return err
+		}
+	}
+	if len(progId.Verbs) > 0 {
+		err := _RegPutStr(classKey+"\\shell", "", progId.Verbs[0].Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ext := range progId.Extensions {
+		err := win.HKEY_CURRENT_USER.RegSetKeyValue(
+			_REG_CLASSES+ext+"\\OpenWithProgids", progId.Id, co.REG_NONE, nil, 0)
+		if err != nil {
+			return err
+		}
+		if err := _RegPutStr(_REG_CLASSES+ext, "", progId.Id); err != nil {
+			return err
+		}
+	}
+
+	win.SHChangeNotify(co.SHCNE_ASSOCCHANGED, co.SHCNF_IDLIST, nil, nil)
+	return nil
+}
+
+// Registers a context menu verb for the current user, under
+// HKEY_CURRENT_USER\Software\Classes. Then calls SHChangeNotify() with
+// SHCNE_ASSOCCHANGED.
+//
+// The class can be a ProgID, "*" for all files, "Directory" for folders, or
+// "SystemFileAssociations\\.ext" for a single extension.
+//
+// Example adding an entry to the context menu of all files:
+//
+//	err := shell.RegisterVerb("*", &shell.Verb{
+//		Name:    "MyApp.Edit",
+//		Label:   "&Edit with MyApp",
+//		Command: `"C:\MyApp\myapp.exe" "%1"`,
+//	})
+func RegisterVerb(class string, verb *Verb) error {
+	if err := _RegPutVerb(class, verb); err != nil {
+		return err
+	}
+	win.SHChangeNotify(co.SHCNE_ASSOCCHANGED, co.SHCNF_IDLIST, nil, nil)
+	return nil
+}
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/ole2/nf-ole2-revokedragdrop
 func RevokeDragDrop(hWnd win.HWND) {
 	ret, _, _ := syscall.SyscallN(proc.RevokeDragDrop.Addr(),
@@ -31,3 +119,250 @@ func RevokeDragDrop(hWnd win.HWND) {
 		panic(hr)
 	}
 }
+
+// Returns the parent folder of the given absolute PIDL, and the last item of
+// the PIDL, relative to the parent folder. The returned child PIDL points into
+// the given PIDL, so it must not be freed.
+//
+// ⚠️ You must defer IShellFolder.Release().
+//
+// Example:
+//
+//	pidl, _ := shell.SHParseDisplayName("C:\\Temp\\foo.txt")
+//	defer pidl.ILFree()
+//
+//	folder, child, err := shell.SHBindToParent(pidl)
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer folder.Release()
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shbindtoparent
+func SHBindToParent(pidl PIDL) (IShellFolder, PIDL, error) {
+	var ppvQueried **comvt.IUnknown
+	var child PIDL
+	ret, _, _ := syscall.SyscallN(proc.SHBindToParent.Addr(),
+		uintptr(pidl),
+		uintptr(unsafe.Pointer(win.GuidFromIid(shellco.IID_IShellFolder))),
+		uintptr(unsafe.Pointer(&ppvQueried)),
+		uintptr(unsafe.Pointer(&child)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIShellFolder(com.NewIUnknown(ppvQueried)), child, nil
+	} else {
+		return nil, PIDL(0), hr
+	}
+}
+
+// Returns the IShellItem of a known folder. If hToken is zero, the folder of
+// the current user is returned.
+//
+// ⚠️ You must defer IShellItem.Release().
+//
+// Example:
+//
+//	docs, err := shell.SHGetKnownFolderItem(shellco.FOLDERID_Documents,
+//		shellco.KF_FLAG_DEFAULT, win.HACCESSTOKEN(0))
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer docs.Release()
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shgetknownfolderitem
+func SHGetKnownFolderItem(
+	folderId shellco.FOLDERID,
+	flags shellco.KF_FLAG,
+	hToken win.HACCESSTOKEN) (IShellItem, error) {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(proc.SHGetKnownFolderItem.Addr(),
+		uintptr(unsafe.Pointer(win.GuidFromClsid(co.CLSID(folderId)))),
+		uintptr(flags), uintptr(hToken),
+		uintptr(unsafe.Pointer(win.GuidFromIid(shellco.IID_IShellItem))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIShellItem(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
+
+// Returns the path of a known folder. If hToken is zero, the folder of the
+// current user is returned.
+//
+// Example:
+//
+//	desktop, err := shell.SHGetKnownFolderPath(shellco.FOLDERID_Desktop,
+//		shellco.KF_FLAG_DEFAULT, win.HACCESSTOKEN(0))
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shgetknownfolderpath
+func SHGetKnownFolderPath(
+	folderId shellco.FOLDERID,
+	flags shellco.KF_FLAG,
+	hToken win.HACCESSTOKEN) (string, error) {
+
+	var pv uintptr
+	ret, _, _ := syscall.SyscallN(proc.SHGetKnownFolderPath.Addr(),
+		uintptr(unsafe.Pointer(win.GuidFromClsid(co.CLSID(folderId)))),
+		uintptr(flags), uintptr(hToken),
+		uintptr(unsafe.Pointer(&pv)))
+
+	defer win.HTASKMEM(pv).CoTaskMemFree() // must be freed even on failure
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return win.Str.FromNativePtr((*uint16)(unsafe.Pointer(pv))), nil
+	} else {
+		return "", hr
+	}
+}
+
+// Returns the property store of the window, which can be used to set a
+// per-window AppUserModelID, so the window is grouped separately in the
+// taskbar.
+//
+// ⚠️ You must defer IPropertyStore.Release().
+//
+// Example:
+//
+//	var hWnd win.HWND // initialized somewhere
+//
+//	ps := shell.SHGetPropertyStoreForWindow(hWnd)
+//	defer ps.Release()
+//
+//	pv := autom.NewPropVariantStr("MyCompany.MyApp.Window2")
+//	defer pv.PropVariantClear()
+//
+//	ps.SetValue(shellco.PKEY_AppUserModel_ID, &pv)
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shgetpropertystoreforwindow
+func SHGetPropertyStoreForWindow(hWnd win.HWND) IPropertyStore {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(proc.SHGetPropertyStoreForWindow.Addr(),
+		uintptr(hWnd),
+		uintptr(unsafe.Pointer(win.GuidFromIid(shellco.IID_IPropertyStore))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIPropertyStore(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+// Returns the absolute PIDL of the given path.
+//
+// ⚠️ You must defer PIDL.ILFree().
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shparsedisplayname
+func SHParseDisplayName(name string) (PIDL, error) {
+	var pidl PIDL
+	ret, _, _ := syscall.SyscallN(proc.SHParseDisplayName.Addr(),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(name))),
+		0, uintptr(unsafe.Pointer(&pidl)), 0, 0)
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return pidl, nil
+	} else {
+		return PIDL(0), hr
+	}
+}
+
+// Removes a ProgID registered with RegisterProgId(), along with its
+// association to the file extensions. Then calls SHChangeNotify() with
+// SHCNE_ASSOCCHANGED.
+func UnregisterProgId(progId *ProgId) error {
+	hkcu := win.HKEY_CURRENT_USER
+
+	if err := _RegIgnoreNotFound(hkcu.RegDeleteTree(_REG_CLASSES + progId.Id)); err != nil {
+		return err
+	}
+
+	for _, ext := range progId.Extensions {
+		err := hkcu.RegDeleteKeyValue(_REG_CLASSES+ext+"\\OpenWithProgids", progId.Id)
+		if err := _RegIgnoreNotFound(err); err != nil {
+			return err
+		}
+
+		if _RegGetStr(_REG_CLASSES+ext, "") == progId.Id {
+			err := hkcu.RegDeleteKeyValue(_REG_CLASSES+ext, "")
+			if err := _RegIgnoreNotFound(err); err != nil {
+				return err
+			}
+		}
+	}
+
+	win.SHChangeNotify(co.SHCNE_ASSOCCHANGED, co.SHCNF_IDLIST, nil, nil)
+	return nil
+}
+
+// Removes a context menu verb registered with RegisterVerb(). Then calls
+// SHChangeNotify() with SHCNE_ASSOCCHANGED.
+func UnregisterVerb(class, verbName string) error {
+	err := win.HKEY_CURRENT_USER.RegDeleteTree(
+		_REG_CLASSES + class + "\\shell\\" + verbName)
+	if err := _RegIgnoreNotFound(err); err != nil {
+		return err
+	}
+
+	win.SHChangeNotify(co.SHCNE_ASSOCCHANGED, co.SHCNF_IDLIST, nil, nil)
+	return nil
+}
+
+// Root of the per-user class registrations.
+const _REG_CLASSES = "Software\\Classes\\"
+
+// Reads a REG_SZ value under HKEY_CURRENT_USER, returning an empty string if
+// it can't be read.
+func _RegGetStr(subKey, valueName string) string {
+	var dataLen uint32
+	valType := co.REG_SZ
+
+	err := win.HKEY_CURRENT_USER.RegGetValue(subKey, valueName, co.RRF_RT_REG_SZ,
+		&valType, nil, &dataLen) // retrieve length
+	if err != nil || dataLen < 2 {
+		return ""
+	}
+
+	buf := make([]uint16, dataLen/2) // terminating null included
+	err = win.HKEY_CURRENT_USER.RegGetValue(subKey, valueName, co.RRF_RT_REG_SZ,
+		&valType, unsafe.Pointer(&buf[0]), &dataLen)
+	if err != nil {
+		return ""
+	}
+	return win.Str.FromNativeSlice(buf)
+}
+
+// Returns nil if the error is ERROR_FILE_NOT_FOUND.
+func _RegIgnoreNotFound(err error) error {
+	if err == errco.FILE_NOT_FOUND {
+		return nil
+	}
+	return err
+}
+
+// Writes a REG_SZ value under HKEY_CURRENT_USER, creating the key if needed.
+func _RegPutStr(subKey, valueName, data string) error {
+	data16 := win.Str.ToNativeSlice(data)
+	err := win.HKEY_CURRENT_USER.RegSetKeyValue(subKey, valueName, co.REG_SZ,
+		unsafe.Pointer(&data16[0]), uint32(len(data16)*2)) // size in bytes, including terminating null
+	runtime.KeepAlive(data16)
+	return err
+}
+
+// Writes the keys of a verb under the given class.
+func _RegPutVerb(class string, verb *Verb) error {
+	verbKey := _REG_CLASSES + class + "\\shell\\" + verb.Name
+
+	if verb.Label != "" {
+		if err := _RegPutStr(verbKey, "", verb.Label); err != nil {
+			return err
+		}
+	}
+	if verb.Icon != "" {
+		if err := _RegPutStr(verbKey, "Icon", verb.Icon); err != nil {
+			return err
+		}
+	}
+	return _RegPutStr(verbKey+"\\command", "", verb.Command)
+}
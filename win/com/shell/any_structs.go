@@ -3,16 +3,124 @@
 package shell
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
 	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
 	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/errco"
 )
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ns-shobjidl_core-cminvokecommandinfoex
+//
+// ⚠️ You must call SetCbSize() to initialize the struct.
+//
+// The LpVerb and LpVerbW fields can hold either a pointer to a verb string, or
+// the offset of the command ID from the first ID passed to
+// IContextMenu.QueryContextMenu().
+type CMINVOKECOMMANDINFOEX struct {
+	cbSize        uint32
+	FMask         shellco.CMIC
+	Hwnd          win.HWND
+	LpVerb        uintptr // ANSI string or command offset
+	LpParameters  *byte
+	LpDirectory   *byte
+	NShow         co.SW
+	DwHotKey      uint32
+	HIcon         win.HICON
+	LpTitle       *byte
+	LpVerbW       uintptr // Unicode string or command offset
+	LpParametersW *uint16
+	LpDirectoryW  *uint16
+	LpTitleW      *uint16
+	PtInvoke      win.POINT
+}
+
+func (cmi *CMINVOKECOMMANDINFOEX) SetCbSize() { cmi.cbSize = uint32(unsafe.Sizeof(*cmi)) }
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shtypes/ns-shtypes-comdlg_filterspec
 type COMDLG_FILTERSPEC struct {
 	PszName *uint16
 	PszSpec *uint16
 }
 
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/notificationactivationcallback/ns-notificationactivationcallback-notification_user_input_data
+type NOTIFICATION_USER_INPUT_DATA struct {
+	Key   *uint16
+	Value *uint16
+}
+
+// Pointer to an [ITEMIDLIST], which identifies an object in the shell
+// namespace.
+//
+// [ITEMIDLIST]: https://docs.microsoft.com/en-us/windows/win32/api/shtypes/ns-shtypes-itemidlist
+type PIDL uintptr
+
+// ⚠️ You must defer PIDL.ILFree().
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-ilclone
+func (pidl PIDL) ILClone() PIDL {
+	ret, _, _ := syscall.SyscallN(proc.ILClone.Addr(), uintptr(pidl))
+	if ret == 0 {
+		panic(errco.E_OUTOFMEMORY)
+	}
+	return PIDL(ret)
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-ilfree
+func (pidl PIDL) ILFree() {
+	syscall.SyscallN(proc.ILFree.Addr(), uintptr(pidl))
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-ilisequal
+func (pidl PIDL) ILIsEqual(other PIDL) bool {
+	ret, _, _ := syscall.SyscallN(proc.ILIsEqual.Addr(),
+		uintptr(pidl), uintptr(other))
+	return ret != 0
+}
+
+// Removes the last item in place; returns false if there was no item to
+// remove.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-ilremovelastid
+func (pidl PIDL) ILRemoveLastID() bool {
+	ret, _, _ := syscall.SyscallN(proc.ILRemoveLastID.Addr(), uintptr(pidl))
+	return ret != 0
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wtypes/ns-wtypes-propertykey
+type PROPERTYKEY struct {
+	FmtId win.GUID
+	PId   uint32
+}
+
+// Creates a PROPERTYKEY from a shellco.PKEY constant.
+func PropertyKeyFrom(pkey shellco.PKEY) PROPERTYKEY {
+	strs := strings.Split(string(pkey), " ")
+	if len(strs) != 2 {
+		panic(fmt.Sprintf("Malformed PKEY: %s", pkey))
+	}
+
+	pid, e := strconv.ParseUint(strs[1], 10, 32)
+	if e != nil {
+		panic(e)
+	}
+	return PROPERTYKEY{
+		FmtId: *win.GuidFromClsid(co.CLSID(strs[0])),
+		PId:   uint32(pid),
+	}
+}
+
+// Converts the PROPERTYKEY to a shellco.PKEY string.
+func (pk *PROPERTYKEY) PKey() shellco.PKEY {
+	return shellco.PKEY(fmt.Sprintf("%s %d", pk.FmtId.String(), pk.PId))
+}
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ns-shobjidl_core-thumbbutton
 type THUMBBUTTON struct {
 	DwMask  shellco.THB
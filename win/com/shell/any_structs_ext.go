@@ -7,3 +7,20 @@ type FilterSpec struct {
 	Name string
 	Spec string
 }
+
+// A file type to be registered with RegisterProgId().
+type ProgId struct {
+	Id           string   // Like "MyCompany.MyApp.Document.1".
+	FriendlyName string   // Shown by Explorer, like "MyApp Document".
+	DefaultIcon  string   // Like "C:\\MyApp\\myapp.exe,0". Optional.
+	Extensions   []string // Like ".mydoc". Optional.
+	Verbs        []Verb   // The first one is the default. Optional.
+}
+
+// A context menu verb to be registered with RegisterVerb() or RegisterProgId().
+type Verb struct {
+	Name    string // Canonical name, like "open", "edit" or "print".
+	Label   string // Menu text, like "&Edit with MyApp". Optional for canonical verbs.
+	Icon    string // Like "C:\\MyApp\\myapp.exe,0". Optional.
+	Command string // Like `"C:\MyApp\myapp.exe" "%1"`.
+}
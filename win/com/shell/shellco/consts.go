@@ -2,6 +2,83 @@
 
 package shellco
 
+// IApplicationAssociationRegistration association level.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-associationlevel
+type AL uint32
+
+const (
+	AL_MACHINE   AL = 0
+	AL_EFFECTIVE AL = 1
+	AL_USER      AL = 2
+)
+
+// IApplicationAssociationRegistration association type.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-associationtype
+type AT uint32
+
+const (
+	AT_FILEEXTENSION   AT = 0
+	AT_URLPROTOCOL     AT = 1
+	AT_STARTMENUCLIENT AT = 2
+	AT_MIMETYPE        AT = 3
+)
+
+// IFileDialogCustomize control state.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-cdcontrolstatef
+type CDCS uint32
+
+const (
+	CDCS_INACTIVE       CDCS = 0x0
+	CDCS_ENABLED        CDCS = 0x1
+	CDCS_VISIBLE        CDCS = 0x2
+	CDCS_ENABLEDVISIBLE CDCS = 0x3
+)
+
+// IContextMenu.QueryContextMenu() uFlags.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icontextmenu-querycontextmenu
+type CMF uint32
+
+const (
+	CMF_NORMAL            CMF = 0x0000_0000
+	CMF_DEFAULTONLY       CMF = 0x0000_0001
+	CMF_VERBSONLY         CMF = 0x0000_0002
+	CMF_EXPLORE           CMF = 0x0000_0004
+	CMF_NOVERBS           CMF = 0x0000_0008
+	CMF_CANRENAME         CMF = 0x0000_0010
+	CMF_NODEFAULT         CMF = 0x0000_0020
+	CMF_ITEMMENU          CMF = 0x0000_0080
+	CMF_EXTENDEDVERBS     CMF = 0x0000_0100
+	CMF_DISABLEDVERBS     CMF = 0x0000_0200
+	CMF_ASYNCVERBSTATE    CMF = 0x0000_0400
+	CMF_OPTIMIZEFORINVOKE CMF = 0x0000_0800
+	CMF_SYNCCASCADEMENU   CMF = 0x0000_1000
+	CMF_DONOTPICKDEFAULT  CMF = 0x0000_2000
+)
+
+// CMINVOKECOMMANDINFOEX fMask.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ns-shobjidl_core-cminvokecommandinfoex
+type CMIC uint32
+
+const (
+	CMIC_MASK_HOTKEY         CMIC = 0x0000_0020
+	CMIC_MASK_ICON           CMIC = 0x0000_0010
+	CMIC_MASK_NOASYNC        CMIC = 0x0000_0100
+	CMIC_MASK_FLAG_NO_UI     CMIC = 0x0000_0400
+	CMIC_MASK_UNICODE        CMIC = 0x0000_4000
+	CMIC_MASK_NO_CONSOLE     CMIC = 0x0000_8000
+	CMIC_MASK_ASYNCOK        CMIC = 0x0010_0000
+	CMIC_MASK_NOZONECHECKS   CMIC = 0x0080_0000
+	CMIC_MASK_FLAG_LOG_USAGE CMIC = 0x0400_0000
+	CMIC_MASK_SHIFT_DOWN     CMIC = 0x1000_0000
+	CMIC_MASK_PTINVOKE       CMIC = 0x2000_0000
+	CMIC_MASK_CONTROL_DOWN   CMIC = 0x4000_0000
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/com/dropeffect-constants
 type DROPEFFECT uint32
 
@@ -50,6 +127,79 @@ const (
 	DWPOS_SPAN    DWPOS = 5
 )
 
+// IFileDialog.AddPlace() position.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-fdap
+type FDAP uint32
+
+const (
+	FDAP_BOTTOM FDAP = 0
+	FDAP_TOP    FDAP = 1
+)
+
+// IFileDialogEvents.OnOverwrite() response.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-fde_overwrite_response
+type FDEOR uint32
+
+const (
+	FDEOR_DEFAULT FDEOR = 0
+	FDEOR_ACCEPT  FDEOR = 1
+	FDEOR_REFUSE  FDEOR = 2
+)
+
+// IFileDialogEvents.OnShareViolation() response.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-fde_shareviolation_response
+type FDESVR uint32
+
+const (
+	FDESVR_DEFAULT FDESVR = 0
+	FDESVR_ACCEPT  FDESVR = 1
+	FDESVR_REFUSE  FDESVR = 2
+)
+
+// IFileOperation.SetOperationFlags() flags, FOF and FOFX.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperation-setoperationflags
+type FOF uint32
+
+const (
+	FOF_MULTIDESTFILES          FOF = 0x0001
+	FOF_CONFIRMMOUSE            FOF = 0x0002
+	FOF_SILENT                  FOF = 0x0004
+	FOF_RENAMEONCOLLISION       FOF = 0x0008
+	FOF_NOCONFIRMATION          FOF = 0x0010
+	FOF_WANTMAPPINGHANDLE       FOF = 0x0020
+	FOF_ALLOWUNDO               FOF = 0x0040
+	FOF_FILESONLY               FOF = 0x0080
+	FOF_SIMPLEPROGRESS          FOF = 0x0100
+	FOF_NOCONFIRMMKDIR          FOF = 0x0200
+	FOF_NOERRORUI               FOF = 0x0400
+	FOF_NOCOPYSECURITYATTRIBS   FOF = 0x0800
+	FOF_NORECURSION             FOF = 0x1000
+	FOF_NO_CONNECTED_ELEMENTS   FOF = 0x2000
+	FOF_WANTNUKEWARNING         FOF = 0x4000
+	FOF_NORECURSEREPARSE        FOF = 0x8000
+	FOF_NO_UI                   FOF = FOF_SILENT | FOF_NOCONFIRMATION | FOF_NOERRORUI | FOF_NOCONFIRMMKDIR
+	FOFX_NOSKIPJUNCTIONS        FOF = 0x0001_0000
+	FOFX_PREFERHARDLINK         FOF = 0x0002_0000
+	FOFX_SHOWELEVATIONPROMPT    FOF = 0x0004_0000
+	FOFX_RECYCLEONDELETE        FOF = 0x0008_0000
+	FOFX_EARLYFAILURE           FOF = 0x0010_0000
+	FOFX_PRESERVEFILEEXTENSIONS FOF = 0x0020_0000
+	FOFX_KEEPNEWERFILE          FOF = 0x0040_0000
+	FOFX_NOCOPYHOOKS            FOF = 0x0080_0000
+	FOFX_NOMINIMIZEBOX          FOF = 0x0100_0000
+	FOFX_MOVEACLSACROSSVOLUMES  FOF = 0x0200_0000
+	FOFX_DONTDISPLAYSOURCEPATH  FOF = 0x0400_0000
+	FOFX_DONTDISPLAYDESTPATH    FOF = 0x0800_0000
+	FOFX_REQUIREELEVATION       FOF = 0x1000_0000
+	FOFX_ADDUNDORECORD          FOF = 0x2000_0000
+	FOFX_COPYASDOWNLOAD         FOF = 0x4000_0000
+	FOFX_DONTDISPLAYLOCATIONS   FOF = 0x8000_0000
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-_fileopendialogoptions
 type FOS uint32
 
@@ -79,6 +229,74 @@ const (
 	FOS_SUPPORTSTREAMABLEITEMS   FOS = 0x8000_0000
 )
 
+// IContextMenu.GetCommandString() uType.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-icontextmenu-getcommandstring
+type GCS uint32
+
+const (
+	GCS_VERB     GCS = 0x0000_0004 // GCS_VERBW
+	GCS_HELPTEXT GCS = 0x0000_0005 // GCS_HELPTEXTW
+	GCS_VALIDATE GCS = 0x0000_0006 // GCS_VALIDATEW
+	GCS_VERBICON GCS = 0x0000_0014 // GCS_VERBICONW
+)
+
+// IShellItem2.GetPropertyStore() flags.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/propsys/ne-propsys-getpropertystoreflags
+type GPS uint32
+
+const (
+	GPS_DEFAULT                 GPS = 0x0000
+	GPS_HANDLERPROPERTIESONLY   GPS = 0x0001
+	GPS_READWRITE               GPS = 0x0002
+	GPS_TEMPORARY               GPS = 0x0004
+	GPS_FASTPROPERTIESONLY      GPS = 0x0008
+	GPS_OPENSLOWITEM            GPS = 0x0010
+	GPS_DELAYCREATION           GPS = 0x0020
+	GPS_BESTEFFORT              GPS = 0x0040
+	GPS_NO_OPLOCK               GPS = 0x0080
+	GPS_PREFERQUERYPROPERTIES   GPS = 0x0100
+	GPS_EXTRINSICPROPERTIES     GPS = 0x0200
+	GPS_EXTRINSICPROPERTIESONLY GPS = 0x0400
+	GPS_VOLATILEPROPERTIES      GPS = 0x0800
+	GPS_VOLATILEPROPERTIESONLY  GPS = 0x1000
+)
+
+// ICustomDestinationList.AppendKnownCategory() category.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-knowndestcategory
+type KDC uint32
+
+const (
+	KDC_FREQUENT KDC = 1
+	KDC_RECENT   KDC = 2
+)
+
+// SHGetKnownFolderPath() and SHGetKnownFolderItem() flags.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/ne-shlobj_core-known_folder_flag
+type KF_FLAG uint32
+
+const (
+	KF_FLAG_DEFAULT                          KF_FLAG = 0x0000_0000
+	KF_FLAG_FORCE_APP_DATA_REDIRECTION       KF_FLAG = 0x0008_0000
+	KF_FLAG_RETURN_FILTER_REDIRECTION_TARGET KF_FLAG = 0x0004_0000
+	KF_FLAG_FORCE_PACKAGE_REDIRECTION        KF_FLAG = 0x0002_0000
+	KF_FLAG_NO_PACKAGE_REDIRECTION           KF_FLAG = 0x0001_0000
+	KF_FLAG_FORCE_APPCONTAINER_REDIRECTION   KF_FLAG = 0x0002_0000
+	KF_FLAG_NO_APPCONTAINER_REDIRECTION      KF_FLAG = 0x0001_0000
+	KF_FLAG_CREATE                           KF_FLAG = 0x0000_8000
+	KF_FLAG_DONT_VERIFY                      KF_FLAG = 0x0000_4000
+	KF_FLAG_DONT_UNEXPAND                    KF_FLAG = 0x0000_2000
+	KF_FLAG_NO_ALIAS                         KF_FLAG = 0x0000_1000
+	KF_FLAG_INIT                             KF_FLAG = 0x0000_0800
+	KF_FLAG_DEFAULT_PATH                     KF_FLAG = 0x0000_0400
+	KF_FLAG_NOT_PARENT_RELATIVE              KF_FLAG = 0x0000_0200
+	KF_FLAG_SIMPLE_IDLIST                    KF_FLAG = 0x0000_0100
+	KF_FLAG_ALIAS_ONLY                       KF_FLAG = 0x8000_0000
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-_sichintf
 type SICHINT uint32
 
@@ -105,6 +323,24 @@ const (
 	SIGDN_PARENTRELATIVEFORUI         SIGDN = 0x8009_4001
 )
 
+// IShellItemImageFactory.GetImage() flags.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitemimagefactory-getimage
+type SIIGBF uint32
+
+const (
+	SIIGBF_RESIZETOFIT    SIIGBF = 0x0000
+	SIIGBF_BIGGERSIZEOK   SIIGBF = 0x0001
+	SIIGBF_MEMORYONLY     SIIGBF = 0x0002
+	SIIGBF_ICONONLY       SIIGBF = 0x0004
+	SIIGBF_THUMBNAILONLY  SIIGBF = 0x0008
+	SIIGBF_INCACHEONLY    SIIGBF = 0x0010
+	SIIGBF_CROPTOSQUARE   SIIGBF = 0x0020
+	SIIGBF_WIDETHUMBNAILS SIIGBF = 0x0040
+	SIIGBF_ICONBACKGROUND SIIGBF = 0x0080
+	SIIGBF_SCALEUP        SIIGBF = 0x0100
+)
+
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishelllinkw-getpath
 type SLGP uint32
 
@@ -208,3 +444,25 @@ const (
 	THBF_HIDDEN         THBF = 0x8
 	THBF_NONINTERACTIVE THBF = 0x10
 )
+
+// IFileOperationProgressSink transfer flags.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifileoperationprogresssink-precopyitem
+type TSF uint32
+
+const (
+	TSF_NORMAL                     TSF = 0
+	TSF_FAIL_EXIST                 TSF = 0x0001
+	TSF_RENAME_EXIST               TSF = 0x0002
+	TSF_OVERWRITE_EXIST            TSF = 0x0004
+	TSF_ALLOW_DECRYPTION           TSF = 0x0008
+	TSF_NO_SECURITY                TSF = 0x0010
+	TSF_COPY_CREATION_TIME         TSF = 0x0020
+	TSF_COPY_WRITE_TIME            TSF = 0x0040
+	TSF_USE_FULL_ACCESS            TSF = 0x0080
+	TSF_DELETE_RECYCLE_IF_POSSIBLE TSF = 0x0100
+	TSF_COPY_HARD_LINK             TSF = 0x0200
+	TSF_COPY_LOCALIZED_NAME        TSF = 0x0400
+	TSF_MOVE_AS_COPY_DELETE        TSF = 0x0800
+	TSF_SUSPEND_SHELLEVENTS        TSF = 0x1000
+)
@@ -6,29 +6,260 @@ import (
 	"github.com/rodrigocfd/windigo/win/co"
 )
 
+// IShellItem.BindToHandler() bhid.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ishellitem-bindtohandler
+type BHID string
+
+const (
+	BHID_AssociationArray   BHID = "bea9ef17-82f1-4f60-9284-4f8db75c3be9"
+	BHID_DataObject         BHID = "b8c0bd9f-ed24-455c-83e6-d5390c4fe8c4"
+	BHID_EnumAssocHandlers  BHID = "b8ab0b9c-c2ec-4f7a-918d-314900e6280a"
+	BHID_EnumItems          BHID = "94f60519-2850-4924-aa5a-d15e84868039"
+	BHID_Filter             BHID = "38d08778-f557-4690-9ebf-ba54706ad8f7"
+	BHID_LinkTargetItem     BHID = "3981e228-f559-11d3-8e3a-00c04f6837d5"
+	BHID_PropertyStore      BHID = "0384e1a4-1523-439c-a4c8-ab911052f586"
+	BHID_RandomAccessStream BHID = "f16fc93b-77ae-4cfe-bda7-a866eea6878d"
+	BHID_SFObject           BHID = "3981e224-f559-11d3-8e3a-00c04f6837d5"
+	BHID_SFUIObject         BHID = "3981e225-f559-11d3-8e3a-00c04f6837d5"
+	BHID_SFViewObject       BHID = "3981e226-f559-11d3-8e3a-00c04f6837d5"
+	BHID_Storage            BHID = "3981e227-f559-11d3-8e3a-00c04f6837d5"
+	BHID_StorageEnum        BHID = "4621a4e3-f0d6-4773-8a9c-46e77b174840"
+	BHID_Stream             BHID = "1cebb3ab-7c10-499a-a417-92ca16c4cb83"
+	BHID_ThumbnailHandler   BHID = "7b2e650a-8e20-4f4a-b09e-6597afc72fb0"
+	BHID_Transfer           BHID = "d5e346a1-f753-4932-b403-4574800e2498"
+)
+
 // Shell COM CLSIDs.
 const (
-	CLSID_DesktopWallpaper co.CLSID = "c2cf3110-460e-4fc1-b9d0-8a1c0c9cc4bd"
-	CLSID_FileOpenDialog   co.CLSID = "dc1c5a9c-e88a-4dde-a5a1-60f82a20aef7"
-	CLSID_FileSaveDialog   co.CLSID = "c0b4e2f3-ba21-4773-8dba-335ec946eb8b"
-	CLSID_ShellLink        co.CLSID = "00021401-0000-0000-c000-000000000046"
-	CLSID_TaskbarList      co.CLSID = "56fdf344-fd6d-11d0-958a-006097c9a090"
+	CLSID_ApplicationAssociationRegistration co.CLSID = "591209c7-767b-42b2-9fba-44ee4615f2c7"
+	CLSID_DesktopWallpaper                   co.CLSID = "c2cf3110-460e-4fc1-b9d0-8a1c0c9cc4bd"
+	CLSID_DestinationList                    co.CLSID = "77f10cf0-3db5-4966-b520-b7c54fd35ed6"
+	CLSID_EnumerableObjectCollection         co.CLSID = "2d3468c1-36a7-43b6-ac24-d3f02fd9607a"
+	CLSID_FileOpenDialog                     co.CLSID = "dc1c5a9c-e88a-4dde-a5a1-60f82a20aef7"
+	CLSID_FileOperation                      co.CLSID = "3ad05575-8857-4850-9277-11b85bdb8e09"
+	CLSID_FileSaveDialog                     co.CLSID = "c0b4e2f3-ba21-4773-8dba-335ec946eb8b"
+	CLSID_ShellLink                          co.CLSID = "00021401-0000-0000-c000-000000000046"
+	CLSID_TaskbarList                        co.CLSID = "56fdf344-fd6d-11d0-958a-006097c9a090"
 )
 
 // Shell COM IIDs.
 const (
-	IID_IDataObject       co.IID = "0000010e-0000-0000-c000-000000000046"
-	IID_IDesktopWallpaper co.IID = "b92b56a9-8b55-4e14-9a89-0199bbb6f93b"
-	IID_IDropTarget       co.IID = "00000122-0000-0000-c000-000000000046"
-	IID_IFileDialog       co.IID = "42f85136-db7e-439c-85f1-e4075d135fc8"
-	IID_IFileOpenDialog   co.IID = "d57c7288-d4ad-4768-be02-9d969532d960"
-	IID_IFileSaveDialog   co.IID = "84bccd23-5fde-4cdb-aea4-af64b83d78ab"
-	IID_IModalWindow      co.IID = "b4db1657-70d7-485e-8e3e-6fcb5a5c1802"
-	IID_IShellItem        co.IID = "43826d1e-e718-42ee-bc55-a1e261c37bfe"
-	IID_IShellItemArray   co.IID = "b63ea76d-1f85-456f-a19c-48159efa858b"
-	IID_IShellLink        co.IID = "000214f9-0000-0000-c000-000000000046"
-	IID_ITaskbarList      co.IID = "56fdf342-fd6d-11d0-958a-006097c9a090"
-	IID_ITaskbarList2     co.IID = "602d4995-b13a-429b-a66e-1935e44f4317"
-	IID_ITaskbarList3     co.IID = "ea1afb91-9e28-4b86-90e9-9e9f8a5eefaf"
-	IID_ITaskbarList4     co.IID = "c43dc798-95d1-4bea-9030-bb99e2983a1a"
+	IID_IApplicationAssociationRegistration co.IID = "4e530b0a-e611-4c77-a3ac-9031d022281b"
+	IID_IContextMenu                        co.IID = "000214e4-0000-0000-c000-000000000046"
+	IID_IContextMenu2                       co.IID = "000214f4-0000-0000-c000-000000000046"
+	IID_IContextMenu3                       co.IID = "bcfce0a0-ec17-11d0-8d10-00a0c90f2719"
+	IID_ICustomDestinationList              co.IID = "6332debf-87b5-4670-90c0-5e57b408a49e"
+	IID_IDataObject                         co.IID = "0000010e-0000-0000-c000-000000000046"
+	IID_IDesktopWallpaper                   co.IID = "b92b56a9-8b55-4e14-9a89-0199bbb6f93b"
+	IID_IDropTarget                         co.IID = "00000122-0000-0000-c000-000000000046"
+	IID_IEnumShellItems                     co.IID = "70629033-e363-4a28-a567-0db78006e6d7"
+	IID_IFileDialog                         co.IID = "42f85136-db7e-439c-85f1-e4075d135fc8"
+	IID_IFileDialogCustomize                co.IID = "e6fdd21a-163f-4975-9c8c-a69f1ba37034"
+	IID_IFileDialogEvents                   co.IID = "973510db-7d7f-452b-8975-74a85828d354"
+	IID_IFileOpenDialog                     co.IID = "d57c7288-d4ad-4768-be02-9d969532d960"
+	IID_IFileOperation                      co.IID = "947aab5f-0a5c-4c13-b4d6-4bf7836fc9f8"
+	IID_IFileOperationProgressSink          co.IID = "04b0f1a7-9490-44bc-96e1-4296a31252e2"
+	IID_IFileSaveDialog                     co.IID = "84bccd23-5fde-4cdb-aea4-af64b83d78ab"
+	IID_IModalWindow                        co.IID = "b4db1657-70d7-485e-8e3e-6fcb5a5c1802"
+	IID_INotificationActivationCallback     co.IID = "53e31837-6600-4a81-9395-75cffe746f94"
+	IID_IObjectArray                        co.IID = "92ca9dcd-5622-4bba-a805-5e9f541bd8c9"
+	IID_IObjectCollection                   co.IID = "5632b1a4-e38a-400a-928a-d4cd63230295"
+	IID_IPropertyStore                      co.IID = "886d8eeb-8cf2-4446-8d02-cdba1dbdcf99"
+	IID_IShellFolder                        co.IID = "000214e6-0000-0000-c000-000000000046"
+	IID_IShellItem                          co.IID = "43826d1e-e718-42ee-bc55-a1e261c37bfe"
+	IID_IShellItem2                         co.IID = "7e9fb0d3-919f-4307-ab2e-9b1860310c93"
+	IID_IShellItemArray                     co.IID = "b63ea76d-1f85-456f-a19c-48159efa858b"
+	IID_IShellItemImageFactory              co.IID = "bcc18b79-ba16-442f-80c4-8a59c30c463b"
+	IID_IShellLink                          co.IID = "000214f9-0000-0000-c000-000000000046"
+	IID_ITaskbarList                        co.IID = "56fdf342-fd6d-11d0-958a-006097c9a090"
+	IID_ITaskbarList2                       co.IID = "602d4995-b13a-429b-a66e-1935e44f4317"
+	IID_ITaskbarList3                       co.IID = "ea1afb91-9e28-4b86-90e9-9e9f8a5eefaf"
+	IID_ITaskbarList4                       co.IID = "c43dc798-95d1-4bea-9030-bb99e2983a1a"
+)
+
+// Known folder IDs, used with SHGetKnownFolderPath() and
+// SHGetKnownFolderItem().
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/shell/knownfolderid
+type FOLDERID string
+
+const (
+	FOLDERID_AccountPictures        FOLDERID = "008ca0b1-55b4-4c56-b8a8-4de4b299d3be"
+	FOLDERID_AddNewPrograms         FOLDERID = "de61d971-5ebc-4f02-a3a9-6c82895e5c04"
+	FOLDERID_AdminTools             FOLDERID = "724ef170-a42d-4fef-9f26-b60e846fba4f"
+	FOLDERID_AppDataDesktop         FOLDERID = "b2c5e279-7add-439f-b28c-c41fe1bbf672"
+	FOLDERID_AppDataDocuments       FOLDERID = "7be16610-1f7f-44ac-bff0-83e15f2ffca1"
+	FOLDERID_AppDataFavorites       FOLDERID = "7cfbefbc-de1f-45aa-b843-a542ac536cc9"
+	FOLDERID_AppDataProgramData     FOLDERID = "559d40a3-a036-40fa-af61-84cb430a4d34"
+	FOLDERID_ApplicationShortcuts   FOLDERID = "a3918781-e5f2-4890-b3d9-a7e54332328c"
+	FOLDERID_AppsFolder             FOLDERID = "1e87508d-89c2-42f0-8a7e-645a0f50ca58"
+	FOLDERID_AppUpdates             FOLDERID = "a305ce99-f527-492b-8b1a-7e76fa98d6e4"
+	FOLDERID_CameraRoll             FOLDERID = "ab5fb87b-7ce2-4f83-915d-550846c9537b"
+	FOLDERID_CDBurning              FOLDERID = "9e52ab10-f80d-49df-acb8-4330f5687855"
+	FOLDERID_ChangeRemovePrograms   FOLDERID = "df7266ac-9274-4867-8d55-3bd661de872d"
+	FOLDERID_CommonAdminTools       FOLDERID = "d0384e7d-bac3-4797-8f14-cba229b392b5"
+	FOLDERID_CommonOEMLinks         FOLDERID = "c1bae2d0-10df-4334-bedd-7aa20b227a9d"
+	FOLDERID_CommonPrograms         FOLDERID = "0139d44e-6afe-49f2-8690-3dafcae6ffb8"
+	FOLDERID_CommonStartMenu        FOLDERID = "a4115719-d62e-491d-aa7c-e74b8be3b067"
+	FOLDERID_CommonStartup          FOLDERID = "82a5ea35-d9cd-47c5-9629-e15d2f714e6e"
+	FOLDERID_CommonTemplates        FOLDERID = "b94237e7-57ac-4347-9151-b08c6c32d1f7"
+	FOLDERID_ComputerFolder         FOLDERID = "0ac0837c-bbf8-452a-850d-79d08e667ca7"
+	FOLDERID_ConflictFolder         FOLDERID = "4bfefb45-347d-4006-a5be-ac0cb0567192"
+	FOLDERID_ConnectionsFolder      FOLDERID = "6f0cd92b-2e97-45d1-88ff-b0d186b8dedd"
+	FOLDERID_Contacts               FOLDERID = "56784854-c6cb-462b-8169-88e350acb882"
+	FOLDERID_ControlPanelFolder     FOLDERID = "82a74aeb-aeb4-465c-a014-d097ee346d63"
+	FOLDERID_Cookies                FOLDERID = "2b0f765d-c0e9-4171-908e-08a611b84ff6"
+	FOLDERID_Desktop                FOLDERID = "b4bfcc3a-db2c-424c-b029-7fe99a87c641"
+	FOLDERID_DeviceMetadataStore    FOLDERID = "5ce4a5e9-e4eb-479d-b89f-130c02886155"
+	FOLDERID_Documents              FOLDERID = "fdd39ad0-238f-46af-adb4-6c85480369c7"
+	FOLDERID_DocumentsLibrary       FOLDERID = "7b0db17d-9cd2-4a93-9733-46cc89022e7c"
+	FOLDERID_Downloads              FOLDERID = "374de290-123f-4565-9164-39c4925e467b"
+	FOLDERID_Favorites              FOLDERID = "1777f761-68ad-4d8a-87bd-30b759fa33dd"
+	FOLDERID_Fonts                  FOLDERID = "fd228cb7-ae11-4ae3-864c-16f3910ab8fe"
+	FOLDERID_Games                  FOLDERID = "cac52c1a-b53d-4edc-92d7-6b2e8ac19434"
+	FOLDERID_GameTasks              FOLDERID = "054fae61-4dd8-4787-80b6-090220c4b700"
+	FOLDERID_History                FOLDERID = "d9dc8a3b-b784-432e-a781-5a1130a75963"
+	FOLDERID_HomeGroup              FOLDERID = "52528a6b-b9e3-4add-b60d-588c2dba842d"
+	FOLDERID_ImplicitAppShortcuts   FOLDERID = "bcb5256f-79f6-4cee-b725-dc34e402fd46"
+	FOLDERID_InternetCache          FOLDERID = "352481e8-33be-4251-ba85-6007caedcf9d"
+	FOLDERID_InternetFolder         FOLDERID = "4d9f7874-4e0c-4904-967b-40b0d20c3e4b"
+	FOLDERID_Libraries              FOLDERID = "1b3ea5dc-b587-4786-b4ef-bd1dc332aeae"
+	FOLDERID_Links                  FOLDERID = "bfb9d5e0-c6a9-404c-b2b2-ae6db6af4968"
+	FOLDERID_LocalAppData           FOLDERID = "f1b32785-6fba-4fcf-9d55-7b8e7f157091"
+	FOLDERID_LocalAppDataLow        FOLDERID = "a520a1a4-1780-4ff6-bd18-167343c5af16"
+	FOLDERID_LocalizedResourcesDir  FOLDERID = "2a00375e-224c-49de-b8d1-440df7ef3ddc"
+	FOLDERID_Music                  FOLDERID = "4bd8d571-6d19-48d3-be97-422220080e43"
+	FOLDERID_MusicLibrary           FOLDERID = "2112ab0a-c86a-4ffe-a368-0de96e47012e"
+	FOLDERID_NetHood                FOLDERID = "c5abbf53-e17f-4121-8900-86626fc2c973"
+	FOLDERID_NetworkFolder          FOLDERID = "d20beec4-5ca8-4905-ae3b-bf251ea09b53"
+	FOLDERID_Objects3D              FOLDERID = "31c0dd25-9439-4f12-bf41-7ff4eda38722"
+	FOLDERID_OriginalImages         FOLDERID = "2c36c0aa-5812-4b87-bfd0-4cd0dfb19b39"
+	FOLDERID_PhotoAlbums            FOLDERID = "69d2cf90-fc33-4fb7-9a0c-ebb0f0fcb43c"
+	FOLDERID_Pictures               FOLDERID = "33e28130-4e1e-4676-835a-98395c3bc3bb"
+	FOLDERID_PicturesLibrary        FOLDERID = "a990ae9f-a03b-4e80-94bc-9912d7504104"
+	FOLDERID_Playlists              FOLDERID = "de92c1c7-837f-4f69-a3bb-86e631204a23"
+	FOLDERID_PrintersFolder         FOLDERID = "76fc4e2d-d6ad-4519-a663-37bd56068185"
+	FOLDERID_PrintHood              FOLDERID = "9274bd8d-cfd1-41c3-b35e-b13f55a758f4"
+	FOLDERID_Profile                FOLDERID = "5e6c858f-0e22-4760-9afe-ea3317b67173"
+	FOLDERID_ProgramData            FOLDERID = "62ab5d82-fdc1-4dc3-a9dd-070d1d495d97"
+	FOLDERID_ProgramFiles           FOLDERID = "905e63b6-c1bf-494e-b29c-65b732d3d21a"
+	FOLDERID_ProgramFilesCommon     FOLDERID = "f7f1ed05-9f6d-47a2-aaae-29d317c6f066"
+	FOLDERID_ProgramFilesCommonX64  FOLDERID = "6365d5a7-0f0d-45e5-87f6-0da56b6a4f7d"
+	FOLDERID_ProgramFilesCommonX86  FOLDERID = "de974d24-d9c6-4d3e-bf91-f4455120b917"
+	FOLDERID_ProgramFilesX64        FOLDERID = "6d809377-6af0-444b-8957-a3773f02200e"
+	FOLDERID_ProgramFilesX86        FOLDERID = "7c5a40ef-a0fb-4bfc-874a-c0f2e0b9fa8e"
+	FOLDERID_Programs               FOLDERID = "a77f5d77-2e2b-44c3-a6a2-aba601054a51"
+	FOLDERID_Public                 FOLDERID = "dfdf76a2-c82a-4d63-906a-5644ac457385"
+	FOLDERID_PublicDesktop          FOLDERID = "c4aa340d-f20f-4863-afef-f87ef2e6ba25"
+	FOLDERID_PublicDocuments        FOLDERID = "ed4824af-dce4-45a8-81e2-fc7965083634"
+	FOLDERID_PublicDownloads        FOLDERID = "3d644c9b-1fb8-4f30-9b45-f670235f79c0"
+	FOLDERID_PublicGameTasks        FOLDERID = "debf2536-e1a8-4c59-b6a2-414586476aea"
+	FOLDERID_PublicLibraries        FOLDERID = "48daf80b-e6cf-4f4e-b800-0e69d84ee384"
+	FOLDERID_PublicMusic            FOLDERID = "3214fab5-9757-4298-bb61-92a9deaa44ff"
+	FOLDERID_PublicPictures         FOLDERID = "b6ebfb86-6907-413c-9af7-4fc2abf07cc5"
+	FOLDERID_PublicRingtones        FOLDERID = "e555ab60-153b-4d17-9f04-a5fe99fc15ec"
+	FOLDERID_PublicUserTiles        FOLDERID = "0482af6c-08f1-4c34-8c90-e17ec98b1e17"
+	FOLDERID_PublicVideos           FOLDERID = "2400183a-6185-49fb-a2d8-4a392a602ba3"
+	FOLDERID_QuickLaunch            FOLDERID = "52a4f021-7b75-48a9-9f6b-4b87a210bc8f"
+	FOLDERID_Recent                 FOLDERID = "ae50c081-ebd2-438a-8655-8a092e34987a"
+	FOLDERID_RecordedTVLibrary      FOLDERID = "1a6fdba2-f42d-4358-a798-b74d745926c5"
+	FOLDERID_RecycleBinFolder       FOLDERID = "b7534046-3ecb-4c18-be4e-64cd4cb7d6ac"
+	FOLDERID_ResourceDir            FOLDERID = "8ad10c31-2adb-4296-a8f7-e4701232c972"
+	FOLDERID_Ringtones              FOLDERID = "c870044b-f49e-4126-a9c3-b52a1ff411e8"
+	FOLDERID_RoamedTileImages       FOLDERID = "aaa8d5a5-f1d6-4259-baa8-78e7ef60835e"
+	FOLDERID_RoamingAppData         FOLDERID = "3eb685db-65f9-4cf6-a03a-e3ef65729f3d"
+	FOLDERID_RoamingTiles           FOLDERID = "00bcfc5a-ed94-4e48-96a1-3f6217f21990"
+	FOLDERID_SampleMusic            FOLDERID = "b250c668-f57d-4ee1-a63c-290ee7d1aa1f"
+	FOLDERID_SamplePictures         FOLDERID = "c4900540-2379-4c75-844b-64e6faf8716b"
+	FOLDERID_SamplePlaylists        FOLDERID = "15ca69b3-30ee-49c1-ace1-6b5ec372afb5"
+	FOLDERID_SampleVideos           FOLDERID = "859ead94-2e85-48ad-a71a-0969cb56a6cd"
+	FOLDERID_SavedGames             FOLDERID = "4c5c32ff-bb9d-43b0-b5b4-2d72e54eaaa4"
+	FOLDERID_SavedPictures          FOLDERID = "3b193882-d3ad-4eab-965a-69829d1fb59f"
+	FOLDERID_SavedPicturesLibrary   FOLDERID = "e25b5812-be88-4bd9-94b0-29233477b6c3"
+	FOLDERID_SavedSearches          FOLDERID = "7d1d3a04-debb-4115-95cf-2f29da2920da"
+	FOLDERID_Screenshots            FOLDERID = "b7bede81-df94-4682-a7d8-57a52620b86f"
+	FOLDERID_SearchHistory          FOLDERID = "0d4c3db6-03a3-462f-a0e6-08924c41b5d4"
+	FOLDERID_SearchHome             FOLDERID = "190337d1-b8ca-4121-a639-6d472d16972a"
+	FOLDERID_SearchTemplates        FOLDERID = "7e636bfe-dfa9-4d5e-b456-d7b39851d8a9"
+	FOLDERID_SendTo                 FOLDERID = "8983036c-27c0-404b-8f08-102d10dcfd74"
+	FOLDERID_SidebarDefaultParts    FOLDERID = "7b396e54-9ec5-4300-be0a-2482ebae1a26"
+	FOLDERID_SidebarParts           FOLDERID = "a75d362e-50fc-4fb7-ac2c-a8beaa314493"
+	FOLDERID_SkyDrive               FOLDERID = "a52bba46-e9e1-435f-b3d9-28daa648c0f6"
+	FOLDERID_SkyDriveCameraRoll     FOLDERID = "767e6811-49cb-4273-87c2-20f355e1085b"
+	FOLDERID_SkyDriveDocuments      FOLDERID = "24d89e24-2f19-4534-9dde-6a6671fbb8fe"
+	FOLDERID_SkyDrivePictures       FOLDERID = "339719b5-8c47-4894-94c2-d8f77add44a6"
+	FOLDERID_StartMenu              FOLDERID = "625b53c3-ab48-4ec1-ba1f-a1ef4146fc19"
+	FOLDERID_Startup                FOLDERID = "b97d20bb-f46a-4c97-ba10-5e3608430854"
+	FOLDERID_SyncManagerFolder      FOLDERID = "43668bf8-c14e-49b2-97c9-747784d784b7"
+	FOLDERID_SyncResultsFolder      FOLDERID = "289a9a43-be44-4057-a41b-587a76d7e7f9"
+	FOLDERID_SyncSetupFolder        FOLDERID = "0f214138-b1d3-4a90-bba9-27cbc0c5389a"
+	FOLDERID_System                 FOLDERID = "1ac14e77-02e7-4e5d-b744-2eb1ae5198b7"
+	FOLDERID_SystemX86              FOLDERID = "d65231b0-b2f1-4857-a4ce-a8e7c6ea7d27"
+	FOLDERID_Templates              FOLDERID = "a63293e8-664e-48db-a079-df759e0509f7"
+	FOLDERID_UserPinned             FOLDERID = "9e3995ab-1f9c-4f13-b827-48b24b6c7174"
+	FOLDERID_UserProfiles           FOLDERID = "0762d272-c50a-4bb0-a382-697dcd729b80"
+	FOLDERID_UserProgramFiles       FOLDERID = "5cd7aee2-2219-4a67-b85d-6c9ce15660cb"
+	FOLDERID_UserProgramFilesCommon FOLDERID = "bcbd3057-ca5c-4622-b42d-bc56db0ae516"
+	FOLDERID_UsersFiles             FOLDERID = "f3ce0f7c-4901-4acc-8648-d5d44b04ef8f"
+	FOLDERID_UsersLibraries         FOLDERID = "a302545d-deff-464b-abe8-61c8648d939b"
+	FOLDERID_Videos                 FOLDERID = "18989b1d-99b5-455b-841c-ab7c74e4ddfc"
+	FOLDERID_VideosLibrary          FOLDERID = "491e922f-5643-4af4-a7eb-4e7a138d8174"
+	FOLDERID_Windows                FOLDERID = "f38bf404-1d43-42f2-9305-67de0b28fc23"
+)
+
+// Property keys, used with IPropertyStore, in the format of the FMTID GUID
+// followed by the property ID.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/properties/props
+type PKEY string
+
+const (
+	PKEY_AppUserModel_ExcludeFromShowInNewInstall PKEY = "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 8"
+	PKEY_AppUserModel_ID                          PKEY = "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 5"
+	PKEY_AppUserModel_IsDestListSeparator         PKEY = "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 6"
+	PKEY_AppUserModel_PreventPinning              PKEY = "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 9"
+	PKEY_AppUserModel_RelaunchCommand             PKEY = "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 2"
+	PKEY_AppUserModel_RelaunchDisplayNameResource PKEY = "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 4"
+	PKEY_AppUserModel_RelaunchIconResource        PKEY = "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 3"
+	PKEY_AppUserModel_ToastActivatorCLSID         PKEY = "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 26"
+	PKEY_Audio_EncodingBitrate                    PKEY = "64440490-4c8b-11d1-8b70-080036b11a03 4"
+	PKEY_Author                                   PKEY = "f29f85e0-4ff9-1068-ab91-08002b27b3d9 4"
+	PKEY_Comment                                  PKEY = "f29f85e0-4ff9-1068-ab91-08002b27b3d9 6"
+	PKEY_ContentType                              PKEY = "d5cdd502-2e9c-101b-9397-08002b2cf9ae 26"
+	PKEY_DateAccessed                             PKEY = "b725f130-47ef-101a-a5f1-02608c9eebac 16"
+	PKEY_DateCreated                              PKEY = "b725f130-47ef-101a-a5f1-02608c9eebac 15"
+	PKEY_DateModified                             PKEY = "b725f130-47ef-101a-a5f1-02608c9eebac 14"
+	PKEY_Document_PageCount                       PKEY = "f29f85e0-4ff9-1068-ab91-08002b27b3d9 14"
+	PKEY_FileAttributes                           PKEY = "b725f130-47ef-101a-a5f1-02608c9eebac 13"
+	PKEY_FileName                                 PKEY = "41cf5ae0-f75a-4806-bd87-59c7d9248eb9 100"
+	PKEY_Image_Dimensions                         PKEY = "6444048f-4c8b-11d1-8b70-080036b11a03 13"
+	PKEY_Image_HorizontalSize                     PKEY = "6444048f-4c8b-11d1-8b70-080036b11a03 3"
+	PKEY_Image_VerticalSize                       PKEY = "6444048f-4c8b-11d1-8b70-080036b11a03 4"
+	PKEY_ItemFolderPathDisplay                    PKEY = "e3e0584c-b788-4a5a-bb20-7f5a44c9acdd 6"
+	PKEY_ItemNameDisplay                          PKEY = "b725f130-47ef-101a-a5f1-02608c9eebac 10"
+	PKEY_ItemPathDisplay                          PKEY = "e3e0584c-b788-4a5a-bb20-7f5a44c9acdd 7"
+	PKEY_ItemType                                 PKEY = "28636aa6-953d-11d2-b5d6-00c04fd918d0 11"
+	PKEY_ItemTypeText                             PKEY = "b725f130-47ef-101a-a5f1-02608c9eebac 4"
+	PKEY_Keywords                                 PKEY = "f29f85e0-4ff9-1068-ab91-08002b27b3d9 5"
+	PKEY_Kind                                     PKEY = "1e3ee840-bc2b-476c-8237-2acd1a839b22 3"
+	PKEY_Link_TargetParsingPath                   PKEY = "b9b4b3fc-2b51-4a42-b5d8-324146afcf25 2"
+	PKEY_Media_Duration                           PKEY = "64440490-4c8b-11d1-8b70-080036b11a03 3"
+	PKEY_Media_Year                               PKEY = "56a3372e-ce9c-11d2-9f0e-006097c686f6 5"
+	PKEY_Music_AlbumArtist                        PKEY = "56a3372e-ce9c-11d2-9f0e-006097c686f6 13"
+	PKEY_Music_AlbumTitle                         PKEY = "56a3372e-ce9c-11d2-9f0e-006097c686f6 4"
+	PKEY_Music_Artist                             PKEY = "56a3372e-ce9c-11d2-9f0e-006097c686f6 2"
+	PKEY_Music_Genre                              PKEY = "56a3372e-ce9c-11d2-9f0e-006097c686f6 11"
+	PKEY_Music_TrackNumber                        PKEY = "56a3372e-ce9c-11d2-9f0e-006097c686f6 7"
+	PKEY_ParsingPath                              PKEY = "28636aa6-953d-11d2-b5d6-00c04fd918d0 30"
+	PKEY_Photo_CameraManufacturer                 PKEY = "14b81da1-0135-4d31-96d9-6cbfc9671a99 271"
+	PKEY_Photo_CameraModel                        PKEY = "14b81da1-0135-4d31-96d9-6cbfc9671a99 272"
+	PKEY_Photo_DateTaken                          PKEY = "14b81da1-0135-4d31-96d9-6cbfc9671a99 36867"
+	PKEY_Rating                                   PKEY = "64440492-4c8b-11d1-8b70-080036b11a03 9"
+	PKEY_Size                                     PKEY = "b725f130-47ef-101a-a5f1-02608c9eebac 12"
+	PKEY_Subject                                  PKEY = "f29f85e0-4ff9-1068-ab91-08002b27b3d9 3"
+	PKEY_Title                                    PKEY = "f29f85e0-4ff9-1068-ab91-08002b27b3d9 2"
+	PKEY_Video_FrameHeight                        PKEY = "64440491-4c8b-11d1-8b70-080036b11a03 4"
+	PKEY_Video_FrameRate                          PKEY = "64440491-4c8b-11d1-8b70-080036b11a03 6"
+	PKEY_Video_FrameWidth                         PKEY = "64440491-4c8b-11d1-8b70-080036b11a03 3"
 )
@@ -6,6 +6,61 @@ import (
 	"github.com/rodrigocfd/windigo/win/com/com/comvt"
 )
 
+// IApplicationAssociationRegistration virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-iapplicationassociationregistration
+type IApplicationAssociationRegistration struct {
+	comvt.IUnknown
+	QueryCurrentDefault   uintptr
+	QueryAppIsDefault     uintptr
+	QueryAppIsDefaultAll  uintptr
+	SetAppAsDefault       uintptr
+	SetAppAsDefaultAll    uintptr
+	ClearUserAssociations uintptr
+}
+
+// IContextMenu virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-icontextmenu
+type IContextMenu struct {
+	comvt.IUnknown
+	QueryContextMenu uintptr
+	InvokeCommand    uintptr
+	GetCommandString uintptr
+}
+
+// IContextMenu2 virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-icontextmenu2
+type IContextMenu2 struct {
+	IContextMenu
+	HandleMenuMsg uintptr
+}
+
+// IContextMenu3 virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-icontextmenu3
+type IContextMenu3 struct {
+	IContextMenu2
+	HandleMenuMsg2 uintptr
+}
+
+// ICustomDestinationList virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-icustomdestinationlist
+type ICustomDestinationList struct {
+	comvt.IUnknown
+	SetAppID               uintptr
+	BeginList              uintptr
+	AppendCategory         uintptr
+	AppendKnownCategory    uintptr
+	AddUserTasks           uintptr
+	CommitList             uintptr
+	GetRemovedDestinations uintptr
+	DeleteList             uintptr
+	AbortList              uintptr
+}
+
 // IDataObject virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/objidl/nn-objidl-idataobject
@@ -56,6 +111,17 @@ type IDropTarget struct {
 	Drop      uintptr
 }
 
+// IEnumShellItems virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ienumshellitems
+type IEnumShellItems struct {
+	comvt.IUnknown
+	Next  uintptr
+	Skip  uintptr
+	Reset uintptr
+	Clone uintptr
+}
+
 // IFileDialog virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifiledialog
@@ -86,6 +152,54 @@ type IFileDialog struct {
 	SetFilter           uintptr
 }
 
+// IFileDialogCustomize virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifiledialogcustomize
+type IFileDialogCustomize struct {
+	comvt.IUnknown
+	EnableOpenDropDown     uintptr
+	AddMenu                uintptr
+	AddPushButton          uintptr
+	AddComboBox            uintptr
+	AddRadioButtonList     uintptr
+	AddCheckButton         uintptr
+	AddEditBox             uintptr
+	AddSeparator           uintptr
+	AddText                uintptr
+	SetControlLabel        uintptr
+	GetControlState        uintptr
+	SetControlState        uintptr
+	GetEditBoxText         uintptr
+	SetEditBoxText         uintptr
+	GetCheckButtonState    uintptr
+	SetCheckButtonState    uintptr
+	AddControlItem         uintptr
+	RemoveControlItem      uintptr
+	RemoveAllControlItems  uintptr
+	GetControlItemState    uintptr
+	SetControlItemState    uintptr
+	GetSelectedControlItem uintptr
+	SetSelectedControlItem uintptr
+	StartVisualGroup       uintptr
+	EndVisualGroup         uintptr
+	MakeProminent          uintptr
+	SetControlItemText     uintptr
+}
+
+// IFileDialogEvents virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifiledialogevents
+type IFileDialogEvents struct {
+	comvt.IUnknown
+	OnFileOk          uintptr
+	OnFolderChanging  uintptr
+	OnFolderChange    uintptr
+	OnSelectionChange uintptr
+	OnShareViolation  uintptr
+	OnTypeChange      uintptr
+	OnOverwrite       uintptr
+}
+
 // IFileOpenDialog virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifileopendialog
@@ -95,6 +209,56 @@ type IFileOpenDialog struct {
 	GetSelectedItems uintptr
 }
 
+// IFileOperation virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifileoperation
+type IFileOperation struct {
+	comvt.IUnknown
+	Advise                  uintptr
+	Unadvise                uintptr
+	SetOperationFlags       uintptr
+	SetProgressMessage      uintptr
+	SetProgressDialog       uintptr
+	SetProperties           uintptr
+	SetOwnerWindow          uintptr
+	ApplyPropertiesToItem   uintptr
+	ApplyPropertiesToItems  uintptr
+	RenameItem              uintptr
+	RenameItems             uintptr
+	MoveItem                uintptr
+	MoveItems               uintptr
+	CopyItem                uintptr
+	CopyItems               uintptr
+	DeleteItem              uintptr
+	DeleteItems             uintptr
+	NewItem                 uintptr
+	PerformOperations       uintptr
+	GetAnyOperationsAborted uintptr
+}
+
+// IFileOperationProgressSink virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifileoperationprogresssink
+type IFileOperationProgressSink struct {
+	comvt.IUnknown
+	StartOperations  uintptr
+	FinishOperations uintptr
+	PreRenameItem    uintptr
+	PostRenameItem   uintptr
+	PreMoveItem      uintptr
+	PostMoveItem     uintptr
+	PreCopyItem      uintptr
+	PostCopyItem     uintptr
+	PreDeleteItem    uintptr
+	PostDeleteItem   uintptr
+	PreNewItem       uintptr
+	PostNewItem      uintptr
+	UpdateProgress   uintptr
+	ResetTimer       uintptr
+	PauseTimer       uintptr
+	ResumeTimer      uintptr
+}
+
 // IFileSaveDialog virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifilesavedialog
@@ -115,6 +279,63 @@ type IModalWindow struct {
 	Show uintptr
 }
 
+// INotificationActivationCallback virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/notificationactivationcallback/nn-notificationactivationcallback-inotificationactivationcallback
+type INotificationActivationCallback struct {
+	comvt.IUnknown
+	Activate uintptr
+}
+
+// IObjectArray virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nn-objectarray-iobjectarray
+type IObjectArray struct {
+	comvt.IUnknown
+	GetCount uintptr
+	GetAt    uintptr
+}
+
+// IObjectCollection virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/objectarray/nn-objectarray-iobjectcollection
+type IObjectCollection struct {
+	IObjectArray
+	AddObject      uintptr
+	AddFromArray   uintptr
+	RemoveObjectAt uintptr
+	Clear          uintptr
+}
+
+// IPropertyStore virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/propsys/nn-propsys-ipropertystore
+type IPropertyStore struct {
+	comvt.IUnknown
+	GetCount uintptr
+	GetAt    uintptr
+	GetValue uintptr
+	SetValue uintptr
+	Commit   uintptr
+}
+
+// IShellFolder virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ishellfolder
+type IShellFolder struct {
+	comvt.IUnknown
+	ParseDisplayName uintptr
+	EnumObjects      uintptr
+	BindToObject     uintptr
+	BindToStorage    uintptr
+	CompareIDs       uintptr
+	CreateViewObject uintptr
+	GetAttributesOf  uintptr
+	GetUIObjectOf    uintptr
+	GetDisplayNameOf uintptr
+	SetNameOf        uintptr
+}
+
 // IShellItem virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ishellitem
@@ -127,6 +348,26 @@ type IShellItem struct {
 	Compare        uintptr
 }
 
+// IShellItem2 virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ishellitem2
+type IShellItem2 struct {
+	IShellItem
+	GetPropertyStore                 uintptr
+	GetPropertyStoreWithCreateObject uintptr
+	GetPropertyStoreForKeys          uintptr
+	GetPropertyDescriptionList       uintptr
+	Update                           uintptr
+	GetProperty                      uintptr
+	GetCLSID                         uintptr
+	GetFileTime                      uintptr
+	GetInt32                         uintptr
+	GetString                        uintptr
+	GetUInt32                        uintptr
+	GetUInt64                        uintptr
+	GetBool                          uintptr
+}
+
 // IShellItemArray virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ishellitemarray
@@ -141,6 +382,14 @@ type IShellItemArray struct {
 	EnumItems                  uintptr
 }
 
+// IShellItemImageFactory virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ishellitemimagefactory
+type IShellItemImageFactory struct {
+	comvt.IUnknown
+	GetImage uintptr
+}
+
 // IShellLink virtual table.
 //
 // 📑 https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ishelllinkw
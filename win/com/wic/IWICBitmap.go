@@ -0,0 +1,21 @@
+//go:build windows
+
+package wic
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/com"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmap
+type IWICBitmap interface {
+	IWICBitmapSource
+}
+
+type _IWICBitmap struct{ IWICBitmapSource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICBitmap.Release().
+func NewIWICBitmap(base com.IUnknown) IWICBitmap {
+	return &_IWICBitmap{IWICBitmapSource: NewIWICBitmapSource(base)}
+}
@@ -0,0 +1,127 @@
+//go:build windows
+
+package wic
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapdecoder
+type IWICBitmapDecoder interface {
+	com.IUnknown
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapdecoder-getcontainerformat
+	GetContainerFormat() wicco.CONTAINER_FORMAT
+
+	// ⚠️ You must defer IWICBitmapFrameDecode.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapdecoder-getframe
+	GetFrame(index uint) IWICBitmapFrameDecode
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapdecoder-getframecount
+	GetFrameCount() uint
+
+	// Returns the metadata of the whole container. Formats without container
+	// metadata, like BMP, return errco.WINCODEC_ERR_UNSUPPORTEDOPERATION.
+	//
+	// ⚠️ You must defer IWICMetadataQueryReader.Release() on the returned
+	// object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapdecoder-getmetadataqueryreader
+	GetMetadataQueryReader() (IWICMetadataQueryReader, error)
+
+	// Returns the thumbnail of the whole container, if any. Otherwise returns
+	// errco.WINCODEC_ERR_CODECNOTHUMBNAIL.
+	//
+	// ⚠️ You must defer IWICBitmapSource.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapdecoder-getthumbnail
+	GetThumbnail() (IWICBitmapSource, error)
+}
+
+type _IWICBitmapDecoder struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICBitmapDecoder.Release().
+func NewIWICBitmapDecoder(base com.IUnknown) IWICBitmapDecoder {
+	return &_IWICBitmapDecoder{IUnknown: base}
+}
+
+func (me *_IWICBitmapDecoder) GetContainerFormat() wicco.CONTAINER_FORMAT {
+	var guid win.GUID
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapDecoder)(unsafe.Pointer(*me.Ptr())).GetContainerFormat,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&guid)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return wicco.CONTAINER_FORMAT(guid.String())
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapDecoder) GetFrame(index uint) IWICBitmapFrameDecode {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapDecoder)(unsafe.Pointer(*me.Ptr())).GetFrame,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(index), uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmapFrameDecode(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapDecoder) GetFrameCount() uint {
+	var count uint32
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapDecoder)(unsafe.Pointer(*me.Ptr())).GetFrameCount,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&count)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return uint(count)
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapDecoder) GetMetadataQueryReader() (IWICMetadataQueryReader, error) {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapDecoder)(unsafe.Pointer(*me.Ptr())).GetMetadataQueryReader,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICMetadataQueryReader(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
+
+func (me *_IWICBitmapDecoder) GetThumbnail() (IWICBitmapSource, error) {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapDecoder)(unsafe.Pointer(*me.Ptr())).GetThumbnail,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmapSource(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
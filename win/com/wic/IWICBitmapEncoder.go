@@ -0,0 +1,101 @@
+//go:build windows
+
+package wic
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapencoder
+type IWICBitmapEncoder interface {
+	com.IUnknown
+
+	// Writes the encoded image to the stream. Must be called after all frames
+	// were committed.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapencoder-commit
+	Commit()
+
+	// Creates a new frame with no encoder options. It must be initialized with
+	// IWICBitmapFrameEncode.Initialize().
+	//
+	// ⚠️ You must defer IWICBitmapFrameEncode.Release() on the returned
+	// object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapencoder-createnewframe
+	CreateNewFrame() IWICBitmapFrameEncode
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapencoder-getcontainerformat
+	GetContainerFormat() wicco.CONTAINER_FORMAT
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapencoder-initialize
+	Initialize(stream com.IStream, cacheOption wicco.BITMAP_ENCODER_CACHE)
+}
+
+type _IWICBitmapEncoder struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICBitmapEncoder.Release().
+func NewIWICBitmapEncoder(base com.IUnknown) IWICBitmapEncoder {
+	return &_IWICBitmapEncoder{IUnknown: base}
+}
+
+func (me *_IWICBitmapEncoder) Commit() {
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapEncoder)(unsafe.Pointer(*me.Ptr())).Commit,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapEncoder) CreateNewFrame() IWICBitmapFrameEncode {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapEncoder)(unsafe.Pointer(*me.Ptr())).CreateNewFrame,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)), 0)
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmapFrameEncode(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapEncoder) GetContainerFormat() wicco.CONTAINER_FORMAT {
+	var guid win.GUID
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapEncoder)(unsafe.Pointer(*me.Ptr())).GetContainerFormat,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&guid)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return wicco.CONTAINER_FORMAT(guid.String())
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapEncoder) Initialize(
+	stream com.IStream, cacheOption wicco.BITMAP_ENCODER_CACHE) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapEncoder)(unsafe.Pointer(*me.Ptr())).Initialize,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(stream.Ptr())), uintptr(cacheOption))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
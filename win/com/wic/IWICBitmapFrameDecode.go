@@ -0,0 +1,72 @@
+//go:build windows
+
+package wic
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapframedecode
+type IWICBitmapFrameDecode interface {
+	IWICBitmapSource
+
+	// Returns the metadata of the frame, like EXIF tags. Formats without
+	// metadata, like BMP, return errco.WINCODEC_ERR_UNSUPPORTEDOPERATION.
+	//
+	// ⚠️ You must defer IWICMetadataQueryReader.Release() on the returned
+	// object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapframedecode-getmetadataqueryreader
+	GetMetadataQueryReader() (IWICMetadataQueryReader, error)
+
+	// Returns the thumbnail of the frame, if any. Otherwise returns
+	// errco.WINCODEC_ERR_CODECNOTHUMBNAIL.
+	//
+	// ⚠️ You must defer IWICBitmapSource.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapframedecode-getthumbnail
+	GetThumbnail() (IWICBitmapSource, error)
+}
+
+type _IWICBitmapFrameDecode struct{ IWICBitmapSource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICBitmapFrameDecode.Release().
+func NewIWICBitmapFrameDecode(base com.IUnknown) IWICBitmapFrameDecode {
+	return &_IWICBitmapFrameDecode{IWICBitmapSource: NewIWICBitmapSource(base)}
+}
+
+func (me *_IWICBitmapFrameDecode) GetMetadataQueryReader() (IWICMetadataQueryReader, error) {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapFrameDecode)(unsafe.Pointer(*me.Ptr())).GetMetadataQueryReader,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICMetadataQueryReader(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
+
+func (me *_IWICBitmapFrameDecode) GetThumbnail() (IWICBitmapSource, error) {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapFrameDecode)(unsafe.Pointer(*me.Ptr())).GetThumbnail,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmapSource(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
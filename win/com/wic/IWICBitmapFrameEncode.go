@@ -0,0 +1,151 @@
+//go:build windows
+
+package wic
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapframeencode
+type IWICBitmapFrameEncode interface {
+	com.IUnknown
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapframeencode-commit
+	Commit()
+
+	// Initializes the frame with no encoder options.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapframeencode-initialize
+	Initialize()
+
+	// Requests the given pixel format, returning the closest one supported by
+	// the encoder, which can be different.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapframeencode-setpixelformat
+	SetPixelFormat(format wicco.PIXEL_FORMAT) wicco.PIXEL_FORMAT
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapframeencode-setresolution
+	SetResolution(dpiX, dpiY float64)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapframeencode-setsize
+	SetSize(width, height uint)
+
+	// Writes rows of pixels in the format set with SetPixelFormat(). Can be
+	// called multiple times, for consecutive rows.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapframeencode-writepixels
+	WritePixels(lineCount, stride uint, pixels []byte)
+
+	// Writes the pixels of the given rectangle of the source; if rc is nil,
+	// the whole source is written. If the size was not set, the source size
+	// is used.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapframeencode-writesource
+	WriteSource(source IWICBitmapSource, rc *RECT)
+}
+
+type _IWICBitmapFrameEncode struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICBitmapFrameEncode.Release().
+func NewIWICBitmapFrameEncode(base com.IUnknown) IWICBitmapFrameEncode {
+	return &_IWICBitmapFrameEncode{IUnknown: base}
+}
+
+func (me *_IWICBitmapFrameEncode) Commit() {
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapFrameEncode)(unsafe.Pointer(*me.Ptr())).Commit,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapFrameEncode) Initialize() {
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapFrameEncode)(unsafe.Pointer(*me.Ptr())).Initialize,
+		uintptr(unsafe.Pointer(me.Ptr())), 0)
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapFrameEncode) SetPixelFormat(
+	format wicco.PIXEL_FORMAT) wicco.PIXEL_FORMAT {
+
+	guid := win.GuidFromClsid(co.CLSID(format)) // in/out
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapFrameEncode)(unsafe.Pointer(*me.Ptr())).SetPixelFormat,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(guid)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return wicco.PIXEL_FORMAT(guid.String())
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapFrameEncode) SetResolution(dpiX, dpiY float64) {
+	args := []uintptr{uintptr(unsafe.Pointer(me.Ptr()))}
+	args = append(args, util.Arg64(math.Float64bits(dpiX))...)
+	args = append(args, util.Arg64(math.Float64bits(dpiY))...)
+
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapFrameEncode)(unsafe.Pointer(*me.Ptr())).SetResolution,
+		args...)
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapFrameEncode) SetSize(width, height uint) {
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapFrameEncode)(unsafe.Pointer(*me.Ptr())).SetSize,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(width), uintptr(height))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapFrameEncode) WritePixels(
+	lineCount, stride uint, pixels []byte) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapFrameEncode)(unsafe.Pointer(*me.Ptr())).WritePixels,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(lineCount), uintptr(stride),
+		uintptr(len(pixels)), uintptr(unsafe.Pointer(&pixels[0])))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapFrameEncode) WriteSource(source IWICBitmapSource, rc *RECT) {
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapFrameEncode)(unsafe.Pointer(*me.Ptr())).WriteSource,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(source.Ptr())),
+		uintptr(unsafe.Pointer(rc)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
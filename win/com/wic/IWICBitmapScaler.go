@@ -0,0 +1,45 @@
+//go:build windows
+
+package wic
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapscaler
+type IWICBitmapScaler interface {
+	IWICBitmapSource
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapscaler-initialize
+	Initialize(source IWICBitmapSource,
+		width, height uint, mode wicco.BITMAP_INTERPOLATION_MODE)
+}
+
+type _IWICBitmapScaler struct{ IWICBitmapSource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICBitmapScaler.Release().
+func NewIWICBitmapScaler(base com.IUnknown) IWICBitmapScaler {
+	return &_IWICBitmapScaler{IWICBitmapSource: NewIWICBitmapSource(base)}
+}
+
+func (me *_IWICBitmapScaler) Initialize(source IWICBitmapSource,
+	width, height uint, mode wicco.BITMAP_INTERPOLATION_MODE) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapScaler)(unsafe.Pointer(*me.Ptr())).Initialize,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(source.Ptr())),
+		uintptr(width), uintptr(height), uintptr(mode))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
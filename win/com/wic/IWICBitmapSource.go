@@ -0,0 +1,106 @@
+//go:build windows
+
+package wic
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapsource
+type IWICBitmapSource interface {
+	com.IUnknown
+
+	// Copies the pixels of the given rectangle into the buffer; if rc is nil,
+	// the whole bitmap is copied. The stride is the number of bytes of each
+	// row in the buffer.
+	//
+	// Example:
+	//
+	//	var src wic.IWICBitmapSource // initialized somewhere, 32bppPBGRA
+	//
+	//	width, height := src.GetSize()
+	//	pixels := make([]byte, width*height*4)
+	//	src.CopyPixels(nil, width*4, pixels)
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapsource-copypixels
+	CopyPixels(rc *RECT, stride uint, buf []byte)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapsource-getpixelformat
+	GetPixelFormat() wicco.PIXEL_FORMAT
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapsource-getresolution
+	GetResolution() (dpiX, dpiY float64)
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicbitmapsource-getsize
+	GetSize() (width, height uint)
+}
+
+type _IWICBitmapSource struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICBitmapSource.Release().
+func NewIWICBitmapSource(base com.IUnknown) IWICBitmapSource {
+	return &_IWICBitmapSource{IUnknown: base}
+}
+
+func (me *_IWICBitmapSource) CopyPixels(rc *RECT, stride uint, buf []byte) {
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapSource)(unsafe.Pointer(*me.Ptr())).CopyPixels,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(rc)),
+		uintptr(stride), uintptr(len(buf)),
+		uintptr(unsafe.Pointer(&buf[0])))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapSource) GetPixelFormat() wicco.PIXEL_FORMAT {
+	var guid win.GUID
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapSource)(unsafe.Pointer(*me.Ptr())).GetPixelFormat,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&guid)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return wicco.PIXEL_FORMAT(guid.String())
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapSource) GetResolution() (dpiX, dpiY float64) {
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapSource)(unsafe.Pointer(*me.Ptr())).GetResolution,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&dpiX)), uintptr(unsafe.Pointer(&dpiY)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICBitmapSource) GetSize() (width, height uint) {
+	var cx, cy uint32
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICBitmapSource)(unsafe.Pointer(*me.Ptr())).GetSize,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&cx)), uintptr(unsafe.Pointer(&cy)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return uint(cx), uint(cy)
+	} else {
+		panic(hr)
+	}
+}
@@ -0,0 +1,95 @@
+//go:build windows
+
+package wic
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicformatconverter
+type IWICFormatConverter interface {
+	IWICBitmapSource
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicformatconverter-canconvert
+	CanConvert(srcFormat, dstFormat wicco.PIXEL_FORMAT) bool
+
+	// Initializes the converter with no custom palette.
+	//
+	// Example:
+	//
+	//	var factory wic.IWICImagingFactory // initialized somewhere
+	//	var frame wic.IWICBitmapFrameDecode
+	//
+	//	conv := factory.CreateFormatConverter()
+	//	defer conv.Release()
+	//
+	//	conv.Initialize(frame, wicco.PIXEL_FORMAT_32bppPBGRA,
+	//		wicco.BITMAP_DITHER_TYPE_NONE, 0,
+	//		wicco.BITMAP_PALETTE_TYPE_MEDIAN_CUT)
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicformatconverter-initialize
+	Initialize(source IWICBitmapSource, dstFormat wicco.PIXEL_FORMAT,
+		dither wicco.BITMAP_DITHER_TYPE, alphaThresholdPercent float64,
+		paletteTranslate wicco.BITMAP_PALETTE_TYPE)
+}
+
+type _IWICFormatConverter struct{ IWICBitmapSource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICFormatConverter.Release().
+func NewIWICFormatConverter(base com.IUnknown) IWICFormatConverter {
+	return &_IWICFormatConverter{IWICBitmapSource: NewIWICBitmapSource(base)}
+}
+
+func (me *_IWICFormatConverter) CanConvert(
+	srcFormat, dstFormat wicco.PIXEL_FORMAT) bool {
+
+	var canConvert int32 // BOOL
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICFormatConverter)(unsafe.Pointer(*me.Ptr())).CanConvert,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.GuidFromClsid(co.CLSID(srcFormat)))),
+		uintptr(unsafe.Pointer(win.GuidFromClsid(co.CLSID(dstFormat)))),
+		uintptr(unsafe.Pointer(&canConvert)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return canConvert != 0
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICFormatConverter) Initialize(
+	source IWICBitmapSource, dstFormat wicco.PIXEL_FORMAT,
+	dither wicco.BITMAP_DITHER_TYPE, alphaThresholdPercent float64,
+	paletteTranslate wicco.BITMAP_PALETTE_TYPE) {
+
+	args := []uintptr{
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(source.Ptr())),
+		uintptr(unsafe.Pointer(win.GuidFromClsid(co.CLSID(dstFormat)))),
+		uintptr(dither),
+		0, // IWICPalette
+	}
+	args = append(args, util.Arg64(math.Float64bits(alphaThresholdPercent))...)
+	args = append(args, uintptr(paletteTranslate))
+
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICFormatConverter)(unsafe.Pointer(*me.Ptr())).Initialize,
+		args...)
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
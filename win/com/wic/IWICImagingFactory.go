@@ -0,0 +1,230 @@
+//go:build windows
+
+package wic
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicimagingfactory
+type IWICImagingFactory interface {
+	com.IUnknown
+
+	// ⚠️ You must defer IWICBitmapScaler.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicimagingfactory-createbitmapscaler
+	CreateBitmapScaler() IWICBitmapScaler
+
+	// Creates a bitmap with a copy of the HBITMAP pixels.
+	//
+	// ⚠️ You must defer IWICBitmap.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicimagingfactory-createbitmapfromhbitmap
+	CreateBitmapFromHBITMAP(hBmp win.HBITMAP,
+		options wicco.BITMAP_ALPHA_CHANNEL) IWICBitmap
+
+	// Creates a bitmap with a copy of the HICON pixels.
+	//
+	// ⚠️ You must defer IWICBitmap.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicimagingfactory-createbitmapfromhicon
+	CreateBitmapFromHICON(hIcon win.HICON) IWICBitmap
+
+	// Opens an image file, choosing the decoder by its contents. If the file
+	// cannot be opened or decoded, returns an error like
+	// errco.WINCODEC_ERR_COMPONENTNOTFOUND.
+	//
+	// ⚠️ You must defer IWICBitmapDecoder.Release() on the returned object.
+	//
+	// Example:
+	//
+	//	var factory wic.IWICImagingFactory // initialized somewhere
+	//
+	//	decoder, err := factory.CreateDecoderFromFilename("C:\\Temp\\foo.png",
+	//		co.GENERIC_READ, wicco.DECODE_METADATA_CACHE_ON_DEMAND)
+	//	if err != nil {
+	//		panic(err)
+	//	}
+	//	defer decoder.Release()
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicimagingfactory-createdecoderfromfilename
+	CreateDecoderFromFilename(filePath string,
+		access co.GENERIC, options wicco.DECODE) (IWICBitmapDecoder, error)
+
+	// Decodes an image from a stream, choosing the decoder by its contents.
+	//
+	// ⚠️ You must defer IWICBitmapDecoder.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicimagingfactory-createdecoderfromstream
+	CreateDecoderFromStream(stream com.IStream,
+		options wicco.DECODE) (IWICBitmapDecoder, error)
+
+	// ⚠️ You must defer IWICBitmapEncoder.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicimagingfactory-createencoder
+	CreateEncoder(format wicco.CONTAINER_FORMAT) IWICBitmapEncoder
+
+	// ⚠️ You must defer IWICFormatConverter.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicimagingfactory-createformatconverter
+	CreateFormatConverter() IWICFormatConverter
+
+	// ⚠️ You must defer IWICStream.Release() on the returned object.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicimagingfactory-createstream
+	CreateStream() IWICStream
+}
+
+type _IWICImagingFactory struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICImagingFactory.Release().
+//
+// Example:
+//
+//	factory := wic.NewIWICImagingFactory(
+//		com.CoCreateInstance(
+//			wicco.CLSID_WICImagingFactory, nil,
+//			comco.CLSCTX_INPROC_SERVER,
+//			wicco.IID_IWICImagingFactory),
+//	)
+//	defer factory.Release()
+func NewIWICImagingFactory(base com.IUnknown) IWICImagingFactory {
+	return &_IWICImagingFactory{IUnknown: base}
+}
+
+func (me *_IWICImagingFactory) CreateBitmapScaler() IWICBitmapScaler {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICImagingFactory)(unsafe.Pointer(*me.Ptr())).CreateBitmapScaler,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmapScaler(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICImagingFactory) CreateBitmapFromHBITMAP(
+	hBmp win.HBITMAP, options wicco.BITMAP_ALPHA_CHANNEL) IWICBitmap {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICImagingFactory)(unsafe.Pointer(*me.Ptr())).CreateBitmapFromHBITMAP,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(hBmp), 0, uintptr(options),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmap(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICImagingFactory) CreateBitmapFromHICON(hIcon win.HICON) IWICBitmap {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICImagingFactory)(unsafe.Pointer(*me.Ptr())).CreateBitmapFromHICON,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(hIcon), uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmap(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICImagingFactory) CreateDecoderFromFilename(filePath string,
+	access co.GENERIC, options wicco.DECODE) (IWICBitmapDecoder, error) {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICImagingFactory)(unsafe.Pointer(*me.Ptr())).CreateDecoderFromFilename,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(filePath))),
+		0, uintptr(access), uintptr(options),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmapDecoder(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
+
+func (me *_IWICImagingFactory) CreateDecoderFromStream(
+	stream com.IStream, options wicco.DECODE) (IWICBitmapDecoder, error) {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICImagingFactory)(unsafe.Pointer(*me.Ptr())).CreateDecoderFromStream,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(stream.Ptr())),
+		0, uintptr(options),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmapDecoder(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
+
+func (me *_IWICImagingFactory) CreateEncoder(
+	format wicco.CONTAINER_FORMAT) IWICBitmapEncoder {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICImagingFactory)(unsafe.Pointer(*me.Ptr())).CreateEncoder,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.GuidFromClsid(co.CLSID(format)))),
+		0, uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICBitmapEncoder(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICImagingFactory) CreateFormatConverter() IWICFormatConverter {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICImagingFactory)(unsafe.Pointer(*me.Ptr())).CreateFormatConverter,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICFormatConverter(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICImagingFactory) CreateStream() IWICStream {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICImagingFactory)(unsafe.Pointer(*me.Ptr())).CreateStream,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIWICStream(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
@@ -0,0 +1,123 @@
+//go:build windows
+
+package wic
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/autom"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicmetadataqueryreader
+type IWICMetadataQueryReader interface {
+	com.IUnknown
+
+	// Returns the format of the metadata block. For the root reader of a
+	// decoder, this is the container format.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicmetadataqueryreader-getcontainerformat
+	GetContainerFormat() wicco.CONTAINER_FORMAT
+
+	// Returns the query path of the reader, relative to the root.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicmetadataqueryreader-getlocation
+	GetLocation() string
+
+	// Retrieves a metadata value by its query expression. The value is
+	// converted with autom.PROPVARIANT.Value(); nested metadata blocks are
+	// returned as IWICMetadataQueryReader objects.
+	//
+	// If the value doesn't exist, returns
+	// errco.WINCODEC_ERR_PROPERTYNOTFOUND.
+	//
+	// ⚠️ If the value is an IWICMetadataQueryReader, you must defer
+	// IWICMetadataQueryReader.Release() on it.
+	//
+	// Example:
+	//
+	//	var frame wic.IWICBitmapFrameDecode // initialized somewhere
+	//
+	//	if reader, err := frame.GetMetadataQueryReader(); err == nil {
+	//		defer reader.Release()
+	//		if model, err := reader.GetMetadataByName("/app1/ifd/{ushort=272}"); err == nil {
+	//			println(model.(string)) // camera model
+	//		}
+	//	}
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicmetadataqueryreader-getmetadatabyname
+	GetMetadataByName(name string) (any, error)
+}
+
+type _IWICMetadataQueryReader struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICMetadataQueryReader.Release().
+func NewIWICMetadataQueryReader(base com.IUnknown) IWICMetadataQueryReader {
+	return &_IWICMetadataQueryReader{IUnknown: base}
+}
+
+func (me *_IWICMetadataQueryReader) GetContainerFormat() wicco.CONTAINER_FORMAT {
+	var guid win.GUID
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICMetadataQueryReader)(unsafe.Pointer(*me.Ptr())).GetContainerFormat,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&guid)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return wicco.CONTAINER_FORMAT(guid.String())
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICMetadataQueryReader) GetLocation() string {
+	var numChars uint32
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICMetadataQueryReader)(unsafe.Pointer(*me.Ptr())).GetLocation,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		0, 0, uintptr(unsafe.Pointer(&numChars))) // retrieve length first
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+
+	buf := make([]uint16, numChars+1)
+	ret, _, _ = syscall.SyscallN(
+		(*wicvt.IWICMetadataQueryReader)(unsafe.Pointer(*me.Ptr())).GetLocation,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(len(buf)), uintptr(unsafe.Pointer(&buf[0])),
+		uintptr(unsafe.Pointer(&numChars)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return win.Str.FromNativeSlice(buf)
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IWICMetadataQueryReader) GetMetadataByName(name string) (any, error) {
+	var pv autom.PROPVARIANT
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICMetadataQueryReader)(unsafe.Pointer(*me.Ptr())).GetMetadataByName,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(name))),
+		uintptr(unsafe.Pointer(&pv)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		return nil, hr
+	}
+	defer pv.PropVariantClear()
+
+	val := pv.Value()
+	if unk, ok := val.(com.IUnknown); ok { // nested metadata block
+		return NewIWICMetadataQueryReader(unk), nil
+	}
+	return val, nil
+}
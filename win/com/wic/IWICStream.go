@@ -0,0 +1,66 @@
+//go:build windows
+
+package wic
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicstream
+type IWICStream interface {
+	com.IStream
+
+	// Opens the file with the given access, usually co.GENERIC_READ or
+	// co.GENERIC_WRITE.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicstream-initializefromfilename
+	InitializeFromFilename(filePath string, access co.GENERIC) error
+
+	// The buffer must remain in memory while the stream is used.
+	//
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nf-wincodec-iwicstream-initializefrommemory
+	InitializeFromMemory(buf []byte)
+}
+
+type _IWICStream struct{ com.IStream }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IWICStream.Release().
+func NewIWICStream(base com.IUnknown) IWICStream {
+	return &_IWICStream{IStream: com.NewIStream(base)}
+}
+
+func (me *_IWICStream) InitializeFromFilename(
+	filePath string, access co.GENERIC) error {
+
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICStream)(unsafe.Pointer(*me.Ptr())).InitializeFromFilename,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(filePath))),
+		uintptr(access))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return nil
+	} else {
+		return hr
+	}
+}
+
+func (me *_IWICStream) InitializeFromMemory(buf []byte) {
+	ret, _, _ := syscall.SyscallN(
+		(*wicvt.IWICStream)(unsafe.Pointer(*me.Ptr())).InitializeFromMemory,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
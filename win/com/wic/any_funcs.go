@@ -0,0 +1,129 @@
+//go:build windows
+
+package wic
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/wic/wicco"
+)
+
+// Decodes the first frame of an image file, converting it to the given pixel
+// format. Direct2D bitmaps require wicco.PIXEL_FORMAT_32bppPBGRA.
+//
+// ⚠️ You must defer IWICBitmapSource.Release() on the returned object.
+//
+// Example:
+//
+//	var factory wic.IWICImagingFactory // initialized somewhere
+//
+//	src, err := wic.NewBitmapSourceFromFile(factory,
+//		"C:\\Temp\\foo.webp", wicco.PIXEL_FORMAT_32bppPBGRA)
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer src.Release()
+func NewBitmapSourceFromFile(factory IWICImagingFactory,
+	filePath string, format wicco.PIXEL_FORMAT) (IWICBitmapSource, error) {
+
+	decoder, err := factory.CreateDecoderFromFilename(filePath,
+		co.GENERIC_READ, wicco.DECODE_METADATA_CACHE_ON_DEMAND)
+	if err != nil {
+		return nil, err
+	}
+	defer decoder.Release()
+
+	frame := decoder.GetFrame(0)
+	defer frame.Release()
+
+	converter := factory.CreateFormatConverter() // keeps a reference to the frame
+	converter.Initialize(frame, format, wicco.BITMAP_DITHER_TYPE_NONE, 0,
+		wicco.BITMAP_PALETTE_TYPE_MEDIAN_CUT)
+	return converter, nil
+}
+
+// Decodes the first frame of an image file into a 32-bit top-down DIB
+// section, with premultiplied alpha, ready to be used with AlphaBlend(). Any
+// format with an installed WIC codec is supported, like PNG, JPEG, TIFF, GIF,
+// WebP and HEIF.
+//
+// ⚠️ You must defer HBITMAP.DeleteObject().
+//
+// Example:
+//
+//	var factory wic.IWICImagingFactory // initialized somewhere
+//
+//	hBmp, err := wic.NewHbitmapFromFile(factory, "C:\\Temp\\foo.png")
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer hBmp.DeleteObject()
+func NewHbitmapFromFile(
+	factory IWICImagingFactory, filePath string) (win.HBITMAP, error) {
+
+	src, err := NewBitmapSourceFromFile(factory,
+		filePath, wicco.PIXEL_FORMAT_32bppPBGRA)
+	if err != nil {
+		return win.HBITMAP(0), err
+	}
+	defer src.Release()
+
+	width, height := src.GetSize()
+	bmi := win.BITMAPINFO{
+		BmiHeader: win.BITMAPINFOHEADER{
+			BiWidth:       int32(width),
+			BiHeight:      -int32(height), // negative: top-down
+			BiPlanes:      1,
+			BiBitCount:    32,
+			BiCompression: co.BI_RGB,
+		},
+	}
+	bmi.BmiHeader.SetBiSize()
+
+	hBmp, pBits := win.HDC(0).CreateDIBSection(&bmi, co.DIB_RGB_COLORS, 0, 0)
+	src.CopyPixels(nil, width*4, unsafe.Slice(pBits, width*height*4))
+	return hBmp, nil
+}
+
+// Encodes the bitmap source into a single-frame image file of the given
+// format, like wicco.CONTAINER_FORMAT_PNG. The file is overwritten, if
+// existing.
+//
+// Example:
+//
+//	var factory wic.IWICImagingFactory // initialized somewhere
+//	var hBmp win.HBITMAP
+//
+//	bmp := factory.CreateBitmapFromHBITMAP(hBmp,
+//		wicco.BITMAP_ALPHA_CHANNEL_USE_PREMULTIPLIED_ALPHA)
+//	defer bmp.Release()
+//
+//	wic.SaveToFile(factory, bmp, wicco.CONTAINER_FORMAT_PNG, "C:\\Temp\\foo.png")
+func SaveToFile(factory IWICImagingFactory, source IWICBitmapSource,
+	format wicco.CONTAINER_FORMAT, filePath string) error {
+
+	stream := factory.CreateStream()
+	defer stream.Release()
+	if err := stream.InitializeFromFilename(filePath, co.GENERIC_WRITE); err != nil {
+		return err
+	}
+
+	encoder := factory.CreateEncoder(format)
+	defer encoder.Release()
+	encoder.Initialize(stream, wicco.BITMAP_ENCODER_CACHE_NO_CACHE)
+
+	frame := encoder.CreateNewFrame()
+	defer frame.Release()
+	frame.Initialize()
+
+	width, height := source.GetSize()
+	frame.SetSize(width, height)
+	frame.SetPixelFormat(source.GetPixelFormat()) // WriteSource() converts, if needed
+	frame.WriteSource(source, nil)
+
+	frame.Commit()
+	encoder.Commit()
+	return nil
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package wic
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/ns-wincodec-wicrect
+type RECT struct {
+	X, Y          int32
+	Width, Height int32
+}
@@ -0,0 +1,88 @@
+//go:build windows
+
+package wicco
+
+// WICBitmapAlphaChannelOption enumeration.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/ne-wincodec-wicbitmapalphachanneloption
+type BITMAP_ALPHA_CHANNEL uint32
+
+const (
+	BITMAP_ALPHA_CHANNEL_USE_ALPHA               BITMAP_ALPHA_CHANNEL = 0
+	BITMAP_ALPHA_CHANNEL_USE_PREMULTIPLIED_ALPHA BITMAP_ALPHA_CHANNEL = 1
+	BITMAP_ALPHA_CHANNEL_IGNORE_ALPHA            BITMAP_ALPHA_CHANNEL = 2
+)
+
+// WICBitmapDitherType enumeration.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/ne-wincodec-wicbitmapdithertype
+type BITMAP_DITHER_TYPE uint32
+
+const (
+	BITMAP_DITHER_TYPE_NONE            BITMAP_DITHER_TYPE = 0
+	BITMAP_DITHER_TYPE_SOLID           BITMAP_DITHER_TYPE = 0
+	BITMAP_DITHER_TYPE_ORDERED4x4      BITMAP_DITHER_TYPE = 1
+	BITMAP_DITHER_TYPE_ORDERED8x8      BITMAP_DITHER_TYPE = 2
+	BITMAP_DITHER_TYPE_ORDERED16x16    BITMAP_DITHER_TYPE = 3
+	BITMAP_DITHER_TYPE_SPIRAL4x4       BITMAP_DITHER_TYPE = 4
+	BITMAP_DITHER_TYPE_SPIRAL8x8       BITMAP_DITHER_TYPE = 5
+	BITMAP_DITHER_TYPE_DUAL_SPIRAL4x4  BITMAP_DITHER_TYPE = 6
+	BITMAP_DITHER_TYPE_DUAL_SPIRAL8x8  BITMAP_DITHER_TYPE = 7
+	BITMAP_DITHER_TYPE_ERROR_DIFFUSION BITMAP_DITHER_TYPE = 8
+)
+
+// WICBitmapEncoderCacheOption enumeration.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/ne-wincodec-wicbitmapencodercacheoption
+type BITMAP_ENCODER_CACHE uint32
+
+const (
+	BITMAP_ENCODER_CACHE_IN_MEMORY BITMAP_ENCODER_CACHE = 0
+	BITMAP_ENCODER_CACHE_TEMP_FILE BITMAP_ENCODER_CACHE = 1
+	BITMAP_ENCODER_CACHE_NO_CACHE  BITMAP_ENCODER_CACHE = 2
+)
+
+// WICBitmapInterpolationMode enumeration.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/ne-wincodec-wicbitmapinterpolationmode
+type BITMAP_INTERPOLATION_MODE uint32
+
+const (
+	BITMAP_INTERPOLATION_MODE_NEAREST_NEIGHBOR   BITMAP_INTERPOLATION_MODE = 0
+	BITMAP_INTERPOLATION_MODE_LINEAR             BITMAP_INTERPOLATION_MODE = 1
+	BITMAP_INTERPOLATION_MODE_CUBIC              BITMAP_INTERPOLATION_MODE = 2
+	BITMAP_INTERPOLATION_MODE_FANT               BITMAP_INTERPOLATION_MODE = 3
+	BITMAP_INTERPOLATION_MODE_HIGH_QUALITY_CUBIC BITMAP_INTERPOLATION_MODE = 4
+)
+
+// WICBitmapPaletteType enumeration.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/ne-wincodec-wicbitmappalettetype
+type BITMAP_PALETTE_TYPE uint32
+
+const (
+	BITMAP_PALETTE_TYPE_CUSTOM            BITMAP_PALETTE_TYPE = 0x0
+	BITMAP_PALETTE_TYPE_MEDIAN_CUT        BITMAP_PALETTE_TYPE = 0x1
+	BITMAP_PALETTE_TYPE_FIXED_BW          BITMAP_PALETTE_TYPE = 0x2
+	BITMAP_PALETTE_TYPE_FIXED_HALFTONE8   BITMAP_PALETTE_TYPE = 0x3
+	BITMAP_PALETTE_TYPE_FIXED_HALFTONE27  BITMAP_PALETTE_TYPE = 0x4
+	BITMAP_PALETTE_TYPE_FIXED_HALFTONE64  BITMAP_PALETTE_TYPE = 0x5
+	BITMAP_PALETTE_TYPE_FIXED_HALFTONE125 BITMAP_PALETTE_TYPE = 0x6
+	BITMAP_PALETTE_TYPE_FIXED_HALFTONE216 BITMAP_PALETTE_TYPE = 0x7
+	BITMAP_PALETTE_TYPE_FIXED_WEB_PALETTE BITMAP_PALETTE_TYPE = 0x7
+	BITMAP_PALETTE_TYPE_FIXED_HALFTONE252 BITMAP_PALETTE_TYPE = 0x8
+	BITMAP_PALETTE_TYPE_FIXED_HALFTONE256 BITMAP_PALETTE_TYPE = 0x9
+	BITMAP_PALETTE_TYPE_FIXED_GRAY4       BITMAP_PALETTE_TYPE = 0xa
+	BITMAP_PALETTE_TYPE_FIXED_GRAY16      BITMAP_PALETTE_TYPE = 0xb
+	BITMAP_PALETTE_TYPE_FIXED_GRAY256     BITMAP_PALETTE_TYPE = 0xc
+)
+
+// WICDecodeOptions enumeration.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/ne-wincodec-wicdecodeoptions
+type DECODE uint32
+
+const (
+	DECODE_METADATA_CACHE_ON_DEMAND DECODE = 0x0
+	DECODE_METADATA_CACHE_ON_LOAD   DECODE = 0x1
+)
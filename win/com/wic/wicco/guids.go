@@ -0,0 +1,76 @@
+//go:build windows
+
+package wicco
+
+import (
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// WIC COM CLSIDs.
+const (
+	CLSID_WICImagingFactory co.CLSID = "cacaf262-9370-4615-a13b-9f5539da4c0a"
+)
+
+// WIC COM IIDs.
+const (
+	IID_IWICBitmap              co.IID = "00000121-a8f2-4877-ba0a-fd2b6645fb94"
+	IID_IWICBitmapDecoder       co.IID = "9edde9e7-8dee-47ea-99df-e6faf2ed44bf"
+	IID_IWICBitmapEncoder       co.IID = "00000103-a8f2-4877-ba0a-fd2b6645fb94"
+	IID_IWICBitmapFrameDecode   co.IID = "3b16811b-6a43-4ec9-a813-3d930c13b940"
+	IID_IWICBitmapFrameEncode   co.IID = "00000105-a8f2-4877-ba0a-fd2b6645fb94"
+	IID_IWICBitmapScaler        co.IID = "00000302-a8f2-4877-ba0a-fd2b6645fb94"
+	IID_IWICBitmapSource        co.IID = "00000120-a8f2-4877-ba0a-fd2b6645fb94"
+	IID_IWICFormatConverter     co.IID = "00000301-a8f2-4877-ba0a-fd2b6645fb94"
+	IID_IWICImagingFactory      co.IID = "ec5ec8a9-c395-4314-9c77-54d7a935ff70"
+	IID_IWICMetadataQueryReader co.IID = "30989668-e1c9-4597-b395-458eedb808df"
+	IID_IWICStream              co.IID = "135ff860-22b7-4ddf-b0f6-218f4f299a43"
+)
+
+// WIC container format GUIDs, which identify the image file formats.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/wic/-wic-guids-clsids
+type CONTAINER_FORMAT string
+
+const (
+	CONTAINER_FORMAT_BMP  CONTAINER_FORMAT = "0af1d87e-fcfe-4188-bdeb-a7906471cbe3"
+	CONTAINER_FORMAT_DDS  CONTAINER_FORMAT = "9967cb95-2e85-4ac8-8ca2-83d7ccd425c9"
+	CONTAINER_FORMAT_GIF  CONTAINER_FORMAT = "1f8a5601-7d4d-4cbd-9c82-1bc8d4eeb9a5"
+	CONTAINER_FORMAT_HEIF CONTAINER_FORMAT = "e1e62521-6787-405b-a339-500715b5763f"
+	CONTAINER_FORMAT_ICO  CONTAINER_FORMAT = "a3a860c4-338f-4c17-919a-fba4b5628f21"
+	CONTAINER_FORMAT_JPEG CONTAINER_FORMAT = "19e4a5aa-5662-4fc5-a0c0-1758028e1057"
+	CONTAINER_FORMAT_PNG  CONTAINER_FORMAT = "1b7cfaf4-713f-473c-bbcd-6137425faeaf"
+	CONTAINER_FORMAT_TIFF CONTAINER_FORMAT = "163bcc30-e2e9-4f0b-961d-a3e9fdb788a3"
+	CONTAINER_FORMAT_WEBP CONTAINER_FORMAT = "e094b0e2-67f2-45b3-b0ea-115337ca7cbf"
+	CONTAINER_FORMAT_WMP  CONTAINER_FORMAT = "57a37caa-367a-4540-916b-f183c5093a4b"
+)
+
+// WIC pixel format GUIDs.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/wic/-wic-codec-native-pixel-formats
+type PIXEL_FORMAT string
+
+const (
+	PIXEL_FORMAT_DONT_CARE      PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc900"
+	PIXEL_FORMAT_1bppIndexed    PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc901"
+	PIXEL_FORMAT_2bppIndexed    PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc902"
+	PIXEL_FORMAT_4bppIndexed    PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc903"
+	PIXEL_FORMAT_8bppIndexed    PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc904"
+	PIXEL_FORMAT_BLACK_WHITE    PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc905"
+	PIXEL_FORMAT_2bppGray       PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc906"
+	PIXEL_FORMAT_4bppGray       PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc907"
+	PIXEL_FORMAT_8bppGray       PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc908"
+	PIXEL_FORMAT_16bppBGR555    PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc909"
+	PIXEL_FORMAT_16bppBGR565    PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc90a"
+	PIXEL_FORMAT_16bppGray      PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc90b"
+	PIXEL_FORMAT_24bppBGR       PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc90c"
+	PIXEL_FORMAT_24bppRGB       PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc90d"
+	PIXEL_FORMAT_32bppBGR       PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc90e"
+	PIXEL_FORMAT_32bppBGRA      PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc90f"
+	PIXEL_FORMAT_32bppPBGRA     PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc910"
+	PIXEL_FORMAT_32bppGrayFloat PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc911"
+	PIXEL_FORMAT_32bppRGBA      PIXEL_FORMAT = "f5c7ad2d-6a8d-43dd-a7a8-a29935261ae9"
+	PIXEL_FORMAT_32bppPRGBA     PIXEL_FORMAT = "3cc4a650-a527-4d37-a916-3142c7ebedba"
+	PIXEL_FORMAT_48bppRGB       PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc915"
+	PIXEL_FORMAT_64bppRGBA      PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc916"
+	PIXEL_FORMAT_64bppPRGBA     PIXEL_FORMAT = "6fddc324-4e03-4bfe-b185-3d77768dc917"
+)
@@ -0,0 +1,163 @@
+//go:build windows
+
+package wicvt
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+)
+
+// IWICBitmap virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmap
+type IWICBitmap struct {
+	IWICBitmapSource
+	Lock          uintptr
+	SetPalette    uintptr
+	SetResolution uintptr
+}
+
+// IWICBitmapDecoder virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapdecoder
+type IWICBitmapDecoder struct {
+	comvt.IUnknown
+	QueryCapability        uintptr
+	Initialize             uintptr
+	GetContainerFormat     uintptr
+	GetDecoderInfo         uintptr
+	CopyPalette            uintptr
+	GetMetadataQueryReader uintptr
+	GetPreview             uintptr
+	GetColorContexts       uintptr
+	GetThumbnail           uintptr
+	GetFrameCount          uintptr
+	GetFrame               uintptr
+}
+
+// IWICBitmapEncoder virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapencoder
+type IWICBitmapEncoder struct {
+	comvt.IUnknown
+	Initialize             uintptr
+	GetContainerFormat     uintptr
+	GetEncoderInfo         uintptr
+	SetColorContexts       uintptr
+	SetPalette             uintptr
+	SetThumbnail           uintptr
+	SetPreview             uintptr
+	CreateNewFrame         uintptr
+	Commit                 uintptr
+	GetMetadataQueryWriter uintptr
+}
+
+// IWICBitmapFrameDecode virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapframedecode
+type IWICBitmapFrameDecode struct {
+	IWICBitmapSource
+	GetMetadataQueryReader uintptr
+	GetColorContexts       uintptr
+	GetThumbnail           uintptr
+}
+
+// IWICBitmapFrameEncode virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapframeencode
+type IWICBitmapFrameEncode struct {
+	comvt.IUnknown
+	Initialize             uintptr
+	SetSize                uintptr
+	SetResolution          uintptr
+	SetPixelFormat         uintptr
+	SetColorContexts       uintptr
+	SetPalette             uintptr
+	SetThumbnail           uintptr
+	WritePixels            uintptr
+	WriteSource            uintptr
+	Commit                 uintptr
+	GetMetadataQueryWriter uintptr
+}
+
+// IWICBitmapScaler virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapscaler
+type IWICBitmapScaler struct {
+	IWICBitmapSource
+	Initialize uintptr
+}
+
+// IWICBitmapSource virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicbitmapsource
+type IWICBitmapSource struct {
+	comvt.IUnknown
+	GetSize        uintptr
+	GetPixelFormat uintptr
+	GetResolution  uintptr
+	CopyPalette    uintptr
+	CopyPixels     uintptr
+}
+
+// IWICFormatConverter virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicformatconverter
+type IWICFormatConverter struct {
+	IWICBitmapSource
+	Initialize uintptr
+	CanConvert uintptr
+}
+
+// IWICImagingFactory virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicimagingfactory
+type IWICImagingFactory struct {
+	comvt.IUnknown
+	CreateDecoderFromFilename                uintptr
+	CreateDecoderFromStream                  uintptr
+	CreateDecoderFromFileHandle              uintptr
+	CreateComponentInfo                      uintptr
+	CreateDecoder                            uintptr
+	CreateEncoder                            uintptr
+	CreatePalette                            uintptr
+	CreateFormatConverter                    uintptr
+	CreateBitmapScaler                       uintptr
+	CreateBitmapClipper                      uintptr
+	CreateBitmapFlipRotator                  uintptr
+	CreateStream                             uintptr
+	CreateColorContext                       uintptr
+	CreateColorTransformer                   uintptr
+	CreateBitmap                             uintptr
+	CreateBitmapFromSource                   uintptr
+	CreateBitmapFromSourceRect               uintptr
+	CreateBitmapFromMemory                   uintptr
+	CreateBitmapFromHBITMAP                  uintptr
+	CreateBitmapFromHICON                    uintptr
+	CreateComponentEnumerator                uintptr
+	CreateFastMetadataEncoderFromDecoder     uintptr
+	CreateFastMetadataEncoderFromFrameDecode uintptr
+	CreateQueryWriter                        uintptr
+	CreateQueryWriterFromReader              uintptr
+}
+
+// IWICMetadataQueryReader virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicmetadataqueryreader
+type IWICMetadataQueryReader struct {
+	comvt.IUnknown
+	GetContainerFormat uintptr
+	GetLocation        uintptr
+	GetMetadataByName  uintptr
+	GetEnumerator      uintptr
+}
+
+// IWICStream virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/wincodec/nn-wincodec-iwicstream
+type IWICStream struct {
+	comvt.IStream
+	InitializeFromIStream       uintptr
+	InitializeFromFilename      uintptr
+	InitializeFromMemory        uintptr
+	InitializeFromIStreamRegion uintptr
+}
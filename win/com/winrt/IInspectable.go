@@ -0,0 +1,64 @@
+//go:build windows
+
+package winrt
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/winrt/winrtco"
+	"github.com/rodrigocfd/windigo/win/com/winrt/winrtvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Base interface of all Windows Runtime classes.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/inspectable/nn-inspectable-iinspectable
+type IInspectable interface {
+	com.IUnknown
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/inspectable/nf-inspectable-iinspectable-getruntimeclassname
+	GetRuntimeClassName() string
+
+	// 📑 https://docs.microsoft.com/en-us/windows/win32/api/inspectable/nf-inspectable-iinspectable-gettrustlevel
+	GetTrustLevel() winrtco.TRUSTLEVEL
+}
+
+type _IInspectable struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IInspectable.Release().
+func NewIInspectable(base com.IUnknown) IInspectable {
+	return &_IInspectable{IUnknown: base}
+}
+
+func (me *_IInspectable) GetRuntimeClassName() string {
+	var hstr HSTRING
+	ret, _, _ := syscall.SyscallN(
+		(*winrtvt.IInspectable)(unsafe.Pointer(*me.Ptr())).GetRuntimeClassName,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&hstr)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		defer hstr.WindowsDeleteString()
+		return hstr.String()
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IInspectable) GetTrustLevel() winrtco.TRUSTLEVEL {
+	var level winrtco.TRUSTLEVEL
+	ret, _, _ := syscall.SyscallN(
+		(*winrtvt.IInspectable)(unsafe.Pointer(*me.Ptr())).GetTrustLevel,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&level)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return level
+	} else {
+		panic(hr)
+	}
+}
@@ -0,0 +1,24 @@
+//go:build windows
+
+package winrt
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/com"
+)
+
+// Windows.UI.Notifications.ToastNotification, created with
+// IToastNotificationFactory.CreateToastNotification().
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotification
+type IToastNotification interface {
+	IInspectable
+}
+
+type _IToastNotification struct{ IInspectable }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IToastNotification.Release().
+func NewIToastNotification(base com.IUnknown) IToastNotification {
+	return &_IToastNotification{IInspectable: NewIInspectable(base)}
+}
@@ -0,0 +1,59 @@
+//go:build windows
+
+package winrt
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/winrt/winrtvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Activation factory of Windows.UI.Notifications.ToastNotification.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotification.-ctor
+type IToastNotificationFactory interface {
+	IInspectable
+
+	// ⚠️ You must defer IToastNotification.Release().
+	//
+	// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotification.-ctor
+	CreateToastNotification(content IXmlDocument) (IToastNotification, error)
+}
+
+type _IToastNotificationFactory struct{ IInspectable }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IToastNotificationFactory.Release().
+//
+// Example:
+//
+//	unk, _ := winrt.RoGetActivationFactory(
+//		winrtco.RUNTIMECLASS_ToastNotification,
+//		winrtco.IID_IToastNotificationFactory)
+//	factory := winrt.NewIToastNotificationFactory(unk)
+//	defer factory.Release()
+func NewIToastNotificationFactory(base com.IUnknown) IToastNotificationFactory {
+	return &_IToastNotificationFactory{IInspectable: NewIInspectable(base)}
+}
+
+func (me *_IToastNotificationFactory) CreateToastNotification(
+	content IXmlDocument) (IToastNotification, error) {
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*winrtvt.IToastNotificationFactory)(unsafe.Pointer(*me.Ptr())).CreateToastNotification,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(content.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIToastNotification(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
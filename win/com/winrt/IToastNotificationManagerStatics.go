@@ -0,0 +1,66 @@
+//go:build windows
+
+package winrt
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/winrt/winrtvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Static methods of Windows.UI.Notifications.ToastNotificationManager.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotificationmanager
+type IToastNotificationManagerStatics interface {
+	IInspectable
+
+	// Desktop applications must pass the AppUserModelID of a Start Menu
+	// shortcut.
+	//
+	// ⚠️ You must defer IToastNotifier.Release().
+	//
+	// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotificationmanager.createtoastnotifier
+	CreateToastNotifierWithId(appId string) (IToastNotifier, error)
+}
+
+type _IToastNotificationManagerStatics struct{ IInspectable }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IToastNotificationManagerStatics.Release().
+//
+// Example:
+//
+//	unk, _ := winrt.RoGetActivationFactory(
+//		winrtco.RUNTIMECLASS_ToastNotificationManager,
+//		winrtco.IID_IToastNotificationManagerStatics)
+//	manager := winrt.NewIToastNotificationManagerStatics(unk)
+//	defer manager.Release()
+func NewIToastNotificationManagerStatics(
+	base com.IUnknown) IToastNotificationManagerStatics {
+
+	return &_IToastNotificationManagerStatics{IInspectable: NewIInspectable(base)}
+}
+
+func (me *_IToastNotificationManagerStatics) CreateToastNotifierWithId(
+	appId string) (IToastNotifier, error) {
+
+	hAppId := WindowsCreateString(appId)
+	defer hAppId.WindowsDeleteString()
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*winrtvt.IToastNotificationManagerStatics)(unsafe.Pointer(*me.Ptr())).CreateToastNotifierWithId,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(hAppId), uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIToastNotifier(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
@@ -0,0 +1,59 @@
+//go:build windows
+
+package winrt
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/winrt/winrtvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Windows.UI.Notifications.ToastNotifier, which displays the toasts of an
+// application.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotifier
+type IToastNotifier interface {
+	IInspectable
+
+	// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotifier.hide
+	Hide(notification IToastNotification) error
+
+	// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotifier.show
+	Show(notification IToastNotification) error
+}
+
+type _IToastNotifier struct{ IInspectable }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IToastNotifier.Release().
+func NewIToastNotifier(base com.IUnknown) IToastNotifier {
+	return &_IToastNotifier{IInspectable: NewIInspectable(base)}
+}
+
+func (me *_IToastNotifier) Hide(notification IToastNotification) error {
+	ret, _, _ := syscall.SyscallN(
+		(*winrtvt.IToastNotifier)(unsafe.Pointer(*me.Ptr())).Hide,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(notification.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		return hr
+	}
+	return nil
+}
+
+func (me *_IToastNotifier) Show(notification IToastNotification) error {
+	ret, _, _ := syscall.SyscallN(
+		(*winrtvt.IToastNotifier)(unsafe.Pointer(*me.Ptr())).Show,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(notification.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		return hr
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+//go:build windows
+
+package winrt
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/com"
+)
+
+// Windows.Data.Xml.Dom.XmlDocument, created with RoActivateInstance(). Its
+// content is loaded with IXmlDocumentIO.LoadXml().
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.data.xml.dom.xmldocument
+type IXmlDocument interface {
+	IInspectable
+}
+
+type _IXmlDocument struct{ IInspectable }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IXmlDocument.Release().
+//
+// Example:
+//
+//	obj, _ := winrt.RoActivateInstance(winrtco.RUNTIMECLASS_XmlDocument)
+//	defer obj.Release()
+//
+//	doc := winrt.NewIXmlDocument(obj.QueryInterface(winrtco.IID_IXmlDocument))
+//	defer doc.Release()
+func NewIXmlDocument(base com.IUnknown) IXmlDocument {
+	return &_IXmlDocument{IInspectable: NewIInspectable(base)}
+}
@@ -0,0 +1,53 @@
+//go:build windows
+
+package winrt
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/winrt/winrtvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Loading and saving methods of Windows.Data.Xml.Dom.XmlDocument.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.data.xml.dom.xmldocument
+type IXmlDocumentIO interface {
+	IInspectable
+
+	// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.data.xml.dom.xmldocument.loadxml
+	LoadXml(xml string) error
+}
+
+type _IXmlDocumentIO struct{ IInspectable }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IXmlDocumentIO.Release().
+//
+// Example:
+//
+//	var doc winrt.IXmlDocument // initialized somewhere
+//
+//	docIo := winrt.NewIXmlDocumentIO(doc.QueryInterface(winrtco.IID_IXmlDocumentIO))
+//	defer docIo.Release()
+func NewIXmlDocumentIO(base com.IUnknown) IXmlDocumentIO {
+	return &_IXmlDocumentIO{IInspectable: NewIInspectable(base)}
+}
+
+func (me *_IXmlDocumentIO) LoadXml(xml string) error {
+	hXml := WindowsCreateString(xml)
+	defer hXml.WindowsDeleteString()
+
+	ret, _, _ := syscall.SyscallN(
+		(*winrtvt.IXmlDocumentIO)(unsafe.Pointer(*me.Ptr())).LoadXml,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(hXml))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		return hr
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+//go:build windows
+
+package winrt
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/winrt/winrtco"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Creates an instance of a Windows Runtime class with its default
+// constructor.
+//
+// ⚠️ You must defer IInspectable.Release().
+//
+// Example:
+//
+//	obj, _ := winrt.RoActivateInstance(winrtco.RUNTIMECLASS_XmlDocument)
+//	defer obj.Release()
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/roapi/nf-roapi-roactivateinstance
+func RoActivateInstance(className winrtco.RUNTIMECLASS) (IInspectable, error) {
+	hClassName := WindowsCreateString(string(className))
+	defer hClassName.WindowsDeleteString()
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(proc.RoActivateInstance.Addr(),
+		uintptr(hClassName), uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIInspectable(com.NewIUnknown(ppvQueried)), nil
+	} else {
+		return nil, hr
+	}
+}
+
+// Retrieves the activation factory of a Windows Runtime class, which is also
+// used to call its static methods.
+//
+// ⚠️ You must defer IUnknown.Release() on the returned COM object.
+//
+// Example:
+//
+//	unk, _ := winrt.RoGetActivationFactory(
+//		winrtco.RUNTIMECLASS_ToastNotificationManager,
+//		winrtco.IID_IToastNotificationManagerStatics)
+//	manager := winrt.NewIToastNotificationManagerStatics(unk)
+//	defer manager.Release()
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/roapi/nf-roapi-rogetactivationfactory
+func RoGetActivationFactory(
+	className winrtco.RUNTIMECLASS, iid co.IID) (com.IUnknown, error) {
+
+	hClassName := WindowsCreateString(string(className))
+	defer hClassName.WindowsDeleteString()
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(proc.RoGetActivationFactory.Addr(),
+		uintptr(hClassName), uintptr(unsafe.Pointer(win.GuidFromIid(iid))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return com.NewIUnknown(ppvQueried), nil
+	} else {
+		return nil, hr
+	}
+}
@@ -0,0 +1,54 @@
+//go:build windows
+
+package winrt
+
+import (
+	"syscall"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Immutable string type used in the Windows Runtime.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/winrt/hstring
+type HSTRING uintptr
+
+// An empty string is represented by a zero HSTRING.
+//
+// ⚠️ You must defer HSTRING.WindowsDeleteString().
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/winstring/nf-winstring-windowscreatestring
+func WindowsCreateString(s string) HSTRING {
+	str16 := win.Str.ToNativeSlice(s)
+	var hstr HSTRING
+	ret, _, _ := syscall.SyscallN(proc.WindowsCreateString.Addr(),
+		uintptr(unsafe.Pointer(&str16[0])), uintptr(len(str16)-1), // without terminating null
+		uintptr(unsafe.Pointer(&hstr)))
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+	return hstr
+}
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/winstring/nf-winstring-windowsdeletestring
+func (hstr HSTRING) WindowsDeleteString() {
+	syscall.SyscallN(proc.WindowsDeleteString.Addr(),
+		uintptr(hstr))
+}
+
+// Converts the HSTRING to a string, using WindowsGetStringRawBuffer().
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/winstring/nf-winstring-windowsgetstringrawbuffer
+func (hstr HSTRING) String() string {
+	var length uint32
+	ret, _, _ := syscall.SyscallN(proc.WindowsGetStringRawBuffer.Addr(),
+		uintptr(hstr), uintptr(unsafe.Pointer(&length)))
+	if ret == 0 || length == 0 {
+		return ""
+	}
+	return string(utf16.Decode(unsafe.Slice((*uint16)(unsafe.Pointer(ret)), length)))
+}
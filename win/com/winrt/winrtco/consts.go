@@ -0,0 +1,24 @@
+//go:build windows
+
+package winrtco
+
+// Full names of Windows Runtime classes, passed to RoActivateInstance() and
+// RoGetActivationFactory().
+type RUNTIMECLASS string
+
+const (
+	RUNTIMECLASS_ToastNotification        RUNTIMECLASS = "Windows.UI.Notifications.ToastNotification"
+	RUNTIMECLASS_ToastNotificationManager RUNTIMECLASS = "Windows.UI.Notifications.ToastNotificationManager"
+	RUNTIMECLASS_XmlDocument              RUNTIMECLASS = "Windows.Data.Xml.Dom.XmlDocument"
+)
+
+// IInspectable.GetTrustLevel() return value.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/inspectable/ne-inspectable-trustlevel
+type TRUSTLEVEL uint32
+
+const (
+	TRUSTLEVEL_BaseTrust    TRUSTLEVEL = 0
+	TRUSTLEVEL_PartialTrust TRUSTLEVEL = 1
+	TRUSTLEVEL_FullTrust    TRUSTLEVEL = 2
+)
@@ -0,0 +1,18 @@
+//go:build windows
+
+package winrtco
+
+import (
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Windows Runtime COM IIDs.
+const (
+	IID_IInspectable                     co.IID = "af86e2e0-b12d-4c6a-9c5a-d7aa65101e90"
+	IID_IToastNotification               co.IID = "997e2675-059e-4e60-8b06-1760917c8b80"
+	IID_IToastNotificationFactory        co.IID = "04124b20-82c6-4229-b109-fd9ed4662b53"
+	IID_IToastNotificationManagerStatics co.IID = "50ac103f-d235-4598-bbef-98fe4d1a3ad4"
+	IID_IToastNotifier                   co.IID = "75927b93-03f3-41ec-91d3-6e5bac1b38e7"
+	IID_IXmlDocument                     co.IID = "f7f3a506-1e87-42d6-bcfb-b8c809fa5494"
+	IID_IXmlDocumentIO                   co.IID = "6cd0e74e-ee65-4489-9ebf-ca43e87ba637"
+)
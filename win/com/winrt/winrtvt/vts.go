@@ -0,0 +1,98 @@
+//go:build windows
+
+package winrtvt
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+)
+
+// IInspectable virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/inspectable/nn-inspectable-iinspectable
+type IInspectable struct {
+	comvt.IUnknown
+	GetIids             uintptr
+	GetRuntimeClassName uintptr
+	GetTrustLevel       uintptr
+}
+
+// IToastNotification virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotification
+type IToastNotification struct {
+	IInspectable
+	Get_Content        uintptr
+	Put_ExpirationTime uintptr
+	Get_ExpirationTime uintptr
+	Add_Dismissed      uintptr
+	Remove_Dismissed   uintptr
+	Add_Activated      uintptr
+	Remove_Activated   uintptr
+	Add_Failed         uintptr
+	Remove_Failed      uintptr
+}
+
+// IToastNotificationFactory virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotification.-ctor
+type IToastNotificationFactory struct {
+	IInspectable
+	CreateToastNotification uintptr
+}
+
+// IToastNotificationManagerStatics virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotificationmanager
+type IToastNotificationManagerStatics struct {
+	IInspectable
+	CreateToastNotifier       uintptr
+	CreateToastNotifierWithId uintptr
+	GetTemplateContent        uintptr
+}
+
+// IToastNotifier virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.ui.notifications.toastnotifier
+type IToastNotifier struct {
+	IInspectable
+	Show                           uintptr
+	Hide                           uintptr
+	Get_Setting                    uintptr
+	AddToSchedule                  uintptr
+	RemoveFromSchedule             uintptr
+	GetScheduledToastNotifications uintptr
+}
+
+// IXmlDocument virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.data.xml.dom.xmldocument
+type IXmlDocument struct {
+	IInspectable
+	Get_Doctype                 uintptr
+	Get_Implementation          uintptr
+	Get_DocumentElement         uintptr
+	CreateElement               uintptr
+	CreateDocumentFragment      uintptr
+	CreateTextNode              uintptr
+	CreateComment               uintptr
+	CreateProcessingInstruction uintptr
+	CreateAttribute             uintptr
+	CreateEntityReference       uintptr
+	GetElementsByTagName        uintptr
+	CreateCDataSection          uintptr
+	Get_DocumentUri             uintptr
+	CreateAttributeNS           uintptr
+	CreateElementNS             uintptr
+	GetElementById              uintptr
+	ImportNode                  uintptr
+}
+
+// IXmlDocumentIO virtual table.
+//
+// 📑 https://docs.microsoft.com/en-us/uwp/api/windows.data.xml.dom.xmldocument.loadxml
+type IXmlDocumentIO struct {
+	IInspectable
+	LoadXml             uintptr
+	LoadXmlWithSettings uintptr
+	SaveToFileAsync     uintptr
+}
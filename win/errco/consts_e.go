@@ -3,21 +3,22 @@
 package errco
 
 const (
-	E_UNEXPECTED           ERROR = 0x8000_ffff
-	E_NOTIMPL              ERROR = 0x8000_4001
-	E_OUTOFMEMORY          ERROR = 0x8007_000e
-	E_INVALIDARG           ERROR = 0x8007_0057
-	E_NOINTERFACE          ERROR = 0x8000_4002
-	E_POINTER              ERROR = 0x8000_4003
-	E_HANDLE               ERROR = 0x8007_0006
-	E_ABORT                ERROR = 0x8000_4004
-	E_FAIL                 ERROR = 0x8000_4005
-	E_ACCESSDENIED         ERROR = 0x8007_0005
-	E_PENDING              ERROR = 0x8000_000a
-	E_BOUNDS               ERROR = 0x8000_000b
-	E_CHANGED_STATE        ERROR = 0x8000_000c
-	E_ILLEGAL_STATE_CHANGE ERROR = 0x8000_000d
-	E_ILLEGAL_METHOD_CALL  ERROR = 0x8000_000e
+	E_UNEXPECTED            ERROR = 0x8000_ffff
+	E_NOTIMPL               ERROR = 0x8000_4001
+	E_OUTOFMEMORY           ERROR = 0x8007_000e
+	E_INVALIDARG            ERROR = 0x8007_0057
+	E_NOINTERFACE           ERROR = 0x8000_4002
+	E_POINTER               ERROR = 0x8000_4003
+	E_HANDLE                ERROR = 0x8007_0006
+	E_ABORT                 ERROR = 0x8000_4004
+	E_FAIL                  ERROR = 0x8000_4005
+	E_ACCESSDENIED          ERROR = 0x8007_0005
+	E_PENDING               ERROR = 0x8000_000a
+	E_BOUNDS                ERROR = 0x8000_000b
+	E_CHANGED_STATE         ERROR = 0x8000_000c
+	E_ILLEGAL_STATE_CHANGE  ERROR = 0x8000_000d
+	E_ILLEGAL_METHOD_CALL   ERROR = 0x8000_000e
+	E_NOT_SUFFICIENT_BUFFER ERROR = 0x8007_007a
 
 	CO_E_NOTINITIALIZED     ERROR = 0x8004_01f0
 	CO_E_ALREADYINITIALIZED ERROR = 0x8004_01f1
@@ -36,6 +37,9 @@ const (
 	CO_E_APPDIDNTREG        ERROR = 0x8004_01fe
 	CO_E_RELEASED           ERROR = 0x8004_01ff
 
+	CLASS_E_NOAGGREGATION     ERROR = 0x8004_0110
+	CLASS_E_CLASSNOTAVAILABLE ERROR = 0x8004_0111
+
 	DISP_E_UNKNOWNINTERFACE ERROR = 0x8002_0001
 	DISP_E_MEMBERNOTFOUND   ERROR = 0x8002_0003
 	DISP_E_PARAMNOTFOUND    ERROR = 0x8002_0004
@@ -142,4 +146,75 @@ const (
 	RPC_E_FULLSIC_REQUIRED            ERROR = 0x8001_0121
 	RPC_E_INVALID_STD_NAME            ERROR = 0x8001_0122
 	RPC_E_UNEXPECTED                  ERROR = 0x8001_ffff
+
+	D2DERR_WRONG_STATE                         ERROR = 0x8899_0001
+	D2DERR_NOT_INITIALIZED                     ERROR = 0x8899_0002
+	D2DERR_UNSUPPORTED_OPERATION               ERROR = 0x8899_0003
+	D2DERR_SCANNER_FAILED                      ERROR = 0x8899_0004
+	D2DERR_SCREEN_ACCESS_DENIED                ERROR = 0x8899_0005
+	D2DERR_DISPLAY_STATE_INVALID               ERROR = 0x8899_0006
+	D2DERR_ZERO_VECTOR                         ERROR = 0x8899_0007
+	D2DERR_INTERNAL_ERROR                      ERROR = 0x8899_0008
+	D2DERR_DISPLAY_FORMAT_NOT_SUPPORTED        ERROR = 0x8899_0009
+	D2DERR_INVALID_CALL                        ERROR = 0x8899_000a
+	D2DERR_NO_HARDWARE_DEVICE                  ERROR = 0x8899_000b
+	D2DERR_RECREATE_TARGET                     ERROR = 0x8899_000c
+	D2DERR_TOO_MANY_SHADER_ELEMENTS            ERROR = 0x8899_000d
+	D2DERR_SHADER_COMPILE_FAILED               ERROR = 0x8899_000e
+	D2DERR_MAX_TEXTURE_SIZE_EXCEEDED           ERROR = 0x8899_000f
+	D2DERR_UNSUPPORTED_VERSION                 ERROR = 0x8899_0010
+	D2DERR_BAD_NUMBER                          ERROR = 0x8899_0011
+	D2DERR_WRONG_FACTORY                       ERROR = 0x8899_0012
+	D2DERR_LAYER_ALREADY_IN_USE                ERROR = 0x8899_0013
+	D2DERR_POP_CALL_DID_NOT_MATCH_PUSH         ERROR = 0x8899_0014
+	D2DERR_WRONG_RESOURCE_DOMAIN               ERROR = 0x8899_0015
+	D2DERR_PUSH_POP_UNBALANCED                 ERROR = 0x8899_0016
+	D2DERR_RENDER_TARGET_HAS_LAYER_OR_CLIPRECT ERROR = 0x8899_0017
+	D2DERR_INCOMPATIBLE_BRUSH_TYPES            ERROR = 0x8899_0018
+	D2DERR_WIN32_ERROR                         ERROR = 0x8899_0019
+	D2DERR_TARGET_NOT_GDI_COMPATIBLE           ERROR = 0x8899_001a
+	D2DERR_TEXT_EFFECT_IS_WRONG_TYPE           ERROR = 0x8899_001b
+	D2DERR_TEXT_RENDERER_NOT_RELEASED          ERROR = 0x8899_001c
+	D2DERR_EXCEEDS_MAX_BITMAP_SIZE             ERROR = 0x8899_001d
+
+	WINCODEC_ERR_WRONGSTATE                       ERROR = 0x8898_2f04
+	WINCODEC_ERR_VALUEOUTOFRANGE                  ERROR = 0x8898_2f05
+	WINCODEC_ERR_UNKNOWNIMAGEFORMAT               ERROR = 0x8898_2f07
+	WINCODEC_ERR_UNSUPPORTEDVERSION               ERROR = 0x8898_2f0b
+	WINCODEC_ERR_NOTINITIALIZED                   ERROR = 0x8898_2f0c
+	WINCODEC_ERR_ALREADYLOCKED                    ERROR = 0x8898_2f0d
+	WINCODEC_ERR_PROPERTYNOTFOUND                 ERROR = 0x8898_2f40
+	WINCODEC_ERR_PROPERTYNOTSUPPORTED             ERROR = 0x8898_2f41
+	WINCODEC_ERR_PROPERTYSIZE                     ERROR = 0x8898_2f42
+	WINCODEC_ERR_CODECPRESENT                     ERROR = 0x8898_2f43
+	WINCODEC_ERR_CODECNOTHUMBNAIL                 ERROR = 0x8898_2f44
+	WINCODEC_ERR_PALETTEUNAVAILABLE               ERROR = 0x8898_2f45
+	WINCODEC_ERR_CODECTOOMANYSCANLINES            ERROR = 0x8898_2f46
+	WINCODEC_ERR_INTERNALERROR                    ERROR = 0x8898_2f48
+	WINCODEC_ERR_SOURCERECTDOESNOTMATCHDIMENSIONS ERROR = 0x8898_2f49
+	WINCODEC_ERR_COMPONENTNOTFOUND                ERROR = 0x8898_2f50
+	WINCODEC_ERR_IMAGESIZEOUTOFRANGE              ERROR = 0x8898_2f51
+	WINCODEC_ERR_TOOMUCHMETADATA                  ERROR = 0x8898_2f52
+	WINCODEC_ERR_BADIMAGE                         ERROR = 0x8898_2f60
+	WINCODEC_ERR_BADHEADER                        ERROR = 0x8898_2f61
+	WINCODEC_ERR_FRAMEMISSING                     ERROR = 0x8898_2f62
+	WINCODEC_ERR_BADMETADATAHEADER                ERROR = 0x8898_2f63
+	WINCODEC_ERR_BADSTREAMDATA                    ERROR = 0x8898_2f70
+	WINCODEC_ERR_STREAMWRITE                      ERROR = 0x8898_2f71
+	WINCODEC_ERR_STREAMREAD                       ERROR = 0x8898_2f72
+	WINCODEC_ERR_STREAMNOTAVAILABLE               ERROR = 0x8898_2f73
+	WINCODEC_ERR_UNSUPPORTEDPIXELFORMAT           ERROR = 0x8898_2f80
+	WINCODEC_ERR_UNSUPPORTEDOPERATION             ERROR = 0x8898_2f81
+	WINCODEC_ERR_INVALIDREGISTRATION              ERROR = 0x8898_2f8a
+	WINCODEC_ERR_COMPONENTINITIALIZEFAILURE       ERROR = 0x8898_2f8b
+	WINCODEC_ERR_INSUFFICIENTBUFFER               ERROR = 0x8898_2f8c
+	WINCODEC_ERR_DUPLICATEMETADATAPRESENT         ERROR = 0x8898_2f8d
+	WINCODEC_ERR_PROPERTYUNEXPECTEDTYPE           ERROR = 0x8898_2f8e
+	WINCODEC_ERR_UNEXPECTEDSIZE                   ERROR = 0x8898_2f8f
+	WINCODEC_ERR_INVALIDQUERYREQUEST              ERROR = 0x8898_2f90
+	WINCODEC_ERR_UNEXPECTEDMETADATATYPE           ERROR = 0x8898_2f91
+	WINCODEC_ERR_REQUESTONLYVALIDATMETADATAROOT   ERROR = 0x8898_2f92
+	WINCODEC_ERR_INVALIDQUERYCHARACTER            ERROR = 0x8898_2f93
+	WINCODEC_ERR_WIN32ERROR                       ERROR = 0x8898_2f94
+	WINCODEC_ERR_INVALIDPROGRESSIVELEVEL          ERROR = 0x8898_2f95
 )
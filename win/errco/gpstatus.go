@@ -0,0 +1,74 @@
+//go:build windows
+
+package errco
+
+import (
+	"fmt"
+)
+
+// GDI+ status codes. Originally Status enumeration.
+//
+// These error codes are unrelated to the ordinary system error codes,
+// represented by the errco.ERROR type.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplustypes/ne-gdiplustypes-status
+type GPSTATUS uint32
+
+// Implements error interface.
+func (err GPSTATUS) Error() string {
+	return err.String()
+}
+
+// Implements fmt.Stringer.
+func (err GPSTATUS) String() string {
+	descr := map[GPSTATUS]string{
+		GPSTATUS_OK:                          "OK",
+		GPSTATUS_GENERIC_ERROR:               "GENERIC_ERROR",
+		GPSTATUS_INVALID_PARAMETER:           "INVALID_PARAMETER",
+		GPSTATUS_OUT_OF_MEMORY:               "OUT_OF_MEMORY",
+		GPSTATUS_OBJECT_BUSY:                 "OBJECT_BUSY",
+		GPSTATUS_INSUFFICIENT_BUFFER:         "INSUFFICIENT_BUFFER",
+		GPSTATUS_NOT_IMPLEMENTED:             "NOT_IMPLEMENTED",
+		GPSTATUS_WIN32_ERROR:                 "WIN32_ERROR",
+		GPSTATUS_WRONG_STATE:                 "WRONG_STATE",
+		GPSTATUS_ABORTED:                     "ABORTED",
+		GPSTATUS_FILE_NOT_FOUND:              "FILE_NOT_FOUND",
+		GPSTATUS_VALUE_OVERFLOW:              "VALUE_OVERFLOW",
+		GPSTATUS_ACCESS_DENIED:               "ACCESS_DENIED",
+		GPSTATUS_UNKNOWN_IMAGE_FORMAT:        "UNKNOWN_IMAGE_FORMAT",
+		GPSTATUS_FONT_FAMILY_NOT_FOUND:       "FONT_FAMILY_NOT_FOUND",
+		GPSTATUS_FONT_STYLE_NOT_FOUND:        "FONT_STYLE_NOT_FOUND",
+		GPSTATUS_NOT_TRUE_TYPE_FONT:          "NOT_TRUE_TYPE_FONT",
+		GPSTATUS_UNSUPPORTED_GDIPLUS_VERSION: "UNSUPPORTED_GDIPLUS_VERSION",
+		GPSTATUS_GDIPLUS_NOT_INITIALIZED:     "GDIPLUS_NOT_INITIALIZED",
+		GPSTATUS_PROPERTY_NOT_FOUND:          "PROPERTY_NOT_FOUND",
+		GPSTATUS_PROPERTY_NOT_SUPPORTED:      "PROPERTY_NOT_SUPPORTED",
+		GPSTATUS_PROFILE_NOT_FOUND:           "PROFILE_NOT_FOUND",
+	}
+	return fmt.Sprintf("[%d 0x%02x] %s", uint32(err), uint32(err), descr[err])
+}
+
+const (
+	GPSTATUS_OK                          GPSTATUS = 0
+	GPSTATUS_GENERIC_ERROR               GPSTATUS = 1
+	GPSTATUS_INVALID_PARAMETER           GPSTATUS = 2
+	GPSTATUS_OUT_OF_MEMORY               GPSTATUS = 3
+	GPSTATUS_OBJECT_BUSY                 GPSTATUS = 4
+	GPSTATUS_INSUFFICIENT_BUFFER         GPSTATUS = 5
+	GPSTATUS_NOT_IMPLEMENTED             GPSTATUS = 6
+	GPSTATUS_WIN32_ERROR                 GPSTATUS = 7
+	GPSTATUS_WRONG_STATE                 GPSTATUS = 8
+	GPSTATUS_ABORTED                     GPSTATUS = 9
+	GPSTATUS_FILE_NOT_FOUND              GPSTATUS = 10
+	GPSTATUS_VALUE_OVERFLOW              GPSTATUS = 11
+	GPSTATUS_ACCESS_DENIED               GPSTATUS = 12
+	GPSTATUS_UNKNOWN_IMAGE_FORMAT        GPSTATUS = 13
+	GPSTATUS_FONT_FAMILY_NOT_FOUND       GPSTATUS = 14
+	GPSTATUS_FONT_STYLE_NOT_FOUND        GPSTATUS = 15
+	GPSTATUS_NOT_TRUE_TYPE_FONT          GPSTATUS = 16
+	GPSTATUS_UNSUPPORTED_GDIPLUS_VERSION GPSTATUS = 17
+	GPSTATUS_GDIPLUS_NOT_INITIALIZED     GPSTATUS = 18
+	GPSTATUS_PROPERTY_NOT_FOUND          GPSTATUS = 19
+	GPSTATUS_PROPERTY_NOT_SUPPORTED      GPSTATUS = 20
+	GPSTATUS_PROFILE_NOT_FOUND           GPSTATUS = 21
+)
@@ -0,0 +1,447 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [ConvertSecurityDescriptorToStringSecurityDescriptor] function.
+//
+// Returns the security descriptor in SDDL format.
+//
+// [ConvertSecurityDescriptorToStringSecurityDescriptor]: https://learn.microsoft.com/en-us/windows/win32/api/sddl/nf-sddl-convertsecuritydescriptortostringsecuritydescriptorw
+func ConvertSecurityDescriptorToStringSecurityDescriptor(
+	sd *SECURITY_DESCRIPTOR, info co.SECURITY_INFORMATION) (string, error) {
+
+	var pStr *uint16
+	ret, _, err := syscall.SyscallN(
+		proc.ConvertSecurityDescriptorToStringSecurityDescriptor.Addr(),
+		uintptr(unsafe.Pointer(sd)), 1, // SDDL_REVISION_1
+		uintptr(info), uintptr(unsafe.Pointer(&pStr)), 0)
+	if ret == 0 {
+		return "", errco.ERROR(err)
+	}
+	defer _LocalFree(unsafe.Pointer(pStr))
+
+	return Str.FromNativePtr(pStr), nil
+}
+
+// [ConvertStringSecurityDescriptorToSecurityDescriptor] function.
+//
+// Parses a security descriptor in SDDL format.
+//
+// Example:
+//
+//	// Full access to SYSTEM and administrators, read to authenticated users.
+//	sd, _ := win.ConvertStringSecurityDescriptorToSecurityDescriptor(
+//		"D:P(A;;GA;;;SY)(A;;GA;;;BA)(A;;GR;;;AU)")
+//
+//	var sa win.SECURITY_ATTRIBUTES
+//	sa.SetNLength()
+//	sa.SetSecurityDescriptor(sd)
+//
+// [ConvertStringSecurityDescriptorToSecurityDescriptor]: https://learn.microsoft.com/en-us/windows/win32/api/sddl/nf-sddl-convertstringsecuritydescriptortosecuritydescriptorw
+func ConvertStringSecurityDescriptorToSecurityDescriptor(
+	sddl string) (*SECURITY_DESCRIPTOR, error) {
+
+	var pSd *SECURITY_DESCRIPTOR
+	ret, _, err := syscall.SyscallN(
+		proc.ConvertStringSecurityDescriptorToSecurityDescriptor.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(sddl))), 1, // SDDL_REVISION_1
+		uintptr(unsafe.Pointer(&pSd)), 0)
+	if ret == 0 {
+		return nil, errco.ERROR(err)
+	}
+	defer _LocalFree(unsafe.Pointer(pSd))
+
+	return _SecurityDescriptorClone(pSd), nil
+}
+
+// [ConvertStringSidToSid] function.
+//
+// Accepts both the S-R-I-S-S… format and the SDDL aliases, like "BA".
+//
+// [ConvertStringSidToSid]: https://learn.microsoft.com/en-us/windows/win32/api/sddl/nf-sddl-convertstringsidtosidw
+func ConvertStringSidToSid(stringSid string) (*SID, error) {
+	var pSid *SID
+	ret, _, err := syscall.SyscallN(proc.ConvertStringSidToSid.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(stringSid))),
+		uintptr(unsafe.Pointer(&pSid)))
+	if ret == 0 {
+		return nil, errco.ERROR(err)
+	}
+	defer _LocalFree(unsafe.Pointer(pSid))
+
+	return pSid.Clone(), nil
+}
+
+// [CreateWellKnownSid] function.
+//
+// The domainSid is needed only for domain-relative SIDs, otherwise pass nil.
+//
+// [CreateWellKnownSid]: https://learn.microsoft.com/en-us/windows/win32/api/securitybaseapi/nf-securitybaseapi-createwellknownsid
+func CreateWellKnownSid(
+	wellKnownSid co.WELL_KNOWN_SID, domainSid *SID) (*SID, error) {
+
+	buf := make([]uint32, 68/4) // SECURITY_MAX_SID_SIZE
+	bufSz := uint32(len(buf) * 4)
+
+	ret, _, err := syscall.SyscallN(proc.CreateWellKnownSid.Addr(),
+		uintptr(wellKnownSid), uintptr(unsafe.Pointer(domainSid)),
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&bufSz)))
+	if ret == 0 {
+		return nil, errco.ERROR(err)
+	}
+	return (*SID)(unsafe.Pointer(&buf[0])), nil
+}
+
+// [CredDelete] function.
+//
+// [CredDelete]: https://learn.microsoft.com/en-us/windows/win32/api/wincred/nf-wincred-creddeletew
+func CredDelete(targetName string, credType co.CRED_TYPE) error {
+	ret, _, err := syscall.SyscallN(proc.CredDelete.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(targetName))),
+		uintptr(credType), 0)
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [CredEnumerate] function.
+//
+// The filter accepts a trailing asterisk, like "MyApp:*". If no credentials
+// match, returns an empty slice.
+//
+// [CredEnumerate]: https://learn.microsoft.com/en-us/windows/win32/api/wincred/nf-wincred-credenumeratew
+func CredEnumerate(filter StrOpt) ([]Credential, error) {
+	var count uint32
+	var ppCreds **_CREDENTIAL
+	ret, _, err := syscall.SyscallN(proc.CredEnumerate.Addr(),
+		uintptr(filter.Raw()), 0,
+		uintptr(unsafe.Pointer(&count)), uintptr(unsafe.Pointer(&ppCreds)))
+	if ret == 0 {
+		if wErr := errco.ERROR(err); wErr == errco.NOT_FOUND {
+			return []Credential{}, nil
+		} else {
+			return nil, wErr
+		}
+	}
+	defer syscall.SyscallN(proc.CredFree.Addr(), uintptr(unsafe.Pointer(ppCreds)))
+
+	pCreds := unsafe.Slice(ppCreds, count)
+	creds := make([]Credential, 0, count)
+	for _, pCred := range pCreds {
+		creds = append(creds, _CredentialClone(pCred))
+	}
+	return creds, nil
+}
+
+// [CredRead] function.
+//
+// If the credential doesn't exist, returns errco.NOT_FOUND.
+//
+// Example:
+//
+//	cred, err := win.CredRead("MyApp:token", co.CRED_TYPE_GENERIC)
+//	if err == errco.NOT_FOUND {
+//		// not stored yet
+//	} else if err == nil {
+//		token := string(cred.Blob)
+//	}
+//
+// [CredRead]: https://learn.microsoft.com/en-us/windows/win32/api/wincred/nf-wincred-credreadw
+func CredRead(targetName string, credType co.CRED_TYPE) (*Credential, error) {
+	var pCred *_CREDENTIAL
+	ret, _, err := syscall.SyscallN(proc.CredRead.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(targetName))),
+		uintptr(credType), 0, uintptr(unsafe.Pointer(&pCred)))
+	if ret == 0 {
+		return nil, errco.ERROR(err)
+	}
+	defer syscall.SyscallN(proc.CredFree.Addr(), uintptr(unsafe.Pointer(pCred)))
+
+	cred := _CredentialClone(pCred)
+	return &cred, nil
+}
+
+// [CredWrite] function.
+//
+// Creates the credential, or replaces it if it already exists.
+//
+// Example:
+//
+//	err := win.CredWrite(&win.Credential{
+//		Type:       co.CRED_TYPE_GENERIC,
+//		TargetName: "MyApp:token",
+//		UserName:   "john",
+//		Blob:       []byte("secret token"),
+//		Persist:    co.CRED_PERSIST_LOCAL_MACHINE,
+//	})
+//
+// [CredWrite]: https://learn.microsoft.com/en-us/windows/win32/api/wincred/nf-wincred-credwritew
+func CredWrite(cred *Credential) error {
+	strOrNil := func(s string) *uint16 {
+		if s == "" {
+			return nil
+		}
+		return Str.ToNativePtr(s)
+	}
+
+	nativeCred := _CREDENTIAL{
+		Flags:              cred.Flags,
+		Type:               cred.Type,
+		TargetName:         Str.ToNativePtr(cred.TargetName),
+		Comment:            strOrNil(cred.Comment),
+		CredentialBlobSize: uint32(len(cred.Blob)),
+		Persist:            cred.Persist,
+		TargetAlias:        strOrNil(cred.TargetAlias),
+		UserName:           strOrNil(cred.UserName),
+	}
+	if len(cred.Blob) > 0 {
+		nativeCred.CredentialBlob = &cred.Blob[0]
+	}
+
+	ret, _, err := syscall.SyscallN(proc.CredWrite.Addr(),
+		uintptr(unsafe.Pointer(&nativeCred)), 0)
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [GetNamedSecurityInfo] function.
+//
+// Returns the self-relative security descriptor of the object, containing the
+// requested information.
+//
+// [GetNamedSecurityInfo]: https://learn.microsoft.com/en-us/windows/win32/api/aclapi/nf-aclapi-getnamedsecurityinfow
+func GetNamedSecurityInfo(
+	objectName string,
+	objectType co.SE_OBJECT,
+	info co.SECURITY_INFORMATION) (*SECURITY_DESCRIPTOR, error) {
+
+	var pSd *SECURITY_DESCRIPTOR
+	ret, _, _ := syscall.SyscallN(proc.GetNamedSecurityInfo.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(objectName))),
+		uintptr(objectType), uintptr(info), 0, 0, 0, 0,
+		uintptr(unsafe.Pointer(&pSd)))
+	if wErr := errco.ERROR(ret); wErr != errco.SUCCESS {
+		return nil, wErr
+	}
+	defer _LocalFree(unsafe.Pointer(pSd))
+
+	return _SecurityDescriptorClone(pSd), nil
+}
+
+// [LookupAccountName] function.
+//
+// [LookupAccountName]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-lookupaccountnamew
+func LookupAccountName(
+	systemName StrOpt,
+	accountName string) (sid *SID, domain string, use co.SID_NAME_USE, e error) {
+
+	pAccountName := Str.ToNativePtr(accountName)
+	sidSz, domainSz := uint32(68), uint32(64) // SECURITY_MAX_SID_SIZE; arbitrary
+
+	for {
+		sidBuf := make([]uint32, (sidSz+3)/4)
+		domainBuf := make([]uint16, domainSz)
+
+		ret, _, err := syscall.SyscallN(proc.LookupAccountName.Addr(),
+			uintptr(systemName.Raw()), uintptr(unsafe.Pointer(pAccountName)),
+			uintptr(unsafe.Pointer(&sidBuf[0])), uintptr(unsafe.Pointer(&sidSz)),
+			uintptr(unsafe.Pointer(&domainBuf[0])), uintptr(unsafe.Pointer(&domainSz)),
+			uintptr(unsafe.Pointer(&use)))
+
+		if ret == 0 {
+			if wErr := errco.ERROR(err); wErr == errco.INSUFFICIENT_BUFFER {
+				continue // sizes were updated, try again
+			} else {
+				return nil, "", co.SID_NAME_USE(0), wErr
+			}
+		}
+		return (*SID)(unsafe.Pointer(&sidBuf[0])), Str.FromNativeSlice(domainBuf), use, nil
+	}
+}
+
+// [LookupAccountSid] function.
+//
+// [LookupAccountSid]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-lookupaccountsidw
+func LookupAccountSid(
+	systemName StrOpt,
+	sid *SID) (account, domain string, use co.SID_NAME_USE, e error) {
+
+	accountSz, domainSz := uint32(64), uint32(64) // arbitrary
+
+	for {
+		accountBuf := make([]uint16, accountSz)
+		domainBuf := make([]uint16, domainSz)
+
+		ret, _, err := syscall.SyscallN(proc.LookupAccountSid.Addr(),
+			uintptr(systemName.Raw()), uintptr(unsafe.Pointer(sid)),
+			uintptr(unsafe.Pointer(&accountBuf[0])), uintptr(unsafe.Pointer(&accountSz)),
+			uintptr(unsafe.Pointer(&domainBuf[0])), uintptr(unsafe.Pointer(&domainSz)),
+			uintptr(unsafe.Pointer(&use)))
+
+		if ret == 0 {
+			if wErr := errco.ERROR(err); wErr == errco.INSUFFICIENT_BUFFER {
+				continue // sizes were updated, try again
+			} else {
+				return "", "", co.SID_NAME_USE(0), wErr
+			}
+		}
+		return Str.FromNativeSlice(accountBuf), Str.FromNativeSlice(domainBuf), use, nil
+	}
+}
+
+// [LookupPrivilegeName] function.
+//
+// [LookupPrivilegeName]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-lookupprivilegenamew
+func LookupPrivilegeName(systemName StrOpt, luid LUID) (co.SE_PRIV, error) {
+	bufSz := uint32(64) // arbitrary
+
+	for {
+		buf := make([]uint16, bufSz)
+		ret, _, err := syscall.SyscallN(proc.LookupPrivilegeName.Addr(),
+			uintptr(systemName.Raw()), uintptr(unsafe.Pointer(&luid)),
+			uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&bufSz)))
+
+		if ret == 0 {
+			if wErr := errco.ERROR(err); wErr == errco.INSUFFICIENT_BUFFER {
+				bufSz++ // size doesn't include terminating null, try again
+				continue
+			} else {
+				return "", wErr
+			}
+		}
+		return co.SE_PRIV(Str.FromNativeSlice(buf)), nil
+	}
+}
+
+// [LookupPrivilegeValue] function.
+//
+// [LookupPrivilegeValue]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-lookupprivilegevaluew
+func LookupPrivilegeValue(systemName StrOpt, name co.SE_PRIV) (LUID, error) {
+	var luid LUID
+	ret, _, err := syscall.SyscallN(proc.LookupPrivilegeValue.Addr(),
+		uintptr(systemName.Raw()),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(string(name)))),
+		uintptr(unsafe.Pointer(&luid)))
+	if ret == 0 {
+		return LUID{}, errco.ERROR(err)
+	}
+	return luid, nil
+}
+
+// Builds a self-relative security descriptor with the given owner, group and
+// DACL; any of them can be nil. If protectDacl is true, the DACL won't inherit
+// ACEs from the parent object. Calls [MakeSelfRelativeSD].
+//
+// Example:
+//
+//	sidAdmins, _ := win.CreateWellKnownSid(co.WELL_KNOWN_SID_BUILTIN_ADMINISTRATORS, nil)
+//
+//	ea := win.EXPLICIT_ACCESS{
+//		GrfAccessPermissions: uint32(co.GENERIC_ALL),
+//		GrfAccessMode:        co.ACCESS_MODE_SET,
+//	}
+//	ea.Trustee.SetSid(sidAdmins, co.TRUSTEE_TYPE_IS_GROUP)
+//
+//	dacl, _ := win.SetEntriesInAcl([]win.EXPLICIT_ACCESS{ea}, nil)
+//	sd, _ := win.MakeSecurityDescriptor(nil, nil, dacl, true)
+//
+// [MakeSelfRelativeSD]: https://learn.microsoft.com/en-us/windows/win32/api/securitybaseapi/nf-securitybaseapi-makeselfrelativesd
+func MakeSecurityDescriptor(
+	owner, group *SID, dacl *ACL, protectDacl bool) (*SECURITY_DESCRIPTOR, error) {
+
+	abs := _SECURITY_DESCRIPTOR_ABSOLUTE{
+		revision: 1, // SECURITY_DESCRIPTOR_REVISION
+		owner:    owner,
+		group:    group,
+		dacl:     dacl,
+	}
+	if dacl != nil {
+		abs.control |= 0x0004 // SE_DACL_PRESENT
+	}
+	if protectDacl {
+		abs.control |= 0x1000 // SE_DACL_PROTECTED
+	}
+
+	var bufSz uint32
+	syscall.SyscallN(proc.MakeSelfRelativeSD.Addr(),
+		uintptr(unsafe.Pointer(&abs)), 0, uintptr(unsafe.Pointer(&bufSz)))
+
+	buf := make([]uint64, (bufSz+7)/8) // aligned to 8 bytes
+	ret, _, err := syscall.SyscallN(proc.MakeSelfRelativeSD.Addr(),
+		uintptr(unsafe.Pointer(&abs)), uintptr(unsafe.Pointer(&buf[0])),
+		uintptr(unsafe.Pointer(&bufSz)))
+	if ret == 0 {
+		return nil, errco.ERROR(err)
+	}
+	return (*SECURITY_DESCRIPTOR)(unsafe.Pointer(&buf[0])), nil
+}
+
+// [SetEntriesInAcl] function.
+//
+// Returns a new ACL, merging the entries into oldAcl, which can be nil.
+//
+// [SetEntriesInAcl]: https://learn.microsoft.com/en-us/windows/win32/api/aclapi/nf-aclapi-setentriesinaclw
+func SetEntriesInAcl(entries []EXPLICIT_ACCESS, oldAcl *ACL) (*ACL, error) {
+	var pEntries *EXPLICIT_ACCESS
+	if len(entries) > 0 {
+		pEntries = &entries[0]
+	}
+
+	var pNewAcl *ACL
+	ret, _, _ := syscall.SyscallN(proc.SetEntriesInAcl.Addr(),
+		uintptr(len(entries)), uintptr(unsafe.Pointer(pEntries)),
+		uintptr(unsafe.Pointer(oldAcl)), uintptr(unsafe.Pointer(&pNewAcl)))
+	if wErr := errco.ERROR(ret); wErr != errco.SUCCESS {
+		return nil, wErr
+	}
+	defer _LocalFree(unsafe.Pointer(pNewAcl))
+
+	return _AclClone(pNewAcl), nil
+}
+
+// [SetNamedSecurityInfo] function.
+//
+// Only the parts specified in info are set; the others can be nil.
+//
+// Example:
+//
+//	var dacl *win.ACL // initialized somewhere
+//
+//	win.SetNamedSecurityInfo("C:\\Temp\\secret.txt", co.SE_OBJECT_FILE,
+//		co.SECURITY_INFORMATION_DACL|co.SECURITY_INFORMATION_PROTECTED_DACL,
+//		nil, nil, dacl, nil)
+//
+// [SetNamedSecurityInfo]: https://learn.microsoft.com/en-us/windows/win32/api/aclapi/nf-aclapi-setnamedsecurityinfow
+func SetNamedSecurityInfo(
+	objectName string,
+	objectType co.SE_OBJECT,
+	info co.SECURITY_INFORMATION,
+	owner, group *SID, dacl, sacl *ACL) error {
+
+	ret, _, _ := syscall.SyscallN(proc.SetNamedSecurityInfo.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(objectName))),
+		uintptr(objectType), uintptr(info),
+		uintptr(unsafe.Pointer(owner)), uintptr(unsafe.Pointer(group)),
+		uintptr(unsafe.Pointer(dacl)), uintptr(unsafe.Pointer(sacl)))
+	if wErr := errco.ERROR(ret); wErr != errco.SUCCESS {
+		return wErr
+	}
+	return nil
+}
+
+// Frees memory allocated by the system with LocalAlloc().
+func _LocalFree(p unsafe.Pointer) {
+	syscall.SyscallN(proc.LocalFree.Addr(), uintptr(p))
+}
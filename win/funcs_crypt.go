@@ -0,0 +1,95 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [CryptProtectData] function.
+//
+// Encrypts the data so that only the current user – or, with
+// co.CRYPTPROTECT_LOCAL_MACHINE, any user of the current computer – can
+// decrypt it. The entropy is optional, and if given, the same entropy must be
+// passed to CryptUnprotectData().
+//
+// Example:
+//
+//	encrypted, _ := win.CryptProtectData([]byte("my token"),
+//		win.StrOptNone(), nil, co.CRYPTPROTECT_UI_FORBIDDEN)
+//
+// [CryptProtectData]: https://learn.microsoft.com/en-us/windows/win32/api/dpapi/nf-dpapi-cryptprotectdata
+func CryptProtectData(
+	data []byte, description StrOpt, entropy []byte,
+	flags co.CRYPTPROTECT) ([]byte, error) {
+
+	var dataIn, dataOut _DATA_BLOB
+	dataIn.SetData(data)
+
+	var pEntropy *_DATA_BLOB
+	if len(entropy) > 0 {
+		pEntropy = &_DATA_BLOB{}
+		pEntropy.SetData(entropy)
+	}
+
+	ret, _, err := syscall.SyscallN(proc.CryptProtectData.Addr(),
+		uintptr(unsafe.Pointer(&dataIn)), uintptr(description.Raw()),
+		uintptr(unsafe.Pointer(pEntropy)), 0, 0, uintptr(flags),
+		uintptr(unsafe.Pointer(&dataOut)))
+	if ret == 0 {
+		return nil, errco.ERROR(err)
+	}
+	defer _LocalFree(unsafe.Pointer(dataOut.pbData))
+
+	return dataOut.Data(), nil
+}
+
+// [CryptUnprotectData] function.
+//
+// Decrypts data encrypted with CryptProtectData(), returning the data itself
+// and its description.
+//
+// [CryptUnprotectData]: https://learn.microsoft.com/en-us/windows/win32/api/dpapi/nf-dpapi-cryptunprotectdata
+func CryptUnprotectData(
+	data, entropy []byte,
+	flags co.CRYPTPROTECT) (decrypted []byte, description string, e error) {
+
+	var dataIn, dataOut _DATA_BLOB
+	dataIn.SetData(data)
+
+	var pEntropy *_DATA_BLOB
+	if len(entropy) > 0 {
+		pEntropy = &_DATA_BLOB{}
+		pEntropy.SetData(entropy)
+	}
+
+	var pDescr *uint16
+	ret, _, err := syscall.SyscallN(proc.CryptUnprotectData.Addr(),
+		uintptr(unsafe.Pointer(&dataIn)), uintptr(unsafe.Pointer(&pDescr)),
+		uintptr(unsafe.Pointer(pEntropy)), 0, 0, uintptr(flags),
+		uintptr(unsafe.Pointer(&dataOut)))
+	if ret == 0 {
+		return nil, "", errco.ERROR(err)
+	}
+	defer _LocalFree(unsafe.Pointer(dataOut.pbData))
+
+	if pDescr != nil {
+		description = Str.FromNativePtr(pDescr)
+		_LocalFree(unsafe.Pointer(pDescr))
+	}
+
+	// Wipe the decrypted secret from the system-allocated buffer.
+	decrypted = dataOut.Data()
+	if dataOut.cbData > 0 {
+		plain := unsafe.Slice(dataOut.pbData, dataOut.cbData)
+		for i := range plain {
+			plain[i] = 0
+		}
+	}
+	return decrypted, description, nil
+}
@@ -3,7 +3,9 @@
 package win
 
 import (
+	"fmt"
 	"runtime"
+	"sort"
 	"strings"
 	"syscall"
 	"unsafe"
@@ -72,6 +74,12 @@ func CreateDirectory(
 
 // [CreateProcess] function.
 //
+// The environment, if not nil, is passed as a Unicode block sorted by name, so
+// CREATE_UNICODE_ENVIRONMENT is automatically added to the creation flags.
+//
+// To pass a STARTUPINFOEX, point startupInfo to its StartupInfo member, and
+// add CREATE_EXTENDED_STARTUPINFO_PRESENT to the creation flags.
+//
 // ⚠️ You must defer HPROCESS.CloseHandle() and HTHREAD.CloseHandle() on
 // HProcess and HThread members of PROCESS_INFORMATION.
 //
@@ -81,38 +89,67 @@ func CreateProcess(
 	processAttributes, threadAttributes *SECURITY_ATTRIBUTES,
 	inheritHandles bool,
 	creationFlags co.CREATE,
-	environment []struct {
-		name string
-		val  string
-	},
+	environment map[string]string,
 	currentDirectory StrOpt,
 	startupInfo *STARTUPINFO,
-	processInformation *PROCESS_INFORMATION) {
+	processInformation *PROCESS_INFORMATION) error {
 
-	var envStrsPtr unsafe.Pointer
+	var envBlock []uint16
 	if environment != nil {
-		envStrs := make([]string, 0, len(environment))
-		for _, pair := range environment {
-			envStrs = append(envStrs, pair.name+"="+pair.val)
-		}
-		envStrsPtr = unsafe.Pointer(Str.ToNativePtrMulti(envStrs))
+		envBlock = _EnvironmentBlock(environment)
+		creationFlags |= co.CREATE_UNICODE_ENVIRONMENT
+	}
+	var envPtr unsafe.Pointer
+	if envBlock != nil {
+		envPtr = unsafe.Pointer(&envBlock[0])
+	}
+
+	var cmdLineBuf []uint16 // CreateProcessW may modify the command line buffer
+	var cmdLinePtr unsafe.Pointer
+	if cmdLine, ok := commandLine.Str(); ok {
+		cmdLineBuf = Str.ToNativeSlice(cmdLine)
+		cmdLinePtr = unsafe.Pointer(&cmdLineBuf[0])
 	}
 
 	ret, _, err := syscall.SyscallN(proc.CreateProcess.Addr(),
 		uintptr(applicationName.Raw()),
-		uintptr(commandLine.Raw()),
+		uintptr(cmdLinePtr),
 		uintptr(unsafe.Pointer(processAttributes)),
 		uintptr(unsafe.Pointer(threadAttributes)),
 		util.BoolToUintptr(inheritHandles),
 		uintptr(creationFlags),
-		uintptr(envStrsPtr),
+		uintptr(envPtr),
 		uintptr(currentDirectory.Raw()),
 		uintptr(unsafe.Pointer(startupInfo)),
 		uintptr(unsafe.Pointer(processInformation)))
+	runtime.KeepAlive(envBlock)
+	runtime.KeepAlive(cmdLineBuf)
 
 	if ret == 0 {
-		panic(errco.ERROR(err))
+		return errco.ERROR(err)
 	}
+	return nil
+}
+
+// Builds the Unicode environment block, with the names sorted
+// case-insensitively, as required by CreateProcess().
+func _EnvironmentBlock(environment map[string]string) []uint16 {
+	names := make([]string, 0, len(environment))
+	for name := range environment {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(a, b int) bool {
+		return strings.ToUpper(names[a]) < strings.ToUpper(names[b])
+	})
+
+	envStrs := make([]string, 0, len(names))
+	for _, name := range names {
+		envStrs = append(envStrs, name+"="+environment[name])
+	}
+	if len(envStrs) == 0 {
+		return []uint16{0, 0} // an empty block still needs 2 terminating nulls
+	}
+	return Str.ToNativeSliceMulti(envStrs)
 }
 
 // [DeleteFile] function.
@@ -817,3 +854,63 @@ func TzSpecificLocalTimeToSystemTime(
 		panic(errco.ERROR(err))
 	}
 }
+
+// [WaitForMultipleObjects] function.
+//
+// If waitAll is false and an object is signaled, the return value minus
+// co.WAIT_OBJECT_0 is the index of the signaled object; if the object is an
+// abandoned mutex, the index is relative to co.WAIT_ABANDONED. Prefer
+// WaitForAnyObject(), which computes the index for you.
+//
+// Panics if more than 64 handles are passed.
+//
+// [WaitForMultipleObjects]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitformultipleobjects
+func WaitForMultipleObjects(
+	handles []HANDLE, waitAll bool, milliseconds NumInf) (co.WAIT, error) {
+
+	if len(handles) == 0 || len(handles) > 64 {
+		panic(fmt.Sprintf("WaitForMultipleObjects() accepts 1 to 64 handles, %d passed.", len(handles)))
+	}
+
+	ret, _, err := syscall.SyscallN(proc.WaitForMultipleObjects.Addr(),
+		uintptr(len(handles)), uintptr(unsafe.Pointer(&handles[0])),
+		util.BoolToUintptr(waitAll), milliseconds.Raw())
+	if co.WAIT(ret) == co.WAIT_FAILED {
+		return co.WAIT_FAILED, errco.ERROR(err)
+	}
+	return co.WAIT(ret), nil
+}
+
+// Waits until any of the given kernel objects is signaled, returning its
+// index within handles. Calls WaitForMultipleObjects() underneath.
+//
+// If the time-out interval elapses, returns -1 with no error. If the signaled
+// object is an abandoned mutex, its index is returned along with
+// errco.ABANDONED_WAIT_0; the calling thread owns the mutex anyway.
+//
+// Example:
+//
+//	var hEvent win.HEVENT          // initialized somewhere
+//	var hProcess win.HPROCESS      // initialized somewhere
+//	var hTimer win.HWAITABLETIMER // initialized somewhere
+//
+//	idx, _ := win.WaitForAnyObject(win.NumInfInfinite(),
+//		win.HANDLE(hEvent), win.HANDLE(hProcess), win.HANDLE(hTimer))
+//	switch idx {
+//	case 0: println("Event set.")
+//	case 1: println("Process finished.")
+//	case 2: println("Timer fired.")
+//	}
+func WaitForAnyObject(milliseconds NumInf, handles ...HANDLE) (int, error) {
+	ret, err := WaitForMultipleObjects(handles, false, milliseconds)
+	switch {
+	case err != nil:
+		return -1, err
+	case ret == co.WAIT_TIMEOUT:
+		return -1, nil
+	case ret >= co.WAIT_ABANDONED && ret < co.WAIT_ABANDONED+co.WAIT(len(handles)):
+		return int(ret - co.WAIT_ABANDONED), errco.ABANDONED_WAIT_0
+	default:
+		return int(ret - co.WAIT_OBJECT_0), nil
+	}
+}
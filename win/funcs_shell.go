@@ -34,6 +34,122 @@ func CommandLineToArgv(cmdLine string) []string {
 	return strs
 }
 
+// [GetCurrentProcessExplicitAppUserModelID] function.
+//
+// Returns an error if no AppUserModelID was explicitly set.
+//
+// [GetCurrentProcessExplicitAppUserModelID]: https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-getcurrentprocessexplicitappusermodelid
+func GetCurrentProcessExplicitAppUserModelID() (string, error) {
+	var pStr *uint16
+	ret, _, _ := syscall.SyscallN(proc.GetCurrentProcessExplicitAppUserModelID.Addr(),
+		uintptr(unsafe.Pointer(&pStr)))
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		return "", hr
+	}
+
+	defer HTASKMEM(unsafe.Pointer(pStr)).CoTaskMemFree()
+	return Str.FromNativePtr(pStr), nil
+}
+
+// [SetCurrentProcessExplicitAppUserModelID] function.
+//
+// Must be called before any window is displayed, so the taskbar buttons are
+// grouped under the given ID.
+//
+// [SetCurrentProcessExplicitAppUserModelID]: https://docs.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-setcurrentprocessexplicitappusermodelid
+func SetCurrentProcessExplicitAppUserModelID(appId string) {
+	ret, _, _ := syscall.SyscallN(proc.SetCurrentProcessExplicitAppUserModelID.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(appId))))
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+// [SHAddToRecentDocs] function, which adds a file to the recent documents,
+// also shown in the recent category of the jump list.
+//
+// Passing an empty string clears all the recent documents.
+//
+// [SHAddToRecentDocs]: https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shaddtorecentdocs
+func SHAddToRecentDocs(path string) {
+	pPath := uintptr(0)
+	if path != "" {
+		pPath = uintptr(unsafe.Pointer(Str.ToNativePtr(path)))
+	}
+	syscall.SyscallN(proc.SHAddToRecentDocs.Addr(),
+		0x0000_0003, pPath) // SHARD_PATHW
+}
+
+// [SHChangeNotify] function.
+//
+// Example notifying that file associations have changed:
+//
+//	win.SHChangeNotify(co.SHCNE_ASSOCCHANGED, co.SHCNF_IDLIST, nil, nil)
+//
+// [SHChangeNotify]: https://docs.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shchangenotify
+func SHChangeNotify(
+	eventId co.SHCNE, flags co.SHCNF, item1, item2 unsafe.Pointer) {
+
+	syscall.SyscallN(proc.SHChangeNotify.Addr(),
+		uintptr(eventId), uintptr(flags), uintptr(item1), uintptr(item2))
+}
+
+// [ShellExecuteEx] function.
+//
+// If co.SEE_MASK_NOCLOSEPROCESS is set, the launched process handle will be
+// returned in SHELLEXECUTEINFO.HProcess, and you must close it.
+//
+// [ShellExecuteEx]: https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shellexecuteexw
+func ShellExecuteEx(info *SHELLEXECUTEINFO) error {
+	ret, _, err := syscall.SyscallN(proc.ShellExecuteEx.Addr(),
+		uintptr(unsafe.Pointer(info)))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// This helper function calls ShellExecuteEx() with co.SEE_MASK_NOCLOSEPROCESS
+// to run a verb – like "open", "edit", "runas" or "print" – upon the given
+// file. An empty verb runs the default one.
+//
+// The returned HPROCESS will be zero if no new process was launched, like when
+// the file is handed to an already running instance.
+//
+// ⚠️ If the returned HPROCESS is not zero, you must defer
+// HPROCESS.CloseHandle().
+//
+// Example:
+//
+//	hProcess, err := win.ShellExecuteVerb(win.HWND(0), "runas",
+//		"C:\\Temp\\setup.exe", win.StrOptSome("/quiet"), co.SW_SHOWNORMAL)
+//	if err != nil {
+//		panic(err)
+//	}
+//	if hProcess != 0 {
+//		defer hProcess.CloseHandle()
+//	}
+func ShellExecuteVerb(
+	hWnd HWND, verb, file string,
+	parameters StrOpt, show co.SW) (HPROCESS, error) {
+
+	sei := SHELLEXECUTEINFO{}
+	sei.SetCbSize()
+	sei.FMask = co.SEE_MASK_NOCLOSEPROCESS | co.SEE_MASK_FLAG_NO_UI
+	sei.Hwnd = hWnd
+	if verb != "" {
+		sei.LpVerb = Str.ToNativePtr(verb)
+	}
+	sei.LpFile = Str.ToNativePtr(file)
+	sei.LpParameters = (*uint16)(parameters.Raw())
+	sei.NShow = show
+
+	if err := ShellExecuteEx(&sei); err != nil {
+		return HPROCESS(0), err
+	}
+	return sei.HProcess, nil
+}
+
 // [ShellNotifyIcon] function.
 //
 // [ShellNotifyIcon]: https://docs.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shell_notifyiconw
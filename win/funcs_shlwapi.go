@@ -0,0 +1,52 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [AssocQueryString] function.
+//
+// The assoc parameter can be a file extension like ".txt", a ProgID, an
+// application name or a URL protocol.
+//
+// Example retrieving the executable associated to text files:
+//
+//	exe, err := win.AssocQueryString(co.ASSOCF_NONE,
+//		co.ASSOCSTR_EXECUTABLE, ".txt", win.StrOptNone())
+//
+// [AssocQueryString]: https://docs.microsoft.com/en-us/windows/win32/api/shlwapi/nf-shlwapi-assocquerystringw
+func AssocQueryString(
+	flags co.ASSOCF, str co.ASSOCSTR,
+	assoc string, extra StrOpt) (string, error) {
+
+	pAssoc := Str.ToNativePtr(assoc)
+	pExtra := extra.Raw()
+	var bufSz uint32
+
+	ret, _, _ := syscall.SyscallN(proc.AssocQueryString.Addr(),
+		uintptr(flags|co.ASSOCF_NOTRUNCATE), uintptr(str),
+		uintptr(unsafe.Pointer(pAssoc)), uintptr(pExtra),
+		0, uintptr(unsafe.Pointer(&bufSz))) // first call to retrieve the size
+	if hr := errco.ERROR(ret); hr != errco.S_FALSE && hr != errco.S_OK {
+		return "", hr
+	}
+
+	buf := make([]uint16, bufSz+1) // room for terminating null
+	bufSz = uint32(len(buf))
+
+	ret, _, _ = syscall.SyscallN(proc.AssocQueryString.Addr(),
+		uintptr(flags|co.ASSOCF_NOTRUNCATE), uintptr(str),
+		uintptr(unsafe.Pointer(pAssoc)), uintptr(pExtra),
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&bufSz)))
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		return "", hr
+	}
+	return Str.FromNativeSlice(buf), nil
+}
@@ -3,6 +3,7 @@
 package win
 
 import (
+	"fmt"
 	"runtime"
 	"sync"
 	"syscall"
@@ -66,6 +67,9 @@ func BroadcastSystemMessage(
 // This function creates HCURSOR only. The HICON variation is
 // CreateIconFromResourceEx().
 //
+// The resBits must start with the hotspot, as two 16-bit values, followed by
+// the image data, like the RT_CURSOR resources.
+//
 // ⚠️ You must defer HCURSOR.DestroyCursor().
 //
 // [CreateCursorFromResourceEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createiconfromresourceex
@@ -74,8 +78,8 @@ func CreateCursorFromResourceEx(
 	cxDesired, cyDesired int,
 	flags co.LR) (HCURSOR, error) {
 
-	hIcon, err := CreateIconFromResourceEx(
-		resBits, fmtVersion, cxDesired, cyDesired, flags)
+	hIcon, err := _CreateIconFromResourceEx(
+		resBits, false, fmtVersion, cxDesired, cyDesired, flags)
 	return HCURSOR(hIcon), err
 }
 
@@ -92,10 +96,19 @@ func CreateIconFromResourceEx(
 	cxDesired, cyDesired int,
 	flags co.LR) (HICON, error) {
 
+	return _CreateIconFromResourceEx(
+		resBits, true, fmtVersion, cxDesired, cyDesired, flags)
+}
+
+func _CreateIconFromResourceEx(
+	resBits []byte, isIcon bool, fmtVersion int,
+	cxDesired, cyDesired int,
+	flags co.LR) (HICON, error) {
+
 	ret, _, err := syscall.SyscallN(proc.CreateIconFromResourceEx.Addr(),
 		uintptr(unsafe.Pointer(&resBits[0])), uintptr(len(resBits)),
-		1, uintptr(fmtVersion), uintptr(cxDesired), uintptr(cyDesired),
-		uintptr(flags))
+		util.BoolToUintptr(isIcon), uintptr(fmtVersion),
+		uintptr(cxDesired), uintptr(cyDesired), uintptr(flags))
 	if ret == 0 {
 		return HICON(0), errco.ERROR(err)
 	}
@@ -231,6 +244,21 @@ func GetCaretPos() RECT {
 	return rc
 }
 
+// [GetClipboardFormatName] function.
+//
+// Works only for registered formats, not the predefined ones.
+//
+// [GetClipboardFormatName]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getclipboardformatnamew
+func GetClipboardFormatName(format co.CF) (string, error) {
+	var buf [256]uint16
+	ret, _, err := syscall.SyscallN(proc.GetClipboardFormatName.Addr(),
+		uintptr(format), uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+	if ret == 0 {
+		return "", errco.ERROR(err)
+	}
+	return Str.FromNativeSlice(buf[:]), nil
+}
+
 // [GetCursorPos] function.
 //
 // [GetCursorPos]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getcursorpos
@@ -421,6 +449,50 @@ func LockSetForegroundWindow(lockCode co.LSFW) {
 	}
 }
 
+// [MsgWaitForMultipleObjectsEx] function.
+//
+// If an object is signaled, the return value minus co.WAIT_OBJECT_0 is the
+// index of the signaled object. If the return value is co.WAIT_OBJECT_0 plus
+// len(handles), there is input in the message queue.
+//
+// Panics if more than 63 handles are passed.
+//
+// [MsgWaitForMultipleObjectsEx]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-msgwaitformultipleobjectsex
+func MsgWaitForMultipleObjectsEx(
+	handles []HANDLE, milliseconds NumInf,
+	wakeMask co.QS, flags co.MWMO) (co.WAIT, error) {
+
+	if len(handles) > 63 {
+		panic(fmt.Sprintf("MsgWaitForMultipleObjectsEx() accepts up to 63 handles, %d passed.", len(handles)))
+	}
+
+	var pHandles *HANDLE
+	if len(handles) > 0 {
+		pHandles = &handles[0]
+	}
+
+	ret, _, err := syscall.SyscallN(proc.MsgWaitForMultipleObjectsEx.Addr(),
+		uintptr(len(handles)), uintptr(unsafe.Pointer(pHandles)),
+		milliseconds.Raw(), uintptr(wakeMask), uintptr(flags))
+	if co.WAIT(ret) == co.WAIT_FAILED {
+		return co.WAIT_FAILED, errco.ERROR(err)
+	}
+	return co.WAIT(ret), nil
+}
+
+// [NotifyWinEvent] function.
+//
+// Signals the system that a predefined accessibility event occurred. For a
+// window as a whole, pass co.OBJID_CLIENT and 0 (CHILDID_SELF) as idChild.
+//
+// [NotifyWinEvent]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-notifywinevent
+func NotifyWinEvent(
+	event co.WINEVENT, hWnd HWND, idObject co.OBJID, idChild int32) {
+
+	syscall.SyscallN(proc.NotifyWinEvent.Addr(),
+		uintptr(event), uintptr(hWnd), uintptr(idObject), uintptr(idChild))
+}
+
 // [PeekMessage] function.
 //
 // [PeekMessage]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-peekmessagew
@@ -471,6 +543,20 @@ func RegisterClassEx(wcx *WNDCLASSEX) (ATOM, error) {
 	}
 }
 
+// [RegisterClipboardFormat] function.
+//
+// If the format is already registered, returns its existing identifier.
+//
+// [RegisterClipboardFormat]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclipboardformatw
+func RegisterClipboardFormat(format string) (co.CF, error) {
+	ret, _, err := syscall.SyscallN(proc.RegisterClipboardFormat.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(format))))
+	if ret == 0 {
+		return co.CF(0), errco.ERROR(err)
+	}
+	return co.CF(ret), nil
+}
+
 // [RegisterWindowMessage] function.
 //
 // [RegisterWindowMessage]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerwindowmessagew
@@ -485,6 +571,17 @@ func RegisterWindowMessage(message string) (co.WM, error) {
 	}
 }
 
+// [ReleaseCapture] function.
+//
+// [ReleaseCapture]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-releasecapture
+func ReleaseCapture() error {
+	ret, _, err := syscall.SyscallN(proc.ReleaseCapture.Addr())
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
 // [ReplyMessage] function.
 //
 // [ReplyMessage]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-replymessage
@@ -494,6 +591,28 @@ func ReplyMessage(result uintptr) bool {
 	return ret != 0
 }
 
+// [TrackMouseEvent] function.
+//
+// Example:
+//
+//	var hWnd win.HWND // initialized somewhere
+//
+//	tme := win.TRACKMOUSEEVENT{
+//		DwFlags:   co.TME_LEAVE,
+//		HwndTrack: hWnd,
+//	}
+//	tme.SetCbSize()
+//	win.TrackMouseEvent(&tme)
+//
+// [TrackMouseEvent]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-trackmouseevent
+func TrackMouseEvent(tme *TRACKMOUSEEVENT) {
+	ret, _, err := syscall.SyscallN(proc.TrackMouseEvent.Addr(),
+		uintptr(unsafe.Pointer(tme)))
+	if ret == 0 {
+		panic(errco.ERROR(err))
+	}
+}
+
 // [TranslateMessage] function.
 //
 // [TranslateMessage]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-translatemessage
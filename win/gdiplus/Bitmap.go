@@ -0,0 +1,225 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A pointer to a GDI+ [Bitmap] object, which is also an Image.
+//
+// [Bitmap]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+type Bitmap Image
+
+// [GdipCreateBitmapFromFile] function.
+//
+// Returns an error if the file cannot be loaded.
+//
+// ⚠️ You must defer Bitmap.Dispose().
+//
+// [GdipCreateBitmapFromFile]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func CreateBitmapFromFile(path string) (Bitmap, error) {
+	var bmp Bitmap
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateBitmapFromFile.Addr(),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(path))),
+		uintptr(unsafe.Pointer(&bmp)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		return Bitmap(0), status
+	}
+	return bmp, nil
+}
+
+// [GdipCreateBitmapFromGraphics] function, which creates a bitmap with the
+// resolution of the given Graphics.
+//
+// ⚠️ You must defer Bitmap.Dispose().
+//
+// [GdipCreateBitmapFromGraphics]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func CreateBitmapFromGraphics(width, height int32, g Graphics) Bitmap {
+	var bmp Bitmap
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateBitmapFromGraphics.Addr(),
+		uintptr(width), uintptr(height), uintptr(g), uintptr(unsafe.Pointer(&bmp)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return bmp
+}
+
+// [GdipCreateBitmapFromHBITMAP] function.
+//
+// The HBITMAP is copied, so it can be deleted right away.
+//
+// ⚠️ You must defer Bitmap.Dispose().
+//
+// [GdipCreateBitmapFromHBITMAP]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func CreateBitmapFromHBITMAP(hBmp win.HBITMAP, hPal win.HGDIOBJ) Bitmap {
+	var bmp Bitmap
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateBitmapFromHBITMAP.Addr(),
+		uintptr(hBmp), uintptr(hPal), uintptr(unsafe.Pointer(&bmp)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return bmp
+}
+
+// [GdipCreateBitmapFromHICON] function.
+//
+// ⚠️ You must defer Bitmap.Dispose().
+//
+// [GdipCreateBitmapFromHICON]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func CreateBitmapFromHICON(hIcon win.HICON) Bitmap {
+	var bmp Bitmap
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateBitmapFromHICON.Addr(),
+		uintptr(hIcon), uintptr(unsafe.Pointer(&bmp)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return bmp
+}
+
+// [GdipCreateBitmapFromScan0] function, which creates an empty bitmap with
+// memory allocated by GDI+.
+//
+// ⚠️ You must defer Bitmap.Dispose().
+//
+// [GdipCreateBitmapFromScan0]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func CreateBitmapFromScan0(
+	width, height int32, format gdiplusco.PIXEL_FORMAT) Bitmap {
+
+	var bmp Bitmap
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateBitmapFromScan0.Addr(),
+		uintptr(width), uintptr(height), 0, uintptr(format), 0,
+		uintptr(unsafe.Pointer(&bmp)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return bmp
+}
+
+// Returns the Bitmap as an Image, so it can be drawn or saved.
+func (bmp Bitmap) AsImage() Image {
+	return Image(bmp)
+}
+
+// [GdipDisposeImage] function.
+//
+// [GdipDisposeImage]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (bmp Bitmap) Dispose() {
+	Image(bmp).Dispose()
+}
+
+// [GdipCreateHBITMAPFromBitmap] function.
+//
+// ⚠️ You must defer HBITMAP.DeleteObject() on the returned HBITMAP.
+//
+// [GdipCreateHBITMAPFromBitmap]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func (bmp Bitmap) GetHBITMAP(background ARGB) win.HBITMAP {
+	var hBmp win.HBITMAP
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateHBITMAPFromBitmap.Addr(),
+		uintptr(bmp), uintptr(unsafe.Pointer(&hBmp)), uintptr(background))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return hBmp
+}
+
+// [GdipCreateHICONFromBitmap] function.
+//
+// ⚠️ You must defer HICON.DestroyIcon() on the returned HICON.
+//
+// [GdipCreateHICONFromBitmap]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func (bmp Bitmap) GetHICON() win.HICON {
+	var hIcon win.HICON
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateHICONFromBitmap.Addr(),
+		uintptr(bmp), uintptr(unsafe.Pointer(&hIcon)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return hIcon
+}
+
+// [GdipBitmapGetPixel] function.
+//
+// [GdipBitmapGetPixel]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func (bmp Bitmap) GetPixel(x, y int32) ARGB {
+	var color ARGB
+	ret, _, _ := syscall.SyscallN(proc.GdipBitmapGetPixel.Addr(),
+		uintptr(bmp), uintptr(x), uintptr(y), uintptr(unsafe.Pointer(&color)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return color
+}
+
+// [GdipBitmapLockBits] function.
+//
+// If rc is nil, the whole bitmap is locked.
+//
+// ⚠️ You must defer Bitmap.UnlockBits() with the returned BITMAP_DATA.
+//
+// Example:
+//
+//	bmp := gdiplus.CreateBitmapFromScan0(64, 64,
+//		gdiplusco.PIXEL_FORMAT_32BPP_ARGB)
+//	defer bmp.Dispose()
+//
+//	data := bmp.LockBits(nil, gdiplusco.IMAGE_LOCK_MODE_WRITE,
+//		gdiplusco.PIXEL_FORMAT_32BPP_ARGB)
+//	defer bmp.UnlockBits(data)
+//
+//	pixels := data.Pixels()
+//
+// [GdipBitmapLockBits]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func (bmp Bitmap) LockBits(
+	rc *RECT, mode gdiplusco.IMAGE_LOCK_MODE,
+	format gdiplusco.PIXEL_FORMAT) *BITMAP_DATA {
+
+	data := &BITMAP_DATA{}
+	ret, _, _ := syscall.SyscallN(proc.GdipBitmapLockBits.Addr(),
+		uintptr(bmp), uintptr(unsafe.Pointer(rc)), uintptr(mode), uintptr(format),
+		uintptr(unsafe.Pointer(data)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return data
+}
+
+// [GdipBitmapSetPixel] function.
+//
+// [GdipBitmapSetPixel]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func (bmp Bitmap) SetPixel(x, y int32, color ARGB) {
+	ret, _, _ := syscall.SyscallN(proc.GdipBitmapSetPixel.Addr(),
+		uintptr(bmp), uintptr(x), uintptr(y), uintptr(color))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipBitmapSetResolution] function.
+//
+// [GdipBitmapSetResolution]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func (bmp Bitmap) SetResolution(dpiX, dpiY float32) {
+	ret, _, _ := syscall.SyscallN(proc.GdipBitmapSetResolution.Addr(),
+		uintptr(bmp), uintptr(math.Float32bits(dpiX)), uintptr(math.Float32bits(dpiY)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipBitmapUnlockBits] function.
+//
+// [GdipBitmapUnlockBits]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-bitmap-flat
+func (bmp Bitmap) UnlockBits(data *BITMAP_DATA) {
+	ret, _, _ := syscall.SyscallN(proc.GdipBitmapUnlockBits.Addr(),
+		uintptr(bmp), uintptr(unsafe.Pointer(data)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
@@ -0,0 +1,196 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A pointer to a GDI+ [Brush] object, which can be a solid, hatch, texture,
+// linear gradient or path gradient brush.
+//
+// [Brush]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-brush-flat
+type Brush uintptr
+
+// [GdipCreateHatchBrush] function.
+//
+// ⚠️ You must defer Brush.Delete().
+//
+// [GdipCreateHatchBrush]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-hatchbrush-flat
+func CreateHatchBrush(
+	style gdiplusco.HATCH_STYLE, foreColor, backColor ARGB) Brush {
+
+	var brush Brush
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateHatchBrush.Addr(),
+		uintptr(style), uintptr(foreColor), uintptr(backColor),
+		uintptr(unsafe.Pointer(&brush)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return brush
+}
+
+// [GdipCreateLineBrush] function.
+//
+// ⚠️ You must defer Brush.Delete().
+//
+// [GdipCreateLineBrush]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-lineargradientbrush-flat
+func CreateLineBrush(
+	pt1, pt2 POINT_F, color1, color2 ARGB, wrapMode gdiplusco.WRAP_MODE) Brush {
+
+	var brush Brush
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateLineBrush.Addr(),
+		uintptr(unsafe.Pointer(&pt1)), uintptr(unsafe.Pointer(&pt2)),
+		uintptr(color1), uintptr(color2), uintptr(wrapMode),
+		uintptr(unsafe.Pointer(&brush)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return brush
+}
+
+// [GdipCreateLineBrushFromRect] function.
+//
+// ⚠️ You must defer Brush.Delete().
+//
+// [GdipCreateLineBrushFromRect]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-lineargradientbrush-flat
+func CreateLineBrushFromRect(
+	rc RECT_F, color1, color2 ARGB,
+	mode gdiplusco.LINEAR_GRADIENT_MODE, wrapMode gdiplusco.WRAP_MODE) Brush {
+
+	var brush Brush
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateLineBrushFromRect.Addr(),
+		uintptr(unsafe.Pointer(&rc)), uintptr(color1), uintptr(color2),
+		uintptr(mode), uintptr(wrapMode), uintptr(unsafe.Pointer(&brush)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return brush
+}
+
+// [GdipCreatePathGradientFromPath] function.
+//
+// ⚠️ You must defer Brush.Delete().
+//
+// [GdipCreatePathGradientFromPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pathgradientbrush-flat
+func CreatePathGradientFromPath(path Path) Brush {
+	var brush Brush
+	ret, _, _ := syscall.SyscallN(proc.GdipCreatePathGradientFromPath.Addr(),
+		uintptr(path), uintptr(unsafe.Pointer(&brush)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return brush
+}
+
+// [GdipCreateSolidFill] function.
+//
+// ⚠️ You must defer Brush.Delete().
+//
+// [GdipCreateSolidFill]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-solidbrush-flat
+func CreateSolidFill(color ARGB) Brush {
+	var brush Brush
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateSolidFill.Addr(),
+		uintptr(color), uintptr(unsafe.Pointer(&brush)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return brush
+}
+
+// [GdipCreateTexture] function.
+//
+// ⚠️ You must defer Brush.Delete().
+//
+// [GdipCreateTexture]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-texturebrush-flat
+func CreateTexture(img Image, wrapMode gdiplusco.WRAP_MODE) Brush {
+	var brush Brush
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateTexture.Addr(),
+		uintptr(img), uintptr(wrapMode), uintptr(unsafe.Pointer(&brush)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return brush
+}
+
+// [GdipDeleteBrush] function.
+//
+// [GdipDeleteBrush]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-brush-flat
+func (brush Brush) Delete() {
+	syscall.SyscallN(proc.GdipDeleteBrush.Addr(), uintptr(brush))
+}
+
+// [GdipGetSolidFillColor] function.
+//
+// Panics if not a solid brush.
+//
+// [GdipGetSolidFillColor]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-solidbrush-flat
+func (brush Brush) GetSolidFillColor() ARGB {
+	var color ARGB
+	ret, _, _ := syscall.SyscallN(proc.GdipGetSolidFillColor.Addr(),
+		uintptr(brush), uintptr(unsafe.Pointer(&color)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return color
+}
+
+// [GdipGetBrushType] function.
+//
+// [GdipGetBrushType]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-brush-flat
+func (brush Brush) GetType() gdiplusco.BRUSH_TYPE {
+	var brushType gdiplusco.BRUSH_TYPE
+	ret, _, _ := syscall.SyscallN(proc.GdipGetBrushType.Addr(),
+		uintptr(brush), uintptr(unsafe.Pointer(&brushType)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return brushType
+}
+
+// [GdipSetPathGradientCenterColor] function.
+//
+// Panics if not a path gradient brush.
+//
+// [GdipSetPathGradientCenterColor]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pathgradientbrush-flat
+func (brush Brush) SetPathGradientCenterColor(color ARGB) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPathGradientCenterColor.Addr(),
+		uintptr(brush), uintptr(color))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPathGradientSurroundColorsWithCount] function.
+//
+// Panics if not a path gradient brush.
+//
+// [GdipSetPathGradientSurroundColorsWithCount]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pathgradientbrush-flat
+func (brush Brush) SetPathGradientSurroundColors(colors []ARGB) {
+	count := int32(len(colors))
+	ret, _, _ := syscall.SyscallN(
+		proc.GdipSetPathGradientSurroundColorsWithCount.Addr(),
+		uintptr(brush), uintptr(unsafe.Pointer(&colors[0])),
+		uintptr(unsafe.Pointer(&count)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetSolidFillColor] function.
+//
+// Panics if not a solid brush.
+//
+// [GdipSetSolidFillColor]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-solidbrush-flat
+func (brush Brush) SetSolidFillColor(color ARGB) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetSolidFillColor.Addr(),
+		uintptr(brush), uintptr(color))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
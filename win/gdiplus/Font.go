@@ -0,0 +1,139 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A pointer to a GDI+ [Font] object.
+//
+// [Font]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-font-flat
+type Font uintptr
+
+// [GdipCreateFont] function.
+//
+// ⚠️ You must defer Font.Delete().
+//
+// Example:
+//
+//	family, _ := gdiplus.CreateFontFamilyFromName("Segoe UI")
+//	defer family.Delete()
+//
+//	font := gdiplus.CreateFont(family, 12,
+//		gdiplusco.FONT_STYLE_BOLD, gdiplusco.UNIT_POINT)
+//	defer font.Delete()
+//
+// [GdipCreateFont]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-font-flat
+func CreateFont(
+	family FontFamily, emSize float32,
+	style gdiplusco.FONT_STYLE, unit gdiplusco.UNIT) Font {
+
+	var font Font
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateFont.Addr(),
+		uintptr(family), uintptr(math.Float32bits(emSize)),
+		uintptr(style), uintptr(unit), uintptr(unsafe.Pointer(&font)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return font
+}
+
+// [GdipCreateFontFromDC] function, which uses the font currently selected
+// into the device context.
+//
+// ⚠️ You must defer Font.Delete().
+//
+// [GdipCreateFontFromDC]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-font-flat
+func CreateFontFromDC(hdc win.HDC) Font {
+	var font Font
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateFontFromDC.Addr(),
+		uintptr(hdc), uintptr(unsafe.Pointer(&font)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return font
+}
+
+// [GdipCreateFontFromLogfont] function.
+//
+// ⚠️ You must defer Font.Delete().
+//
+// [GdipCreateFontFromLogfont]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-font-flat
+func CreateFontFromLogfont(hdc win.HDC, lf *win.LOGFONT) Font {
+	var font Font
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateFontFromLogfont.Addr(),
+		uintptr(hdc), uintptr(unsafe.Pointer(lf)), uintptr(unsafe.Pointer(&font)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return font
+}
+
+// [GdipDeleteFont] function.
+//
+// [GdipDeleteFont]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-font-flat
+func (font Font) Delete() {
+	syscall.SyscallN(proc.GdipDeleteFont.Addr(), uintptr(font))
+}
+
+// [GdipGetFamily] function.
+//
+// ⚠️ You must defer FontFamily.Delete() on the returned FontFamily.
+//
+// [GdipGetFamily]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-font-flat
+func (font Font) GetFamily() FontFamily {
+	var family FontFamily
+	ret, _, _ := syscall.SyscallN(proc.GdipGetFamily.Addr(),
+		uintptr(font), uintptr(unsafe.Pointer(&family)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return family
+}
+
+// [GdipGetFontHeight] function.
+//
+// [GdipGetFontHeight]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-font-flat
+func (font Font) GetHeight(g Graphics) float32 {
+	var height float32
+	ret, _, _ := syscall.SyscallN(proc.GdipGetFontHeight.Addr(),
+		uintptr(font), uintptr(g), uintptr(unsafe.Pointer(&height)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return height
+}
+
+// [GdipGetFontSize] function.
+//
+// [GdipGetFontSize]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-font-flat
+func (font Font) GetSize() float32 {
+	var size float32
+	ret, _, _ := syscall.SyscallN(proc.GdipGetFontSize.Addr(),
+		uintptr(font), uintptr(unsafe.Pointer(&size)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return size
+}
+
+// [GdipGetFontStyle] function.
+//
+// [GdipGetFontStyle]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-font-flat
+func (font Font) GetStyle() gdiplusco.FONT_STYLE {
+	var style gdiplusco.FONT_STYLE
+	ret, _, _ := syscall.SyscallN(proc.GdipGetFontStyle.Addr(),
+		uintptr(font), uintptr(unsafe.Pointer(&style)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return style
+}
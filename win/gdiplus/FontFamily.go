@@ -0,0 +1,106 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A pointer to a GDI+ [FontFamily] object.
+//
+// [FontFamily]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-fontfamily-flat
+type FontFamily uintptr
+
+// [GdipCreateFontFamilyFromName] function.
+//
+// Returns errco.GPSTATUS_FONT_FAMILY_NOT_FOUND if there is no such font
+// installed.
+//
+// ⚠️ You must defer FontFamily.Delete().
+//
+// [GdipCreateFontFamilyFromName]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-fontfamily-flat
+func CreateFontFamilyFromName(name string) (FontFamily, error) {
+	var family FontFamily
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateFontFamilyFromName.Addr(),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(name))),
+		0, uintptr(unsafe.Pointer(&family))) // installed fonts collection
+
+	if status := errco.GPSTATUS(ret); status == errco.GPSTATUS_OK {
+		return family, nil
+	} else if status == errco.GPSTATUS_FONT_FAMILY_NOT_FOUND {
+		return FontFamily(0), status
+	} else {
+		panic(status)
+	}
+}
+
+// [GdipGetGenericFontFamilyMonospace] function.
+//
+// ⚠️ You must defer FontFamily.Delete().
+//
+// [GdipGetGenericFontFamilyMonospace]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-fontfamily-flat
+func GetGenericFontFamilyMonospace() FontFamily {
+	return _GenericFontFamily(proc.GdipGetGenericFontFamilyMonospace)
+}
+
+// [GdipGetGenericFontFamilySansSerif] function.
+//
+// ⚠️ You must defer FontFamily.Delete().
+//
+// [GdipGetGenericFontFamilySansSerif]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-fontfamily-flat
+func GetGenericFontFamilySansSerif() FontFamily {
+	return _GenericFontFamily(proc.GdipGetGenericFontFamilySansSerif)
+}
+
+// [GdipGetGenericFontFamilySerif] function.
+//
+// ⚠️ You must defer FontFamily.Delete().
+//
+// [GdipGetGenericFontFamilySerif]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-fontfamily-flat
+func GetGenericFontFamilySerif() FontFamily {
+	return _GenericFontFamily(proc.GdipGetGenericFontFamilySerif)
+}
+
+func _GenericFontFamily(procGeneric *syscall.LazyProc) FontFamily {
+	var generic FontFamily
+	ret, _, _ := syscall.SyscallN(procGeneric.Addr(),
+		uintptr(unsafe.Pointer(&generic)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+
+	// The generic families are shared, so we return a clone, which can be
+	// safely deleted.
+	var family FontFamily
+	ret, _, _ = syscall.SyscallN(proc.GdipCloneFontFamily.Addr(),
+		uintptr(generic), uintptr(unsafe.Pointer(&family)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return family
+}
+
+// [GdipDeleteFontFamily] function.
+//
+// [GdipDeleteFontFamily]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-fontfamily-flat
+func (family FontFamily) Delete() {
+	syscall.SyscallN(proc.GdipDeleteFontFamily.Addr(), uintptr(family))
+}
+
+// [GdipGetFamilyName] function.
+//
+// [GdipGetFamilyName]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-fontfamily-flat
+func (family FontFamily) GetFamilyName() string {
+	var buf [32]uint16 // LF_FACESIZE
+	ret, _, _ := syscall.SyscallN(proc.GdipGetFamilyName.Addr(),
+		uintptr(family), uintptr(unsafe.Pointer(&buf[0])), 0) // LANG_NEUTRAL
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return win.Str.FromNativeSlice(buf[:])
+}
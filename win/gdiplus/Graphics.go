@@ -0,0 +1,612 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A pointer to a GDI+ [Graphics] object.
+//
+// [Graphics]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+type Graphics uintptr
+
+// [GdipCreateFromHDC] function.
+//
+// ⚠️ You must defer Graphics.Delete().
+//
+// Example:
+//
+//	var hdc win.HDC // initialized somewhere
+//
+//	g := gdiplus.CreateFromHDC(hdc)
+//	defer g.Delete()
+//
+//	g.SetSmoothingMode(gdiplusco.SMOOTHING_MODE_ANTI_ALIAS)
+//
+//	pen := gdiplus.CreatePen(gdiplus.MakeArgb(255, 0, 0, 255), 2, gdiplusco.UNIT_PIXEL)
+//	defer pen.Delete()
+//
+//	g.DrawEllipse(pen, gdiplus.RECT_F{X: 10, Y: 10, Width: 100, Height: 60})
+//
+// [GdipCreateFromHDC]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func CreateFromHDC(hdc win.HDC) Graphics {
+	var g Graphics
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateFromHDC.Addr(),
+		uintptr(hdc), uintptr(unsafe.Pointer(&g)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return g
+}
+
+// [GdipCreateFromHWND] function.
+//
+// ⚠️ You must defer Graphics.Delete().
+//
+// [GdipCreateFromHWND]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func CreateFromHWND(hWnd win.HWND) Graphics {
+	var g Graphics
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateFromHWND.Addr(),
+		uintptr(hWnd), uintptr(unsafe.Pointer(&g)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return g
+}
+
+// [GdipGraphicsClear] function.
+//
+// [GdipGraphicsClear]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) Clear(color ARGB) {
+	ret, _, _ := syscall.SyscallN(proc.GdipGraphicsClear.Addr(),
+		uintptr(g), uintptr(color))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDeleteGraphics] function.
+//
+// [GdipDeleteGraphics]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) Delete() {
+	syscall.SyscallN(proc.GdipDeleteGraphics.Addr(), uintptr(g))
+}
+
+// [GdipDrawArc] function.
+//
+// [GdipDrawArc]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawArc(pen Pen, bound RECT_F, startAngle, sweepAngle float32) {
+	args := append([]uintptr{uintptr(g), uintptr(pen)}, bound.args()...)
+	args = append(args,
+		uintptr(math.Float32bits(startAngle)), uintptr(math.Float32bits(sweepAngle)))
+
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawArc.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawBezier] function.
+//
+// [GdipDrawBezier]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawBezier(pen Pen, pt1, pt2, pt3, pt4 POINT_F) {
+	args := append([]uintptr{uintptr(g), uintptr(pen)}, pt1.args()...)
+	args = append(args, pt2.args()...)
+	args = append(args, pt3.args()...)
+	args = append(args, pt4.args()...)
+
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawBezier.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawEllipse] function.
+//
+// [GdipDrawEllipse]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawEllipse(pen Pen, bound RECT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawEllipse.Addr(),
+		append([]uintptr{uintptr(g), uintptr(pen)}, bound.args()...)...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawImage] function.
+//
+// [GdipDrawImage]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawImage(img Image, pt POINT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawImage.Addr(),
+		append([]uintptr{uintptr(g), uintptr(img)}, pt.args()...)...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawImageRect] function.
+//
+// [GdipDrawImageRect]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawImageRect(img Image, dest RECT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawImageRect.Addr(),
+		append([]uintptr{uintptr(g), uintptr(img)}, dest.args()...)...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawImageRectRect] function.
+//
+// [GdipDrawImageRectRect]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawImageRectRect(
+	img Image, dest, src RECT_F, srcUnit gdiplusco.UNIT) {
+
+	args := append([]uintptr{uintptr(g), uintptr(img)}, dest.args()...)
+	args = append(args, src.args()...)
+	args = append(args, uintptr(srcUnit), 0, 0, 0) // no attributes, no callback
+
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawImageRectRect.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawLine] function.
+//
+// [GdipDrawLine]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawLine(pen Pen, pt1, pt2 POINT_F) {
+	args := append([]uintptr{uintptr(g), uintptr(pen)}, pt1.args()...)
+	args = append(args, pt2.args()...)
+
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawLine.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawLines] function.
+//
+// [GdipDrawLines]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawLines(pen Pen, pts []POINT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawLines.Addr(),
+		uintptr(g), uintptr(pen),
+		uintptr(unsafe.Pointer(&pts[0])), uintptr(len(pts)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawPath] function.
+//
+// [GdipDrawPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawPath(pen Pen, path Path) {
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawPath.Addr(),
+		uintptr(g), uintptr(pen), uintptr(path))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawPie] function.
+//
+// [GdipDrawPie]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawPie(pen Pen, bound RECT_F, startAngle, sweepAngle float32) {
+	args := append([]uintptr{uintptr(g), uintptr(pen)}, bound.args()...)
+	args = append(args,
+		uintptr(math.Float32bits(startAngle)), uintptr(math.Float32bits(sweepAngle)))
+
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawPie.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawPolygon] function.
+//
+// [GdipDrawPolygon]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawPolygon(pen Pen, pts []POINT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawPolygon.Addr(),
+		uintptr(g), uintptr(pen),
+		uintptr(unsafe.Pointer(&pts[0])), uintptr(len(pts)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawRectangle] function.
+//
+// [GdipDrawRectangle]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawRectangle(pen Pen, rc RECT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawRectangle.Addr(),
+		append([]uintptr{uintptr(g), uintptr(pen)}, rc.args()...)...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDrawString] function.
+//
+// The format is optional, it can be zero.
+//
+// [GdipDrawString]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) DrawString(
+	text string, font Font, layout RECT_F, format StringFormat, brush Brush) {
+
+	ret, _, _ := syscall.SyscallN(proc.GdipDrawString.Addr(),
+		uintptr(g), uintptr(unsafe.Pointer(win.Str.ToNativePtr(text))),
+		^uintptr(0), // -1, null-terminated string
+		uintptr(font), uintptr(unsafe.Pointer(&layout)),
+		uintptr(format), uintptr(brush))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipFillEllipse] function.
+//
+// [GdipFillEllipse]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) FillEllipse(brush Brush, bound RECT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipFillEllipse.Addr(),
+		append([]uintptr{uintptr(g), uintptr(brush)}, bound.args()...)...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipFillPath] function.
+//
+// [GdipFillPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) FillPath(brush Brush, path Path) {
+	ret, _, _ := syscall.SyscallN(proc.GdipFillPath.Addr(),
+		uintptr(g), uintptr(brush), uintptr(path))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipFillPie] function.
+//
+// [GdipFillPie]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) FillPie(brush Brush, bound RECT_F, startAngle, sweepAngle float32) {
+	args := append([]uintptr{uintptr(g), uintptr(brush)}, bound.args()...)
+	args = append(args,
+		uintptr(math.Float32bits(startAngle)), uintptr(math.Float32bits(sweepAngle)))
+
+	ret, _, _ := syscall.SyscallN(proc.GdipFillPie.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipFillPolygon] function.
+//
+// [GdipFillPolygon]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) FillPolygon(
+	brush Brush, pts []POINT_F, fillMode gdiplusco.FILL_MODE) {
+
+	ret, _, _ := syscall.SyscallN(proc.GdipFillPolygon.Addr(),
+		uintptr(g), uintptr(brush),
+		uintptr(unsafe.Pointer(&pts[0])), uintptr(len(pts)), uintptr(fillMode))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipFillRectangle] function.
+//
+// [GdipFillRectangle]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) FillRectangle(brush Brush, rc RECT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipFillRectangle.Addr(),
+		append([]uintptr{uintptr(g), uintptr(brush)}, rc.args()...)...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipFlush] function.
+//
+// [GdipFlush]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) Flush(intention gdiplusco.FLUSH_INTENTION) {
+	ret, _, _ := syscall.SyscallN(proc.GdipFlush.Addr(),
+		uintptr(g), uintptr(intention))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipGetDC] function.
+//
+// ⚠️ You must defer Graphics.ReleaseDC(). No other Graphics method can be
+// called until then.
+//
+// [GdipGetDC]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) GetDC() win.HDC {
+	var hdc win.HDC
+	ret, _, _ := syscall.SyscallN(proc.GdipGetDC.Addr(),
+		uintptr(g), uintptr(unsafe.Pointer(&hdc)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return hdc
+}
+
+// [GdipGetDpiX] and [GdipGetDpiY] functions.
+//
+// [GdipGetDpiX]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+// [GdipGetDpiY]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) GetDpi() (dpiX, dpiY float32) {
+	ret, _, _ := syscall.SyscallN(proc.GdipGetDpiX.Addr(),
+		uintptr(g), uintptr(unsafe.Pointer(&dpiX)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+
+	ret, _, _ = syscall.SyscallN(proc.GdipGetDpiY.Addr(),
+		uintptr(g), uintptr(unsafe.Pointer(&dpiY)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return
+}
+
+// [GdipGetSmoothingMode] function.
+//
+// [GdipGetSmoothingMode]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) GetSmoothingMode() gdiplusco.SMOOTHING_MODE {
+	var mode gdiplusco.SMOOTHING_MODE
+	ret, _, _ := syscall.SyscallN(proc.GdipGetSmoothingMode.Addr(),
+		uintptr(g), uintptr(unsafe.Pointer(&mode)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return mode
+}
+
+// [GdipGetWorldTransform] function.
+//
+// ⚠️ You must defer Matrix.Delete() on the returned Matrix.
+//
+// [GdipGetWorldTransform]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) GetWorldTransform() Matrix {
+	m := CreateMatrix()
+	ret, _, _ := syscall.SyscallN(proc.GdipGetWorldTransform.Addr(),
+		uintptr(g), uintptr(m))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		m.Delete()
+		panic(status)
+	}
+	return m
+}
+
+// [GdipMeasureString] function.
+//
+// The format is optional, it can be zero.
+//
+// [GdipMeasureString]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) MeasureString(
+	text string, font Font, layout RECT_F,
+	format StringFormat) (bounds RECT_F, codepointsFitted, linesFilled int32) {
+
+	ret, _, _ := syscall.SyscallN(proc.GdipMeasureString.Addr(),
+		uintptr(g), uintptr(unsafe.Pointer(win.Str.ToNativePtr(text))),
+		^uintptr(0), // -1, null-terminated string
+		uintptr(font), uintptr(unsafe.Pointer(&layout)), uintptr(format),
+		uintptr(unsafe.Pointer(&bounds)),
+		uintptr(unsafe.Pointer(&codepointsFitted)),
+		uintptr(unsafe.Pointer(&linesFilled)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return
+}
+
+// [GdipReleaseDC] function.
+//
+// [GdipReleaseDC]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) ReleaseDC(hdc win.HDC) {
+	ret, _, _ := syscall.SyscallN(proc.GdipReleaseDC.Addr(),
+		uintptr(g), uintptr(hdc))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipResetClip] function.
+//
+// [GdipResetClip]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) ResetClip() {
+	ret, _, _ := syscall.SyscallN(proc.GdipResetClip.Addr(), uintptr(g))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipResetWorldTransform] function.
+//
+// [GdipResetWorldTransform]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) ResetWorldTransform() {
+	ret, _, _ := syscall.SyscallN(proc.GdipResetWorldTransform.Addr(), uintptr(g))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipRestoreGraphics] function.
+//
+// [GdipRestoreGraphics]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) Restore(state uint32) {
+	ret, _, _ := syscall.SyscallN(proc.GdipRestoreGraphics.Addr(),
+		uintptr(g), uintptr(state))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipRotateWorldTransform] function.
+//
+// [GdipRotateWorldTransform]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) RotateWorldTransform(angle float32, order gdiplusco.MATRIX_ORDER) {
+	ret, _, _ := syscall.SyscallN(proc.GdipRotateWorldTransform.Addr(),
+		uintptr(g), uintptr(math.Float32bits(angle)), uintptr(order))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSaveGraphics] function.
+//
+// Returns the state to be passed to Graphics.Restore().
+//
+// [GdipSaveGraphics]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) Save() uint32 {
+	var state uint32
+	ret, _, _ := syscall.SyscallN(proc.GdipSaveGraphics.Addr(),
+		uintptr(g), uintptr(unsafe.Pointer(&state)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return state
+}
+
+// [GdipScaleWorldTransform] function.
+//
+// [GdipScaleWorldTransform]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) ScaleWorldTransform(sx, sy float32, order gdiplusco.MATRIX_ORDER) {
+	ret, _, _ := syscall.SyscallN(proc.GdipScaleWorldTransform.Addr(),
+		uintptr(g), uintptr(math.Float32bits(sx)), uintptr(math.Float32bits(sy)),
+		uintptr(order))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetClipPath] function.
+//
+// [GdipSetClipPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetClipPath(path Path, mode gdiplusco.COMBINE_MODE) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetClipPath.Addr(),
+		uintptr(g), uintptr(path), uintptr(mode))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetClipRect] function.
+//
+// [GdipSetClipRect]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetClipRect(rc RECT_F, mode gdiplusco.COMBINE_MODE) {
+	args := append([]uintptr{uintptr(g)}, rc.args()...)
+	args = append(args, uintptr(mode))
+
+	ret, _, _ := syscall.SyscallN(proc.GdipSetClipRect.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetCompositingMode] function.
+//
+// [GdipSetCompositingMode]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetCompositingMode(mode gdiplusco.COMPOSITING_MODE) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetCompositingMode.Addr(),
+		uintptr(g), uintptr(mode))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetCompositingQuality] function.
+//
+// [GdipSetCompositingQuality]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetCompositingQuality(quality gdiplusco.COMPOSITING_QUALITY) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetCompositingQuality.Addr(),
+		uintptr(g), uintptr(quality))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetInterpolationMode] function.
+//
+// [GdipSetInterpolationMode]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetInterpolationMode(mode gdiplusco.INTERPOLATION_MODE) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetInterpolationMode.Addr(),
+		uintptr(g), uintptr(mode))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPageUnit] function.
+//
+// [GdipSetPageUnit]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetPageUnit(unit gdiplusco.UNIT) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPageUnit.Addr(),
+		uintptr(g), uintptr(unit))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPixelOffsetMode] function.
+//
+// [GdipSetPixelOffsetMode]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetPixelOffsetMode(mode gdiplusco.PIXEL_OFFSET_MODE) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPixelOffsetMode.Addr(),
+		uintptr(g), uintptr(mode))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetSmoothingMode] function.
+//
+// [GdipSetSmoothingMode]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetSmoothingMode(mode gdiplusco.SMOOTHING_MODE) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetSmoothingMode.Addr(),
+		uintptr(g), uintptr(mode))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetTextRenderingHint] function.
+//
+// [GdipSetTextRenderingHint]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetTextRenderingHint(hint gdiplusco.TEXT_RENDERING_HINT) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetTextRenderingHint.Addr(),
+		uintptr(g), uintptr(hint))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetWorldTransform] function.
+//
+// [GdipSetWorldTransform]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) SetWorldTransform(m Matrix) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetWorldTransform.Addr(),
+		uintptr(g), uintptr(m))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipTranslateWorldTransform] function.
+//
+// [GdipTranslateWorldTransform]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphics-flat
+func (g Graphics) TranslateWorldTransform(dx, dy float32, order gdiplusco.MATRIX_ORDER) {
+	ret, _, _ := syscall.SyscallN(proc.GdipTranslateWorldTransform.Addr(),
+		uintptr(g), uintptr(math.Float32bits(dx)), uintptr(math.Float32bits(dy)),
+		uintptr(order))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
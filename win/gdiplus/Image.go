@@ -0,0 +1,179 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A pointer to a GDI+ [Image] object.
+//
+// [Image]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+type Image uintptr
+
+// [GdipLoadImageFromFile] function.
+//
+// Returns an error if the file cannot be loaded.
+//
+// ⚠️ You must defer Image.Dispose().
+//
+// [GdipLoadImageFromFile]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func LoadImageFromFile(path string) (Image, error) {
+	var img Image
+	ret, _, _ := syscall.SyscallN(proc.GdipLoadImageFromFile.Addr(),
+		uintptr(unsafe.Pointer(win.Str.ToNativePtr(path))),
+		uintptr(unsafe.Pointer(&img)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		return Image(0), status
+	}
+	return img, nil
+}
+
+// [GdipCloneImage] function.
+//
+// ⚠️ You must defer Image.Dispose() on the returned Image.
+//
+// [GdipCloneImage]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) Clone() Image {
+	var clone Image
+	ret, _, _ := syscall.SyscallN(proc.GdipCloneImage.Addr(),
+		uintptr(img), uintptr(unsafe.Pointer(&clone)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return clone
+}
+
+// [GdipDisposeImage] function.
+//
+// [GdipDisposeImage]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) Dispose() {
+	syscall.SyscallN(proc.GdipDisposeImage.Addr(), uintptr(img))
+}
+
+// [GdipGetImageGraphicsContext] function, which allows drawing onto the
+// image.
+//
+// ⚠️ You must defer Graphics.Delete() on the returned Graphics.
+//
+// [GdipGetImageGraphicsContext]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) GetGraphicsContext() Graphics {
+	var g Graphics
+	ret, _, _ := syscall.SyscallN(proc.GdipGetImageGraphicsContext.Addr(),
+		uintptr(img), uintptr(unsafe.Pointer(&g)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return g
+}
+
+// [GdipGetImageHeight] function.
+//
+// [GdipGetImageHeight]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) GetHeight() uint32 {
+	var height uint32
+	ret, _, _ := syscall.SyscallN(proc.GdipGetImageHeight.Addr(),
+		uintptr(img), uintptr(unsafe.Pointer(&height)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return height
+}
+
+// [GdipGetImagePixelFormat] function.
+//
+// [GdipGetImagePixelFormat]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) GetPixelFormat() gdiplusco.PIXEL_FORMAT {
+	var format gdiplusco.PIXEL_FORMAT
+	ret, _, _ := syscall.SyscallN(proc.GdipGetImagePixelFormat.Addr(),
+		uintptr(img), uintptr(unsafe.Pointer(&format)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return format
+}
+
+// [GdipGetImageThumbnail] function.
+//
+// ⚠️ You must defer Image.Dispose() on the returned Image.
+//
+// [GdipGetImageThumbnail]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) GetThumbnail(width, height uint32) Image {
+	var thumb Image
+	ret, _, _ := syscall.SyscallN(proc.GdipGetImageThumbnail.Addr(),
+		uintptr(img), uintptr(width), uintptr(height),
+		uintptr(unsafe.Pointer(&thumb)), 0, 0) // no abort callback
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return thumb
+}
+
+// [GdipGetImageType] function.
+//
+// [GdipGetImageType]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) GetType() gdiplusco.IMAGE_TYPE {
+	var imgType gdiplusco.IMAGE_TYPE
+	ret, _, _ := syscall.SyscallN(proc.GdipGetImageType.Addr(),
+		uintptr(img), uintptr(unsafe.Pointer(&imgType)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return imgType
+}
+
+// [GdipGetImageWidth] function.
+//
+// [GdipGetImageWidth]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) GetWidth() uint32 {
+	var width uint32
+	ret, _, _ := syscall.SyscallN(proc.GdipGetImageWidth.Addr(),
+		uintptr(img), uintptr(unsafe.Pointer(&width)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return width
+}
+
+// [GdipImageRotateFlip] function.
+//
+// [GdipImageRotateFlip]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) RotateFlip(rotateFlip gdiplusco.ROTATE_FLIP) {
+	ret, _, _ := syscall.SyscallN(proc.GdipImageRotateFlip.Addr(),
+		uintptr(img), uintptr(rotateFlip))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSaveImageToFile] function.
+//
+// The encoder CLSID can be retrieved with EncoderClsidFromMimeType().
+//
+// Returns an error if the file cannot be written.
+//
+// Example:
+//
+//	img, _ := gdiplus.LoadImageFromFile("C:\\Temp\\foo.bmp")
+//	defer img.Dispose()
+//
+//	clsid, _ := gdiplus.EncoderClsidFromMimeType("image/png")
+//	img.SaveToFile("C:\\Temp\\foo.png", clsid)
+//
+// [GdipSaveImageToFile]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func (img Image) SaveToFile(path string, encoder co.CLSID) error {
+	ret, _, _ := syscall.SyscallN(proc.GdipSaveImageToFile.Addr(),
+		uintptr(img), uintptr(unsafe.Pointer(win.Str.ToNativePtr(path))),
+		uintptr(unsafe.Pointer(win.GuidFromClsid(encoder))), 0) // no encoder parameters
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		return status
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A pointer to a GDI+ [Matrix] object, a 3x2 affine transformation.
+//
+// [Matrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+type Matrix uintptr
+
+// [GdipCreateMatrix] function, which creates an identity matrix.
+//
+// ⚠️ You must defer Matrix.Delete().
+//
+// [GdipCreateMatrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func CreateMatrix() Matrix {
+	var m Matrix
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateMatrix.Addr(),
+		uintptr(unsafe.Pointer(&m)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return m
+}
+
+// [GdipCreateMatrix2] function.
+//
+// ⚠️ You must defer Matrix.Delete().
+//
+// [GdipCreateMatrix2]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func CreateMatrixElements(m11, m12, m21, m22, dx, dy float32) Matrix {
+	var m Matrix
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateMatrix2.Addr(),
+		uintptr(math.Float32bits(m11)), uintptr(math.Float32bits(m12)),
+		uintptr(math.Float32bits(m21)), uintptr(math.Float32bits(m22)),
+		uintptr(math.Float32bits(dx)), uintptr(math.Float32bits(dy)),
+		uintptr(unsafe.Pointer(&m)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return m
+}
+
+// [GdipCloneMatrix] function.
+//
+// ⚠️ You must defer Matrix.Delete() on the returned Matrix.
+//
+// [GdipCloneMatrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) Clone() Matrix {
+	var clone Matrix
+	ret, _, _ := syscall.SyscallN(proc.GdipCloneMatrix.Addr(),
+		uintptr(m), uintptr(unsafe.Pointer(&clone)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return clone
+}
+
+// [GdipDeleteMatrix] function.
+//
+// [GdipDeleteMatrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) Delete() {
+	syscall.SyscallN(proc.GdipDeleteMatrix.Addr(), uintptr(m))
+}
+
+// [GdipGetMatrixElements] function.
+//
+// Returns m11, m12, m21, m22, dx and dy, in this order.
+//
+// [GdipGetMatrixElements]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) GetElements() [6]float32 {
+	var elems [6]float32
+	ret, _, _ := syscall.SyscallN(proc.GdipGetMatrixElements.Addr(),
+		uintptr(m), uintptr(unsafe.Pointer(&elems[0])))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return elems
+}
+
+// [GdipInvertMatrix] function.
+//
+// Returns errco.GPSTATUS_INVALID_PARAMETER if the matrix is not invertible.
+//
+// [GdipInvertMatrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) Invert() error {
+	ret, _, _ := syscall.SyscallN(proc.GdipInvertMatrix.Addr(), uintptr(m))
+	if status := errco.GPSTATUS(ret); status == errco.GPSTATUS_OK {
+		return nil
+	} else if status == errco.GPSTATUS_INVALID_PARAMETER {
+		return status
+	} else {
+		panic(status)
+	}
+}
+
+// [GdipIsMatrixIdentity] function.
+//
+// [GdipIsMatrixIdentity]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) IsIdentity() bool {
+	var isIdentity int32 // BOOL
+	ret, _, _ := syscall.SyscallN(proc.GdipIsMatrixIdentity.Addr(),
+		uintptr(m), uintptr(unsafe.Pointer(&isIdentity)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return isIdentity != 0
+}
+
+// [GdipMultiplyMatrix] function.
+//
+// [GdipMultiplyMatrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) Multiply(other Matrix, order gdiplusco.MATRIX_ORDER) {
+	ret, _, _ := syscall.SyscallN(proc.GdipMultiplyMatrix.Addr(),
+		uintptr(m), uintptr(other), uintptr(order))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipRotateMatrix] function.
+//
+// [GdipRotateMatrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) Rotate(angle float32, order gdiplusco.MATRIX_ORDER) {
+	ret, _, _ := syscall.SyscallN(proc.GdipRotateMatrix.Addr(),
+		uintptr(m), uintptr(math.Float32bits(angle)), uintptr(order))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipScaleMatrix] function.
+//
+// [GdipScaleMatrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) Scale(sx, sy float32, order gdiplusco.MATRIX_ORDER) {
+	ret, _, _ := syscall.SyscallN(proc.GdipScaleMatrix.Addr(),
+		uintptr(m), uintptr(math.Float32bits(sx)), uintptr(math.Float32bits(sy)),
+		uintptr(order))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetMatrixElements] function.
+//
+// [GdipSetMatrixElements]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) SetElements(m11, m12, m21, m22, dx, dy float32) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetMatrixElements.Addr(),
+		uintptr(m),
+		uintptr(math.Float32bits(m11)), uintptr(math.Float32bits(m12)),
+		uintptr(math.Float32bits(m21)), uintptr(math.Float32bits(m22)),
+		uintptr(math.Float32bits(dx)), uintptr(math.Float32bits(dy)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipShearMatrix] function.
+//
+// [GdipShearMatrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) Shear(shearX, shearY float32, order gdiplusco.MATRIX_ORDER) {
+	ret, _, _ := syscall.SyscallN(proc.GdipShearMatrix.Addr(),
+		uintptr(m), uintptr(math.Float32bits(shearX)),
+		uintptr(math.Float32bits(shearY)), uintptr(order))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipTransformMatrixPoints] function.
+//
+// The points are transformed in place.
+//
+// [GdipTransformMatrixPoints]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) TransformPoints(pts []POINT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipTransformMatrixPoints.Addr(),
+		uintptr(m), uintptr(unsafe.Pointer(&pts[0])), uintptr(len(pts)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipTranslateMatrix] function.
+//
+// [GdipTranslateMatrix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-matrix-flat
+func (m Matrix) Translate(dx, dy float32, order gdiplusco.MATRIX_ORDER) {
+	ret, _, _ := syscall.SyscallN(proc.GdipTranslateMatrix.Addr(),
+		uintptr(m), uintptr(math.Float32bits(dx)), uintptr(math.Float32bits(dy)),
+		uintptr(order))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
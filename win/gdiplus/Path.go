@@ -0,0 +1,337 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A pointer to a GDI+ [GraphicsPath] object.
+//
+// [GraphicsPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+type Path uintptr
+
+// [GdipCreatePath] function.
+//
+// ⚠️ You must defer Path.Delete().
+//
+// [GdipCreatePath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func CreatePath(fillMode gdiplusco.FILL_MODE) Path {
+	var path Path
+	ret, _, _ := syscall.SyscallN(proc.GdipCreatePath.Addr(),
+		uintptr(fillMode), uintptr(unsafe.Pointer(&path)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return path
+}
+
+// [GdipAddPathArc] function.
+//
+// [GdipAddPathArc]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddArc(bound RECT_F, startAngle, sweepAngle float32) {
+	args := append([]uintptr{uintptr(path)}, bound.args()...)
+	args = append(args,
+		uintptr(math.Float32bits(startAngle)), uintptr(math.Float32bits(sweepAngle)))
+
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathArc.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipAddPathBezier] function.
+//
+// [GdipAddPathBezier]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddBezier(pt1, pt2, pt3, pt4 POINT_F) {
+	args := append([]uintptr{uintptr(path)}, pt1.args()...)
+	args = append(args, pt2.args()...)
+	args = append(args, pt3.args()...)
+	args = append(args, pt4.args()...)
+
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathBezier.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipAddPathEllipse] function.
+//
+// [GdipAddPathEllipse]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddEllipse(bound RECT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathEllipse.Addr(),
+		append([]uintptr{uintptr(path)}, bound.args()...)...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipAddPathLine] function.
+//
+// [GdipAddPathLine]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddLine(pt1, pt2 POINT_F) {
+	args := append([]uintptr{uintptr(path)}, pt1.args()...)
+	args = append(args, pt2.args()...)
+
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathLine.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipAddPathLine2] function.
+//
+// [GdipAddPathLine2]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddLines(pts []POINT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathLine2.Addr(),
+		uintptr(path), uintptr(unsafe.Pointer(&pts[0])), uintptr(len(pts)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipAddPathPath] function.
+//
+// [GdipAddPathPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddPath(other Path, connect bool) {
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathPath.Addr(),
+		uintptr(path), uintptr(other), util.BoolToUintptr(connect))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipAddPathPie] function.
+//
+// [GdipAddPathPie]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddPie(bound RECT_F, startAngle, sweepAngle float32) {
+	args := append([]uintptr{uintptr(path)}, bound.args()...)
+	args = append(args,
+		uintptr(math.Float32bits(startAngle)), uintptr(math.Float32bits(sweepAngle)))
+
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathPie.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipAddPathPolygon] function.
+//
+// [GdipAddPathPolygon]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddPolygon(pts []POINT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathPolygon.Addr(),
+		uintptr(path), uintptr(unsafe.Pointer(&pts[0])), uintptr(len(pts)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipAddPathRectangle] function.
+//
+// [GdipAddPathRectangle]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddRectangle(rc RECT_F) {
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathRectangle.Addr(),
+		append([]uintptr{uintptr(path)}, rc.args()...)...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipAddPathString] function.
+//
+// The format is optional, it can be zero.
+//
+// [GdipAddPathString]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) AddString(
+	text string, family FontFamily, style gdiplusco.FONT_STYLE, emSize float32,
+	layout RECT_F, format StringFormat) {
+
+	ret, _, _ := syscall.SyscallN(proc.GdipAddPathString.Addr(),
+		uintptr(path), uintptr(unsafe.Pointer(win.Str.ToNativePtr(text))),
+		^uintptr(0), // -1, null-terminated string
+		uintptr(family), uintptr(style), uintptr(math.Float32bits(emSize)),
+		uintptr(unsafe.Pointer(&layout)), uintptr(format))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipClonePath] function.
+//
+// ⚠️ You must defer Path.Delete() on the returned Path.
+//
+// [GdipClonePath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) Clone() Path {
+	var clone Path
+	ret, _, _ := syscall.SyscallN(proc.GdipClonePath.Addr(),
+		uintptr(path), uintptr(unsafe.Pointer(&clone)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return clone
+}
+
+// [GdipClosePathFigure] function.
+//
+// [GdipClosePathFigure]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) CloseFigure() {
+	ret, _, _ := syscall.SyscallN(proc.GdipClosePathFigure.Addr(), uintptr(path))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipClosePathFigures] function.
+//
+// [GdipClosePathFigures]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) CloseFigures() {
+	ret, _, _ := syscall.SyscallN(proc.GdipClosePathFigures.Addr(), uintptr(path))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipDeletePath] function.
+//
+// [GdipDeletePath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) Delete() {
+	syscall.SyscallN(proc.GdipDeletePath.Addr(), uintptr(path))
+}
+
+// [GdipFlattenPath] function.
+//
+// The matrix is optional, it can be zero.
+//
+// [GdipFlattenPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) Flatten(m Matrix, flatness float32) {
+	ret, _, _ := syscall.SyscallN(proc.GdipFlattenPath.Addr(),
+		uintptr(path), uintptr(m), uintptr(math.Float32bits(flatness)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipGetPointCount] function.
+//
+// [GdipGetPointCount]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) GetPointCount() int {
+	var count int32
+	ret, _, _ := syscall.SyscallN(proc.GdipGetPointCount.Addr(),
+		uintptr(path), uintptr(unsafe.Pointer(&count)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return int(count)
+}
+
+// [GdipGetPathWorldBounds] function.
+//
+// The matrix and the pen are optional, they can be zero.
+//
+// [GdipGetPathWorldBounds]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) GetWorldBounds(m Matrix, pen Pen) RECT_F {
+	var bounds RECT_F
+	ret, _, _ := syscall.SyscallN(proc.GdipGetPathWorldBounds.Addr(),
+		uintptr(path), uintptr(unsafe.Pointer(&bounds)), uintptr(m), uintptr(pen))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return bounds
+}
+
+// [GdipIsOutlineVisiblePathPoint] function.
+//
+// The graphics is optional, it can be zero.
+//
+// [GdipIsOutlineVisiblePathPoint]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) IsOutlineVisiblePoint(pt POINT_F, pen Pen, g Graphics) bool {
+	var isVisible int32 // BOOL
+	args := append([]uintptr{uintptr(path)}, pt.args()...)
+	args = append(args, uintptr(pen), uintptr(g), uintptr(unsafe.Pointer(&isVisible)))
+
+	ret, _, _ := syscall.SyscallN(proc.GdipIsOutlineVisiblePathPoint.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return isVisible != 0
+}
+
+// [GdipIsVisiblePathPoint] function.
+//
+// The graphics is optional, it can be zero.
+//
+// [GdipIsVisiblePathPoint]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) IsVisiblePoint(pt POINT_F, g Graphics) bool {
+	var isVisible int32 // BOOL
+	args := append([]uintptr{uintptr(path)}, pt.args()...)
+	args = append(args, uintptr(g), uintptr(unsafe.Pointer(&isVisible)))
+
+	ret, _, _ := syscall.SyscallN(proc.GdipIsVisiblePathPoint.Addr(), args...)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return isVisible != 0
+}
+
+// [GdipResetPath] function.
+//
+// [GdipResetPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) Reset() {
+	ret, _, _ := syscall.SyscallN(proc.GdipResetPath.Addr(), uintptr(path))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPathFillMode] function.
+//
+// [GdipSetPathFillMode]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) SetFillMode(fillMode gdiplusco.FILL_MODE) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPathFillMode.Addr(),
+		uintptr(path), uintptr(fillMode))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipStartPathFigure] function.
+//
+// [GdipStartPathFigure]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) StartFigure() {
+	ret, _, _ := syscall.SyscallN(proc.GdipStartPathFigure.Addr(), uintptr(path))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipTransformPath] function.
+//
+// [GdipTransformPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) Transform(m Matrix) {
+	ret, _, _ := syscall.SyscallN(proc.GdipTransformPath.Addr(),
+		uintptr(path), uintptr(m))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipWidenPath] function.
+//
+// The matrix is optional, it can be zero.
+//
+// [GdipWidenPath]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-graphicspath-flat
+func (path Path) Widen(pen Pen, m Matrix, flatness float32) {
+	ret, _, _ := syscall.SyscallN(proc.GdipWidenPath.Addr(),
+		uintptr(path), uintptr(pen), uintptr(m), uintptr(math.Float32bits(flatness)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
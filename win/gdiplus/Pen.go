@@ -0,0 +1,162 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A pointer to a GDI+ [Pen] object.
+//
+// [Pen]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+type Pen uintptr
+
+// [GdipCreatePen1] function.
+//
+// ⚠️ You must defer Pen.Delete().
+//
+// [GdipCreatePen1]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func CreatePen(color ARGB, width float32, unit gdiplusco.UNIT) Pen {
+	var pen Pen
+	ret, _, _ := syscall.SyscallN(proc.GdipCreatePen1.Addr(),
+		uintptr(color), uintptr(math.Float32bits(width)), uintptr(unit),
+		uintptr(unsafe.Pointer(&pen)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return pen
+}
+
+// [GdipCreatePen2] function.
+//
+// ⚠️ You must defer Pen.Delete().
+//
+// [GdipCreatePen2]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func CreatePenFromBrush(brush Brush, width float32, unit gdiplusco.UNIT) Pen {
+	var pen Pen
+	ret, _, _ := syscall.SyscallN(proc.GdipCreatePen2.Addr(),
+		uintptr(brush), uintptr(math.Float32bits(width)), uintptr(unit),
+		uintptr(unsafe.Pointer(&pen)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return pen
+}
+
+// [GdipDeletePen] function.
+//
+// [GdipDeletePen]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) Delete() {
+	syscall.SyscallN(proc.GdipDeletePen.Addr(), uintptr(pen))
+}
+
+// [GdipGetPenColor] function.
+//
+// [GdipGetPenColor]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) GetColor() ARGB {
+	var color ARGB
+	ret, _, _ := syscall.SyscallN(proc.GdipGetPenColor.Addr(),
+		uintptr(pen), uintptr(unsafe.Pointer(&color)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return color
+}
+
+// [GdipGetPenWidth] function.
+//
+// [GdipGetPenWidth]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) GetWidth() float32 {
+	var width float32
+	ret, _, _ := syscall.SyscallN(proc.GdipGetPenWidth.Addr(),
+		uintptr(pen), uintptr(unsafe.Pointer(&width)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return width
+}
+
+// [GdipSetPenColor] function.
+//
+// [GdipSetPenColor]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) SetColor(color ARGB) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPenColor.Addr(),
+		uintptr(pen), uintptr(color))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPenDashArray] function.
+//
+// Also sets the dash style to DASH_STYLE_CUSTOM.
+//
+// [GdipSetPenDashArray]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) SetDashArray(dashes []float32) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPenDashArray.Addr(),
+		uintptr(pen), uintptr(unsafe.Pointer(&dashes[0])), uintptr(len(dashes)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPenDashStyle] function.
+//
+// [GdipSetPenDashStyle]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) SetDashStyle(style gdiplusco.DASH_STYLE) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPenDashStyle.Addr(),
+		uintptr(pen), uintptr(style))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPenEndCap] function.
+//
+// [GdipSetPenEndCap]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) SetEndCap(lineCap gdiplusco.LINE_CAP) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPenEndCap.Addr(),
+		uintptr(pen), uintptr(lineCap))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPenLineJoin] function.
+//
+// [GdipSetPenLineJoin]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) SetLineJoin(join gdiplusco.LINE_JOIN) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPenLineJoin.Addr(),
+		uintptr(pen), uintptr(join))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPenStartCap] function.
+//
+// [GdipSetPenStartCap]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) SetStartCap(lineCap gdiplusco.LINE_CAP) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPenStartCap.Addr(),
+		uintptr(pen), uintptr(lineCap))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetPenWidth] function.
+//
+// [GdipSetPenWidth]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-pen-flat
+func (pen Pen) SetWidth(width float32) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetPenWidth.Addr(),
+		uintptr(pen), uintptr(math.Float32bits(width)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
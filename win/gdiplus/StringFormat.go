@@ -0,0 +1,142 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/errco"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A pointer to a GDI+ [StringFormat] object.
+//
+// [StringFormat]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+type StringFormat uintptr
+
+// [GdipCreateStringFormat] function.
+//
+// ⚠️ You must defer StringFormat.Delete().
+//
+// [GdipCreateStringFormat]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func CreateStringFormat(
+	flags gdiplusco.STRING_FORMAT_FLAGS, language uint16) StringFormat {
+
+	var format StringFormat
+	ret, _, _ := syscall.SyscallN(proc.GdipCreateStringFormat.Addr(),
+		uintptr(flags), uintptr(language), uintptr(unsafe.Pointer(&format)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return format
+}
+
+// [GdipStringFormatGetGenericDefault] function.
+//
+// ⚠️ You must defer StringFormat.Delete().
+//
+// [GdipStringFormatGetGenericDefault]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func StringFormatGetGenericDefault() StringFormat {
+	return _GenericStringFormat(proc.GdipStringFormatGetGenericDefault)
+}
+
+// [GdipStringFormatGetGenericTypographic] function.
+//
+// ⚠️ You must defer StringFormat.Delete().
+//
+// [GdipStringFormatGetGenericTypographic]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func StringFormatGetGenericTypographic() StringFormat {
+	return _GenericStringFormat(proc.GdipStringFormatGetGenericTypographic)
+}
+
+func _GenericStringFormat(procGeneric *syscall.LazyProc) StringFormat {
+	var generic StringFormat
+	ret, _, _ := syscall.SyscallN(procGeneric.Addr(),
+		uintptr(unsafe.Pointer(&generic)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+
+	// The generic formats are shared, so we return a clone, which can be
+	// safely modified and deleted.
+	return generic.Clone()
+}
+
+// [GdipCloneStringFormat] function.
+//
+// ⚠️ You must defer StringFormat.Delete() on the returned StringFormat.
+//
+// [GdipCloneStringFormat]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func (format StringFormat) Clone() StringFormat {
+	var clone StringFormat
+	ret, _, _ := syscall.SyscallN(proc.GdipCloneStringFormat.Addr(),
+		uintptr(format), uintptr(unsafe.Pointer(&clone)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return clone
+}
+
+// [GdipDeleteStringFormat] function.
+//
+// [GdipDeleteStringFormat]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func (format StringFormat) Delete() {
+	syscall.SyscallN(proc.GdipDeleteStringFormat.Addr(), uintptr(format))
+}
+
+// [GdipSetStringFormatAlign] function.
+//
+// [GdipSetStringFormatAlign]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func (format StringFormat) SetAlign(align gdiplusco.STRING_ALIGNMENT) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetStringFormatAlign.Addr(),
+		uintptr(format), uintptr(align))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetStringFormatFlags] function.
+//
+// [GdipSetStringFormatFlags]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func (format StringFormat) SetFlags(flags gdiplusco.STRING_FORMAT_FLAGS) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetStringFormatFlags.Addr(),
+		uintptr(format), uintptr(flags))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetStringFormatHotkeyPrefix] function.
+//
+// [GdipSetStringFormatHotkeyPrefix]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func (format StringFormat) SetHotkeyPrefix(prefix gdiplusco.HOTKEY_PREFIX) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetStringFormatHotkeyPrefix.Addr(),
+		uintptr(format), uintptr(prefix))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetStringFormatLineAlign] function.
+//
+// [GdipSetStringFormatLineAlign]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func (format StringFormat) SetLineAlign(align gdiplusco.STRING_ALIGNMENT) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetStringFormatLineAlign.Addr(),
+		uintptr(format), uintptr(align))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
+
+// [GdipSetStringFormatTrimming] function.
+//
+// [GdipSetStringFormatTrimming]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-stringformat-flat
+func (format StringFormat) SetTrimming(trimming gdiplusco.STRING_TRIMMING) {
+	ret, _, _ := syscall.SyscallN(proc.GdipSetStringFormatTrimming.Addr(),
+		uintptr(format), uintptr(trimming))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+}
@@ -0,0 +1,110 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [GdiplusStartup] function.
+//
+// If input is nil, the default values will be used.
+//
+// ⚠️ You must defer GdiplusShutdown().
+//
+// Example:
+//
+//	token := gdiplus.GdiplusStartup(nil)
+//	defer gdiplus.GdiplusShutdown(token)
+//
+// [GdiplusStartup]: https://docs.microsoft.com/en-us/windows/win32/api/gdiplusinit/nf-gdiplusinit-gdiplusstartup
+func GdiplusStartup(input *STARTUP_INPUT) uintptr {
+	if input == nil {
+		input = &STARTUP_INPUT{}
+		input.SetDefaults()
+	}
+
+	var token uintptr
+	ret, _, _ := syscall.SyscallN(proc.GdiplusStartup.Addr(),
+		uintptr(unsafe.Pointer(&token)), uintptr(unsafe.Pointer(input)), 0)
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+	return token
+}
+
+// [GdiplusShutdown] function.
+//
+// [GdiplusShutdown]: https://docs.microsoft.com/en-us/windows/win32/api/gdiplusinit/nf-gdiplusinit-gdiplusshutdown
+func GdiplusShutdown(token uintptr) {
+	syscall.SyscallN(proc.GdiplusShutdown.Addr(), token)
+}
+
+// Returns the CLSID of the image encoder for the given MIME type, like
+// "image/png" or "image/jpeg", to be passed to Image.SaveToFile().
+func EncoderClsidFromMimeType(mimeType string) (co.CLSID, bool) {
+	for _, enc := range GetImageEncoders() {
+		if strings.EqualFold(enc.MimeType, mimeType) {
+			return enc.Clsid, true
+		}
+	}
+	return "", false
+}
+
+// [GdipGetImageDecoders] function.
+//
+// [GdipGetImageDecoders]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func GetImageDecoders() []IMAGE_CODEC_INFO {
+	return _GetImageCodecs(proc.GdipGetImageDecodersSize, proc.GdipGetImageDecoders)
+}
+
+// [GdipGetImageEncoders] function.
+//
+// [GdipGetImageEncoders]: https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-image-flat
+func GetImageEncoders() []IMAGE_CODEC_INFO {
+	return _GetImageCodecs(proc.GdipGetImageEncodersSize, proc.GdipGetImageEncoders)
+}
+
+func _GetImageCodecs(procSize, procCodecs *syscall.LazyProc) []IMAGE_CODEC_INFO {
+	var num, size uint32
+	ret, _, _ := syscall.SyscallN(procSize.Addr(),
+		uintptr(unsafe.Pointer(&num)), uintptr(unsafe.Pointer(&size)))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	} else if num == 0 {
+		return []IMAGE_CODEC_INFO{}
+	}
+
+	// The strings are stored in the same buffer, right after the structs.
+	buf := make([]byte, size)
+	ret, _, _ = syscall.SyscallN(procCodecs.Addr(),
+		uintptr(num), uintptr(size), uintptr(unsafe.Pointer(&buf[0])))
+	if status := errco.GPSTATUS(ret); status != errco.GPSTATUS_OK {
+		panic(status)
+	}
+
+	nativeInfos := unsafe.Slice((*_IMAGE_CODEC_INFO)(unsafe.Pointer(&buf[0])), num)
+	infos := make([]IMAGE_CODEC_INFO, 0, num)
+	for i := range nativeInfos {
+		nfo := &nativeInfos[i]
+		infos = append(infos, IMAGE_CODEC_INFO{
+			Clsid:             co.CLSID(nfo.Clsid.String()),
+			FormatId:          co.CLSID(nfo.FormatId.String()),
+			CodecName:         win.Str.FromNativePtr(nfo.CodecName),
+			DllName:           win.Str.FromNativePtr(nfo.DllName),
+			FormatDescription: win.Str.FromNativePtr(nfo.FormatDescription),
+			FilenameExtension: win.Str.FromNativePtr(nfo.FilenameExtension),
+			MimeType:          win.Str.FromNativePtr(nfo.MimeType),
+			Flags:             nfo.Flags,
+			Version:           nfo.Version,
+		})
+	}
+	return infos
+}
@@ -0,0 +1,141 @@
+//go:build windows
+
+package gdiplus
+
+import (
+	"math"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/gdiplus/gdiplusco"
+)
+
+// A 32-bit color value in the 0xAARRGGBB format.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-color-class
+type ARGB uint32
+
+// Creates an ARGB color from its components.
+func MakeArgb(alpha, red, green, blue uint8) ARGB {
+	return ARGB(uint32(alpha)<<24 | uint32(red)<<16 | uint32(green)<<8 | uint32(blue))
+}
+
+// Creates an ARGB color from a COLORREF, with the given alpha.
+func ArgbFromColorref(alpha uint8, color win.COLORREF) ARGB {
+	return MakeArgb(alpha, color.Red(), color.Green(), color.Blue())
+}
+
+func (c ARGB) Alpha() uint8 { return uint8(c >> 24) }
+func (c ARGB) Red() uint8   { return uint8(c >> 16) }
+func (c ARGB) Green() uint8 { return uint8(c >> 8) }
+func (c ARGB) Blue() uint8  { return uint8(c) }
+
+// Converts to a COLORREF, discarding the alpha.
+func (c ARGB) ToColorref() win.COLORREF {
+	return win.RGB(c.Red(), c.Green(), c.Blue())
+}
+
+// [BitmapData] struct.
+//
+// [BitmapData]: https://docs.microsoft.com/en-us/windows/win32/api/gdiplusimaging/nl-gdiplusimaging-bitmapdata
+type BITMAP_DATA struct {
+	Width       uint32
+	Height      uint32
+	Stride      int32
+	PixelFormat gdiplusco.PIXEL_FORMAT
+	Scan0       uintptr
+	reserved    uintptr
+}
+
+// Returns the locked pixels as a slice, which is valid until
+// Bitmap.UnlockBits() is called.
+func (bd *BITMAP_DATA) Pixels() []byte {
+	stride := bd.Stride
+	if stride < 0 {
+		stride = -stride
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(bd.Scan0)), int(stride)*int(bd.Height))
+}
+
+// [GdiplusStartupInput] struct.
+//
+// ⚠️ You must call SetDefaults().
+//
+// [GdiplusStartupInput]: https://docs.microsoft.com/en-us/windows/win32/api/gdiplusinit/ns-gdiplusinit-gdiplusstartupinput
+type STARTUP_INPUT struct {
+	GdiplusVersion           uint32
+	DebugEventCallback       uintptr
+	SuppressBackgroundThread int32 // BOOL
+	SuppressExternalCodecs   int32 // BOOL
+}
+
+func (si *STARTUP_INPUT) SetDefaults() { si.GdiplusVersion = 1 }
+
+// [ImageCodecInfo] class, with the data copied into Go strings.
+//
+// [ImageCodecInfo]: https://docs.microsoft.com/en-us/windows/win32/api/gdiplusimaging/nl-gdiplusimaging-imagecodecinfo
+type IMAGE_CODEC_INFO struct {
+	Clsid             co.CLSID
+	FormatId          co.CLSID
+	CodecName         string
+	DllName           string
+	FormatDescription string
+	FilenameExtension string
+	MimeType          string
+	Flags             uint32
+	Version           uint32
+}
+
+// Native memory layout of ImageCodecInfo.
+type _IMAGE_CODEC_INFO struct {
+	Clsid             win.GUID
+	FormatId          win.GUID
+	CodecName         *uint16
+	DllName           *uint16
+	FormatDescription *uint16
+	FilenameExtension *uint16
+	MimeType          *uint16
+	Flags             uint32
+	Version           uint32
+	SigCount          uint32
+	SigSize           uint32
+	SigPattern        *byte
+	SigMask           *byte
+}
+
+// [PointF] struct.
+//
+// [PointF]: https://docs.microsoft.com/en-us/windows/win32/api/gdiplustypes/nl-gdiplustypes-pointf
+type POINT_F struct {
+	X, Y float32
+}
+
+// Syscall arguments of a POINT_F passed as separated X and Y.
+func (pt POINT_F) args() []uintptr {
+	return []uintptr{uintptr(math.Float32bits(pt.X)), uintptr(math.Float32bits(pt.Y))}
+}
+
+// [Rect] struct.
+//
+// [Rect]: https://docs.microsoft.com/en-us/windows/win32/api/gdiplustypes/nl-gdiplustypes-rect
+type RECT struct {
+	X, Y          int32
+	Width, Height int32
+}
+
+// [RectF] struct.
+//
+// [RectF]: https://docs.microsoft.com/en-us/windows/win32/api/gdiplustypes/nl-gdiplustypes-rectf
+type RECT_F struct {
+	X, Y          float32
+	Width, Height float32
+}
+
+// Syscall arguments of a RECT_F passed as separated X, Y, width and height.
+func (rc RECT_F) args() []uintptr {
+	return []uintptr{
+		uintptr(math.Float32bits(rc.X)), uintptr(math.Float32bits(rc.Y)),
+		uintptr(math.Float32bits(rc.Width)), uintptr(math.Float32bits(rc.Height)),
+	}
+}
@@ -0,0 +1,364 @@
+//go:build windows
+
+package gdiplusco
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-brushtype
+type BRUSH_TYPE int32
+
+const (
+	BRUSH_TYPE_SOLID_COLOR     BRUSH_TYPE = 0
+	BRUSH_TYPE_HATCH_FILL      BRUSH_TYPE = 1
+	BRUSH_TYPE_TEXTURE_FILL    BRUSH_TYPE = 2
+	BRUSH_TYPE_PATH_GRADIENT   BRUSH_TYPE = 3
+	BRUSH_TYPE_LINEAR_GRADIENT BRUSH_TYPE = 4
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-combinemode
+type COMBINE_MODE int32
+
+const (
+	COMBINE_MODE_REPLACE    COMBINE_MODE = 0
+	COMBINE_MODE_INTERSECT  COMBINE_MODE = 1
+	COMBINE_MODE_UNION      COMBINE_MODE = 2
+	COMBINE_MODE_XOR        COMBINE_MODE = 3
+	COMBINE_MODE_EXCLUDE    COMBINE_MODE = 4
+	COMBINE_MODE_COMPLEMENT COMBINE_MODE = 5
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-compositingmode
+type COMPOSITING_MODE int32
+
+const (
+	COMPOSITING_MODE_SOURCE_OVER COMPOSITING_MODE = 0
+	COMPOSITING_MODE_SOURCE_COPY COMPOSITING_MODE = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-compositingquality
+type COMPOSITING_QUALITY int32
+
+const (
+	COMPOSITING_QUALITY_INVALID         COMPOSITING_QUALITY = -1
+	COMPOSITING_QUALITY_DEFAULT         COMPOSITING_QUALITY = 0
+	COMPOSITING_QUALITY_HIGH_SPEED      COMPOSITING_QUALITY = 1
+	COMPOSITING_QUALITY_HIGH_QUALITY    COMPOSITING_QUALITY = 2
+	COMPOSITING_QUALITY_GAMMA_CORRECTED COMPOSITING_QUALITY = 3
+	COMPOSITING_QUALITY_ASSUME_LINEAR   COMPOSITING_QUALITY = 4
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-dashstyle
+type DASH_STYLE int32
+
+const (
+	DASH_STYLE_SOLID        DASH_STYLE = 0
+	DASH_STYLE_DASH         DASH_STYLE = 1
+	DASH_STYLE_DOT          DASH_STYLE = 2
+	DASH_STYLE_DASH_DOT     DASH_STYLE = 3
+	DASH_STYLE_DASH_DOT_DOT DASH_STYLE = 4
+	DASH_STYLE_CUSTOM       DASH_STYLE = 5
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-fillmode
+type FILL_MODE int32
+
+const (
+	FILL_MODE_ALTERNATE FILL_MODE = 0
+	FILL_MODE_WINDING   FILL_MODE = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-flushintention
+type FLUSH_INTENTION int32
+
+const (
+	FLUSH_INTENTION_FLUSH FLUSH_INTENTION = 0
+	FLUSH_INTENTION_SYNC  FLUSH_INTENTION = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-fontstyle
+type FONT_STYLE int32
+
+const (
+	FONT_STYLE_REGULAR     FONT_STYLE = 0
+	FONT_STYLE_BOLD        FONT_STYLE = 1
+	FONT_STYLE_ITALIC      FONT_STYLE = 2
+	FONT_STYLE_BOLD_ITALIC FONT_STYLE = 3
+	FONT_STYLE_UNDERLINE   FONT_STYLE = 4
+	FONT_STYLE_STRIKEOUT   FONT_STYLE = 8
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-hatchstyle
+type HATCH_STYLE int32
+
+const (
+	HATCH_STYLE_HORIZONTAL               HATCH_STYLE = 0
+	HATCH_STYLE_VERTICAL                 HATCH_STYLE = 1
+	HATCH_STYLE_FORWARD_DIAGONAL         HATCH_STYLE = 2
+	HATCH_STYLE_BACKWARD_DIAGONAL        HATCH_STYLE = 3
+	HATCH_STYLE_CROSS                    HATCH_STYLE = 4
+	HATCH_STYLE_DIAGONAL_CROSS           HATCH_STYLE = 5
+	HATCH_STYLE_05_PERCENT               HATCH_STYLE = 6
+	HATCH_STYLE_10_PERCENT               HATCH_STYLE = 7
+	HATCH_STYLE_20_PERCENT               HATCH_STYLE = 8
+	HATCH_STYLE_25_PERCENT               HATCH_STYLE = 9
+	HATCH_STYLE_30_PERCENT               HATCH_STYLE = 10
+	HATCH_STYLE_40_PERCENT               HATCH_STYLE = 11
+	HATCH_STYLE_50_PERCENT               HATCH_STYLE = 12
+	HATCH_STYLE_60_PERCENT               HATCH_STYLE = 13
+	HATCH_STYLE_70_PERCENT               HATCH_STYLE = 14
+	HATCH_STYLE_75_PERCENT               HATCH_STYLE = 15
+	HATCH_STYLE_80_PERCENT               HATCH_STYLE = 16
+	HATCH_STYLE_90_PERCENT               HATCH_STYLE = 17
+	HATCH_STYLE_LIGHT_DOWNWARD_DIAGONAL  HATCH_STYLE = 18
+	HATCH_STYLE_LIGHT_UPWARD_DIAGONAL    HATCH_STYLE = 19
+	HATCH_STYLE_DARK_DOWNWARD_DIAGONAL   HATCH_STYLE = 20
+	HATCH_STYLE_DARK_UPWARD_DIAGONAL     HATCH_STYLE = 21
+	HATCH_STYLE_WIDE_DOWNWARD_DIAGONAL   HATCH_STYLE = 22
+	HATCH_STYLE_WIDE_UPWARD_DIAGONAL     HATCH_STYLE = 23
+	HATCH_STYLE_LIGHT_VERTICAL           HATCH_STYLE = 24
+	HATCH_STYLE_LIGHT_HORIZONTAL         HATCH_STYLE = 25
+	HATCH_STYLE_NARROW_VERTICAL          HATCH_STYLE = 26
+	HATCH_STYLE_NARROW_HORIZONTAL        HATCH_STYLE = 27
+	HATCH_STYLE_DARK_VERTICAL            HATCH_STYLE = 28
+	HATCH_STYLE_DARK_HORIZONTAL          HATCH_STYLE = 29
+	HATCH_STYLE_DASHED_DOWNWARD_DIAGONAL HATCH_STYLE = 30
+	HATCH_STYLE_DASHED_UPWARD_DIAGONAL   HATCH_STYLE = 31
+	HATCH_STYLE_DASHED_HORIZONTAL        HATCH_STYLE = 32
+	HATCH_STYLE_DASHED_VERTICAL          HATCH_STYLE = 33
+	HATCH_STYLE_SMALL_CONFETTI           HATCH_STYLE = 34
+	HATCH_STYLE_LARGE_CONFETTI           HATCH_STYLE = 35
+	HATCH_STYLE_ZIG_ZAG                  HATCH_STYLE = 36
+	HATCH_STYLE_WAVE                     HATCH_STYLE = 37
+	HATCH_STYLE_DIAGONAL_BRICK           HATCH_STYLE = 38
+	HATCH_STYLE_HORIZONTAL_BRICK         HATCH_STYLE = 39
+	HATCH_STYLE_WEAVE                    HATCH_STYLE = 40
+	HATCH_STYLE_PLAID                    HATCH_STYLE = 41
+	HATCH_STYLE_DIVOT                    HATCH_STYLE = 42
+	HATCH_STYLE_DOTTED_GRID              HATCH_STYLE = 43
+	HATCH_STYLE_DOTTED_DIAMOND           HATCH_STYLE = 44
+	HATCH_STYLE_SHINGLE                  HATCH_STYLE = 45
+	HATCH_STYLE_TRELLIS                  HATCH_STYLE = 46
+	HATCH_STYLE_SPHERE                   HATCH_STYLE = 47
+	HATCH_STYLE_SMALL_GRID               HATCH_STYLE = 48
+	HATCH_STYLE_SMALL_CHECKER_BOARD      HATCH_STYLE = 49
+	HATCH_STYLE_LARGE_CHECKER_BOARD      HATCH_STYLE = 50
+	HATCH_STYLE_OUTLINED_DIAMOND         HATCH_STYLE = 51
+	HATCH_STYLE_SOLID_DIAMOND            HATCH_STYLE = 52
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-hotkeyprefix
+type HOTKEY_PREFIX int32
+
+const (
+	HOTKEY_PREFIX_NONE HOTKEY_PREFIX = 0
+	HOTKEY_PREFIX_SHOW HOTKEY_PREFIX = 1
+	HOTKEY_PREFIX_HIDE HOTKEY_PREFIX = 2
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-imagelockmode
+type IMAGE_LOCK_MODE uint32
+
+const (
+	IMAGE_LOCK_MODE_READ           IMAGE_LOCK_MODE = 0x0001
+	IMAGE_LOCK_MODE_WRITE          IMAGE_LOCK_MODE = 0x0002
+	IMAGE_LOCK_MODE_USER_INPUT_BUF IMAGE_LOCK_MODE = 0x0004
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-imagetype
+type IMAGE_TYPE int32
+
+const (
+	IMAGE_TYPE_UNKNOWN  IMAGE_TYPE = 0
+	IMAGE_TYPE_BITMAP   IMAGE_TYPE = 1
+	IMAGE_TYPE_METAFILE IMAGE_TYPE = 2
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-interpolationmode
+type INTERPOLATION_MODE int32
+
+const (
+	INTERPOLATION_MODE_INVALID               INTERPOLATION_MODE = -1
+	INTERPOLATION_MODE_DEFAULT               INTERPOLATION_MODE = 0
+	INTERPOLATION_MODE_LOW_QUALITY           INTERPOLATION_MODE = 1
+	INTERPOLATION_MODE_HIGH_QUALITY          INTERPOLATION_MODE = 2
+	INTERPOLATION_MODE_BILINEAR              INTERPOLATION_MODE = 3
+	INTERPOLATION_MODE_BICUBIC               INTERPOLATION_MODE = 4
+	INTERPOLATION_MODE_NEAREST_NEIGHBOR      INTERPOLATION_MODE = 5
+	INTERPOLATION_MODE_HIGH_QUALITY_BILINEAR INTERPOLATION_MODE = 6
+	INTERPOLATION_MODE_HIGH_QUALITY_BICUBIC  INTERPOLATION_MODE = 7
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-lineargradientmode
+type LINEAR_GRADIENT_MODE int32
+
+const (
+	LINEAR_GRADIENT_MODE_HORIZONTAL        LINEAR_GRADIENT_MODE = 0
+	LINEAR_GRADIENT_MODE_VERTICAL          LINEAR_GRADIENT_MODE = 1
+	LINEAR_GRADIENT_MODE_FORWARD_DIAGONAL  LINEAR_GRADIENT_MODE = 2
+	LINEAR_GRADIENT_MODE_BACKWARD_DIAGONAL LINEAR_GRADIENT_MODE = 3
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-linecap
+type LINE_CAP int32
+
+const (
+	LINE_CAP_FLAT           LINE_CAP = 0
+	LINE_CAP_SQUARE         LINE_CAP = 1
+	LINE_CAP_ROUND          LINE_CAP = 2
+	LINE_CAP_TRIANGLE       LINE_CAP = 3
+	LINE_CAP_NO_ANCHOR      LINE_CAP = 0x0010
+	LINE_CAP_SQUARE_ANCHOR  LINE_CAP = 0x0011
+	LINE_CAP_ROUND_ANCHOR   LINE_CAP = 0x0012
+	LINE_CAP_DIAMOND_ANCHOR LINE_CAP = 0x0013
+	LINE_CAP_ARROW_ANCHOR   LINE_CAP = 0x0014
+	LINE_CAP_CUSTOM         LINE_CAP = 0x00ff
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-linejoin
+type LINE_JOIN int32
+
+const (
+	LINE_JOIN_MITER         LINE_JOIN = 0
+	LINE_JOIN_BEVEL         LINE_JOIN = 1
+	LINE_JOIN_ROUND         LINE_JOIN = 2
+	LINE_JOIN_MITER_CLIPPED LINE_JOIN = 3
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-matrixorder
+type MATRIX_ORDER int32
+
+const (
+	MATRIX_ORDER_PREPEND MATRIX_ORDER = 0
+	MATRIX_ORDER_APPEND  MATRIX_ORDER = 1
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/gdiplus/-gdiplus-constant-image-pixel-format-constants
+type PIXEL_FORMAT int32
+
+const (
+	PIXEL_FORMAT_UNDEFINED       PIXEL_FORMAT = 0
+	PIXEL_FORMAT_1BPP_INDEXED    PIXEL_FORMAT = 0x0003_0101
+	PIXEL_FORMAT_4BPP_INDEXED    PIXEL_FORMAT = 0x0003_0402
+	PIXEL_FORMAT_8BPP_INDEXED    PIXEL_FORMAT = 0x0003_0803
+	PIXEL_FORMAT_16BPP_GRAYSCALE PIXEL_FORMAT = 0x0010_1004
+	PIXEL_FORMAT_16BPP_RGB555    PIXEL_FORMAT = 0x0002_1005
+	PIXEL_FORMAT_16BPP_RGB565    PIXEL_FORMAT = 0x0002_1006
+	PIXEL_FORMAT_16BPP_ARGB1555  PIXEL_FORMAT = 0x0006_1007
+	PIXEL_FORMAT_24BPP_RGB       PIXEL_FORMAT = 0x0002_1808
+	PIXEL_FORMAT_32BPP_RGB       PIXEL_FORMAT = 0x0002_2009
+	PIXEL_FORMAT_32BPP_ARGB      PIXEL_FORMAT = 0x0026_200a
+	PIXEL_FORMAT_32BPP_PARGB     PIXEL_FORMAT = 0x000e_200b
+	PIXEL_FORMAT_48BPP_RGB       PIXEL_FORMAT = 0x0010_300c
+	PIXEL_FORMAT_64BPP_ARGB      PIXEL_FORMAT = 0x0034_400d
+	PIXEL_FORMAT_64BPP_PARGB     PIXEL_FORMAT = 0x001c_400e
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-pixeloffsetmode
+type PIXEL_OFFSET_MODE int32
+
+const (
+	PIXEL_OFFSET_MODE_INVALID      PIXEL_OFFSET_MODE = -1
+	PIXEL_OFFSET_MODE_DEFAULT      PIXEL_OFFSET_MODE = 0
+	PIXEL_OFFSET_MODE_HIGH_SPEED   PIXEL_OFFSET_MODE = 1
+	PIXEL_OFFSET_MODE_HIGH_QUALITY PIXEL_OFFSET_MODE = 2
+	PIXEL_OFFSET_MODE_NONE         PIXEL_OFFSET_MODE = 3
+	PIXEL_OFFSET_MODE_HALF         PIXEL_OFFSET_MODE = 4
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-rotatefliptype
+type ROTATE_FLIP int32
+
+const (
+	ROTATE_FLIP_ROTATE_NONE_FLIP_NONE ROTATE_FLIP = 0
+	ROTATE_FLIP_ROTATE_90_FLIP_NONE   ROTATE_FLIP = 1
+	ROTATE_FLIP_ROTATE_180_FLIP_NONE  ROTATE_FLIP = 2
+	ROTATE_FLIP_ROTATE_270_FLIP_NONE  ROTATE_FLIP = 3
+	ROTATE_FLIP_ROTATE_NONE_FLIP_X    ROTATE_FLIP = 4
+	ROTATE_FLIP_ROTATE_90_FLIP_X      ROTATE_FLIP = 5
+	ROTATE_FLIP_ROTATE_180_FLIP_X     ROTATE_FLIP = 6
+	ROTATE_FLIP_ROTATE_270_FLIP_X     ROTATE_FLIP = 7
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-smoothingmode
+type SMOOTHING_MODE int32
+
+const (
+	SMOOTHING_MODE_INVALID        SMOOTHING_MODE = -1
+	SMOOTHING_MODE_DEFAULT        SMOOTHING_MODE = 0
+	SMOOTHING_MODE_HIGH_SPEED     SMOOTHING_MODE = 1
+	SMOOTHING_MODE_HIGH_QUALITY   SMOOTHING_MODE = 2
+	SMOOTHING_MODE_NONE           SMOOTHING_MODE = 3
+	SMOOTHING_MODE_ANTI_ALIAS     SMOOTHING_MODE = 4
+	SMOOTHING_MODE_ANTI_ALIAS_8X8 SMOOTHING_MODE = 5
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-stringalignment
+type STRING_ALIGNMENT int32
+
+const (
+	STRING_ALIGNMENT_NEAR   STRING_ALIGNMENT = 0
+	STRING_ALIGNMENT_CENTER STRING_ALIGNMENT = 1
+	STRING_ALIGNMENT_FAR    STRING_ALIGNMENT = 2
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-stringformatflags
+type STRING_FORMAT_FLAGS int32
+
+const (
+	STRING_FORMAT_FLAGS_NONE                    STRING_FORMAT_FLAGS = 0
+	STRING_FORMAT_FLAGS_DIRECTION_RIGHT_TO_LEFT STRING_FORMAT_FLAGS = 0x0001
+	STRING_FORMAT_FLAGS_DIRECTION_VERTICAL      STRING_FORMAT_FLAGS = 0x0002
+	STRING_FORMAT_FLAGS_NO_FIT_BLACK_BOX        STRING_FORMAT_FLAGS = 0x0004
+	STRING_FORMAT_FLAGS_DISPLAY_FORMAT_CONTROL  STRING_FORMAT_FLAGS = 0x0020
+	STRING_FORMAT_FLAGS_NO_FONT_FALLBACK        STRING_FORMAT_FLAGS = 0x0400
+	STRING_FORMAT_FLAGS_MEASURE_TRAILING_SPACES STRING_FORMAT_FLAGS = 0x0800
+	STRING_FORMAT_FLAGS_NO_WRAP                 STRING_FORMAT_FLAGS = 0x1000
+	STRING_FORMAT_FLAGS_LINE_LIMIT              STRING_FORMAT_FLAGS = 0x2000
+	STRING_FORMAT_FLAGS_NO_CLIP                 STRING_FORMAT_FLAGS = 0x4000
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-stringtrimming
+type STRING_TRIMMING int32
+
+const (
+	STRING_TRIMMING_NONE               STRING_TRIMMING = 0
+	STRING_TRIMMING_CHARACTER          STRING_TRIMMING = 1
+	STRING_TRIMMING_WORD               STRING_TRIMMING = 2
+	STRING_TRIMMING_ELLIPSIS_CHARACTER STRING_TRIMMING = 3
+	STRING_TRIMMING_ELLIPSIS_WORD      STRING_TRIMMING = 4
+	STRING_TRIMMING_ELLIPSIS_PATH      STRING_TRIMMING = 5
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-textrenderinghint
+type TEXT_RENDERING_HINT int32
+
+const (
+	TEXT_RENDERING_HINT_SYSTEM_DEFAULT                TEXT_RENDERING_HINT = 0
+	TEXT_RENDERING_HINT_SINGLE_BIT_PER_PIXEL_GRID_FIT TEXT_RENDERING_HINT = 1
+	TEXT_RENDERING_HINT_SINGLE_BIT_PER_PIXEL          TEXT_RENDERING_HINT = 2
+	TEXT_RENDERING_HINT_ANTI_ALIAS_GRID_FIT           TEXT_RENDERING_HINT = 3
+	TEXT_RENDERING_HINT_ANTI_ALIAS                    TEXT_RENDERING_HINT = 4
+	TEXT_RENDERING_HINT_CLEAR_TYPE_GRID_FIT           TEXT_RENDERING_HINT = 5
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-unit
+type UNIT int32
+
+const (
+	UNIT_WORLD      UNIT = 0
+	UNIT_DISPLAY    UNIT = 1
+	UNIT_PIXEL      UNIT = 2
+	UNIT_POINT      UNIT = 3
+	UNIT_INCH       UNIT = 4
+	UNIT_DOCUMENT   UNIT = 5
+	UNIT_MILLIMETER UNIT = 6
+)
+
+// 📑 https://docs.microsoft.com/en-us/windows/win32/api/gdiplusenums/ne-gdiplusenums-wrapmode
+type WRAP_MODE int32
+
+const (
+	WRAP_MODE_TILE         WRAP_MODE = 0
+	WRAP_MODE_TILE_FLIP_X  WRAP_MODE = 1
+	WRAP_MODE_TILE_FLIP_Y  WRAP_MODE = 2
+	WRAP_MODE_TILE_FLIP_XY WRAP_MODE = 3
+	WRAP_MODE_CLAMP        WRAP_MODE = 4
+)
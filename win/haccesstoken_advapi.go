@@ -0,0 +1,207 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to an [access token].
+//
+// [access token]: https://learn.microsoft.com/en-us/windows/win32/secauthz/access-tokens
+type HACCESSTOKEN HANDLE
+
+// [AdjustTokenPrivileges] function.
+//
+// If not all privileges could be adjusted, returns errco.NOT_ALL_ASSIGNED.
+//
+// [AdjustTokenPrivileges]: https://learn.microsoft.com/en-us/windows/win32/api/securitybaseapi/nf-securitybaseapi-adjusttokenprivileges
+func (hToken HACCESSTOKEN) AdjustTokenPrivileges(
+	disableAllPrivileges bool, newState []LUID_AND_ATTRIBUTES) error {
+
+	// TOKEN_PRIVILEGES: a uint32 count followed by the LUID_AND_ATTRIBUTES array.
+	buf := make([]uint32, 1+len(newState)*3)
+	buf[0] = uint32(len(newState))
+	copy(unsafe.Slice((*LUID_AND_ATTRIBUTES)(unsafe.Pointer(&buf[1])), len(newState)),
+		newState)
+
+	ret, _, err := syscall.SyscallN(proc.AdjustTokenPrivileges.Addr(),
+		uintptr(hToken), util.BoolToUintptr(disableAllPrivileges),
+		uintptr(unsafe.Pointer(&buf[0])), 0, 0, 0)
+	if wErr := errco.ERROR(err); ret == 0 || wErr == errco.NOT_ALL_ASSIGNED {
+		return wErr // succeeds partially with NOT_ALL_ASSIGNED
+	}
+	return nil
+}
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://docs.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hToken HACCESSTOKEN) CloseHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseHandle.Addr(),
+		uintptr(hToken))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// Enables or disables a single privilege of the token, which must have been
+// opened with co.TOKEN_ADJUST_PRIVILEGES. Calls LookupPrivilegeValue() and
+// HACCESSTOKEN.AdjustTokenPrivileges().
+//
+// Example:
+//
+//	hToken, _ := win.GetCurrentProcess().OpenProcessToken(
+//		co.TOKEN_ADJUST_PRIVILEGES | co.TOKEN_QUERY)
+//	defer hToken.CloseHandle()
+//
+//	if err := hToken.EnablePrivilege(co.SE_PRIV_SHUTDOWN, true); err != nil {
+//		panic(err)
+//	}
+func (hToken HACCESSTOKEN) EnablePrivilege(name co.SE_PRIV, enable bool) error {
+	luid, err := LookupPrivilegeValue(StrOptNone(), name)
+	if err != nil {
+		return err
+	}
+
+	attrs := co.SE_PRIVILEGE_NONE
+	if enable {
+		attrs = co.SE_PRIVILEGE_ENABLED
+	}
+	return hToken.AdjustTokenPrivileges(false,
+		[]LUID_AND_ATTRIBUTES{{Luid: luid, Attributes: attrs}})
+}
+
+// [GetTokenInformation] function.
+//
+// Returns the raw buffer, whose beginning is the struct corresponding to
+// infoClass. This function is rather tricky. Prefer using
+// HACCESSTOKEN.GetTokenElevation(), HACCESSTOKEN.GetTokenElevationType(),
+// HACCESSTOKEN.GetTokenGroups(), HACCESSTOKEN.GetTokenIntegrityLevel(),
+// HACCESSTOKEN.GetTokenPrivileges() or HACCESSTOKEN.GetTokenUser().
+//
+// [GetTokenInformation]: https://learn.microsoft.com/en-us/windows/win32/api/securitybaseapi/nf-securitybaseapi-gettokeninformation
+func (hToken HACCESSTOKEN) GetTokenInformation(
+	infoClass co.TOKEN_INFO) ([]uint64, error) {
+
+	var bytesNeeded uint32
+	syscall.SyscallN(proc.GetTokenInformation.Addr(),
+		uintptr(hToken), uintptr(infoClass), 0, 0,
+		uintptr(unsafe.Pointer(&bytesNeeded)))
+
+	for {
+		buf := make([]uint64, (bytesNeeded+7)/8+1) // aligned to 8 bytes
+		ret, _, err := syscall.SyscallN(proc.GetTokenInformation.Addr(),
+			uintptr(hToken), uintptr(infoClass),
+			uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)*8),
+			uintptr(unsafe.Pointer(&bytesNeeded)))
+
+		if ret == 0 {
+			if wErr := errco.ERROR(err); wErr == errco.INSUFFICIENT_BUFFER {
+				continue // token changed in-between, try again
+			} else {
+				return nil, wErr
+			}
+		}
+		return buf, nil
+	}
+}
+
+// Returns whether the token is elevated. Calls
+// HACCESSTOKEN.GetTokenInformation().
+func (hToken HACCESSTOKEN) GetTokenElevation() (bool, error) {
+	buf, err := hToken.GetTokenInformation(co.TOKEN_INFO_ELEVATION)
+	if err != nil {
+		return false, err
+	}
+	return *(*uint32)(unsafe.Pointer(&buf[0])) != 0, nil // TOKEN_ELEVATION
+}
+
+// Returns the elevation type of the token. Calls
+// HACCESSTOKEN.GetTokenInformation().
+func (hToken HACCESSTOKEN) GetTokenElevationType() (co.TOKEN_ELEVATION_TYPE, error) {
+	buf, err := hToken.GetTokenInformation(co.TOKEN_INFO_ELEVATION_TYPE)
+	if err != nil {
+		return co.TOKEN_ELEVATION_TYPE(0), err
+	}
+	return *(*co.TOKEN_ELEVATION_TYPE)(unsafe.Pointer(&buf[0])), nil
+}
+
+// Returns the groups of the token; the SIDs are copied into Go memory. Calls
+// HACCESSTOKEN.GetTokenInformation().
+func (hToken HACCESSTOKEN) GetTokenGroups() ([]SID_AND_ATTRIBUTES, error) {
+	buf, err := hToken.GetTokenInformation(co.TOKEN_INFO_GROUPS)
+	if err != nil {
+		return nil, err
+	}
+
+	// TOKEN_GROUPS: a uint32 count followed by the SID_AND_ATTRIBUTES array,
+	// whose SIDs point within the buffer itself.
+	count := *(*uint32)(unsafe.Pointer(&buf[0]))
+	pGroups := (*SID_AND_ATTRIBUTES)(unsafe.Add(unsafe.Pointer(&buf[0]),
+		unsafe.Offsetof(struct {
+			c uint32
+			g SID_AND_ATTRIBUTES
+		}{}.g)))
+
+	groups := make([]SID_AND_ATTRIBUTES, 0, count)
+	for _, group := range unsafe.Slice(pGroups, count) {
+		groups = append(groups, SID_AND_ATTRIBUTES{
+			Sid:        group.Sid.Clone(),
+			Attributes: group.Attributes,
+		})
+	}
+	return groups, nil
+}
+
+// Returns the mandatory integrity level of the token. Calls
+// HACCESSTOKEN.GetTokenInformation().
+//
+// Returns errco.INVALID_SID if the label SID has no sub-authorities.
+func (hToken HACCESSTOKEN) GetTokenIntegrityLevel() (co.SECURITY_MANDATORY, error) {
+	buf, err := hToken.GetTokenInformation(co.TOKEN_INFO_INTEGRITY_LEVEL)
+	if err != nil {
+		return co.SECURITY_MANDATORY(0), err
+	}
+
+	label := (*SID_AND_ATTRIBUTES)(unsafe.Pointer(&buf[0])) // TOKEN_MANDATORY_LABEL
+	subAuths := label.Sid.SubAuthorities()
+	if len(subAuths) == 0 {
+		return co.SECURITY_MANDATORY(0), errco.INVALID_SID
+	}
+	return co.SECURITY_MANDATORY(subAuths[len(subAuths)-1]), nil
+}
+
+// Returns the privileges of the token. Calls
+// HACCESSTOKEN.GetTokenInformation().
+//
+// The names can be retrieved with LookupPrivilegeName().
+func (hToken HACCESSTOKEN) GetTokenPrivileges() ([]LUID_AND_ATTRIBUTES, error) {
+	buf, err := hToken.GetTokenInformation(co.TOKEN_INFO_PRIVILEGES)
+	if err != nil {
+		return nil, err
+	}
+
+	// TOKEN_PRIVILEGES: a uint32 count followed by the LUID_AND_ATTRIBUTES array.
+	count := *(*uint32)(unsafe.Pointer(&buf[0]))
+	pPrivs := (*LUID_AND_ATTRIBUTES)(unsafe.Add(unsafe.Pointer(&buf[0]), 4))
+	return append([]LUID_AND_ATTRIBUTES{}, unsafe.Slice(pPrivs, count)...), nil
+}
+
+// Returns the user of the token, copied into Go memory. Calls
+// HACCESSTOKEN.GetTokenInformation().
+func (hToken HACCESSTOKEN) GetTokenUser() (*SID, error) {
+	buf, err := hToken.GetTokenInformation(co.TOKEN_INFO_USER)
+	if err != nil {
+		return nil, err
+	}
+	user := (*SID_AND_ATTRIBUTES)(unsafe.Pointer(&buf[0])) // TOKEN_USER
+	return user.Sid.Clone(), nil
+}
@@ -0,0 +1,90 @@
+//go:build windows
+
+package win
+
+import (
+	"image"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/dib"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// This helper function creates a 32-bit top-down DIB section with the pixels
+// of the image, with premultiplied alpha, ready to be used with AlphaBlend().
+//
+// ⚠️ You must defer HBITMAP.DeleteObject().
+//
+// Example:
+//
+//	fin, _ := os.Open("C:\\Temp\\foo.png")
+//	defer fin.Close()
+//	img, _ := png.Decode(fin)
+//
+//	hBmp := win.CreateBitmapFromImage(img)
+//	defer hBmp.DeleteObject()
+func CreateBitmapFromImage(img image.Image) HBITMAP {
+	bounds := img.Bounds()
+	return _CreateDibSection32(bounds.Dx(), bounds.Dy(), dib.ToBgra(img, true))
+}
+
+// Creates a 32-bit top-down DIB section with the given BGRA rows.
+func _CreateDibSection32(width, height int, pix []byte) HBITMAP {
+	bmi := BITMAPINFO{
+		BmiHeader: BITMAPINFOHEADER{
+			BiWidth:       int32(width),
+			BiHeight:      -int32(height), // negative: top-down
+			BiPlanes:      1,
+			BiBitCount:    32,
+			BiCompression: co.BI_RGB,
+		},
+	}
+	bmi.BmiHeader.SetBiSize()
+
+	hBmp, pBits := HDC(0).CreateDIBSection(&bmi, co.DIB_RGB_COLORS, 0, 0)
+	copy(unsafe.Slice(pBits, len(pix)), pix)
+	return hBmp
+}
+
+// This helper method converts the bitmap into an image, retrieving its pixels
+// with HDC.GetDIBits().
+//
+// 32-bit bitmaps are assumed to have premultiplied alpha, and an *image.RGBA
+// is returned. If no pixel has alpha, as with bitmaps created by GDI drawing
+// functions, the image is opaque.
+//
+// Example:
+//
+//	var hBmp win.HBITMAP // initialized somewhere
+//
+//	fout, _ := os.Create("C:\\Temp\\foo.png")
+//	defer fout.Close()
+//	png.Encode(fout, hBmp.ToImage())
+func (hBmp HBITMAP) ToImage() image.Image {
+	pix, width, height := hBmp.readBgra()
+	return dib.FromBgra(pix, width, height, true)
+}
+
+// Retrieves the pixels as 32-bit top-down BGRA rows.
+func (hBmp HBITMAP) readBgra() (pix []byte, width, height int) {
+	var bm BITMAP
+	hBmp.GetObject(&bm)
+	width, height = int(bm.BmWidth), int(bm.BmHeight)
+
+	bmi := BITMAPINFO{
+		BmiHeader: BITMAPINFOHEADER{
+			BiWidth:       int32(width),
+			BiHeight:      -int32(height), // negative: top-down
+			BiPlanes:      1,
+			BiBitCount:    32,
+			BiCompression: co.BI_RGB,
+		},
+	}
+
+	hdcScreen := HWND(0).GetDC()
+	defer HWND(0).ReleaseDC(hdcScreen)
+
+	pix = make([]byte, width*height*4)
+	hdcScreen.GetDIBits(hBmp, 0, height, pix, &bmi, co.DIB_RGB_COLORS)
+	return pix, width, height
+}
@@ -3,9 +3,141 @@
 package win
 
 import (
+	"encoding/binary"
+	"image"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/cfhtml"
+	"github.com/rodrigocfd/windigo/internal/dib"
+	"github.com/rodrigocfd/windigo/internal/proc"
 	"github.com/rodrigocfd/windigo/win/co"
 )
 
+// Names of the registered clipboard formats used by the helper methods.
+const (
+	_CF_NAME_HTML = "HTML Format"
+	_CF_NAME_RTF  = "Rich Text Format"
+)
+
+// Registers the format with RegisterClipboardFormat(), panicking on error.
+func (HCLIPBOARD) registeredFormat(name string) co.CF {
+	format, err := RegisterClipboardFormat(name)
+	if err != nil {
+		panic(err)
+	}
+	return format
+}
+
+// Calls GetClipboardData() directly, returning false instead of panicking if
+// the data cannot be retrieved, since another process may have changed the
+// clipboard, or failed to render a delayed format.
+func (HCLIPBOARD) clipboardData(format co.CF) (HGLOBAL, bool) {
+	ret, _, _ := syscall.SyscallN(proc.GetClipboardData.Addr(),
+		uintptr(format))
+	return HGLOBAL(ret), ret != 0
+}
+
+// This helper method reads a copy of the raw clipboard data in the given
+// format, which can be a predefined or a registered one. Returns false if the
+// format is not available.
+//
+// Example:
+//
+//	hClip := hWnd.OpenClipboard()
+//	defer hClip.CloseClipboard()
+//
+//	myFormat, _ := win.RegisterClipboardFormat("MyApp Data")
+//	if data, ok := hClip.ReadData(myFormat); ok {
+//		println(len(data))
+//	}
+func (hClip HCLIPBOARD) ReadData(format co.CF) ([]byte, bool) {
+	if !hClip.IsClipboardFormatAvailable(format) {
+		return nil, false
+	}
+
+	hMem, ok := hClip.clipboardData(format)
+	if !ok {
+		return nil, false
+	}
+	src := hMem.GlobalLock(hMem.GlobalSize())
+	defer hMem.GlobalUnlock()
+
+	data := make([]byte, len(src))
+	copy(data, src)
+	return data, true
+}
+
+// This helper method reads the file paths copied with CF_HDROP, like the ones
+// copied in Windows Explorer. Returns false if the format is not available.
+func (hClip HCLIPBOARD) ReadFiles() ([]string, bool) {
+	if !hClip.IsClipboardFormatAvailable(co.CF_HDROP) {
+		return nil, false
+	}
+	hMem, ok := hClip.clipboardData(co.CF_HDROP)
+	if !ok {
+		return nil, false
+	}
+	hDrop := HDROP(hMem) // owned by the clipboard
+	return hDrop.listFiles(), true
+}
+
+// This helper method reads and decodes the CF_HTML format, returning the HTML
+// fragment and the source URL, which may be empty. Returns false if the format
+// is not available or cannot be decoded.
+func (hClip HCLIPBOARD) ReadHtml() (fragment, sourceUrl string, ok bool) {
+	data, ok := hClip.ReadData(hClip.registeredFormat(_CF_NAME_HTML))
+	if !ok {
+		return "", "", false
+	}
+	fragment, sourceUrl, err := cfhtml.Decode(data)
+	if err != nil {
+		return "", "", false
+	}
+	return fragment, sourceUrl, true
+}
+
+// This helper method reads and decodes an image from CF_DIBV5 or CF_DIB, which
+// are synthesized by the system when a CF_BITMAP is copied. Returns false if
+// no image is available or it cannot be decoded.
+func (hClip HCLIPBOARD) ReadImage() (image.Image, bool) {
+	for _, format := range []co.CF{co.CF_DIBV5, co.CF_DIB} {
+		if data, ok := hClip.ReadData(format); ok {
+			if img, err := dib.Decode(data, 0); err == nil {
+				return img, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// This helper method reads the RTF text. Returns false if the format is not
+// available.
+func (hClip HCLIPBOARD) ReadRtf() (string, bool) {
+	data, ok := hClip.ReadData(hClip.registeredFormat(_CF_NAME_RTF))
+	if !ok {
+		return "", false
+	}
+	for i, b := range data {
+		if b == 0 {
+			data = data[:i] // null-terminated
+			break
+		}
+	}
+	return string(data), true
+}
+
+// This helper method reads the CF_UNICODETEXT text. Returns false if the format
+// is not available.
+func (hClip HCLIPBOARD) ReadText() (string, bool) {
+	data, ok := hClip.ReadData(co.CF_UNICODETEXT)
+	if !ok || len(data) < 2 {
+		return "", ok
+	}
+	return Str.FromNativeSlice(
+		unsafe.Slice((*uint16)(unsafe.Pointer(&data[0])), len(data)/2)), true
+}
+
 // This helper method writes a bitmap to the clipboard with
 // HCLIPBOARD.SetClipboardData().
 //
@@ -15,6 +147,58 @@ func (hClip HCLIPBOARD) WriteBitmap(hBmp HBITMAP) {
 	hClip.SetClipboardData(co.CF_BITMAP, HGLOBAL(hBmp))
 }
 
+// This helper method writes raw data in the given format, which can be a
+// predefined or a registered one, with HCLIPBOARD.SetClipboardData().
+func (hClip HCLIPBOARD) WriteData(format co.CF, data []byte) {
+	hMem := GlobalAlloc(co.GMEM_MOVEABLE, len(data))
+	if len(data) > 0 {
+		dest := hMem.GlobalLock(len(data))
+		copy(dest, data)
+		hMem.GlobalUnlock()
+	}
+	hClip.SetClipboardData(format, hMem) // pass pointer ownership
+}
+
+// This helper method writes file paths as CF_HDROP, so they can be pasted in
+// Windows Explorer.
+func (hClip HCLIPBOARD) WriteFiles(paths []string) {
+	const szDropFiles = 20 // DROPFILES struct
+
+	list := Str.ToNativeSliceMulti(paths) // double null-terminated
+	data := make([]byte, szDropFiles+len(list)*2)
+	binary.LittleEndian.PutUint32(data[0:], szDropFiles) // pFiles
+	binary.LittleEndian.PutUint32(data[16:], 1)          // fWide
+	for i, ch := range list {
+		binary.LittleEndian.PutUint16(data[szDropFiles+i*2:], ch)
+	}
+	hClip.WriteData(co.CF_HDROP, data)
+}
+
+// This helper method encodes an HTML fragment as CF_HTML and writes it. The
+// sourceUrl is optional.
+//
+// Usually you also write a plain text version with HCLIPBOARD.WriteString(),
+// for applications which don't support HTML.
+func (hClip HCLIPBOARD) WriteHtml(fragment, sourceUrl string) {
+	hClip.WriteData(hClip.registeredFormat(_CF_NAME_HTML),
+		cfhtml.Encode(fragment, sourceUrl))
+}
+
+// This helper method encodes the image as CF_DIBV5, with alpha channel, and
+// writes it. The system synthesizes CF_DIB and CF_BITMAP for applications
+// which request them.
+func (hClip HCLIPBOARD) WriteImage(img image.Image) {
+	hClip.WriteData(co.CF_DIBV5, dib.Encode(img))
+}
+
+// This helper method writes the RTF text, which must be ASCII, as RTF escapes
+// any other characters.
+func (hClip HCLIPBOARD) WriteRtf(rtf string) {
+	data := make([]byte, len(rtf)+1) // null-terminated
+	copy(data, rtf)
+	hClip.WriteData(hClip.registeredFormat(_CF_NAME_RTF), data)
+}
+
 // This helper method writes a string to the clipboard with
 // HCLIPBOARD.SetClipboardData().
 func (hClip HCLIPBOARD) WriteString(text string) {
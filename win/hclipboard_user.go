@@ -73,6 +73,25 @@ func (HCLIPBOARD) EnumClipboardFormats() []co.CF {
 	}
 }
 
+// [GetClipboardData] function.
+//
+// ⚠️ The returned hMem is owned by the clipboard, do not call HGLOBAL.Free().
+// It is valid only until HCLIPBOARD.CloseClipboard().
+//
+// Panics if the format is not available; check it first with
+// HCLIPBOARD.IsClipboardFormatAvailable(). Unless you're doing something
+// specific, prefer HCLIPBOARD.ReadData() or the other Read helpers.
+//
+// [GetClipboardData]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getclipboarddata
+func (HCLIPBOARD) GetClipboardData(format co.CF) HGLOBAL {
+	ret, _, err := syscall.SyscallN(proc.GetClipboardData.Addr(),
+		uintptr(format))
+	if ret == 0 {
+		panic(errco.ERROR(err))
+	}
+	return HGLOBAL(ret)
+}
+
 // [GetClipboardSequenceNumber] function.
 //
 // [GetClipboardSequenceNumber]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getclipboardsequencenumber
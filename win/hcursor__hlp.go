@@ -0,0 +1,45 @@
+//go:build windows
+
+package win
+
+import (
+	"encoding/binary"
+
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/ico"
+)
+
+// This helper function creates a cursor from the best entry of a .cur file,
+// for the given logical size and DPI, with CreateCursorFromResourceEx(). The
+// hotspot is scaled along with the image.
+//
+// Returns ico.ErrFormat if the file has no entries.
+//
+// ⚠️ You must defer HCURSOR.DestroyCursor().
+func CreateCursorFromCur(curFile *ico.File, size int, dpi int) (HCURSOR, error) {
+	entry := curFile.Best(size, dpi)
+	if entry == nil {
+		return HCURSOR(0), ico.ErrFormat
+	}
+
+	px := _IcoScaledSize(size, dpi)
+	resBits := make([]byte, 4+len(entry.Data))
+	binary.LittleEndian.PutUint16(resBits[0:], _IcoScaledHotspot(entry.HotspotX, entry.Width, px))
+	binary.LittleEndian.PutUint16(resBits[2:], _IcoScaledHotspot(entry.HotspotY, entry.Height, px))
+	copy(resBits[4:], entry.Data)
+
+	return CreateCursorFromResourceEx(resBits, 0x0003_0000, px, px, co.LR_DEFAULTCOLOR)
+}
+
+// Scales the hotspot coordinate from the entry dimension to the size the
+// cursor is stretched to, keeping it inside the image.
+func _IcoScaledHotspot(hotspot, entryDim, px int) uint16 {
+	if entryDim <= 0 || entryDim == px {
+		return uint16(hotspot)
+	}
+	scaled := (hotspot*px + entryDim/2) / entryDim
+	if scaled >= px {
+		scaled = px - 1
+	}
+	return uint16(scaled)
+}
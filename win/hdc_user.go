@@ -13,6 +13,17 @@ import (
 	"github.com/rodrigocfd/windigo/win/errco"
 )
 
+// [DrawFocusRect] function.
+//
+// [DrawFocusRect]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-drawfocusrect
+func (hdc HDC) DrawFocusRect(rc *RECT) {
+	ret, _, err := syscall.SyscallN(proc.DrawFocusRect.Addr(),
+		uintptr(hdc), uintptr(unsafe.Pointer(rc)))
+	if ret == 0 {
+		panic(errco.ERROR(err))
+	}
+}
+
 // [DrawIcon] function.
 //
 // [DrawIcon]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-drawicon
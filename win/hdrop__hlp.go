@@ -5,6 +5,13 @@ package win
 // This helper method retrieves all file names with DragQueryFile() and calls
 // DragFinish().
 func (hDrop HDROP) ListFilesAndFinish() []string {
+	paths := hDrop.listFiles()
+	hDrop.DragFinish()
+	return paths
+}
+
+// Retrieves all file names with DragQueryFile(), sorted.
+func (hDrop HDROP) listFiles() []string {
 	var pathBuf [_MAX_PATH + 1]uint16 // buffer to receive all paths
 	count := hDrop.DragQueryFile(0xffff_ffff, nil, 0)
 	paths := make([]string, 0, count) // paths to be returned
@@ -13,7 +20,6 @@ func (hDrop HDROP) ListFilesAndFinish() []string {
 		hDrop.DragQueryFile(i, &pathBuf[0], uint32(len(pathBuf)))
 		paths = append(paths, Str.FromNativeSlice(pathBuf[:]))
 	}
-	hDrop.DragFinish()
 
 	Path.Sort(paths)
 	return paths
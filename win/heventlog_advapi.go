@@ -0,0 +1,110 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to a registered [event source] of the classic Event Log.
+//
+// [event source]: https://learn.microsoft.com/en-us/windows/win32/eventlog/event-sources
+type HEVENTLOG HANDLE
+
+// [RegisterEventSource] function.
+//
+// The source should have been previously installed with
+// EventLogInstallSource(), otherwise Event Viewer won't be able to format the
+// messages.
+//
+// ⚠️ You must defer HEVENTLOG.DeregisterEventSource().
+//
+// Example:
+//
+//	hLog, _ := win.RegisterEventSource(win.StrOptNone(), "MyService")
+//	defer hLog.DeregisterEventSource()
+//
+//	hLog.ReportEvent(co.EVENTLOG_INFORMATION_TYPE, 0, 1000, nil,
+//		[]string{"Service started."}, nil)
+//
+// [RegisterEventSource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-registereventsourcew
+func RegisterEventSource(
+	uncServerName StrOpt, sourceName string) (HEVENTLOG, error) {
+
+	ret, _, err := syscall.SyscallN(proc.RegisterEventSource.Addr(),
+		uintptr(uncServerName.Raw()),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(sourceName))))
+	if ret == 0 {
+		return HEVENTLOG(0), errco.ERROR(err)
+	}
+	return HEVENTLOG(ret), nil
+}
+
+// [DeregisterEventSource] function.
+//
+// [DeregisterEventSource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-deregistereventsource
+func (hLog HEVENTLOG) DeregisterEventSource() error {
+	ret, _, err := syscall.SyscallN(proc.DeregisterEventSource.Addr(),
+		uintptr(hLog))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [ReportEvent] function.
+//
+// The eventId is the message identifier in the message table of the source,
+// and the strings are its insertion parameters %1, %2, and so on. Both userSid
+// and rawData are optional.
+//
+// [ReportEvent]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-reporteventw
+func (hLog HEVENTLOG) ReportEvent(
+	eventType co.EVENTLOG, category uint16, eventId uint32,
+	userSid *SID, strs []string, rawData []byte) error {
+
+	var pStrs **uint16
+	if len(strs) > 0 {
+		ptrs := make([]*uint16, 0, len(strs))
+		for _, s := range strs {
+			ptrs = append(ptrs, Str.ToNativePtr(s))
+		}
+		pStrs = &ptrs[0]
+	}
+
+	var pData *byte
+	if len(rawData) > 0 {
+		pData = &rawData[0]
+	}
+
+	ret, _, err := syscall.SyscallN(proc.ReportEvent.Addr(),
+		uintptr(hLog), uintptr(eventType), uintptr(category), uintptr(eventId),
+		uintptr(unsafe.Pointer(userSid)), uintptr(len(strs)),
+		uintptr(len(rawData)), uintptr(unsafe.Pointer(pStrs)),
+		uintptr(unsafe.Pointer(pData)))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// Calls HEVENTLOG.ReportEvent() with co.EVENTLOG_ERROR_TYPE and no category.
+func (hLog HEVENTLOG) ReportError(eventId uint32, strs ...string) error {
+	return hLog.ReportEvent(co.EVENTLOG_ERROR_TYPE, 0, eventId, nil, strs, nil)
+}
+
+// Calls HEVENTLOG.ReportEvent() with co.EVENTLOG_INFORMATION_TYPE and no
+// category.
+func (hLog HEVENTLOG) ReportInfo(eventId uint32, strs ...string) error {
+	return hLog.ReportEvent(co.EVENTLOG_INFORMATION_TYPE, 0, eventId, nil, strs, nil)
+}
+
+// Calls HEVENTLOG.ReportEvent() with co.EVENTLOG_WARNING_TYPE and no category.
+func (hLog HEVENTLOG) ReportWarning(eventId uint32, strs ...string) error {
+	return hLog.ReportEvent(co.EVENTLOG_WARNING_TYPE, 0, eventId, nil, strs, nil)
+}
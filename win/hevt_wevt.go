@@ -0,0 +1,212 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to a Windows Event Log [object], like a query result set, an event
+// or a render context.
+//
+// [object]: https://learn.microsoft.com/en-us/windows/win32/wes/windows-event-log-reference
+type HEVT HANDLE
+
+// [EvtQuery] function.
+//
+// The path is a channel name, like "Application" or
+// "Microsoft-Windows-PowerShell/Operational", or a log file path if
+// co.EVT_QUERY_FILE_PATH is passed. The query is an XPath expression or a
+// structured XML query; if none, all events are returned.
+//
+// ⚠️ You must defer HEVT.EvtClose().
+//
+// Example:
+//
+//	hQuery, _ := win.EvtQuery(win.StrOptSome("Application"),
+//		win.StrOptSome("*[System[(Level=2) and Provider[@Name='MyService']]]"),
+//		co.EVT_QUERY_CHANNEL_PATH|co.EVT_QUERY_REVERSE_DIRECTION)
+//	defer hQuery.EvtClose()
+//
+//	for {
+//		hEvents, _ := hQuery.EvtNext(10, win.NumInfInfinite())
+//		if len(hEvents) == 0 {
+//			break
+//		}
+//		for _, hEvent := range hEvents {
+//			sys, _ := hEvent.EvtRenderSystem()
+//			println(sys.EventId, sys.TimeCreated.String())
+//			hEvent.EvtClose()
+//		}
+//	}
+//
+// [EvtQuery]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtquery
+func EvtQuery(path, query StrOpt, flags co.EVT_QUERY) (HEVT, error) {
+	ret, _, err := syscall.SyscallN(proc.EvtQuery.Addr(),
+		0, uintptr(path.Raw()), uintptr(query.Raw()), uintptr(flags))
+	if ret == 0 {
+		return HEVT(0), errco.ERROR(err)
+	}
+	return HEVT(ret), nil
+}
+
+// [EvtCreateRenderContext] function.
+//
+// The valuePaths are XPath expressions of the values to be rendered, and are
+// used only with co.EVT_RENDER_CONTEXT_VALUES.
+//
+// ⚠️ You must defer HEVT.EvtClose().
+//
+// [EvtCreateRenderContext]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtcreaterendercontext
+func EvtCreateRenderContext(
+	valuePaths []string, flags co.EVT_RENDER_CONTEXT) (HEVT, error) {
+
+	var pPaths **uint16
+	if len(valuePaths) > 0 {
+		ptrs := make([]*uint16, 0, len(valuePaths))
+		for _, path := range valuePaths {
+			ptrs = append(ptrs, Str.ToNativePtr(path))
+		}
+		pPaths = &ptrs[0]
+	}
+
+	ret, _, err := syscall.SyscallN(proc.EvtCreateRenderContext.Addr(),
+		uintptr(len(valuePaths)), uintptr(unsafe.Pointer(pPaths)),
+		uintptr(flags))
+	if ret == 0 {
+		return HEVT(0), errco.ERROR(err)
+	}
+	return HEVT(ret), nil
+}
+
+// [EvtClose] function.
+//
+// [EvtClose]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtclose
+func (hEvt HEVT) EvtClose() error {
+	ret, _, err := syscall.SyscallN(proc.EvtClose.Addr(), uintptr(hEvt))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [EvtNext] function.
+//
+// Returns at most maxEvents events; when there are no more events, returns an
+// empty slice. Returns errco.INVALID_PARAMETER if maxEvents is zero.
+//
+// ⚠️ You must call HEVT.EvtClose() on each returned event.
+//
+// [EvtNext]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtnext
+func (hEvt HEVT) EvtNext(maxEvents uint, timeout NumInf) ([]HEVT, error) {
+	if maxEvents == 0 {
+		return nil, errco.INVALID_PARAMETER
+	}
+	hEvents := make([]HEVT, maxEvents)
+	var returned uint32
+
+	ret, _, err := syscall.SyscallN(proc.EvtNext.Addr(),
+		uintptr(hEvt), uintptr(maxEvents), uintptr(unsafe.Pointer(&hEvents[0])),
+		uintptr(timeout.Raw()), 0, uintptr(unsafe.Pointer(&returned)))
+	if ret == 0 {
+		if wErr := errco.ERROR(err); wErr == errco.NO_MORE_ITEMS {
+			return []HEVT{}, nil
+		} else {
+			return nil, wErr
+		}
+	}
+	return hEvents[:returned], nil
+}
+
+// [EvtRender] function.
+//
+// This function is rather tricky. Prefer using HEVT.EvtRenderSystem(),
+// HEVT.EvtRenderValues() or HEVT.EvtRenderXml().
+//
+// Returns the rendered buffer, and the number of properties.
+//
+// [EvtRender]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtrender
+func (hEvt HEVT) EvtRender(
+	hContext HEVT, flags co.EVT_RENDER) (buf []uint64, numProps uint32, e error) {
+
+	var bufUsed uint32
+	buf = make([]uint64, 64) // arbitrary, 8-byte aligned
+
+	for {
+		ret, _, err := syscall.SyscallN(proc.EvtRender.Addr(),
+			uintptr(hContext), uintptr(hEvt), uintptr(flags),
+			uintptr(len(buf)*8), uintptr(unsafe.Pointer(&buf[0])),
+			uintptr(unsafe.Pointer(&bufUsed)), uintptr(unsafe.Pointer(&numProps)))
+		if ret != 0 {
+			return buf, numProps, nil
+		} else if wErr := errco.ERROR(err); wErr != errco.INSUFFICIENT_BUFFER {
+			return nil, 0, wErr
+		}
+		buf = make([]uint64, (bufUsed+7)/8)
+	}
+}
+
+// Renders the System section of the event, using HEVT.EvtRender() with a
+// co.EVT_RENDER_CONTEXT_SYSTEM context.
+func (hEvt HEVT) EvtRenderSystem() (_EvtSystem, error) {
+	hContext, err := EvtCreateRenderContext(nil, co.EVT_RENDER_CONTEXT_SYSTEM)
+	if err != nil {
+		return _EvtSystem{}, err
+	}
+	defer hContext.EvtClose()
+
+	vals, err := hEvt.EvtRenderValues(hContext)
+	if err != nil {
+		return _EvtSystem{}, err
+	}
+
+	var sys _EvtSystem
+	sys.fromValues(vals)
+	return sys, nil
+}
+
+// Renders the values selected by the context, using HEVT.EvtRender() with
+// co.EVT_RENDER_EVENT_VALUES.
+//
+// Each value is decoded into a Go type, like string, uint16, time.Time or
+// *SID; absent values are nil, and arrays are []any.
+//
+// Example:
+//
+//	var hEvent win.HEVT // initialized somewhere
+//
+//	hContext, _ := win.EvtCreateRenderContext(
+//		[]string{"Event/EventData/Data[@Name='param1']"},
+//		co.EVT_RENDER_CONTEXT_VALUES)
+//	defer hContext.EvtClose()
+//
+//	vals, _ := hEvent.EvtRenderValues(hContext)
+//	param1, _ := vals[0].(string)
+func (hEvt HEVT) EvtRenderValues(hContext HEVT) ([]any, error) {
+	buf, numProps, err := hEvt.EvtRender(hContext, co.EVT_RENDER_EVENT_VALUES)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := unsafe.Slice((*_EVT_VARIANT)(unsafe.Pointer(&buf[0])), numProps)
+	vals := make([]any, 0, numProps)
+	for i := range variants {
+		vals = append(vals, variants[i].Value())
+	}
+	return vals, nil
+}
+
+// Renders the whole event as XML, using HEVT.EvtRender() with
+// co.EVT_RENDER_EVENT_XML.
+func (hEvt HEVT) EvtRenderXml() (string, error) {
+	buf, _, err := hEvt.EvtRender(HEVT(0), co.EVT_RENDER_EVENT_XML)
+	if err != nil {
+		return "", err
+	}
+	return Str.FromNativePtr((*uint16)(unsafe.Pointer(&buf[0]))), nil
+}
@@ -0,0 +1,99 @@
+//go:build windows
+
+package win
+
+import (
+	"image"
+
+	"github.com/rodrigocfd/windigo/internal/dib"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/ico"
+)
+
+// This helper function creates an icon from the image, with
+// CreateIconIndirect(). The alpha channel is preserved, and an AND mask is
+// also generated for legacy applications.
+//
+// ⚠️ You must defer HICON.DestroyIcon().
+func CreateIconFromImage(img image.Image) HICON {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	hbmColor := _CreateDibSection32(width, height, dib.ToBgra(img, false))
+	defer hbmColor.DeleteObject()
+
+	andMask := dib.AndMask(img, 2) // CreateBitmap() rows are WORD-aligned
+	hbmMask := CreateBitmap(int32(width), int32(height), 1, 1, &andMask[0])
+	defer hbmMask.DeleteObject()
+
+	ii := ICONINFO{
+		HbmMask:  hbmMask,
+		HbmColor: hbmColor,
+	}
+	ii.SetFIcon(true)
+	return CreateIconIndirect(&ii) // bitmaps are copied
+}
+
+// This helper method converts the icon into an image, with straight alpha.
+//
+// If the icon has no alpha channel, transparency is taken from its AND mask.
+//
+// Example:
+//
+//	var hIcon win.HICON // initialized somewhere
+//
+//	fout, _ := os.Create("C:\\Temp\\foo.png")
+//	defer fout.Close()
+//	png.Encode(fout, hIcon.ToImage())
+func (hIcon HICON) ToImage() image.Image {
+	var ii ICONINFO
+	hIcon.GetIconInfo(&ii)
+	defer ii.HbmMask.DeleteObject()
+
+	if ii.HbmColor == 0 { // monochrome: AND mask on top, XOR mask below
+		maskPix, width, doubleHeight := ii.HbmMask.readBgra()
+		height := doubleHeight / 2
+		andPix, xorPix := maskPix[:width*height*4], maskPix[width*height*4:]
+		dib.ApplyAndMask(xorPix, andPix)
+		return dib.FromBgra(xorPix, width, height, false)
+	}
+
+	defer ii.HbmColor.DeleteObject()
+	pix, width, height := ii.HbmColor.readBgra()
+	if !dib.HasAlpha(pix) {
+		maskPix, _, _ := ii.HbmMask.readBgra()
+		dib.ApplyAndMask(pix, maskPix)
+	}
+	return dib.FromBgra(pix, width, height, false)
+}
+
+// This helper function creates an icon from the best entry of an .ico file,
+// for the given logical size and DPI, with CreateIconFromResourceEx().
+//
+// Returns ico.ErrFormat if the file has no entries.
+//
+// ⚠️ You must defer HICON.DestroyIcon().
+//
+// Example:
+//
+//	fin, _ := os.Open("C:\\Temp\\app.ico")
+//	defer fin.Close()
+//	icoFile, _ := ico.Read(fin)
+//
+//	hIcon, _ := win.CreateIconFromIco(icoFile, 32, 96)
+//	defer hIcon.DestroyIcon()
+func CreateIconFromIco(icoFile *ico.File, size int, dpi int) (HICON, error) {
+	entry := icoFile.Best(size, dpi)
+	if entry == nil {
+		return HICON(0), ico.ErrFormat
+	}
+	px := _IcoScaledSize(size, dpi)
+	return CreateIconFromResourceEx(entry.Data, 0x0003_0000, px, px, co.LR_DEFAULTCOLOR)
+}
+
+func _IcoScaledSize(size, dpi int) int {
+	if dpi == 0 {
+		dpi = 96
+	}
+	return (size*dpi + 48) / 96
+}
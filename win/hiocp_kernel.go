@@ -0,0 +1,95 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to an [I/O completion port].
+//
+// [I/O completion port]: https://learn.microsoft.com/en-us/windows/win32/fileio/i-o-completion-ports
+type HIOCP HANDLE
+
+// [CreateIoCompletionPort] function.
+//
+// Pass zero as fileHandle and existingCompletionPort to create a new port which
+// is not associated with any file, like the ones used with job objects.
+//
+// ⚠️ You must defer HIOCP.CloseHandle().
+//
+// [CreateIoCompletionPort]: https://learn.microsoft.com/en-us/windows/win32/fileio/createiocompletionport
+func CreateIoCompletionPort(
+	fileHandle HANDLE, existingCompletionPort HIOCP,
+	completionKey uintptr, numberOfConcurrentThreads uint32) (HIOCP, error) {
+
+	if fileHandle == 0 {
+		fileHandle = HANDLE(^uintptr(0)) // INVALID_HANDLE_VALUE
+	}
+
+	ret, _, err := syscall.SyscallN(proc.CreateIoCompletionPort.Addr(),
+		uintptr(fileHandle), uintptr(existingCompletionPort),
+		completionKey, uintptr(numberOfConcurrentThreads))
+	if ret == 0 {
+		return HIOCP(0), errco.ERROR(err)
+	}
+	return HIOCP(ret), nil
+}
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://docs.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hPort HIOCP) CloseHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseHandle.Addr(),
+		uintptr(hPort))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [GetQueuedCompletionStatus] function.
+//
+// For job object notifications, numBytes holds a co.JOB_OBJECT_MSG, and
+// overlapped holds the process ID, if any.
+//
+// Example:
+//
+//	var hPort win.HIOCP // associated to a job object
+//
+//	msg, _, pid, _ := hPort.GetQueuedCompletionStatus(win.NumInfInfinite())
+//	if co.JOB_OBJECT_MSG(msg) == co.JOB_OBJECT_MSG_EXIT_PROCESS {
+//		println("Exited:", pid)
+//	}
+//
+// [GetQueuedCompletionStatus]: https://learn.microsoft.com/en-us/windows/win32/api/ioapiset/nf-ioapiset-getqueuedcompletionstatus
+func (hPort HIOCP) GetQueuedCompletionStatus(
+	milliseconds NumInf) (numBytes uint32, completionKey, overlapped uintptr, e error) {
+
+	ret, _, err := syscall.SyscallN(proc.GetQueuedCompletionStatus.Addr(),
+		uintptr(hPort), uintptr(unsafe.Pointer(&numBytes)),
+		uintptr(unsafe.Pointer(&completionKey)),
+		uintptr(unsafe.Pointer(&overlapped)), milliseconds.Raw())
+	if ret == 0 {
+		e = errco.ERROR(err)
+	}
+	return
+}
+
+// [PostQueuedCompletionStatus] function.
+//
+// [PostQueuedCompletionStatus]: https://learn.microsoft.com/en-us/windows/win32/api/ioapiset/nf-ioapiset-postqueuedcompletionstatus
+func (hPort HIOCP) PostQueuedCompletionStatus(
+	numBytes uint32, completionKey, overlapped uintptr) error {
+
+	ret, _, err := syscall.SyscallN(proc.PostQueuedCompletionStatus.Addr(),
+		uintptr(hPort), uintptr(numBytes), completionKey, overlapped)
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
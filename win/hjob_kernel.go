@@ -0,0 +1,264 @@
+//go:build windows
+
+package win
+
+import (
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to a [job object].
+//
+// [job object]: https://learn.microsoft.com/en-us/windows/win32/procthread/job-objects
+type HJOB HANDLE
+
+// [CreateJobObject] function.
+//
+// ⚠️ You must defer HJOB.CloseHandle().
+//
+// Example:
+//
+//	hJob, _ := win.CreateJobObject(nil, win.StrOptNone())
+//	defer hJob.CloseHandle() // all processes in the job are killed
+//
+//	var limits win.JOBOBJECT_EXTENDED_LIMIT_INFORMATION
+//	limits.BasicLimitInformation.LimitFlags = co.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE
+//	hJob.SetInformationJobObject(co.JOBOBJECTINFOCLASS_EXTENDED_LIMIT, &limits)
+//
+// [CreateJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-createjobobjectw
+func CreateJobObject(
+	securityAttributes *SECURITY_ATTRIBUTES, name StrOpt) (HJOB, error) {
+
+	ret, _, err := syscall.SyscallN(proc.CreateJobObject.Addr(),
+		uintptr(unsafe.Pointer(securityAttributes)), uintptr(name.Raw()))
+	if ret == 0 {
+		return HJOB(0), errco.ERROR(err)
+	}
+	return HJOB(ret), nil
+}
+
+// [OpenJobObject] function.
+//
+// ⚠️ You must defer HJOB.CloseHandle().
+//
+// [OpenJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-openjobobjectw
+func OpenJobObject(
+	desiredAccess co.JOB_OBJECT, inheritHandle bool, name string) (HJOB, error) {
+
+	ret, _, err := syscall.SyscallN(proc.OpenJobObject.Addr(),
+		uintptr(desiredAccess), util.BoolToUintptr(inheritHandle),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(name))))
+	if ret == 0 {
+		return HJOB(0), errco.ERROR(err)
+	}
+	return HJOB(ret), nil
+}
+
+// [AssignProcessToJobObject] function.
+//
+// [AssignProcessToJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-assignprocesstojobobject
+func (hJob HJOB) AssignProcessToJobObject(hProcess HPROCESS) error {
+	ret, _, err := syscall.SyscallN(proc.AssignProcessToJobObject.Addr(),
+		uintptr(hJob), uintptr(hProcess))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [CloseHandle] function.
+//
+// If the job has JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE, and this is the last
+// handle to it, all its processes are terminated.
+//
+// [CloseHandle]: https://docs.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hJob HJOB) CloseHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseHandle.Addr(),
+		uintptr(hJob))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [QueryInformationJobObject] function.
+//
+// Example:
+//
+//	var hJob win.HJOB // initialized somewhere
+//
+//	accounting, _ := hJob.QueryInformationJobObject(
+//		co.JOBOBJECTINFOCLASS_BASIC_ACCOUNTING)
+//	numActive := accounting.(win.JOBOBJECT_BASIC_ACCOUNTING_INFORMATION).ActiveProcesses
+//
+//	pids, _ := hJob.QueryInformationJobObject(
+//		co.JOBOBJECTINFOCLASS_BASIC_PROCESS_ID_LIST)
+//	for _, pid := range pids.([]uint32) {
+//		println(pid)
+//	}
+//
+// [QueryInformationJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-queryinformationjobobject
+func (hJob HJOB) QueryInformationJobObject(
+	infoClass co.JOBOBJECTINFOCLASS) (interface{}, error) {
+
+	var accountingBuf JOBOBJECT_BASIC_ACCOUNTING_INFORMATION
+	var basicLimitBuf JOBOBJECT_BASIC_LIMIT_INFORMATION
+	var extendedLimitBuf JOBOBJECT_EXTENDED_LIMIT_INFORMATION
+	var cpuRateBuf JOBOBJECT_CPU_RATE_CONTROL_INFORMATION
+	var ptrRaw unsafe.Pointer
+	var cbSize uintptr
+
+	switch infoClass {
+	case co.JOBOBJECTINFOCLASS_BASIC_ACCOUNTING:
+		ptrRaw = unsafe.Pointer(&accountingBuf)
+		cbSize = unsafe.Sizeof(accountingBuf)
+	case co.JOBOBJECTINFOCLASS_BASIC_LIMIT:
+		ptrRaw = unsafe.Pointer(&basicLimitBuf)
+		cbSize = unsafe.Sizeof(basicLimitBuf)
+	case co.JOBOBJECTINFOCLASS_BASIC_PROCESS_ID_LIST:
+		return hJob.queryProcessIdList()
+	case co.JOBOBJECTINFOCLASS_EXTENDED_LIMIT:
+		ptrRaw = unsafe.Pointer(&extendedLimitBuf)
+		cbSize = unsafe.Sizeof(extendedLimitBuf)
+	case co.JOBOBJECTINFOCLASS_CPU_RATE_CONTROL:
+		ptrRaw = unsafe.Pointer(&cpuRateBuf)
+		cbSize = unsafe.Sizeof(cpuRateBuf)
+	default:
+		panic("Invalid co.JOBOBJECTINFOCLASS value.")
+	}
+	defer runtime.KeepAlive(ptrRaw)
+
+	ret, _, err := syscall.SyscallN(proc.QueryInformationJobObject.Addr(),
+		uintptr(hJob), uintptr(infoClass), uintptr(ptrRaw), cbSize, 0)
+	if ret == 0 {
+		return nil, errco.ERROR(err)
+	}
+
+	switch infoClass {
+	case co.JOBOBJECTINFOCLASS_BASIC_ACCOUNTING:
+		return accountingBuf, nil
+	case co.JOBOBJECTINFOCLASS_BASIC_LIMIT:
+		return basicLimitBuf, nil
+	case co.JOBOBJECTINFOCLASS_EXTENDED_LIMIT:
+		return extendedLimitBuf, nil
+	default: // co.JOBOBJECTINFOCLASS_CPU_RATE_CONTROL
+		return cpuRateBuf, nil
+	}
+}
+
+// Retrieves JOBOBJECT_BASIC_PROCESS_ID_LIST, growing the buffer as needed.
+func (hJob HJOB) queryProcessIdList() ([]uint32, error) {
+	const PTR_SZ = unsafe.Sizeof(uintptr(0)) // in bytes
+
+	const BLOCK int = 64 // arbitrary
+	bufSz := BLOCK
+
+	for {
+		// NumberOfAssignedProcesses (DWORD), NumberOfProcessIdsInList (DWORD),
+		// then ProcessIdList (ULONG_PTR array); 2 DWORDs fit in 1 uintptr on
+		// 64-bit, and in 2 on 32-bit.
+		headerSz := 8 / int(PTR_SZ)
+		buf := make([]uintptr, headerSz+bufSz)
+
+		ret, _, err := syscall.SyscallN(proc.QueryInformationJobObject.Addr(),
+			uintptr(hJob), uintptr(co.JOBOBJECTINFOCLASS_BASIC_PROCESS_ID_LIST),
+			uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf))*PTR_SZ, 0)
+
+		if wErr := errco.ERROR(err); ret == 0 && wErr != errco.MORE_DATA {
+			return nil, wErr
+		} else if ret != 0 {
+			numIds := *(*uint32)(unsafe.Add(unsafe.Pointer(&buf[0]), 4))
+			pids := make([]uint32, 0, numIds)
+			for _, pid := range buf[headerSz : headerSz+int(numIds)] {
+				pids = append(pids, uint32(pid))
+			}
+			return pids, nil
+		}
+
+		bufSz += BLOCK // increase buffer size to try again
+	}
+}
+
+// [SetInformationJobObject] function.
+//
+// The info must be a pointer to the struct which corresponds to the given info
+// class, except for JOBOBJECTINFOCLASS_BASIC_PROCESS_ID_LIST, which cannot be
+// set.
+//
+// Example:
+//
+//	var hJob win.HJOB // initialized somewhere
+//
+//	var limits win.JOBOBJECT_EXTENDED_LIMIT_INFORMATION
+//	limits.BasicLimitInformation.LimitFlags = co.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE |
+//		co.JOB_OBJECT_LIMIT_JOB_MEMORY
+//	limits.JobMemoryLimit = 512 * 1024 * 1024
+//
+//	hJob.SetInformationJobObject(co.JOBOBJECTINFOCLASS_EXTENDED_LIMIT, &limits)
+//
+// [SetInformationJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-setinformationjobobject
+func (hJob HJOB) SetInformationJobObject(
+	infoClass co.JOBOBJECTINFOCLASS, info interface{}) error {
+
+	var ptrRaw unsafe.Pointer
+	var cbSize uintptr
+
+	switch infoClass {
+	case co.JOBOBJECTINFOCLASS_BASIC_LIMIT:
+		if info, ok := info.(*JOBOBJECT_BASIC_LIMIT_INFORMATION); !ok {
+			panic("JOBOBJECTINFOCLASS_BASIC_LIMIT must have a *JOBOBJECT_BASIC_LIMIT_INFORMATION.")
+		} else {
+			ptrRaw = unsafe.Pointer(info)
+			cbSize = unsafe.Sizeof(*info)
+		}
+	case co.JOBOBJECTINFOCLASS_ASSOCIATE_COMPLETION_PORT:
+		if info, ok := info.(*JOBOBJECT_ASSOCIATE_COMPLETION_PORT); !ok {
+			panic("JOBOBJECTINFOCLASS_ASSOCIATE_COMPLETION_PORT must have a *JOBOBJECT_ASSOCIATE_COMPLETION_PORT.")
+		} else {
+			ptrRaw = unsafe.Pointer(info)
+			cbSize = unsafe.Sizeof(*info)
+		}
+	case co.JOBOBJECTINFOCLASS_EXTENDED_LIMIT:
+		if info, ok := info.(*JOBOBJECT_EXTENDED_LIMIT_INFORMATION); !ok {
+			panic("JOBOBJECTINFOCLASS_EXTENDED_LIMIT must have a *JOBOBJECT_EXTENDED_LIMIT_INFORMATION.")
+		} else {
+			ptrRaw = unsafe.Pointer(info)
+			cbSize = unsafe.Sizeof(*info)
+		}
+	case co.JOBOBJECTINFOCLASS_CPU_RATE_CONTROL:
+		if info, ok := info.(*JOBOBJECT_CPU_RATE_CONTROL_INFORMATION); !ok {
+			panic("JOBOBJECTINFOCLASS_CPU_RATE_CONTROL must have a *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION.")
+		} else {
+			ptrRaw = unsafe.Pointer(info)
+			cbSize = unsafe.Sizeof(*info)
+		}
+	default:
+		panic("Invalid co.JOBOBJECTINFOCLASS value.")
+	}
+
+	ret, _, err := syscall.SyscallN(proc.SetInformationJobObject.Addr(),
+		uintptr(hJob), uintptr(infoClass), uintptr(ptrRaw), cbSize)
+	runtime.KeepAlive(ptrRaw)
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [TerminateJobObject] function.
+//
+// [TerminateJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-terminatejobobject
+func (hJob HJOB) TerminateJobObject(exitCode uint32) error {
+	ret, _, err := syscall.SyscallN(proc.TerminateJobObject.Addr(),
+		uintptr(hJob), uintptr(exitCode))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
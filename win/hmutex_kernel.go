@@ -0,0 +1,104 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to a [mutex].
+//
+// [mutex]: https://learn.microsoft.com/en-us/windows/win32/sync/mutex-objects
+type HMUTEX HANDLE
+
+// [CreateMutex] function.
+//
+// If a named mutex already exists, a handle to it is returned, and
+// alreadyExists is true; this is commonly used to detect another running
+// instance of the application.
+//
+// ⚠️ You must defer HMUTEX.CloseHandle().
+//
+// Example:
+//
+//	hMutex, alreadyExists, _ := win.CreateMutex(
+//		nil, false, win.StrOptSome("Local\\MyApp"))
+//	defer hMutex.CloseHandle()
+//
+//	if alreadyExists {
+//		println("Another instance is running.")
+//	}
+//
+// [CreateMutex]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-createmutexw
+func CreateMutex(
+	securityAttributes *SECURITY_ATTRIBUTES,
+	initialOwner bool,
+	name StrOpt) (hMutex HMUTEX, alreadyExists bool, e error) {
+
+	ret, _, err := syscall.SyscallN(proc.CreateMutex.Addr(),
+		uintptr(unsafe.Pointer(securityAttributes)),
+		util.BoolToUintptr(initialOwner), uintptr(name.Raw()))
+	if ret == 0 {
+		return HMUTEX(0), false, errco.ERROR(err)
+	}
+	return HMUTEX(ret), errco.ERROR(err) == errco.ALREADY_EXISTS, nil
+}
+
+// [OpenMutex] function.
+//
+// ⚠️ You must defer HMUTEX.CloseHandle().
+//
+// [OpenMutex]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-openmutexw
+func OpenMutex(
+	desiredAccess co.MUTEX, inheritHandle bool, name string) (HMUTEX, error) {
+
+	ret, _, err := syscall.SyscallN(proc.OpenMutex.Addr(),
+		uintptr(desiredAccess), util.BoolToUintptr(inheritHandle),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(name))))
+	if ret == 0 {
+		return HMUTEX(0), errco.ERROR(err)
+	}
+	return HMUTEX(ret), nil
+}
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://docs.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hMutex HMUTEX) CloseHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseHandle.Addr(),
+		uintptr(hMutex))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [ReleaseMutex] function.
+//
+// [ReleaseMutex]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-releasemutex
+func (hMutex HMUTEX) ReleaseMutex() error {
+	ret, _, err := syscall.SyscallN(proc.ReleaseMutex.Addr(),
+		uintptr(hMutex))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [WaitForSingleObject] function.
+//
+// [WaitForSingleObject]: https://docs.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitforsingleobject
+func (hMutex HMUTEX) WaitForSingleObject(milliseconds NumInf) (co.WAIT, error) {
+	ret, _, err := syscall.SyscallN(proc.WaitForSingleObject.Addr(),
+		uintptr(hMutex), milliseconds.Raw())
+	if co.WAIT(ret) == co.WAIT_FAILED {
+		return co.WAIT_FAILED, errco.ERROR(err)
+	}
+	return co.WAIT(ret), nil
+}
@@ -42,6 +42,24 @@ func CreateNamedPipe(
 	return HPIPE(ret), nil
 }
 
+// [CreatePipe] function.
+//
+// ⚠️ You must defer HPIPE.CloseHandle() on both returned handles.
+//
+// [CreatePipe]: https://learn.microsoft.com/en-us/windows/win32/api/namedpipeapi/nf-namedpipeapi-createpipe
+func CreatePipe(
+	pipeAttributes *SECURITY_ATTRIBUTES,
+	size uint32) (hReadPipe, hWritePipe HPIPE, e error) {
+
+	ret, _, err := syscall.SyscallN(proc.CreatePipe.Addr(),
+		uintptr(unsafe.Pointer(&hReadPipe)), uintptr(unsafe.Pointer(&hWritePipe)),
+		uintptr(unsafe.Pointer(pipeAttributes)), uintptr(size))
+	if ret == 0 {
+		hReadPipe, hWritePipe, e = HPIPE(0), HPIPE(0), errco.ERROR(err)
+	}
+	return
+}
+
 // [ConnectNamedPipe] function.
 //
 // [ConnectNamedPipe]: https://learn.microsoft.com/en-us/windows/win32/api/namedpipeapi/nf-namedpipeapi-connectnamedpipe
@@ -90,3 +108,15 @@ func (hPipe HPIPE) WriteFile(
 
 	return HFILE(hPipe).WriteFile(data, overlapped)
 }
+
+// [SetHandleInformation] function.
+//
+// [SetHandleInformation]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-sethandleinformation
+func (hPipe HPIPE) SetHandleInformation(mask, flags co.HANDLE_FLAG) error {
+	ret, _, err := syscall.SyscallN(proc.SetHandleInformation.Addr(),
+		uintptr(hPipe), uintptr(mask), uintptr(flags))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
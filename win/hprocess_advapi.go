@@ -0,0 +1,37 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [OpenProcessToken] function.
+//
+// ⚠️ You must defer HACCESSTOKEN.CloseHandle().
+//
+// Example:
+//
+//	hToken, _ := win.GetCurrentProcess().OpenProcessToken(co.TOKEN_QUERY)
+//	defer hToken.CloseHandle()
+//
+//	isElevated, _ := hToken.GetTokenElevation()
+//
+// [OpenProcessToken]: https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-openprocesstoken
+func (hProcess HPROCESS) OpenProcessToken(
+	desiredAccess co.TOKEN) (HACCESSTOKEN, error) {
+
+	var hToken HACCESSTOKEN
+	ret, _, err := syscall.SyscallN(proc.OpenProcessToken.Addr(),
+		uintptr(hProcess), uintptr(desiredAccess),
+		uintptr(unsafe.Pointer(&hToken)))
+	if ret == 0 {
+		return HACCESSTOKEN(0), errco.ERROR(err)
+	}
+	return hToken, nil
+}
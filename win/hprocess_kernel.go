@@ -137,6 +137,21 @@ func (hProcess HPROCESS) GetProcessTimes() (
 	return
 }
 
+// [IsProcessInJob] function.
+//
+// Pass zero as hJob to check whether the process runs under any job.
+//
+// [IsProcessInJob]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi/nf-jobapi-isprocessinjob
+func (hProcess HPROCESS) IsProcessInJob(hJob HJOB) (bool, error) {
+	var result int32 // BOOL
+	ret, _, err := syscall.SyscallN(proc.IsProcessInJob.Addr(),
+		uintptr(hProcess), uintptr(hJob), uintptr(unsafe.Pointer(&result)))
+	if ret == 0 {
+		return false, errco.ERROR(err)
+	}
+	return result != 0, nil
+}
+
 // [ReadProcessMemory] function.
 //
 // [ReadProcessMemory]: https://docs.microsoft.com/en-us/windows/win32/api/memoryapi/nf-memoryapi-readprocessmemory
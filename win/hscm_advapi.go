@@ -0,0 +1,117 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to the [Service Control Manager] database.
+//
+// [Service Control Manager]: https://learn.microsoft.com/en-us/windows/win32/services/service-control-manager
+type HSCM HANDLE
+
+// [OpenSCManager] function.
+//
+// ⚠️ You must defer HSCM.CloseServiceHandle().
+//
+// Example:
+//
+//	hScm, _ := win.OpenSCManager(win.StrOptNone(), co.SC_MANAGER_CONNECT)
+//	defer hScm.CloseServiceHandle()
+//
+// [OpenSCManager]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-openscmanagerw
+func OpenSCManager(
+	machineName StrOpt, desiredAccess co.SC_MANAGER) (HSCM, error) {
+
+	ret, _, err := syscall.SyscallN(proc.OpenSCManager.Addr(),
+		uintptr(machineName.Raw()), 0, uintptr(desiredAccess))
+	if ret == 0 {
+		return HSCM(0), errco.ERROR(err)
+	}
+	return HSCM(ret), nil
+}
+
+// [CloseServiceHandle] function.
+//
+// [CloseServiceHandle]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-closeservicehandle
+func (hScm HSCM) CloseServiceHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseServiceHandle.Addr(),
+		uintptr(hScm))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [CreateService] function.
+//
+// If serviceStartName is none, the service runs as LocalSystem.
+//
+// ⚠️ You must defer HSERVICE.CloseServiceHandle().
+//
+// Example:
+//
+//	var hScm win.HSCM // initialized somewhere
+//
+//	hSvc, _ := hScm.CreateService("MySvc", "My Service",
+//		co.SERVICE_ALL_ACCESS, co.SERVICE_TYPE_WIN32_OWN_PROCESS,
+//		co.SERVICE_START_TYPE_AUTO, co.SERVICE_ERROR_NORMAL,
+//		"\"C:\\Program Files\\MySvc\\mysvc.exe\"",
+//		win.StrOptNone(), nil, win.StrOptNone(), win.StrOptNone())
+//	defer hSvc.CloseServiceHandle()
+//
+// [CreateService]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-createservicew
+func (hScm HSCM) CreateService(
+	serviceName, displayName string,
+	desiredAccess co.SERVICE,
+	serviceType co.SERVICE_TYPE,
+	startType co.SERVICE_START_TYPE,
+	errorControl co.SERVICE_ERROR,
+	binaryPathName string,
+	loadOrderGroup StrOpt,
+	dependencies []string,
+	serviceStartName, password StrOpt) (HSERVICE, error) {
+
+	var pDependencies *uint16
+	if len(dependencies) > 0 {
+		pDependencies = Str.ToNativePtrMulti(dependencies)
+	}
+
+	ret, _, err := syscall.SyscallN(proc.CreateService.Addr(),
+		uintptr(hScm),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(serviceName))),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(displayName))),
+		uintptr(desiredAccess), uintptr(serviceType),
+		uintptr(startType), uintptr(errorControl),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(binaryPathName))),
+		uintptr(loadOrderGroup.Raw()), 0,
+		uintptr(unsafe.Pointer(pDependencies)),
+		uintptr(serviceStartName.Raw()), uintptr(password.Raw()))
+	if ret == 0 {
+		return HSERVICE(0), errco.ERROR(err)
+	}
+	return HSERVICE(ret), nil
+}
+
+// [OpenService] function.
+//
+// ⚠️ You must defer HSERVICE.CloseServiceHandle().
+//
+// [OpenService]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-openservicew
+func (hScm HSCM) OpenService(
+	serviceName string, desiredAccess co.SERVICE) (HSERVICE, error) {
+
+	ret, _, err := syscall.SyscallN(proc.OpenService.Addr(),
+		uintptr(hScm), uintptr(unsafe.Pointer(Str.ToNativePtr(serviceName))),
+		uintptr(desiredAccess))
+	if ret == 0 {
+		return HSERVICE(0), errco.ERROR(err)
+	}
+	return HSERVICE(ret), nil
+}
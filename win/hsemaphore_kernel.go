@@ -0,0 +1,97 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to a [semaphore].
+//
+// [semaphore]: https://learn.microsoft.com/en-us/windows/win32/sync/semaphore-objects
+type HSEMAPHORE HANDLE
+
+// [CreateSemaphore] function.
+//
+// If a named semaphore already exists, a handle to it is returned, and
+// alreadyExists is true.
+//
+// ⚠️ You must defer HSEMAPHORE.CloseHandle().
+//
+// [CreateSemaphore]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-createsemaphorew
+func CreateSemaphore(
+	securityAttributes *SECURITY_ATTRIBUTES,
+	initialCount, maximumCount int32,
+	name StrOpt) (hSemaphore HSEMAPHORE, alreadyExists bool, e error) {
+
+	ret, _, err := syscall.SyscallN(proc.CreateSemaphore.Addr(),
+		uintptr(unsafe.Pointer(securityAttributes)),
+		uintptr(initialCount), uintptr(maximumCount), uintptr(name.Raw()))
+	if ret == 0 {
+		return HSEMAPHORE(0), false, errco.ERROR(err)
+	}
+	return HSEMAPHORE(ret), errco.ERROR(err) == errco.ALREADY_EXISTS, nil
+}
+
+// [OpenSemaphore] function.
+//
+// ⚠️ You must defer HSEMAPHORE.CloseHandle().
+//
+// [OpenSemaphore]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-opensemaphorew
+func OpenSemaphore(
+	desiredAccess co.SEMAPHORE,
+	inheritHandle bool, name string) (HSEMAPHORE, error) {
+
+	ret, _, err := syscall.SyscallN(proc.OpenSemaphore.Addr(),
+		uintptr(desiredAccess), util.BoolToUintptr(inheritHandle),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(name))))
+	if ret == 0 {
+		return HSEMAPHORE(0), errco.ERROR(err)
+	}
+	return HSEMAPHORE(ret), nil
+}
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://docs.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hSemaphore HSEMAPHORE) CloseHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseHandle.Addr(),
+		uintptr(hSemaphore))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [ReleaseSemaphore] function.
+//
+// [ReleaseSemaphore]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-releasesemaphore
+func (hSemaphore HSEMAPHORE) ReleaseSemaphore(
+	releaseCount int32) (previousCount int32, e error) {
+
+	ret, _, err := syscall.SyscallN(proc.ReleaseSemaphore.Addr(),
+		uintptr(hSemaphore), uintptr(releaseCount),
+		uintptr(unsafe.Pointer(&previousCount)))
+	if ret == 0 {
+		previousCount, e = 0, errco.ERROR(err)
+	}
+	return
+}
+
+// [WaitForSingleObject] function.
+//
+// [WaitForSingleObject]: https://docs.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitforsingleobject
+func (hSemaphore HSEMAPHORE) WaitForSingleObject(milliseconds NumInf) (co.WAIT, error) {
+	ret, _, err := syscall.SyscallN(proc.WaitForSingleObject.Addr(),
+		uintptr(hSemaphore), milliseconds.Raw())
+	if co.WAIT(ret) == co.WAIT_FAILED {
+		return co.WAIT_FAILED, errco.ERROR(err)
+	}
+	return co.WAIT(ret), nil
+}
@@ -0,0 +1,375 @@
+//go:build windows
+
+package win
+
+import (
+	"runtime"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to a [service].
+//
+// [service]: https://learn.microsoft.com/en-us/windows/win32/services/services
+type HSERVICE HANDLE
+
+// [ChangeServiceConfig] function.
+//
+// Pass the NOCHANGE constants and StrOptNone() for the parameters which must
+// not be changed. A nil dependencies also means no change; to remove all
+// dependencies, pass an empty, non-nil slice.
+//
+// [ChangeServiceConfig]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-changeserviceconfigw
+func (hService HSERVICE) ChangeServiceConfig(
+	serviceType co.SERVICE_TYPE,
+	startType co.SERVICE_START_TYPE,
+	errorControl co.SERVICE_ERROR,
+	binaryPathName, loadOrderGroup StrOpt,
+	dependencies []string,
+	serviceStartName, password, displayName StrOpt) error {
+
+	var pDependencies *uint16
+	if dependencies != nil {
+		pDependencies = Str.ToNativePtrMulti(dependencies)
+	}
+
+	ret, _, err := syscall.SyscallN(proc.ChangeServiceConfig.Addr(),
+		uintptr(hService), uintptr(serviceType),
+		uintptr(startType), uintptr(errorControl),
+		uintptr(binaryPathName.Raw()), uintptr(loadOrderGroup.Raw()), 0,
+		uintptr(unsafe.Pointer(pDependencies)),
+		uintptr(serviceStartName.Raw()), uintptr(password.Raw()),
+		uintptr(displayName.Raw()))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [ChangeServiceConfig2] function.
+//
+// This function is rather tricky. Prefer using HSERVICE.SetDelayedAutoStart(),
+// HSERVICE.SetDescription() or HSERVICE.SetFailureActions().
+//
+// [ChangeServiceConfig2]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-changeserviceconfig2w
+func (hService HSERVICE) ChangeServiceConfig2(
+	infoLevel co.SERVICE_CONFIG, info unsafe.Pointer) error {
+
+	ret, _, err := syscall.SyscallN(proc.ChangeServiceConfig2.Addr(),
+		uintptr(hService), uintptr(infoLevel), uintptr(info))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [CloseServiceHandle] function.
+//
+// [CloseServiceHandle]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-closeservicehandle
+func (hService HSERVICE) CloseServiceHandle() error {
+	return HSCM(hService).CloseServiceHandle()
+}
+
+// [ControlService] function.
+//
+// [ControlService]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-controlservice
+func (hService HSERVICE) ControlService(
+	control co.SERVICE_CONTROL) (SERVICE_STATUS, error) {
+
+	var status SERVICE_STATUS
+	ret, _, err := syscall.SyscallN(proc.ControlService.Addr(),
+		uintptr(hService), uintptr(control), uintptr(unsafe.Pointer(&status)))
+	if ret == 0 {
+		return status, errco.ERROR(err) // status is filled even on some errors
+	}
+	return status, nil
+}
+
+// [DeleteService] function.
+//
+// The service is actually deleted only after all its handles are closed and
+// it is stopped.
+//
+// [DeleteService]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-deleteservice
+func (hService HSERVICE) DeleteService() error {
+	ret, _, err := syscall.SyscallN(proc.DeleteService.Addr(),
+		uintptr(hService))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// Returned by HSERVICE.QueryServiceConfig().
+type _ServiceConfig struct {
+	ServiceType      co.SERVICE_TYPE
+	StartType        co.SERVICE_START_TYPE
+	ErrorControl     co.SERVICE_ERROR
+	BinaryPathName   string
+	LoadOrderGroup   string
+	TagId            uint32
+	Dependencies     []string
+	ServiceStartName string
+	DisplayName      string
+}
+
+// [QueryServiceConfig] function.
+//
+// [QueryServiceConfig]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-queryserviceconfigw
+func (hService HSERVICE) QueryServiceConfig() (_ServiceConfig, error) {
+	var bytesNeeded uint32
+	syscall.SyscallN(proc.QueryServiceConfig.Addr(),
+		uintptr(hService), 0, 0, uintptr(unsafe.Pointer(&bytesNeeded)))
+
+	for {
+		buf := make([]uint64, (bytesNeeded+7)/8+1) // aligned to 8 bytes
+		ret, _, err := syscall.SyscallN(proc.QueryServiceConfig.Addr(),
+			uintptr(hService), uintptr(unsafe.Pointer(&buf[0])),
+			uintptr(len(buf)*8), uintptr(unsafe.Pointer(&bytesNeeded)))
+
+		if ret == 0 {
+			if wErr := errco.ERROR(err); wErr == errco.INSUFFICIENT_BUFFER {
+				continue // config changed in-between, try again
+			} else {
+				return _ServiceConfig{}, wErr
+			}
+		}
+
+		qsc := (*QUERY_SERVICE_CONFIG)(unsafe.Pointer(&buf[0]))
+		info := _ServiceConfig{
+			ServiceType:      qsc.DwServiceType,
+			StartType:        qsc.DwStartType,
+			ErrorControl:     qsc.DwErrorControl,
+			BinaryPathName:   Str.FromNativePtr(qsc.LpBinaryPathName),
+			LoadOrderGroup:   Str.FromNativePtr(qsc.LpLoadOrderGroup),
+			TagId:            qsc.DwTagId,
+			Dependencies:     Str.FromNativePtrMulti(qsc.LpDependencies),
+			ServiceStartName: Str.FromNativePtr(qsc.LpServiceStartName),
+			DisplayName:      Str.FromNativePtr(qsc.LpDisplayName),
+		}
+		runtime.KeepAlive(buf)
+		return info, nil
+	}
+}
+
+// [QueryServiceConfig2] function.
+//
+// Returns the raw buffer, whose beginning is the struct corresponding to
+// infoLevel. This function is rather tricky. Prefer using
+// HSERVICE.QueryDelayedAutoStart(), HSERVICE.QueryDescription() or
+// HSERVICE.QueryFailureActions().
+//
+// [QueryServiceConfig2]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-queryserviceconfig2w
+func (hService HSERVICE) QueryServiceConfig2(
+	infoLevel co.SERVICE_CONFIG) ([]uint64, error) {
+
+	var bytesNeeded uint32
+	syscall.SyscallN(proc.QueryServiceConfig2.Addr(),
+		uintptr(hService), uintptr(infoLevel), 0, 0,
+		uintptr(unsafe.Pointer(&bytesNeeded)))
+
+	for {
+		buf := make([]uint64, (bytesNeeded+7)/8+1) // aligned to 8 bytes
+		ret, _, err := syscall.SyscallN(proc.QueryServiceConfig2.Addr(),
+			uintptr(hService), uintptr(infoLevel),
+			uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)*8),
+			uintptr(unsafe.Pointer(&bytesNeeded)))
+
+		if ret == 0 {
+			if wErr := errco.ERROR(err); wErr == errco.INSUFFICIENT_BUFFER {
+				continue // config changed in-between, try again
+			} else {
+				return nil, wErr
+			}
+		}
+		return buf, nil
+	}
+}
+
+// Returns whether an auto-start service is started after the other auto-start
+// services, with a short delay. Calls HSERVICE.QueryServiceConfig2().
+func (hService HSERVICE) QueryDelayedAutoStart() (bool, error) {
+	buf, err := hService.QueryServiceConfig2(co.SERVICE_CONFIG_DELAYED_AUTO_START_INFO)
+	if err != nil {
+		return false, err
+	}
+	return (*SERVICE_DELAYED_AUTO_START_INFO)(unsafe.Pointer(&buf[0])).FDelayedAutostart(), nil
+}
+
+// Returns the description of the service. Calls
+// HSERVICE.QueryServiceConfig2().
+func (hService HSERVICE) QueryDescription() (string, error) {
+	buf, err := hService.QueryServiceConfig2(co.SERVICE_CONFIG_DESCRIPTION)
+	if err != nil {
+		return "", err
+	}
+	desc := Str.FromNativePtr((*SERVICE_DESCRIPTION)(unsafe.Pointer(&buf[0])).LpDescription)
+	runtime.KeepAlive(buf)
+	return desc, nil
+}
+
+// Returned by HSERVICE.QueryFailureActions().
+type _ServiceFailureActions struct {
+	ResetPeriod time.Duration // Zero if the failure count is never reset.
+	RebootMsg   string
+	Command     string
+	Actions     []SC_ACTION
+}
+
+// Returns the actions taken by the Service Control Manager when the service
+// fails. Calls HSERVICE.QueryServiceConfig2().
+func (hService HSERVICE) QueryFailureActions() (_ServiceFailureActions, error) {
+	buf, err := hService.QueryServiceConfig2(co.SERVICE_CONFIG_FAILURE_ACTIONS)
+	if err != nil {
+		return _ServiceFailureActions{}, err
+	}
+
+	sfa := (*SERVICE_FAILURE_ACTIONS)(unsafe.Pointer(&buf[0]))
+	info := _ServiceFailureActions{
+		RebootMsg: Str.FromNativePtr(sfa.LpRebootMsg),
+		Command:   Str.FromNativePtr(sfa.LpCommand),
+		Actions:   append([]SC_ACTION{}, sfa.LpsaActions()...), // copy out of buf
+	}
+	if sfa.DwResetPeriod != 0xffff_ffff { // INFINITE
+		info.ResetPeriod = time.Duration(sfa.DwResetPeriod) * time.Second
+	}
+	runtime.KeepAlive(buf)
+	return info, nil
+}
+
+// [QueryServiceStatusEx] function.
+//
+// [QueryServiceStatusEx]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-queryservicestatusex
+func (hService HSERVICE) QueryServiceStatusEx() (SERVICE_STATUS_PROCESS, error) {
+	var ssp SERVICE_STATUS_PROCESS
+	var bytesNeeded uint32
+	ret, _, err := syscall.SyscallN(proc.QueryServiceStatusEx.Addr(),
+		uintptr(hService), 0, // SC_STATUS_PROCESS_INFO
+		uintptr(unsafe.Pointer(&ssp)), unsafe.Sizeof(ssp),
+		uintptr(unsafe.Pointer(&bytesNeeded)))
+	if ret == 0 {
+		return SERVICE_STATUS_PROCESS{}, errco.ERROR(err)
+	}
+	return ssp, nil
+}
+
+// Sets whether an auto-start service is started after the other auto-start
+// services, with a short delay. Calls HSERVICE.ChangeServiceConfig2().
+func (hService HSERVICE) SetDelayedAutoStart(delayed bool) error {
+	var dasi SERVICE_DELAYED_AUTO_START_INFO
+	dasi.SetFDelayedAutostart(delayed)
+	return hService.ChangeServiceConfig2(
+		co.SERVICE_CONFIG_DELAYED_AUTO_START_INFO, unsafe.Pointer(&dasi))
+}
+
+// Sets the description of the service. Calls HSERVICE.ChangeServiceConfig2().
+func (hService HSERVICE) SetDescription(description string) error {
+	sd := SERVICE_DESCRIPTION{
+		LpDescription: Str.ToNativePtr(description),
+	}
+	return hService.ChangeServiceConfig2(
+		co.SERVICE_CONFIG_DESCRIPTION, unsafe.Pointer(&sd))
+}
+
+// Sets the actions taken by the Service Control Manager when the service
+// fails, also known as recovery actions. Calls HSERVICE.ChangeServiceConfig2().
+//
+// The failure count is reset after resetPeriod without failures; zero means
+// it is never reset. If onNonCrashFailures is true, the actions are also taken
+// when the service stops with a non-zero exit code.
+//
+// Note that the service must have SERVICE_START access to use
+// co.SC_ACTION_RESTART, and the caller must have the shutdown privilege to use
+// co.SC_ACTION_REBOOT.
+//
+// Example:
+//
+//	var hSvc win.HSERVICE // initialized somewhere
+//
+//	hSvc.SetFailureActions(24*time.Hour, win.StrOptNone(), win.StrOptNone(),
+//		[]win.SC_ACTION{
+//			{Type: co.SC_ACTION_RESTART, Delay: 5000},
+//			{Type: co.SC_ACTION_RESTART, Delay: 30000},
+//			{Type: co.SC_ACTION_NONE},
+//		}, true)
+func (hService HSERVICE) SetFailureActions(
+	resetPeriod time.Duration,
+	rebootMsg, command StrOpt,
+	actions []SC_ACTION,
+	onNonCrashFailures bool) error {
+
+	var sfa SERVICE_FAILURE_ACTIONS
+	sfa.DwResetPeriod = 0xffff_ffff // INFINITE
+	if resetPeriod != 0 {
+		sfa.DwResetPeriod = uint32(resetPeriod / time.Second)
+	}
+	sfa.LpRebootMsg = (*uint16)(rebootMsg.Raw())
+	sfa.LpCommand = (*uint16)(command.Raw())
+	sfa.SetLpsaActions(actions)
+
+	if err := hService.ChangeServiceConfig2(
+		co.SERVICE_CONFIG_FAILURE_ACTIONS, unsafe.Pointer(&sfa)); err != nil {
+		return err
+	}
+
+	var faf SERVICE_FAILURE_ACTIONS_FLAG
+	faf.SetFFailureActionsOnNonCrashFailures(onNonCrashFailures)
+	return hService.ChangeServiceConfig2(
+		co.SERVICE_CONFIG_FAILURE_ACTIONS_FLAG, unsafe.Pointer(&faf))
+}
+
+// [StartService] function.
+//
+// [StartService]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-startservicew
+func (hService HSERVICE) StartService(args ...string) error {
+	pArgs := make([]*uint16, 0, len(args))
+	for _, arg := range args {
+		pArgs = append(pArgs, Str.ToNativePtr(arg))
+	}
+
+	var ppArgs **uint16
+	if len(pArgs) > 0 {
+		ppArgs = &pArgs[0]
+	}
+
+	ret, _, err := syscall.SyscallN(proc.StartService.Addr(),
+		uintptr(hService), uintptr(len(pArgs)), uintptr(unsafe.Pointer(ppArgs)))
+	runtime.KeepAlive(pArgs)
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// Sends a stop control to the service, and waits until it reaches the stopped
+// state, or the timeout elapses. Calls HSERVICE.ControlService() and
+// HSERVICE.QueryServiceStatusEx().
+//
+// Returns errco.SERVICE_REQUEST_TIMEOUT if the service did not stop in time.
+func (hService HSERVICE) Stop(timeout time.Duration) error {
+	status, err := hService.ControlService(co.SERVICE_CONTROL_STOP)
+	if err != nil && err != errco.SERVICE_NOT_ACTIVE {
+		return err
+	}
+	if status.DwCurrentState == co.SERVICE_STATE_STOPPED || err == errco.SERVICE_NOT_ACTIVE {
+		return nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(250 * time.Millisecond)
+
+		ssp, err := hService.QueryServiceStatusEx()
+		if err != nil {
+			return err
+		} else if ssp.DwCurrentState == co.SERVICE_STATE_STOPPED {
+			return nil
+		}
+	}
+	return errco.SERVICE_REQUEST_TIMEOUT
+}
@@ -0,0 +1,75 @@
+//go:build windows
+
+package win
+
+import (
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to the [status] of a service, used to report it to the Service
+// Control Manager. It's not closed.
+//
+// [status]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-registerservicectrlhandlerexw
+type HSERVICESTATUS HANDLE
+
+// [RegisterServiceCtrlHandlerEx] function.
+//
+// The callback is called by the Service Control Manager dispatcher, in its
+// own thread, and must return quickly. It receives the control code, the
+// event type and a pointer to the event data, valid only during the call.
+//
+// Prefer using ServiceRun(), which calls this function.
+//
+// [RegisterServiceCtrlHandlerEx]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-registerservicectrlhandlerexw
+func RegisterServiceCtrlHandlerEx(
+	serviceName string,
+	callback func(control, eventType uint32, eventData uintptr) errco.ERROR,
+) (HSERVICESTATUS, error) {
+
+	pPack := &_ServiceHandlerPack{f: callback}
+	_globalServiceHandlerMutex.Lock()
+	if _globalServiceHandlerFuncs == nil { // the set was not initialized yet?
+		_globalServiceHandlerFuncs = make(map[*_ServiceHandlerPack]struct{}, 1)
+	}
+	_globalServiceHandlerFuncs[pPack] = struct{}{} // store pointer in the set, never removed
+	_globalServiceHandlerMutex.Unlock()
+
+	ret, _, err := syscall.SyscallN(proc.RegisterServiceCtrlHandlerEx.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(serviceName))),
+		_globalServiceHandlerCallback, uintptr(unsafe.Pointer(pPack)))
+	if ret == 0 {
+		return HSERVICESTATUS(0), errco.ERROR(err)
+	}
+	return HSERVICESTATUS(ret), nil
+}
+
+type _ServiceHandlerPack struct {
+	f func(control, eventType uint32, eventData uintptr) errco.ERROR
+}
+
+var (
+	_globalServiceHandlerFuncs    map[*_ServiceHandlerPack]struct{}
+	_globalServiceHandlerMutex    = sync.Mutex{}
+	_globalServiceHandlerCallback = syscall.NewCallback(
+		func(control, eventType uint32, eventData, context uintptr) uintptr {
+			pPack := (*_ServiceHandlerPack)(unsafe.Pointer(context))
+			return uintptr(pPack.f(control, eventType, eventData))
+		})
+)
+
+// [SetServiceStatus] function.
+//
+// [SetServiceStatus]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/nf-winsvc-setservicestatus
+func (hStatus HSERVICESTATUS) SetServiceStatus(status *SERVICE_STATUS) error {
+	ret, _, err := syscall.SyscallN(proc.SetServiceStatus.Addr(),
+		uintptr(hStatus), uintptr(unsafe.Pointer(status)))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
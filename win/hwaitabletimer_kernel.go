@@ -0,0 +1,121 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A handle to a [waitable timer].
+//
+// [waitable timer]: https://learn.microsoft.com/en-us/windows/win32/sync/waitable-timer-objects
+type HWAITABLETIMER HANDLE
+
+// [CreateWaitableTimer] function.
+//
+// If a named timer already exists, a handle to it is returned, and
+// alreadyExists is true.
+//
+// ⚠️ You must defer HWAITABLETIMER.CloseHandle().
+//
+// [CreateWaitableTimer]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-createwaitabletimerw
+func CreateWaitableTimer(
+	securityAttributes *SECURITY_ATTRIBUTES,
+	manualReset bool,
+	name StrOpt) (hTimer HWAITABLETIMER, alreadyExists bool, e error) {
+
+	ret, _, err := syscall.SyscallN(proc.CreateWaitableTimer.Addr(),
+		uintptr(unsafe.Pointer(securityAttributes)),
+		util.BoolToUintptr(manualReset), uintptr(name.Raw()))
+	if ret == 0 {
+		return HWAITABLETIMER(0), false, errco.ERROR(err)
+	}
+	return HWAITABLETIMER(ret), errco.ERROR(err) == errco.ALREADY_EXISTS, nil
+}
+
+// [OpenWaitableTimer] function.
+//
+// ⚠️ You must defer HWAITABLETIMER.CloseHandle().
+//
+// [OpenWaitableTimer]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-openwaitabletimerw
+func OpenWaitableTimer(
+	desiredAccess co.TIMER,
+	inheritHandle bool, name string) (HWAITABLETIMER, error) {
+
+	ret, _, err := syscall.SyscallN(proc.OpenWaitableTimer.Addr(),
+		uintptr(desiredAccess), util.BoolToUintptr(inheritHandle),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(name))))
+	if ret == 0 {
+		return HWAITABLETIMER(0), errco.ERROR(err)
+	}
+	return HWAITABLETIMER(ret), nil
+}
+
+// [CancelWaitableTimer] function.
+//
+// [CancelWaitableTimer]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-cancelwaitabletimer
+func (hTimer HWAITABLETIMER) CancelWaitableTimer() error {
+	ret, _, err := syscall.SyscallN(proc.CancelWaitableTimer.Addr(),
+		uintptr(hTimer))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://docs.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hTimer HWAITABLETIMER) CloseHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseHandle.Addr(),
+		uintptr(hTimer))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [SetWaitableTimer] function.
+//
+// The timer is signaled after the given delay, relative to now, and then
+// periodically, if period is not zero. No completion routine is used.
+//
+// Example:
+//
+//	var hTimer win.HWAITABLETIMER // initialized somewhere
+//
+//	hTimer.SetWaitableTimer(2*time.Second, 500*time.Millisecond, false)
+//
+// [SetWaitableTimer]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-setwaitabletimer
+func (hTimer HWAITABLETIMER) SetWaitableTimer(
+	dueTime, period time.Duration, resume bool) error {
+
+	dueTime100ns := -util.DurationToNano100(dueTime) // negative means relative
+	ret, _, err := syscall.SyscallN(proc.SetWaitableTimer.Addr(),
+		uintptr(hTimer), uintptr(unsafe.Pointer(&dueTime100ns)),
+		uintptr(int32(period/time.Millisecond)), 0, 0,
+		util.BoolToUintptr(resume))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [WaitForSingleObject] function.
+//
+// [WaitForSingleObject]: https://docs.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitforsingleobject
+func (hTimer HWAITABLETIMER) WaitForSingleObject(milliseconds NumInf) (co.WAIT, error) {
+	ret, _, err := syscall.SyscallN(proc.WaitForSingleObject.Addr(),
+		uintptr(hTimer), milliseconds.Raw())
+	if co.WAIT(ret) == co.WAIT_FAILED {
+		return co.WAIT_FAILED, errco.ERROR(err)
+	}
+	return co.WAIT(ret), nil
+}
@@ -50,6 +50,14 @@ func FindWindow(className ClassName, title StrOpt) (HWND, bool) {
 	return HWND(ret), ret != 0
 }
 
+// [GetCapture] function.
+//
+// [GetCapture]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getcapture
+func GetCapture() HWND {
+	ret, _, _ := syscall.SyscallN(proc.GetCapture.Addr())
+	return HWND(ret)
+}
+
 // [GetClipboardOwner] function.
 //
 // [GetClipboardOwner]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getclipboardowner
@@ -104,6 +112,23 @@ func GetShellWindow() HWND {
 	return HWND(ret)
 }
 
+// [AddClipboardFormatListener] function.
+//
+// The window will receive WM_CLIPBOARDUPDATE messages whenever the contents of
+// the clipboard change.
+//
+// ⚠️ You must defer HWND.RemoveClipboardFormatListener().
+//
+// [AddClipboardFormatListener]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-addclipboardformatlistener
+func (hWnd HWND) AddClipboardFormatListener() error {
+	ret, _, err := syscall.SyscallN(proc.AddClipboardFormatListener.Addr(),
+		uintptr(hWnd))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
 // [BeginPaint] function.
 //
 // ⚠️ You must defer HWND.EndPaint().
@@ -343,6 +368,17 @@ func (hWnd HWND) GetDC() HDC {
 	return HDC(ret)
 }
 
+// [GetDpiForWindow] function.
+//
+// Available in Windows 10, version 1607.
+//
+// [GetDpiForWindow]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getdpiforwindow
+func (hWnd HWND) GetDpiForWindow() uint32 {
+	ret, _, _ := syscall.SyscallN(proc.GetDpiForWindow.Addr(),
+		uintptr(hWnd))
+	return uint32(ret)
+}
+
 // [GetDlgCtrlID] function.
 //
 // [GetDlgCtrlID]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getdlgctrlid
@@ -423,6 +459,17 @@ func (hWnd HWND) GetParent() HWND {
 	return HWND(ret)
 }
 
+// [GetProp] function.
+//
+// Returns zero if the property does not exist.
+//
+// [GetProp]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getpropw
+func (hWnd HWND) GetProp(name string) HANDLE {
+	ret, _, _ := syscall.SyscallN(proc.GetProp.Addr(),
+		uintptr(hWnd), uintptr(unsafe.Pointer(Str.ToNativePtr(name))))
+	return HANDLE(ret)
+}
+
 // [GetScrollInfo] function.
 //
 // [GetScrollInfo]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getscrollinfo
@@ -821,6 +868,30 @@ func (hWnd HWND) RealGetWindowClass() string {
 	return Str.FromNativeSlice(buf[:])
 }
 
+// [RemoveClipboardFormatListener] function.
+//
+// [RemoveClipboardFormatListener]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-removeclipboardformatlistener
+func (hWnd HWND) RemoveClipboardFormatListener() error {
+	ret, _, err := syscall.SyscallN(proc.RemoveClipboardFormatListener.Addr(),
+		uintptr(hWnd))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [RemoveProp] function.
+//
+// Returns the data associated with the removed property, or zero if it did
+// not exist.
+//
+// [RemoveProp]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-removepropw
+func (hWnd HWND) RemoveProp(name string) HANDLE {
+	ret, _, _ := syscall.SyscallN(proc.RemoveProp.Addr(),
+		uintptr(hWnd), uintptr(unsafe.Pointer(Str.ToNativePtr(name))))
+	return HANDLE(ret)
+}
+
 // [ReleaseDC] function.
 //
 // [ReleaseDC]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-releasedc
@@ -887,6 +958,19 @@ func (hWnd HWND) SendMessageTimeout(
 	}
 }
 
+// [SetCapture] function.
+//
+// Returns the window which previously had the mouse capture, if any.
+//
+// ⚠️ You must call ReleaseCapture() when the capture is no longer needed.
+//
+// [SetCapture]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setcapture
+func (hWnd HWND) SetCapture() HWND {
+	ret, _, _ := syscall.SyscallN(proc.SetCapture.Addr(),
+		uintptr(hWnd))
+	return HWND(ret)
+}
+
 // [SetFocus] function.
 //
 // Returns a handle to the previously focused window.
@@ -939,6 +1023,21 @@ func (hWnd HWND) SetMenu(hMenu HMENU) {
 	}
 }
 
+// [SetProp] function.
+//
+// ⚠️ You must call HWND.RemoveProp() before the window is destroyed.
+//
+// [SetProp]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setpropw
+func (hWnd HWND) SetProp(name string, data HANDLE) error {
+	ret, _, err := syscall.SyscallN(proc.SetProp.Addr(),
+		uintptr(hWnd), uintptr(unsafe.Pointer(Str.ToNativePtr(name))),
+		uintptr(data))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
 // [SetScrollInfo] function.
 //
 // Returns the current position of the scroll box.
@@ -1124,6 +1223,19 @@ func (hWnd HWND) TranslateAccelerator(hAccel HACCEL, msg *MSG) error {
 	return nil
 }
 
+// [ValidateRect] function.
+//
+// If rc is nil, the whole client area is validated.
+//
+// [ValidateRect]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-validaterect
+func (hWnd HWND) ValidateRect(rc *RECT) {
+	ret, _, err := syscall.SyscallN(proc.ValidateRect.Addr(),
+		uintptr(hWnd), uintptr(unsafe.Pointer(rc)))
+	if ret == 0 {
+		panic(errco.ERROR(err))
+	}
+}
+
 // [UpdateWindow] function.
 //
 // [UpdateWindow]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-updatewindow
@@ -0,0 +1,333 @@
+// Package ico implements a codec for .ico and .cur files, with both BMP and
+// PNG-compressed entries. It's also registered with image.RegisterFormat, so
+// importing this package allows image.Decode() to read the largest image of
+// an .ico file.
+//
+// This package has no Windows dependencies, so it can be used on any OS. To
+// create an HICON or HCURSOR, see win.CreateIconFromIco() and
+// win.CreateCursorFromCur().
+//
+// Example:
+//
+//	fin, _ := os.Open("C:\\Temp\\foo.ico")
+//	defer fin.Close()
+//	icoFile, _ := ico.Read(fin)
+//
+//	entry := icoFile.Best(32, 144) // 32x32 icon at 150% scaling
+//	img, _ := entry.Image()
+package ico
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+
+	"github.com/rodrigocfd/windigo/internal/dib"
+)
+
+const (
+	_DIR_SIZE       = 6  // ICONDIR struct
+	_DIR_ENTRY_SIZE = 16 // ICONDIRENTRY struct
+
+	_TYPE_ICON   = 1
+	_TYPE_CURSOR = 2
+)
+
+// The 8-byte signature of PNG files.
+var _PNG_SIGNATURE = []byte("\x89PNG\r\n\x1a\n")
+
+// Returned when the data is not a valid .ico or .cur file.
+var ErrFormat = errors.New("ico: invalid format")
+
+func init() {
+	image.RegisterFormat("ico", "\x00\x00\x01\x00", Decode, DecodeConfig)
+	image.RegisterFormat("cur", "\x00\x00\x02\x00", Decode, DecodeConfig)
+}
+
+// An .ico or .cur file, with one or more images.
+type File struct {
+	IsCursor bool    // Tells whether this is a .cur file.
+	Entries  []Entry // All images of the file.
+}
+
+// A single image of an .ico or .cur file.
+type Entry struct {
+	Width    int // Width in pixels.
+	Height   int // Height in pixels.
+	BitCount int // Bits per pixel.
+	HotspotX int // Horizontal hotspot; cursors only.
+	HotspotY int // Vertical hotspot; cursors only.
+
+	// The image data: either a PNG file, or a DIB with a BITMAPINFOHEADER
+	// of twice the height, followed by the AND mask. This is also the format
+	// expected by CreateIconFromResourceEx().
+	Data []byte
+}
+
+// Reads an .ico or .cur file.
+func Read(r io.Reader) (*File, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < _DIR_SIZE {
+		return nil, ErrFormat
+	}
+
+	le := binary.LittleEndian
+	fileType := le.Uint16(data[2:])
+	count := int(le.Uint16(data[4:]))
+	if le.Uint16(data[0:]) != 0 ||
+		(fileType != _TYPE_ICON && fileType != _TYPE_CURSOR) ||
+		count == 0 || len(data) < _DIR_SIZE+count*_DIR_ENTRY_SIZE {
+		return nil, ErrFormat
+	}
+
+	f := &File{
+		IsCursor: fileType == _TYPE_CURSOR,
+		Entries:  make([]Entry, 0, count),
+	}
+
+	for i := 0; i < count; i++ {
+		dirEntry := data[_DIR_SIZE+i*_DIR_ENTRY_SIZE:]
+		size := int(le.Uint32(dirEntry[8:]))
+		offset := int(le.Uint32(dirEntry[12:]))
+		if offset < 0 || size <= 0 || offset > len(data) || size > len(data)-offset {
+			return nil, ErrFormat
+		}
+
+		entry := Entry{
+			Width:    dirSize(dirEntry[0]),
+			Height:   dirSize(dirEntry[1]),
+			BitCount: int(le.Uint16(dirEntry[6:])),
+			Data:     data[offset : offset+size : offset+size],
+		}
+		if f.IsCursor {
+			entry.HotspotX = int(le.Uint16(dirEntry[4:]))
+			entry.HotspotY = int(le.Uint16(dirEntry[6:]))
+			entry.BitCount = 0
+		}
+		entry.readDimensions()
+		f.Entries = append(f.Entries, entry)
+	}
+	return f, nil
+}
+
+// In ICONDIRENTRY, zero means 256 pixels.
+func dirSize(b byte) int {
+	if b == 0 {
+		return 256
+	}
+	return int(b)
+}
+
+// Updates the dimensions and bit count with the actual values of the image
+// data, since the directory values can be absent or wrong.
+func (e *Entry) readDimensions() {
+	be, le := binary.BigEndian, binary.LittleEndian
+	if e.IsPng() {
+		if len(e.Data) >= 26 { // IHDR chunk comes first
+			e.Width = int(be.Uint32(e.Data[16:]))
+			e.Height = int(be.Uint32(e.Data[20:]))
+			if colorType := e.Data[25]; colorType == 6 { // truecolor with alpha
+				e.BitCount = int(e.Data[24]) * 4
+			} else if e.BitCount == 0 {
+				e.BitCount = 32
+			}
+		}
+	} else if len(e.Data) >= 16 && le.Uint32(e.Data) >= 40 {
+		e.Width = int(int32(le.Uint32(e.Data[4:])))
+		e.Height = int(int32(le.Uint32(e.Data[8:]))) / 2
+		e.BitCount = int(le.Uint16(e.Data[14:]))
+	}
+}
+
+// Tells whether the entry image is PNG-compressed; otherwise it's a DIB.
+func (e *Entry) IsPng() bool {
+	return bytes.HasPrefix(e.Data, _PNG_SIGNATURE)
+}
+
+// Decodes the entry image.
+func (e *Entry) Image() (image.Image, error) {
+	if e.IsPng() {
+		return png.Decode(bytes.NewReader(e.Data))
+	}
+	return dib.DecodeIcon(e.Data)
+}
+
+// Returns the best entry for the given logical size and DPI: the smallest one
+// which is at least size*dpi/96 pixels, preferring higher bit counts; or the
+// largest one, if none is big enough. A zero dpi is taken as 96.
+//
+// Returns nil if the file has no entries.
+func (f *File) Best(size, dpi int) *Entry {
+	if dpi == 0 {
+		dpi = 96
+	}
+	target := (size*dpi + 48) / 96
+
+	var best *Entry
+	for i := range f.Entries {
+		e := &f.Entries[i]
+		if best == nil {
+			best = e
+			continue
+		}
+
+		eBig, bestBig := e.dim() >= target, best.dim() >= target
+		switch {
+		case eBig && !bestBig:
+			best = e
+		case !eBig && bestBig:
+			// keep best
+		case eBig && bestBig && e.dim() != best.dim():
+			if e.dim() < best.dim() { // smallest of the big enough ones
+				best = e
+			}
+		case !eBig && !bestBig && e.dim() != best.dim():
+			if e.dim() > best.dim() { // largest of the small ones
+				best = e
+			}
+		case e.BitCount > best.BitCount: // same size
+			best = e
+		}
+	}
+	return best
+}
+
+func (e *Entry) dim() int {
+	if e.Width > e.Height {
+		return e.Width
+	}
+	return e.Height
+}
+
+// Writes the .ico or .cur file.
+func (f *File) Write(w io.Writer) error {
+	if len(f.Entries) == 0 || len(f.Entries) > 0xffff {
+		return errors.New("ico: invalid number of entries")
+	}
+
+	le := binary.LittleEndian
+	hdr := make([]byte, _DIR_SIZE+len(f.Entries)*_DIR_ENTRY_SIZE)
+	if f.IsCursor {
+		le.PutUint16(hdr[2:], _TYPE_CURSOR)
+	} else {
+		le.PutUint16(hdr[2:], _TYPE_ICON)
+	}
+	le.PutUint16(hdr[4:], uint16(len(f.Entries)))
+
+	offset := len(hdr)
+	for i := range f.Entries {
+		e := &f.Entries[i]
+		if e.Width <= 0 || e.Width > 256 || e.Height <= 0 || e.Height > 256 {
+			return errors.New("ico: entry dimensions must be between 1 and 256")
+		}
+
+		dirEntry := hdr[_DIR_SIZE+i*_DIR_ENTRY_SIZE:]
+		dirEntry[0] = byte(e.Width)  // 256 becomes zero
+		dirEntry[1] = byte(e.Height) // 256 becomes zero
+		if f.IsCursor {
+			le.PutUint16(dirEntry[4:], uint16(e.HotspotX))
+			le.PutUint16(dirEntry[6:], uint16(e.HotspotY))
+		} else {
+			le.PutUint16(dirEntry[4:], 1) // planes
+			le.PutUint16(dirEntry[6:], uint16(e.BitCount))
+		}
+		le.PutUint32(dirEntry[8:], uint32(len(e.Data)))
+		le.PutUint32(dirEntry[12:], uint32(offset))
+		offset += len(e.Data)
+	}
+
+	if _, err := w.Write(hdr); err != nil {
+		return err
+	}
+	for i := range f.Entries {
+		if _, err := w.Write(f.Entries[i].Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Creates a 32-bit entry from the image, which must be at most 256x256 pixels.
+// Images of 256 pixels are PNG-compressed, the smaller ones are stored as DIBs,
+// as expected by older programs.
+func NewEntry(img image.Image) (Entry, error) {
+	bounds := img.Bounds()
+	e := Entry{
+		Width:    bounds.Dx(),
+		Height:   bounds.Dy(),
+		BitCount: 32,
+	}
+	if e.Width <= 0 || e.Width > 256 || e.Height <= 0 || e.Height > 256 {
+		return Entry{}, errors.New("ico: image dimensions must be between 1 and 256")
+	}
+
+	if e.Width == 256 || e.Height == 256 {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return Entry{}, err
+		}
+		e.Data = buf.Bytes()
+	} else {
+		e.Data = dib.EncodeIcon(img)
+	}
+	return e, nil
+}
+
+// Encodes the images into an .ico file, one entry for each image. The usual
+// sizes are 16, 24, 32, 48 and 256 pixels.
+//
+// Example:
+//
+//	var img16, img32, img256 image.Image // initialized somewhere
+//
+//	fout, _ := os.Create("C:\\Temp\\app.ico")
+//	defer fout.Close()
+//	ico.Encode(fout, img16, img32, img256)
+func Encode(w io.Writer, imgs ...image.Image) error {
+	f := File{Entries: make([]Entry, 0, len(imgs))}
+	for _, img := range imgs {
+		e, err := NewEntry(img)
+		if err != nil {
+			return err
+		}
+		f.Entries = append(f.Entries, e)
+	}
+	return f.Write(w)
+}
+
+// Decodes the largest image of an .ico or .cur file.
+func Decode(r io.Reader) (image.Image, error) {
+	f, err := Read(r)
+	if err != nil {
+		return nil, err
+	}
+	return f.Best(1<<16, 96).Image()
+}
+
+// Returns the dimensions and color model of the largest image of an .ico or
+// .cur file.
+func DecodeConfig(r io.Reader) (image.Config, error) {
+	f, err := Read(r)
+	if err != nil {
+		return image.Config{}, err
+	}
+	e := f.Best(1<<16, 96)
+	if e.IsPng() {
+		return png.DecodeConfig(bytes.NewReader(e.Data))
+	}
+	img, err := e.Image()
+	if err != nil {
+		return image.Config{}, err
+	}
+	return image.Config{
+		ColorModel: img.ColorModel(),
+		Width:      img.Bounds().Dx(),
+		Height:     img.Bounds().Dy(),
+	}, nil
+}
@@ -0,0 +1,253 @@
+package ico
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// A square image with a gradient, whose first pixel is fully transparent.
+func testImage(size int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 50, A: 255})
+		}
+	}
+	img.SetNRGBA(0, 0, color.NRGBA{})
+	img.SetNRGBA(1, 0, color.NRGBA{R: 10, G: 20, B: 30, A: 128})
+	return img
+}
+
+func sameImage(t *testing.T, got image.Image, want *image.NRGBA) {
+	t.Helper()
+	if got.Bounds() != want.Bounds() {
+		t.Fatalf("bounds: got %v, want %v", got.Bounds(), want.Bounds())
+	}
+	for y := 0; y < want.Bounds().Dy(); y++ {
+		for x := 0; x < want.Bounds().Dx(); x++ {
+			g := color.NRGBAModel.Convert(got.At(x, y)).(color.NRGBA)
+			if w := want.NRGBAAt(x, y); g != w {
+				t.Fatalf("pixel %d,%d: got %v, want %v", x, y, g, w)
+			}
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	img16, img256 := testImage(16), testImage(256)
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, img16, img256); err != nil {
+		t.Fatal(err)
+	}
+	f, err := Read(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.IsCursor || len(f.Entries) != 2 {
+		t.Fatalf("got cursor %v with %d entries", f.IsCursor, len(f.Entries))
+	}
+
+	for i, want := range []struct {
+		size  int
+		isPng bool
+		img   *image.NRGBA
+	}{
+		{16, false, img16},
+		{256, true, img256},
+	} {
+		e := &f.Entries[i]
+		if e.Width != want.size || e.Height != want.size || e.BitCount != 32 {
+			t.Errorf("entry %d: got %dx%d %d-bit", i, e.Width, e.Height, e.BitCount)
+		}
+		if e.IsPng() != want.isPng {
+			t.Errorf("entry %d: IsPng got %v", i, e.IsPng())
+		}
+		decoded, err := e.Image()
+		if err != nil {
+			t.Fatalf("entry %d: %v", i, err)
+		}
+		sameImage(t, decoded, want.img)
+	}
+
+	var again bytes.Buffer
+	if err := f.Write(&again); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(again.Bytes(), buf.Bytes()) {
+		t.Error("writing the read file changed its contents")
+	}
+}
+
+func TestImageDecode(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, testImage(16), testImage(32)); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "ico" || cfg.Width != 32 || cfg.Height != 32 {
+		t.Errorf("DecodeConfig: got %q %dx%d", format, cfg.Width, cfg.Height)
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "ico" {
+		t.Errorf("Decode: got format %q", format)
+	}
+	sameImage(t, img, testImage(32)) // the largest one
+}
+
+func TestCursor(t *testing.T) {
+	dibEntry, err := NewEntry(testImage(32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dibEntry.HotspotX, dibEntry.HotspotY = 3, 30
+	pngEntry, err := NewEntry(testImage(256))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pngEntry.HotspotX, pngEntry.HotspotY = 255, 0
+
+	var buf bytes.Buffer
+	if err := (&File{IsCursor: true, Entries: []Entry{dibEntry, pngEntry}}).Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if typ := binary.LittleEndian.Uint16(buf.Bytes()[2:]); typ != _TYPE_CURSOR {
+		t.Errorf("file type: got %d, want %d", typ, _TYPE_CURSOR)
+	}
+
+	f, err := Read(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.IsCursor || len(f.Entries) != 2 {
+		t.Fatalf("got cursor %v with %d entries", f.IsCursor, len(f.Entries))
+	}
+	for i, want := range [][2]int{{3, 30}, {255, 0}} {
+		e := &f.Entries[i]
+		if e.HotspotX != want[0] || e.HotspotY != want[1] {
+			t.Errorf("entry %d: hotspot got %d,%d, want %d,%d",
+				i, e.HotspotX, e.HotspotY, want[0], want[1])
+		}
+		if e.BitCount != 32 { // not stored in the directory, read from the image
+			t.Errorf("entry %d: BitCount got %d, want 32", i, e.BitCount)
+		}
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "cur" || cfg.Width != 256 {
+		t.Errorf("DecodeConfig: got %q %dx%d", format, cfg.Width, cfg.Height)
+	}
+}
+
+func TestBest(t *testing.T) {
+	f := &File{Entries: []Entry{
+		{Width: 16, Height: 16, BitCount: 32},
+		{Width: 32, Height: 32, BitCount: 8},
+		{Width: 32, Height: 32, BitCount: 32},
+		{Width: 32, Height: 32, BitCount: 4},
+		{Width: 48, Height: 48, BitCount: 32},
+		{Width: 256, Height: 256, BitCount: 32},
+	}}
+
+	tests := []struct {
+		size, dpi int
+		want      int // index in f.Entries
+	}{
+		{16, 96, 0},
+		{16, 0, 0},    // zero DPI is 96
+		{8, 96, 0},    // smallest is big enough
+		{24, 96, 2},   // next size up, highest bit count
+		{32, 96, 2},   // exact size, highest bit count
+		{16, 192, 2},  // 200% scaling needs 32 pixels
+		{32, 120, 4},  // 125% scaling needs 40 pixels
+		{32, 144, 4},  // 150% scaling needs 48 pixels
+		{64, 96, 5},   // only 256 is big enough
+		{512, 96, 5},  // none is big enough, largest one
+		{300, 144, 5}, // none is big enough, largest one
+	}
+	for _, tt := range tests {
+		if got := f.Best(tt.size, tt.dpi); got != &f.Entries[tt.want] {
+			t.Errorf("Best(%d, %d): got %+v, want %+v", tt.size, tt.dpi, *got, f.Entries[tt.want])
+		}
+	}
+
+	small := &File{Entries: []Entry{
+		{Width: 16, Height: 16, BitCount: 32},
+		{Width: 24, Height: 24, BitCount: 8},
+		{Width: 24, Height: 24, BitCount: 32},
+	}}
+	if got := small.Best(48, 96); got != &small.Entries[2] {
+		t.Errorf("largest of the small ones: got %+v", *got)
+	}
+
+	if got := (&File{}).Best(32, 96); got != nil {
+		t.Errorf("empty file: got %+v, want nil", *got)
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, testImage(16)); err != nil {
+		t.Fatal(err)
+	}
+	valid := buf.Bytes()
+
+	corrupt := func(edit func(data []byte)) []byte {
+		data := append([]byte{}, valid...)
+		edit(data)
+		return data
+	}
+	le := binary.LittleEndian
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"short header", valid[:_DIR_SIZE-1]},
+		{"reserved", corrupt(func(d []byte) { d[0] = 1 })},
+		{"type", corrupt(func(d []byte) { le.PutUint16(d[2:], 3) })},
+		{"no entries", corrupt(func(d []byte) { le.PutUint16(d[4:], 0) })},
+		{"truncated directory", valid[:_DIR_SIZE+_DIR_ENTRY_SIZE-1]},
+		{"zero size", corrupt(func(d []byte) { le.PutUint32(d[_DIR_SIZE+8:], 0) })},
+		{"size past end", corrupt(func(d []byte) { le.PutUint32(d[_DIR_SIZE+8:], uint32(len(d))) })},
+		{"offset past end", corrupt(func(d []byte) { le.PutUint32(d[_DIR_SIZE+12:], uint32(len(d)+1)) })},
+		{"truncated data", valid[:len(valid)-1]},
+	}
+	for _, tt := range tests {
+		if _, err := Read(bytes.NewReader(tt.data)); !errors.Is(err, ErrFormat) {
+			t.Errorf("%s: got %v, want ErrFormat", tt.name, err)
+		}
+	}
+}
+
+func TestWriteInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (&File{}).Write(&buf); err == nil {
+		t.Error("no entries: expected error")
+	}
+	for _, size := range []int{0, 257} {
+		f := &File{Entries: []Entry{{Width: size, Height: 16, Data: []byte{0}}}}
+		if err := f.Write(&buf); err == nil {
+			t.Errorf("width %d: expected error", size)
+		}
+	}
+	if _, err := NewEntry(image.NewNRGBA(image.Rect(0, 0, 257, 16))); err == nil {
+		t.Error("NewEntry 257 pixels: expected error")
+	}
+}
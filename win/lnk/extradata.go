@@ -0,0 +1,251 @@
+package lnk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ExtraData block signatures.
+const (
+	_SIG_ENVIRONMENT      = 0xa000_0001
+	_SIG_TRACKER          = 0xa000_0003
+	_SIG_SPECIAL_FOLDER   = 0xa000_0005
+	_SIG_DARWIN           = 0xa000_0006
+	_SIG_ICON_ENVIRONMENT = 0xa000_0007
+	_SIG_PROPERTY_STORE   = 0xa000_0009
+	_SIG_KNOWN_FOLDER     = 0xa000_000b
+)
+
+// Size of the EnvironmentVariableDataBlock and IconEnvironmentDataBlock.
+const _ENVIRONMENT_BLOCK_SIZE = 0x314
+
+// An ExtraData block not parsed by this package, like ConsoleDataBlock or
+// DarwinDataBlock.
+type ExtraBlock struct {
+	Signature uint32 // BlockSignature, like 0xa0000002 for ConsoleDataBlock.
+	Data      []byte // Block contents, after BlockSize and BlockSignature.
+}
+
+// The KnownFolderDataBlock of a .lnk file.
+type KnownFolder struct {
+	Id     string // KNOWNFOLDERID, like "905e63b6-c1bf-494e-b29c-65b732d3d21a".
+	Offset uint32 // Offset of the folder item within the IDList.
+}
+
+// The SpecialFolderDataBlock of a .lnk file.
+type SpecialFolder struct {
+	Id     uint32 // CSIDL of the folder.
+	Offset uint32 // Offset of the folder item within the IDList.
+}
+
+// The TrackerDataBlock of a .lnk file, used by the Distributed Link Tracking
+// service to find the target if it's moved.
+type Tracker struct {
+	MachineId  string    // NetBIOS name of the machine where the target was last known.
+	Droid      [2]string // Volume and object GUIDs of the target.
+	DroidBirth [2]string // Volume and object GUIDs of the target when it was created.
+}
+
+// Reads the ExtraData blocks, until the TerminalBlock.
+func (f *File) readExtraData(data []byte) error {
+	le := binary.LittleEndian
+	for len(data) >= 4 {
+		size := le.Uint32(data)
+		if size < 4 {
+			break // TerminalBlock
+		}
+		if size < 8 || size > uint32(len(data)) {
+			return ErrFormat
+		}
+		sig := le.Uint32(data[4:])
+		block := data[8:size:size]
+		data = data[size:]
+
+		var err error
+		switch sig {
+		case _SIG_ENVIRONMENT:
+			f.Environment, err = readEnvironmentBlock(block)
+		case _SIG_ICON_ENVIRONMENT:
+			f.IconEnvironment, err = readEnvironmentBlock(block)
+		case _SIG_KNOWN_FOLDER:
+			if len(block) < 20 {
+				return ErrFormat
+			}
+			f.KnownFolder = &KnownFolder{
+				Id:     guidFromBytes(block),
+				Offset: le.Uint32(block[16:]),
+			}
+		case _SIG_SPECIAL_FOLDER:
+			if len(block) < 8 {
+				return ErrFormat
+			}
+			f.SpecialFolder = &SpecialFolder{
+				Id:     le.Uint32(block),
+				Offset: le.Uint32(block[4:]),
+			}
+		case _SIG_TRACKER:
+			if len(block) < 0x58 {
+				return ErrFormat
+			}
+			f.Tracker = &Tracker{
+				MachineId:  decodeAnsi(block[8:24]),
+				Droid:      [2]string{guidFromBytes(block[24:]), guidFromBytes(block[40:])},
+				DroidBirth: [2]string{guidFromBytes(block[56:]), guidFromBytes(block[72:])},
+			}
+		case _SIG_PROPERTY_STORE:
+			f.Properties, err = readPropertyStore(block)
+		default:
+			f.OtherBlocks = append(f.OtherBlocks, ExtraBlock{Signature: sig, Data: block})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reads an EnvironmentVariableDataBlock or IconEnvironmentDataBlock, preferring
+// the Unicode string.
+func readEnvironmentBlock(block []byte) (string, error) {
+	if len(block) < _ENVIRONMENT_BLOCK_SIZE-8 {
+		return "", ErrFormat
+	}
+	if str := decodeUtf16(block[260:780]); str != "" {
+		return str, nil
+	}
+	return decodeAnsi(block[:260]), nil
+}
+
+// Writes the ExtraData blocks, followed by the TerminalBlock.
+func (f *File) writeExtraData(buf *bytes.Buffer) error {
+	le := binary.LittleEndian
+	writeBlock := func(sig uint32, block []byte) {
+		buf.Write(le.AppendUint32(nil, uint32(len(block)+8)))
+		buf.Write(le.AppendUint32(nil, sig))
+		buf.Write(block)
+	}
+
+	for _, env := range [...]struct {
+		sig uint32
+		str string
+	}{
+		{_SIG_ENVIRONMENT, f.Environment},
+		{_SIG_ICON_ENVIRONMENT, f.IconEnvironment},
+	} {
+		if env.str != "" {
+			block, err := environmentBlock(env.str)
+			if err != nil {
+				return err
+			}
+			writeBlock(env.sig, block)
+		}
+	}
+
+	if f.KnownFolder != nil {
+		block, err := guidToBytes(f.KnownFolder.Id)
+		if err != nil {
+			return err
+		}
+		writeBlock(_SIG_KNOWN_FOLDER, le.AppendUint32(block, f.KnownFolder.Offset))
+	}
+
+	if f.SpecialFolder != nil {
+		block := le.AppendUint32(nil, f.SpecialFolder.Id)
+		writeBlock(_SIG_SPECIAL_FOLDER, le.AppendUint32(block, f.SpecialFolder.Offset))
+	}
+
+	if len(f.Properties) > 0 {
+		block, err := propertyStoreBytes(f.Properties)
+		if err != nil {
+			return err
+		}
+		writeBlock(_SIG_PROPERTY_STORE, block)
+	}
+
+	if f.Tracker != nil {
+		block, err := f.Tracker.bytes()
+		if err != nil {
+			return err
+		}
+		writeBlock(_SIG_TRACKER, block)
+	}
+
+	for i := range f.OtherBlocks {
+		writeBlock(f.OtherBlocks[i].Signature, f.OtherBlocks[i].Data)
+	}
+
+	buf.Write([]byte{0, 0, 0, 0}) // TerminalBlock
+	return nil
+}
+
+// Serializes an EnvironmentVariableDataBlock or IconEnvironmentDataBlock,
+// without BlockSize and BlockSignature.
+func environmentBlock(str string) ([]byte, error) {
+	ansi, unicode := encodeAnsiz(str), encodeUtf16z(str)
+	if len(ansi) > 260 || len(unicode) > 520 {
+		return nil, errors.New("lnk: environment string is too long")
+	}
+	block := make([]byte, _ENVIRONMENT_BLOCK_SIZE-8)
+	copy(block[:260], ansi)
+	copy(block[260:], unicode)
+	return block, nil
+}
+
+// Serializes the TrackerDataBlock, without BlockSize and BlockSignature.
+func (t *Tracker) bytes() ([]byte, error) {
+	le := binary.LittleEndian
+	if len(t.MachineId) > 15 || !isAscii(t.MachineId) {
+		return nil, errors.New("lnk: invalid Tracker MachineId")
+	}
+
+	block := make([]byte, 24, 0x58)
+	le.PutUint32(block[0:], 0x58) // Length
+	copy(block[8:], t.MachineId)  // Version is zero
+	for _, guid := range [...]string{
+		t.Droid[0], t.Droid[1], t.DroidBirth[0], t.DroidBirth[1],
+	} {
+		guidBytes, err := guidToBytes(guid)
+		if err != nil {
+			return nil, err
+		}
+		block = append(block, guidBytes...)
+	}
+	return block, nil
+}
+
+// Formats the 16-byte binary GUID as a string, like
+// "00021401-0000-0000-c000-000000000046".
+func guidFromBytes(data []byte) string {
+	le := binary.LittleEndian
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		le.Uint32(data), le.Uint16(data[4:]), le.Uint16(data[6:]),
+		data[8:10], data[10:16])
+}
+
+// Parses a string GUID into its 16-byte binary form. An empty string is taken
+// as the null GUID.
+func guidToBytes(guid string) ([]byte, error) {
+	if guid == "" {
+		return make([]byte, 16), nil
+	}
+
+	parts := strings.Split(strings.Trim(guid, "{}"), "-")
+	if len(parts) != 5 || len(parts[0]) != 8 || len(parts[1]) != 4 ||
+		len(parts[2]) != 4 || len(parts[3]) != 4 || len(parts[4]) != 12 {
+		return nil, fmt.Errorf("lnk: malformed GUID: %s", guid)
+	}
+	raw, err := hex.DecodeString(strings.Join(parts, ""))
+	if err != nil {
+		return nil, fmt.Errorf("lnk: malformed GUID: %s", guid)
+	}
+
+	// The first three parts are little-endian.
+	raw[0], raw[1], raw[2], raw[3] = raw[3], raw[2], raw[1], raw[0]
+	raw[4], raw[5] = raw[5], raw[4]
+	raw[6], raw[7] = raw[7], raw[6]
+	return raw, nil
+}
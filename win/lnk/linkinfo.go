@@ -0,0 +1,326 @@
+package lnk
+
+import (
+	"encoding/binary"
+)
+
+// VolumeID DriveType, the same values of GetDriveType().
+const (
+	DRIVE_UNKNOWN     = 0
+	DRIVE_NO_ROOT_DIR = 1
+	DRIVE_REMOVABLE   = 2
+	DRIVE_FIXED       = 3
+	DRIVE_REMOTE      = 4
+	DRIVE_CDROM       = 5
+	DRIVE_RAMDISK     = 6
+)
+
+// The LinkTargetIDList of a .lnk file: the SHITEMID entries of an
+// ITEMIDLIST, each one without its 2-byte size.
+type IdList [][]byte
+
+// Reads an IDList structure, which ends with a zero TerminalID.
+func readIdList(data []byte) (IdList, error) {
+	idl := IdList{} // non-nil, since it's present
+	for {
+		if len(data) < 2 {
+			return nil, ErrFormat
+		}
+		size := int(binary.LittleEndian.Uint16(data))
+		if size == 0 {
+			return idl, nil
+		}
+		if size < 2 || size > len(data) {
+			return nil, ErrFormat
+		}
+		idl = append(idl, data[2:size:size])
+		data = data[size:]
+	}
+}
+
+// Serializes the IDList as an ITEMIDLIST, including the zero TerminalID.
+func (idl IdList) Bytes() []byte {
+	var data []byte
+	for _, item := range idl {
+		data = binary.LittleEndian.AppendUint16(data, uint16(len(item)+2))
+		data = append(data, item...)
+	}
+	return append(data, 0, 0)
+}
+
+// The LinkInfo of a .lnk file, which tells the location of the target.
+type LinkInfo struct {
+	Volume           *VolumeId    // Volume of LocalBasePath; nil if absent.
+	LocalBasePath    string       // Local path of the target, like "C:\\Temp\\foo.exe".
+	Network          *NetworkLink // Network share of the target; nil if absent.
+	CommonPathSuffix string       // Appended to LocalBasePath or NetworkLink.NetName.
+}
+
+// The VolumeID of a LinkInfo.
+type VolumeId struct {
+	DriveType    uint32 // DRIVE_FIXED, DRIVE_REMOTE, etc.
+	SerialNumber uint32 // Volume serial number.
+	Label        string // Volume label.
+}
+
+// The CommonNetworkRelativeLink of a LinkInfo.
+type NetworkLink struct {
+	NetName      string // Share name, like "\\\\server\\share".
+	DeviceName   string // Mapped drive, like "Z:"; empty if none.
+	ProviderType uint32 // WNNC_NET network provider; zero if none.
+}
+
+// Reads a LinkInfo structure.
+func readLinkInfo(data []byte) (*LinkInfo, error) {
+	le := binary.LittleEndian
+	if len(data) < 0x1c {
+		return nil, ErrFormat
+	}
+	hdrSize := int(le.Uint32(data[4:]))
+	if hdrSize < 0x1c || hdrSize > len(data) {
+		return nil, ErrFormat
+	}
+	liFlags := le.Uint32(data[8:])
+	hasUnicode := hdrSize >= 0x24
+
+	li := &LinkInfo{}
+	var err error
+
+	if liFlags&0x1 != 0 { // VolumeIDAndLocalBasePath
+		if li.Volume, err = readVolumeId(data, le.Uint32(data[12:])); err != nil {
+			return nil, err
+		}
+		if li.LocalBasePath, err = readAnsiz(data, le.Uint32(data[16:])); err != nil {
+			return nil, err
+		}
+		if hasUnicode {
+			if off := le.Uint32(data[28:]); off != 0 {
+				if li.LocalBasePath, err = readUtf16z(data, off); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if liFlags&0x2 != 0 { // CommonNetworkRelativeLinkAndPathSuffix
+		if li.Network, err = readNetworkLink(data, le.Uint32(data[20:])); err != nil {
+			return nil, err
+		}
+	}
+
+	if off := le.Uint32(data[24:]); off != 0 {
+		if li.CommonPathSuffix, err = readAnsiz(data, off); err != nil {
+			return nil, err
+		}
+	}
+	if hasUnicode {
+		if off := le.Uint32(data[32:]); off != 0 {
+			if li.CommonPathSuffix, err = readUtf16z(data, off); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return li, nil
+}
+
+// Reads a VolumeID structure at the given offset.
+func readVolumeId(data []byte, offset uint32) (*VolumeId, error) {
+	le := binary.LittleEndian
+	if offset > uint32(len(data)) || len(data)-int(offset) < 0x10 {
+		return nil, ErrFormat
+	}
+	data = data[offset:]
+	size := le.Uint32(data)
+	if size < 0x10 || size > uint32(len(data)) {
+		return nil, ErrFormat
+	}
+	data = data[:size]
+
+	vol := &VolumeId{
+		DriveType:    le.Uint32(data[4:]),
+		SerialNumber: le.Uint32(data[8:]),
+	}
+	var err error
+	if labelOff := le.Uint32(data[12:]); labelOff == 0x14 && size >= 0x14 {
+		vol.Label, err = readUtf16z(data, le.Uint32(data[16:]))
+	} else {
+		vol.Label, err = readAnsiz(data, labelOff)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+// Reads a CommonNetworkRelativeLink structure at the given offset.
+func readNetworkLink(data []byte, offset uint32) (*NetworkLink, error) {
+	le := binary.LittleEndian
+	if offset > uint32(len(data)) || len(data)-int(offset) < 0x14 {
+		return nil, ErrFormat
+	}
+	data = data[offset:]
+	size := le.Uint32(data)
+	if size < 0x14 || size > uint32(len(data)) {
+		return nil, ErrFormat
+	}
+	data = data[:size]
+
+	cnrlFlags := le.Uint32(data[4:])
+	netNameOff := le.Uint32(data[8:])
+	deviceNameOff := le.Uint32(data[12:])
+	hasUnicode := netNameOff > 0x14 && size >= 0x1c
+
+	nl := &NetworkLink{}
+	var err error
+
+	if nl.NetName, err = readAnsiz(data, netNameOff); err != nil {
+		return nil, err
+	}
+	if hasUnicode {
+		if off := le.Uint32(data[20:]); off != 0 {
+			if nl.NetName, err = readUtf16z(data, off); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if cnrlFlags&0x1 != 0 { // ValidDevice
+		if nl.DeviceName, err = readAnsiz(data, deviceNameOff); err != nil {
+			return nil, err
+		}
+		if hasUnicode {
+			if off := le.Uint32(data[24:]); off != 0 {
+				if nl.DeviceName, err = readUtf16z(data, off); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if cnrlFlags&0x2 != 0 { // ValidNetType
+		nl.ProviderType = le.Uint32(data[16:])
+	}
+	return nl, nil
+}
+
+// Serializes the LinkInfo structure. Non-ASCII strings are also stored in
+// Unicode.
+func (li *LinkInfo) bytes() []byte {
+	le := binary.LittleEndian
+	hasUnicode := !isAscii(li.LocalBasePath) || !isAscii(li.CommonPathSuffix)
+
+	hdrSize := 0x1c
+	if hasUnicode {
+		hdrSize = 0x24
+	}
+	data := make([]byte, hdrSize)
+	put := func(offField int, block []byte) { // appends the block, storing its offset
+		off := uint32(len(data))
+		data = append(data, block...)
+		le.PutUint32(data[offField:], off)
+	}
+
+	liFlags := uint32(0)
+	hasLocal := li.Volume != nil || li.LocalBasePath != ""
+	if hasLocal {
+		liFlags |= 0x1 // VolumeIDAndLocalBasePath
+		vol := li.Volume
+		if vol == nil {
+			vol = &VolumeId{}
+		}
+		put(12, vol.bytes())
+		put(16, encodeAnsiz(li.LocalBasePath))
+	}
+	if li.Network != nil {
+		liFlags |= 0x2 // CommonNetworkRelativeLinkAndPathSuffix
+		put(20, li.Network.bytes())
+	}
+	put(24, encodeAnsiz(li.CommonPathSuffix))
+
+	if hasUnicode {
+		if hasLocal {
+			put(28, encodeUtf16z(li.LocalBasePath))
+		}
+		put(32, encodeUtf16z(li.CommonPathSuffix))
+	}
+
+	le.PutUint32(data[0:], uint32(len(data)))
+	le.PutUint32(data[4:], uint32(hdrSize))
+	le.PutUint32(data[8:], liFlags)
+	return data
+}
+
+// Serializes the VolumeID structure.
+func (vol *VolumeId) bytes() []byte {
+	le := binary.LittleEndian
+	var data []byte
+	if isAscii(vol.Label) {
+		data = make([]byte, 0x10)
+		le.PutUint32(data[12:], 0x10)
+		data = append(data, encodeAnsiz(vol.Label)...)
+	} else {
+		data = make([]byte, 0x14)
+		le.PutUint32(data[12:], 0x14) // tells that the Unicode offset is present
+		le.PutUint32(data[16:], 0x14)
+		data = append(data, encodeUtf16z(vol.Label)...)
+	}
+	le.PutUint32(data[0:], uint32(len(data)))
+	le.PutUint32(data[4:], vol.DriveType)
+	le.PutUint32(data[8:], vol.SerialNumber)
+	return data
+}
+
+// Serializes the CommonNetworkRelativeLink structure.
+func (nl *NetworkLink) bytes() []byte {
+	le := binary.LittleEndian
+	hasUnicode := !isAscii(nl.NetName) || !isAscii(nl.DeviceName)
+
+	hdrSize := 0x14
+	if hasUnicode {
+		hdrSize = 0x1c
+	}
+	data := make([]byte, hdrSize)
+	put := func(offField int, block []byte) {
+		off := uint32(len(data))
+		data = append(data, block...)
+		le.PutUint32(data[offField:], off)
+	}
+
+	cnrlFlags := uint32(0)
+	put(8, encodeAnsiz(nl.NetName))
+	if nl.DeviceName != "" {
+		cnrlFlags |= 0x1 // ValidDevice
+		put(12, encodeAnsiz(nl.DeviceName))
+	}
+	if nl.ProviderType != 0 {
+		cnrlFlags |= 0x2 // ValidNetType
+		le.PutUint32(data[16:], nl.ProviderType)
+	}
+
+	if hasUnicode {
+		put(20, encodeUtf16z(nl.NetName))
+		if nl.DeviceName != "" {
+			put(24, encodeUtf16z(nl.DeviceName))
+		}
+	}
+
+	le.PutUint32(data[0:], uint32(len(data)))
+	le.PutUint32(data[4:], cnrlFlags)
+	return data
+}
+
+// Reads a null-terminated ANSI string at the given offset.
+func readAnsiz(data []byte, offset uint32) (string, error) {
+	if offset >= uint32(len(data)) {
+		return "", ErrFormat
+	}
+	return decodeAnsi(data[offset:]), nil
+}
+
+// Reads a null-terminated UTF-16 string at the given offset.
+func readUtf16z(data []byte, offset uint32) (string, error) {
+	if offset >= uint32(len(data)) {
+		return "", ErrFormat
+	}
+	return decodeUtf16(data[offset:]), nil
+}
@@ -0,0 +1,439 @@
+// Package lnk implements a codec for .lnk files, the [Shell Link] binary
+// format: ShellLinkHeader, LinkTargetIDList, LinkInfo, StringData and the
+// ExtraData blocks.
+//
+// This package has no Windows dependencies, so it can be used on any OS. To
+// convert to and from a COM shell link, see shell.ShellLinkFromLnk() and
+// shell.LnkFromShellLink().
+//
+// Example:
+//
+//	f := lnk.New("C:\\Program Files\\MyApp\\MyApp.exe")
+//	f.WorkingDir = "C:\\Program Files\\MyApp"
+//	f.Arguments = "--fast"
+//	f.Name = "My application"
+//
+//	fout, _ := os.Create("C:\\Temp\\MyApp.lnk")
+//	defer fout.Close()
+//	f.Write(fout)
+//
+// [Shell Link]: https://docs.microsoft.com/en-us/openspecs/windows_protocols/ms-shllink/16cb4ca1-9339-4d0c-a68d-bf1d6cc0f943
+package lnk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// Size of the ShellLinkHeader struct.
+const _HEADER_SIZE = 0x4c
+
+// CLSID_ShellLink, which identifies the file, as stored in the header.
+var _LINK_CLSID = []byte{
+	0x01, 0x14, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46,
+}
+
+// Returned when the data is not a valid .lnk file.
+var ErrFormat = errors.New("lnk: invalid format")
+
+// ShellLinkHeader LinkFlags.
+//
+// The flags which tell which structures are present are computed from the
+// File fields on File.Write().
+type LINK_FLAGS uint32
+
+const (
+	LINK_FLAGS_HAS_LINK_TARGET_ID_LIST           LINK_FLAGS = 0x0000_0001
+	LINK_FLAGS_HAS_LINK_INFO                     LINK_FLAGS = 0x0000_0002
+	LINK_FLAGS_HAS_NAME                          LINK_FLAGS = 0x0000_0004
+	LINK_FLAGS_HAS_RELATIVE_PATH                 LINK_FLAGS = 0x0000_0008
+	LINK_FLAGS_HAS_WORKING_DIR                   LINK_FLAGS = 0x0000_0010
+	LINK_FLAGS_HAS_ARGUMENTS                     LINK_FLAGS = 0x0000_0020
+	LINK_FLAGS_HAS_ICON_LOCATION                 LINK_FLAGS = 0x0000_0040
+	LINK_FLAGS_IS_UNICODE                        LINK_FLAGS = 0x0000_0080
+	LINK_FLAGS_FORCE_NO_LINK_INFO                LINK_FLAGS = 0x0000_0100
+	LINK_FLAGS_HAS_EXP_STRING                    LINK_FLAGS = 0x0000_0200
+	LINK_FLAGS_RUN_IN_SEPARATE_PROCESS           LINK_FLAGS = 0x0000_0400
+	LINK_FLAGS_HAS_DARWIN_ID                     LINK_FLAGS = 0x0000_1000
+	LINK_FLAGS_RUN_AS_USER                       LINK_FLAGS = 0x0000_2000
+	LINK_FLAGS_HAS_EXP_ICON                      LINK_FLAGS = 0x0000_4000
+	LINK_FLAGS_NO_PIDL_ALIAS                     LINK_FLAGS = 0x0000_8000
+	LINK_FLAGS_RUN_WITH_SHIM_LAYER               LINK_FLAGS = 0x0002_0000
+	LINK_FLAGS_FORCE_NO_LINK_TRACK               LINK_FLAGS = 0x0004_0000
+	LINK_FLAGS_ENABLE_TARGET_METADATA            LINK_FLAGS = 0x0008_0000
+	LINK_FLAGS_DISABLE_LINK_PATH_TRACKING        LINK_FLAGS = 0x0010_0000
+	LINK_FLAGS_DISABLE_KNOWN_FOLDER_TRACKING     LINK_FLAGS = 0x0020_0000
+	LINK_FLAGS_DISABLE_KNOWN_FOLDER_ALIAS        LINK_FLAGS = 0x0040_0000
+	LINK_FLAGS_ALLOW_LINK_TO_LINK                LINK_FLAGS = 0x0080_0000
+	LINK_FLAGS_UNALIAS_ON_SAVE                   LINK_FLAGS = 0x0100_0000
+	LINK_FLAGS_PREFER_ENVIRONMENT_PATH           LINK_FLAGS = 0x0200_0000
+	LINK_FLAGS_KEEP_LOCAL_ID_LIST_FOR_UNC_TARGET LINK_FLAGS = 0x0400_0000
+)
+
+// The flags computed from the File fields.
+const _COMPUTED_FLAGS = LINK_FLAGS_HAS_LINK_TARGET_ID_LIST |
+	LINK_FLAGS_HAS_LINK_INFO | LINK_FLAGS_HAS_NAME |
+	LINK_FLAGS_HAS_RELATIVE_PATH | LINK_FLAGS_HAS_WORKING_DIR |
+	LINK_FLAGS_HAS_ARGUMENTS | LINK_FLAGS_HAS_ICON_LOCATION |
+	LINK_FLAGS_IS_UNICODE | LINK_FLAGS_HAS_EXP_STRING |
+	LINK_FLAGS_HAS_DARWIN_ID | LINK_FLAGS_HAS_EXP_ICON
+
+// ShellLinkHeader ShowCommand, the same values of co.SW.
+const (
+	SW_SHOWNORMAL      = 1
+	SW_SHOWMAXIMIZED   = 3
+	SW_SHOWMINNOACTIVE = 7
+)
+
+// ShellLinkHeader HotKey modifiers, the same values of co.HOTKEYF.
+const (
+	HOTKEYF_SHIFT   = 0x01
+	HOTKEYF_CONTROL = 0x02
+	HOTKEYF_ALT     = 0x04
+)
+
+// A .lnk file.
+type File struct {
+	Flags          LINK_FLAGS // Flags not computed from the other fields.
+	FileAttributes uint32     // FILE_ATTRIBUTE flags of the target.
+	CreationTime   time.Time  // Creation time of the target; zero if not set.
+	AccessTime     time.Time  // Last access time of the target; zero if not set.
+	WriteTime      time.Time  // Last write time of the target; zero if not set.
+	FileSize       uint32     // Size of the target, lower 32 bits.
+	IconIndex      int32      // Index of the icon within IconLocation.
+	ShowCmd        int        // SW_SHOWNORMAL, SW_SHOWMAXIMIZED or SW_SHOWMINNOACTIVE.
+	Hotkey         uint16     // Virtual key code in the low byte, HOTKEYF modifiers in the high byte.
+
+	IdList   IdList    // LinkTargetIDList; nil if absent.
+	LinkInfo *LinkInfo // Location of the target; nil if absent.
+
+	Name         string // Description of the shortcut.
+	RelativePath string // Path of the target, relative to the .lnk file.
+	WorkingDir   string // Working directory of the target.
+	Arguments    string // Command line arguments of the target.
+	IconLocation string // Path of the file which contains the icon.
+
+	Environment     string         // Target path with environment variables, from EnvironmentVariableDataBlock.
+	IconEnvironment string         // Icon path with environment variables, from IconEnvironmentDataBlock.
+	KnownFolder     *KnownFolder   // KnownFolderDataBlock; nil if absent.
+	SpecialFolder   *SpecialFolder // SpecialFolderDataBlock; nil if absent.
+	Tracker         *Tracker       // TrackerDataBlock; nil if absent.
+	Properties      []Property     // PropertyStoreDataBlock contents.
+	OtherBlocks     []ExtraBlock   // ExtraData blocks not parsed by this package, kept as they are.
+}
+
+// Creates a new File pointing to the given target, which can be a local path,
+// like "C:\\Temp\\foo.exe", or a UNC path, like "\\\\server\\share\\foo.exe".
+// Any other string, like a path with environment variables, is stored in the
+// Environment field.
+func New(target string) *File {
+	f := &File{ShowCmd: SW_SHOWNORMAL}
+
+	if strings.HasPrefix(target, `\\`) {
+		parts := strings.SplitN(target[2:], `\`, 3)
+		netName, suffix := target, ""
+		if len(parts) == 3 {
+			netName = `\\` + parts[0] + `\` + parts[1]
+			suffix = parts[2]
+		}
+		f.LinkInfo = &LinkInfo{
+			Network:          &NetworkLink{NetName: netName},
+			CommonPathSuffix: suffix,
+		}
+	} else if len(target) >= 3 && target[1] == ':' && target[2] == '\\' &&
+		!strings.Contains(target, "%") {
+
+		f.LinkInfo = &LinkInfo{
+			Volume:        &VolumeId{DriveType: DRIVE_FIXED},
+			LocalBasePath: target,
+		}
+	} else {
+		f.Environment = target
+	}
+	return f
+}
+
+// Returns the path of the target, as stored in LinkInfo or, if absent, in the
+// Environment field.
+func (f *File) TargetPath() string {
+	if li := f.LinkInfo; li != nil {
+		if li.LocalBasePath != "" {
+			return li.LocalBasePath + li.CommonPathSuffix
+		} else if li.Network != nil {
+			if li.CommonPathSuffix == "" {
+				return li.Network.NetName
+			}
+			return li.Network.NetName + `\` + li.CommonPathSuffix
+		}
+	}
+	return f.Environment
+}
+
+// Reads a .lnk file.
+//
+// Strings not stored in Unicode are decoded as Latin-1, since the code page of
+// the machine which created the file is unknown.
+func Read(r io.Reader) (*File, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	le := binary.LittleEndian
+	if len(data) < _HEADER_SIZE || le.Uint32(data) != _HEADER_SIZE ||
+		!bytes.Equal(data[4:20], _LINK_CLSID) {
+		return nil, ErrFormat
+	}
+
+	flags := LINK_FLAGS(le.Uint32(data[20:]))
+	f := &File{
+		Flags:          flags &^ _COMPUTED_FLAGS,
+		FileAttributes: le.Uint32(data[24:]),
+		CreationTime:   fromFiletime(le.Uint64(data[28:])),
+		AccessTime:     fromFiletime(le.Uint64(data[36:])),
+		WriteTime:      fromFiletime(le.Uint64(data[44:])),
+		FileSize:       le.Uint32(data[52:]),
+		IconIndex:      int32(le.Uint32(data[56:])),
+		ShowCmd:        int(int32(le.Uint32(data[60:]))),
+		Hotkey:         le.Uint16(data[64:]),
+	}
+	data = data[_HEADER_SIZE:]
+
+	if flags&LINK_FLAGS_HAS_LINK_TARGET_ID_LIST != 0 {
+		if len(data) < 2 {
+			return nil, ErrFormat
+		}
+		size := int(le.Uint16(data))
+		if size > len(data)-2 {
+			return nil, ErrFormat
+		}
+		if f.IdList, err = readIdList(data[2 : 2+size]); err != nil {
+			return nil, err
+		}
+		data = data[2+size:]
+	}
+
+	if flags&LINK_FLAGS_HAS_LINK_INFO != 0 {
+		if len(data) < 4 {
+			return nil, ErrFormat
+		}
+		size := int(le.Uint32(data))
+		if size < 4 || size > len(data) {
+			return nil, ErrFormat
+		}
+		if flags&LINK_FLAGS_FORCE_NO_LINK_INFO == 0 {
+			if f.LinkInfo, err = readLinkInfo(data[:size]); err != nil {
+				return nil, err
+			}
+		}
+		data = data[size:]
+	}
+
+	isUnicode := flags&LINK_FLAGS_IS_UNICODE != 0
+	for _, sd := range [...]struct {
+		flag LINK_FLAGS
+		dest *string
+	}{
+		{LINK_FLAGS_HAS_NAME, &f.Name},
+		{LINK_FLAGS_HAS_RELATIVE_PATH, &f.RelativePath},
+		{LINK_FLAGS_HAS_WORKING_DIR, &f.WorkingDir},
+		{LINK_FLAGS_HAS_ARGUMENTS, &f.Arguments},
+		{LINK_FLAGS_HAS_ICON_LOCATION, &f.IconLocation},
+	} {
+		if flags&sd.flag != 0 {
+			if *sd.dest, data, err = readStringData(data, isUnicode); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := f.readExtraData(data); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Reads a StringData structure, returning the remaining data.
+func readStringData(data []byte, isUnicode bool) (string, []byte, error) {
+	if len(data) < 2 {
+		return "", nil, ErrFormat
+	}
+	count := int(binary.LittleEndian.Uint16(data))
+	data = data[2:]
+
+	if isUnicode {
+		if count*2 > len(data) {
+			return "", nil, ErrFormat
+		}
+		return decodeUtf16(data[:count*2]), data[count*2:], nil
+	}
+	if count > len(data) {
+		return "", nil, ErrFormat
+	}
+	return decodeAnsi(data[:count]), data[count:], nil
+}
+
+// Writes the .lnk file. All strings are stored in Unicode.
+func (f *File) Write(w io.Writer) error {
+	flags := f.Flags&^_COMPUTED_FLAGS | LINK_FLAGS_IS_UNICODE
+	if f.IdList != nil {
+		flags |= LINK_FLAGS_HAS_LINK_TARGET_ID_LIST
+	}
+	if f.LinkInfo != nil {
+		flags |= LINK_FLAGS_HAS_LINK_INFO
+	}
+	if f.Environment != "" {
+		flags |= LINK_FLAGS_HAS_EXP_STRING
+	}
+	if f.IconEnvironment != "" {
+		flags |= LINK_FLAGS_HAS_EXP_ICON
+	}
+	for i := range f.OtherBlocks {
+		if f.OtherBlocks[i].Signature == _SIG_DARWIN {
+			flags |= LINK_FLAGS_HAS_DARWIN_ID
+		}
+	}
+
+	var buf bytes.Buffer
+	le := binary.LittleEndian
+
+	hdr := make([]byte, _HEADER_SIZE)
+	le.PutUint32(hdr[0:], _HEADER_SIZE)
+	copy(hdr[4:], _LINK_CLSID)
+	le.PutUint32(hdr[24:], f.FileAttributes)
+	le.PutUint64(hdr[28:], toFiletime(f.CreationTime))
+	le.PutUint64(hdr[36:], toFiletime(f.AccessTime))
+	le.PutUint64(hdr[44:], toFiletime(f.WriteTime))
+	le.PutUint32(hdr[52:], f.FileSize)
+	le.PutUint32(hdr[56:], uint32(f.IconIndex))
+	le.PutUint32(hdr[60:], uint32(int32(f.ShowCmd)))
+	le.PutUint16(hdr[64:], f.Hotkey)
+	buf.Write(hdr) // flags are written at the end
+
+	if f.IdList != nil {
+		idl := f.IdList.Bytes()
+		if len(idl) > 0xffff {
+			return errors.New("lnk: IdList is too large")
+		}
+		buf.Write(le.AppendUint16(nil, uint16(len(idl))))
+		buf.Write(idl)
+	}
+
+	if f.LinkInfo != nil {
+		buf.Write(f.LinkInfo.bytes())
+	}
+
+	for _, sd := range [...]struct {
+		flag LINK_FLAGS
+		str  string
+	}{
+		{LINK_FLAGS_HAS_NAME, f.Name},
+		{LINK_FLAGS_HAS_RELATIVE_PATH, f.RelativePath},
+		{LINK_FLAGS_HAS_WORKING_DIR, f.WorkingDir},
+		{LINK_FLAGS_HAS_ARGUMENTS, f.Arguments},
+		{LINK_FLAGS_HAS_ICON_LOCATION, f.IconLocation},
+	} {
+		if sd.str != "" {
+			flags |= sd.flag
+			str16 := utf16.Encode([]rune(sd.str))
+			if len(str16) > 0xffff {
+				return errors.New("lnk: string is too long")
+			}
+			buf.Write(le.AppendUint16(nil, uint16(len(str16))))
+			for _, ch := range str16 {
+				buf.Write(le.AppendUint16(nil, ch))
+			}
+		}
+	}
+
+	if err := f.writeExtraData(&buf); err != nil {
+		return err
+	}
+
+	data := buf.Bytes()
+	le.PutUint32(data[20:], uint32(flags))
+	_, err := w.Write(data)
+	return err
+}
+
+// Number of 100-nanosecond intervals between 1601 and 1970.
+const _FILETIME_EPOCH = 116_444_736_000_000_000
+
+func fromFiletime(ft uint64) time.Time {
+	if ft == 0 {
+		return time.Time{}
+	}
+	nano100 := int64(ft - _FILETIME_EPOCH)
+	return time.Unix(nano100/10_000_000, nano100%10_000_000*100)
+}
+
+func toFiletime(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.Unix()*10_000_000 + int64(t.Nanosecond()/100) + _FILETIME_EPOCH)
+}
+
+// Decodes UTF-16 data, stopping at the first null.
+func decodeUtf16(data []byte) string {
+	str16 := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		ch := binary.LittleEndian.Uint16(data[i:])
+		if ch == 0 {
+			break
+		}
+		str16 = append(str16, ch)
+	}
+	return string(utf16.Decode(str16))
+}
+
+// Decodes ANSI data as Latin-1, stopping at the first null.
+func decodeAnsi(data []byte) string {
+	runes := make([]rune, 0, len(data))
+	for _, b := range data {
+		if b == 0 {
+			break
+		}
+		runes = append(runes, rune(b))
+	}
+	return string(runes)
+}
+
+// Encodes the string as null-terminated UTF-16.
+func encodeUtf16z(s string) []byte {
+	str16 := utf16.Encode([]rune(s))
+	data := make([]byte, 0, (len(str16)+1)*2)
+	for _, ch := range str16 {
+		data = binary.LittleEndian.AppendUint16(data, ch)
+	}
+	return append(data, 0, 0)
+}
+
+// Encodes the string as null-terminated Latin-1, replacing the characters
+// which cannot be represented with '?'.
+func encodeAnsiz(s string) []byte {
+	data := make([]byte, 0, len(s)+1)
+	for _, ch := range s {
+		if ch > 0xff {
+			ch = '?'
+		}
+		data = append(data, byte(ch))
+	}
+	return append(data, 0)
+}
+
+// Tells whether the string can be stored in ANSI without losses.
+func isAscii(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,384 @@
+package lnk
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Writes the file and reads it back.
+func roundTrip(t *testing.T, f *File) *File {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	f2, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	return f2
+}
+
+func TestHeader(t *testing.T) {
+	f := New(`C:\Temp\foo.exe`)
+	f.Flags = LINK_FLAGS_RUN_AS_USER
+	f.FileAttributes = 0x20
+	f.CreationTime = time.Date(2020, 1, 2, 3, 4, 5, 600, time.UTC)
+	f.WriteTime = time.Date(2021, 6, 7, 8, 9, 10, 0, time.UTC)
+	f.FileSize = 12345
+	f.IconIndex = -3
+	f.ShowCmd = SW_SHOWMAXIMIZED
+	f.Hotkey = HOTKEYF_CONTROL<<8 | 'K'
+	f.IdList = IdList{{0x1f, 0x50}, {1, 2, 3, 4}}
+
+	f2 := roundTrip(t, f)
+	if f2.Flags != f.Flags || f2.FileAttributes != f.FileAttributes ||
+		f2.FileSize != f.FileSize || f2.IconIndex != f.IconIndex ||
+		f2.ShowCmd != f.ShowCmd || f2.Hotkey != f.Hotkey {
+		t.Errorf("header: got %+v", f2)
+	}
+	if !f2.CreationTime.Equal(f.CreationTime) || !f2.WriteTime.Equal(f.WriteTime) ||
+		!f2.AccessTime.IsZero() {
+		t.Errorf("times: got %v, %v, %v", f2.CreationTime, f2.AccessTime, f2.WriteTime)
+	}
+	if !reflect.DeepEqual(f2.IdList, f.IdList) {
+		t.Errorf("IdList: got %v, want %v", f2.IdList, f.IdList)
+	}
+}
+
+func TestLinkInfoLocal(t *testing.T) {
+	for _, target := range []string{
+		`C:\Temp\foo.exe`,
+		`D:\Téléchargements\日本語.txt`, // stored in Unicode too
+	} {
+		f := New(target)
+		f.LinkInfo.Volume.SerialNumber = 0xdead_beef
+		f.LinkInfo.Volume.Label = "Système"
+
+		f2 := roundTrip(t, f)
+		if f2.LinkInfo == nil || f2.LinkInfo.Volume == nil {
+			t.Fatalf("%s: LinkInfo not read", target)
+		}
+		if got := f2.TargetPath(); got != target {
+			t.Errorf("TargetPath: got %q, want %q", got, target)
+		}
+		if !reflect.DeepEqual(f2.LinkInfo.Volume, f.LinkInfo.Volume) {
+			t.Errorf("Volume: got %+v, want %+v", f2.LinkInfo.Volume, f.LinkInfo.Volume)
+		}
+		if f2.LinkInfo.Network != nil {
+			t.Errorf("%s: unexpected network link", target)
+		}
+	}
+}
+
+func TestLinkInfoUnc(t *testing.T) {
+	for _, target := range []string{
+		`\\server\share\dir\foo.exe`,
+		`\\sérvér\pärtagé\ファイル.doc`,
+		`\\server\share`,
+	} {
+		f := New(target)
+		f2 := roundTrip(t, f)
+		if got := f2.TargetPath(); got != target {
+			t.Errorf("TargetPath: got %q, want %q", got, target)
+		}
+		if f2.LinkInfo.Volume != nil || f2.LinkInfo.LocalBasePath != "" {
+			t.Errorf("%s: unexpected local path", target)
+		}
+	}
+
+	f := New(`\\server\share\foo.txt`)
+	f.LinkInfo.Network.DeviceName = "Z:"
+	f.LinkInfo.Network.ProviderType = 0x2_0000 // WNNC_NET_LANMAN
+	f2 := roundTrip(t, f)
+	if !reflect.DeepEqual(f2.LinkInfo.Network, f.LinkInfo.Network) {
+		t.Errorf("Network: got %+v, want %+v", f2.LinkInfo.Network, f.LinkInfo.Network)
+	}
+}
+
+func TestStringData(t *testing.T) {
+	f := New(`C:\Temp\foo.exe`)
+	f.Name = "Descrição – 説明"
+	f.RelativePath = `..\foo.exe`
+	f.WorkingDir = `C:\Temp`
+	f.Arguments = `--name "a b" 😀`
+	f.IconLocation = `%SystemRoot%\system32\shell32.dll`
+
+	f2 := roundTrip(t, f)
+	if f2.Name != f.Name || f2.RelativePath != f.RelativePath ||
+		f2.WorkingDir != f.WorkingDir || f2.Arguments != f.Arguments ||
+		f2.IconLocation != f.IconLocation {
+		t.Errorf("strings: got %+v", f2)
+	}
+
+	// Empty strings are not written.
+	f2 = roundTrip(t, New(`C:\Temp\foo.exe`))
+	if f2.Name != "" || f2.Arguments != "" {
+		t.Errorf("empty strings: got %+v", f2)
+	}
+}
+
+func TestStringDataAnsi(t *testing.T) {
+	// Writes the file, then clears IS_UNICODE and rewrites the StringData as
+	// ANSI, as older versions of Windows do.
+	var buf bytes.Buffer
+	if err := New(`C:\a.exe`).Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	withoutStrings := buf.Bytes()
+	extraStart := len(withoutStrings) - 4 // TerminalBlock
+
+	data := append([]byte{}, withoutStrings[:extraStart]...)
+	flags := uint32(LINK_FLAGS_HAS_LINK_INFO | LINK_FLAGS_HAS_ARGUMENTS)
+	data[20], data[21], data[22], data[23] = byte(flags), byte(flags>>8), 0, 0
+	data = append(data, 4, 0, 'a', 0xe9, 'b', 'c') // "aébc" in Latin-1
+	data = append(data, 0, 0, 0, 0)
+
+	f, err := Read(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if f.Arguments != "aébc" {
+		t.Errorf("Arguments: got %q", f.Arguments)
+	}
+}
+
+func TestExtraData(t *testing.T) {
+	f := New(`%USERPROFILE%\foo.exe`)
+	f.IconEnvironment = `%SystemRoot%\ícone.ico`
+	f.KnownFolder = &KnownFolder{Id: "905e63b6-c1bf-494e-b29c-65b732d3d21a", Offset: 20}
+	f.SpecialFolder = &SpecialFolder{Id: 0x26, Offset: 20}
+	f.Tracker = &Tracker{
+		MachineId: "desktop-1234",
+		Droid: [2]string{
+			"11111111-2222-3333-4444-555555555555",
+			"66666666-7777-8888-9999-aaaaaaaaaaaa",
+		},
+		DroidBirth: [2]string{
+			"bbbbbbbb-cccc-dddd-eeee-ffffffffffff",
+			"00000000-0000-0000-0000-000000000001",
+		},
+	}
+	f.OtherBlocks = []ExtraBlock{{Signature: 0xa000_0002, Data: []byte{1, 2, 3, 4}}}
+
+	f2 := roundTrip(t, f)
+	if f2.LinkInfo != nil {
+		t.Errorf("unexpected LinkInfo")
+	}
+	if f2.Environment != f.Environment || f2.TargetPath() != f.Environment {
+		t.Errorf("Environment: got %q", f2.Environment)
+	}
+	if f2.IconEnvironment != f.IconEnvironment {
+		t.Errorf("IconEnvironment: got %q", f2.IconEnvironment)
+	}
+	if !reflect.DeepEqual(f2.KnownFolder, f.KnownFolder) {
+		t.Errorf("KnownFolder: got %+v", f2.KnownFolder)
+	}
+	if !reflect.DeepEqual(f2.SpecialFolder, f.SpecialFolder) {
+		t.Errorf("SpecialFolder: got %+v", f2.SpecialFolder)
+	}
+	if !reflect.DeepEqual(f2.Tracker, f.Tracker) {
+		t.Errorf("Tracker: got %+v", f2.Tracker)
+	}
+	if !reflect.DeepEqual(f2.OtherBlocks, f.OtherBlocks) {
+		t.Errorf("OtherBlocks: got %+v", f2.OtherBlocks)
+	}
+	if f2.Flags&(LINK_FLAGS_HAS_EXP_STRING|LINK_FLAGS_HAS_EXP_ICON) != 0 {
+		t.Errorf("computed flags leaked into Flags: %#x", f2.Flags)
+	}
+}
+
+func TestExtraDataErrors(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(`C:\a.exe`)
+	f.Environment = string(make([]rune, 300)) // longer than MAX_PATH
+	if err := f.Write(&buf); err == nil {
+		t.Errorf("long environment string: expected error")
+	}
+
+	f = New(`C:\a.exe`)
+	f.Tracker = &Tracker{MachineId: "machine-name-too-long"}
+	if err := f.Write(&buf); err == nil {
+		t.Errorf("long MachineId: expected error")
+	}
+
+	f = New(`C:\a.exe`)
+	f.KnownFolder = &KnownFolder{Id: "not-a-guid"}
+	if err := f.Write(&buf); err == nil {
+		t.Errorf("malformed KnownFolder: expected error")
+	}
+}
+
+const _PKEY_APPID = "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 5"
+
+func TestPropertyStore(t *testing.T) {
+	f := New(`C:\Temp\foo.exe`)
+	f.SetProperty(NewPropertyStr(_PKEY_APPID, "MyCompany.MyApp – ü"))
+	f.SetProperty(NewPropertyBool("9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 9", true))
+	f.SetProperty(NewPropertyUint32("9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 8", 42))
+	f.SetProperty(NewPropertyGuid("9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 26",
+		"0d9d5b6a-1d2b-4c84-9b4c-3ffb1c2a5f21"))
+	f.SetProperty(NewPropertyUint32("b725f130-47ef-101a-a5f1-02608c9eebac 13", 7))
+	f.Properties = append(f.Properties, Property{
+		FormatId: FMTID_STRING_NAMED,
+		Name:     "Custom",
+		Type:     VT_UI4,
+		Data:     []byte{9, 0, 0, 0},
+	})
+
+	f2 := roundTrip(t, f)
+	if len(f2.Properties) != len(f.Properties) {
+		t.Fatalf("Properties: got %d, want %d", len(f2.Properties), len(f.Properties))
+	}
+
+	if p, ok := f2.Property(_PKEY_APPID); !ok {
+		t.Errorf("AppUserModel.ID not found")
+	} else if s, ok := p.Str(); !ok || s != "MyCompany.MyApp – ü" {
+		t.Errorf("AppUserModel.ID: got %q, %v", s, ok)
+	}
+	if p, ok := f2.Property("9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 9"); !ok {
+		t.Errorf("bool not found")
+	} else if b, ok := p.Bool(); !ok || !b {
+		t.Errorf("bool: got %v, %v", b, ok)
+	}
+	if p, ok := f2.Property("9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 8"); !ok {
+		t.Errorf("uint32 not found")
+	} else if n, ok := p.Uint32(); !ok || n != 42 {
+		t.Errorf("uint32: got %v, %v", n, ok)
+	}
+	if p, ok := f2.Property("9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 26"); !ok {
+		t.Errorf("GUID not found")
+	} else if g, ok := p.Guid(); !ok || g != "0d9d5b6a-1d2b-4c84-9b4c-3ffb1c2a5f21" {
+		t.Errorf("GUID: got %q, %v", g, ok)
+	}
+	if p, ok := f2.Property("b725f130-47ef-101a-a5f1-02608c9eebac 13"); !ok {
+		t.Errorf("second storage not found")
+	} else if _, ok := p.Str(); ok {
+		t.Errorf("VT_UI4 read as string")
+	}
+
+	named := f2.Properties[len(f2.Properties)-1]
+	if named.Name != "Custom" || named.FormatId != FMTID_STRING_NAMED {
+		t.Errorf("named property: got %+v", named)
+	}
+	if _, ok := f2.Property(FMTID_STRING_NAMED + " 0"); ok {
+		t.Errorf("named property found by key")
+	}
+}
+
+func TestPropertyKeyBraces(t *testing.T) {
+	f := New(`C:\Temp\foo.exe`)
+	f.SetProperty(NewPropertyStr("{9F4C2855-9F79-4B39-A8D0-E1D42DE1D5F3} 5", "a"))
+	f.SetProperty(NewPropertyStr(_PKEY_APPID, "b")) // replaces the first
+
+	if len(f.Properties) != 1 {
+		t.Fatalf("Properties: got %d, want 1", len(f.Properties))
+	}
+	for _, key := range []string{
+		_PKEY_APPID,
+		"{9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3} 5",
+		"{9F4C2855-9F79-4B39-A8D0-E1D42DE1D5F3} 5",
+	} {
+		p, ok := f.Property(key)
+		if !ok {
+			t.Errorf("%s: not found", key)
+			continue
+		}
+		if s, _ := p.Str(); s != "b" {
+			t.Errorf("%s: got %q", key, s)
+		}
+	}
+	for _, key := range []string{"", "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3", _PKEY_APPID + "0"} {
+		if _, ok := f.Property(key); ok {
+			t.Errorf("%q: unexpectedly found", key)
+		}
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	f := New(`\\server\share\foo.exe`)
+	f.IdList = IdList{{1, 2, 3}}
+	f.Name = "name"
+	f.Arguments = "args"
+
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	noExtra := buf.Len() - 4 // TerminalBlock
+
+	f.Environment = `%TEMP%\foo.exe`
+	f.SetProperty(NewPropertyStr(_PKEY_APPID, "MyApp"))
+	f.Tracker = &Tracker{MachineId: "pc"}
+	buf.Reset()
+	if err := f.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	// Anything cut before the ExtraData is invalid.
+	for n := 0; n < noExtra; n++ {
+		if _, err := Read(bytes.NewReader(data[:n])); !errors.Is(err, ErrFormat) {
+			t.Errorf("cut at %d: got %v, want ErrFormat", n, err)
+		}
+	}
+
+	// Cut ExtraData must never panic, and blocks cut in the middle are
+	// invalid; a missing TerminalBlock is tolerated.
+	blockEnds := map[int]bool{noExtra: true}
+	for pos := noExtra; pos+4 <= len(data); {
+		size := int(data[pos]) | int(data[pos+1])<<8 | int(data[pos+2])<<16 | int(data[pos+3])<<24
+		if size < 4 {
+			break
+		}
+		pos += size
+		blockEnds[pos] = true
+	}
+	for n := noExtra; n < len(data); n++ {
+		_, err := Read(bytes.NewReader(data[:n]))
+		if blockEnds[n] && err != nil {
+			t.Errorf("cut at block end %d: unexpected error %v", n, err)
+		} else if !blockEnds[n] && !isBlockEndPlus(blockEnds, n) &&
+			!errors.Is(err, ErrFormat) {
+			t.Errorf("cut at %d: got %v, want ErrFormat", n, err)
+		}
+	}
+}
+
+// Tells whether n is less than 4 bytes after a block end, where the remaining
+// bytes are too few to hold a BlockSize, and are taken as the TerminalBlock.
+func isBlockEndPlus(blockEnds map[int]bool, n int) bool {
+	for i := 1; i < 4; i++ {
+		if blockEnds[n-i] {
+			return true
+		}
+	}
+	return false
+}
+
+func TestInvalidHeader(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New(`C:\a.exe`).Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	badSize := append([]byte{}, data...)
+	badSize[0] = 0x4d
+	badClsid := append([]byte{}, data...)
+	badClsid[4] ^= 0xff
+
+	for name, d := range map[string][]byte{
+		"empty":      {},
+		"bad size":   badSize,
+		"bad CLSID":  badClsid,
+		"not a link": []byte("this is not a shell link file at all, but a long text string......"),
+	} {
+		if _, err := Read(bytes.NewReader(d)); !errors.Is(err, ErrFormat) {
+			t.Errorf("%s: got %v, want ErrFormat", name, err)
+		}
+	}
+}
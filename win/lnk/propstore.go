@@ -0,0 +1,322 @@
+package lnk
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// Version of the Serialized Property Storage, "1SPS".
+const _PROPSTORE_VERSION = 0x5350_5331
+
+// FMTID of the property storage whose properties are identified by name.
+const FMTID_STRING_NAMED = "d5cdd505-2e9c-101b-9397-08002b2cf9ae"
+
+// Type of a property value, the same values of automco.VT.
+type VT uint16
+
+const (
+	VT_EMPTY    VT = 0
+	VT_I4       VT = 3
+	VT_BSTR     VT = 8
+	VT_BOOL     VT = 11
+	VT_UI4      VT = 19
+	VT_UI8      VT = 21
+	VT_LPWSTR   VT = 31
+	VT_FILETIME VT = 64
+	VT_CLSID    VT = 72
+)
+
+// A property of the PropertyStoreDataBlock of a .lnk file, like
+// System.AppUserModel.ID.
+type Property struct {
+	FormatId string // FMTID of the property set, like "9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3".
+	Id       uint32 // Property identifier; zero for named properties.
+	Name     string // Property name; only within FMTID_STRING_NAMED.
+	Type     VT     // Type of the value.
+	Data     []byte // Serialized value, after the type and its padding.
+}
+
+// Creates a VT_LPWSTR property. The key has the "fmtid pid" format, the same
+// of shellco.PKEY.
+//
+// Panics if the key is malformed.
+//
+// Example:
+//
+//	var f *lnk.File // initialized somewhere
+//
+//	f.SetProperty(lnk.NewPropertyStr(
+//		"9f4c2855-9f79-4b39-a8d0-e1d42de1d5f3 5", // PKEY_AppUserModel_ID
+//		"MyCompany.MyApp"))
+func NewPropertyStr(pkey, value string) Property {
+	str16 := append(utf16.Encode([]rune(value)), 0)
+	data := binary.LittleEndian.AppendUint32(nil, uint32(len(str16)))
+	for _, ch := range str16 {
+		data = binary.LittleEndian.AppendUint16(data, ch)
+	}
+	return newProperty(pkey, VT_LPWSTR, pad4(data))
+}
+
+// Creates a VT_UI4 property. The key has the "fmtid pid" format, the same of
+// shellco.PKEY.
+//
+// Panics if the key is malformed.
+func NewPropertyUint32(pkey string, value uint32) Property {
+	return newProperty(pkey, VT_UI4, binary.LittleEndian.AppendUint32(nil, value))
+}
+
+// Creates a VT_BOOL property. The key has the "fmtid pid" format, the same of
+// shellco.PKEY.
+//
+// Panics if the key is malformed.
+func NewPropertyBool(pkey string, value bool) Property {
+	data := []byte{0, 0, 0, 0} // VARIANT_FALSE, padded
+	if value {
+		data[0], data[1] = 0xff, 0xff // VARIANT_TRUE
+	}
+	return newProperty(pkey, VT_BOOL, data)
+}
+
+// Creates a VT_CLSID property. The key has the "fmtid pid" format, the same of
+// shellco.PKEY.
+//
+// Panics if the key or the GUID are malformed.
+func NewPropertyGuid(pkey, guid string) Property {
+	data, err := guidToBytes(guid)
+	if err != nil {
+		panic(err)
+	}
+	return newProperty(pkey, VT_CLSID, data)
+}
+
+func newProperty(pkey string, vt VT, data []byte) Property {
+	fmtId, pid, ok := strings.Cut(pkey, " ")
+	if _, err := guidToBytes(fmtId); !ok || err != nil {
+		panic(fmt.Sprintf("Malformed PKEY: %s", pkey))
+	}
+	id, err := strconv.ParseUint(pid, 10, 32)
+	if err != nil {
+		panic(fmt.Sprintf("Malformed PKEY: %s", pkey))
+	}
+	return Property{
+		FormatId: strings.ToLower(strings.Trim(fmtId, "{}")),
+		Id:       uint32(id),
+		Type:     vt,
+		Data:     data,
+	}
+}
+
+// Returns the key of the property in the "fmtid pid" format, the same of
+// shellco.PKEY.
+func (p *Property) Key() string {
+	return fmt.Sprintf("%s %d", p.FormatId, p.Id)
+}
+
+// Returns the value of a VT_LPWSTR or VT_BSTR property.
+func (p *Property) Str() (string, bool) {
+	if len(p.Data) < 4 {
+		return "", false
+	}
+	size := int(binary.LittleEndian.Uint32(p.Data))
+	switch p.Type {
+	case VT_LPWSTR: // size in chars
+		if size > (len(p.Data)-4)/2 {
+			return "", false
+		}
+		return decodeUtf16(p.Data[4 : 4+size*2]), true
+	case VT_BSTR: // size in bytes
+		if size > len(p.Data)-4 {
+			return "", false
+		}
+		return decodeUtf16(p.Data[4 : 4+size]), true
+	}
+	return "", false
+}
+
+// Returns the value of a VT_UI4 or VT_I4 property.
+func (p *Property) Uint32() (uint32, bool) {
+	if (p.Type != VT_UI4 && p.Type != VT_I4) || len(p.Data) < 4 {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(p.Data), true
+}
+
+// Returns the value of a VT_BOOL property.
+func (p *Property) Bool() (bool, bool) {
+	if p.Type != VT_BOOL || len(p.Data) < 2 {
+		return false, false
+	}
+	return binary.LittleEndian.Uint16(p.Data) != 0, true
+}
+
+// Returns the value of a VT_CLSID property.
+func (p *Property) Guid() (string, bool) {
+	if p.Type != VT_CLSID || len(p.Data) < 16 {
+		return "", false
+	}
+	return guidFromBytes(p.Data), true
+}
+
+// Returns the property with the given key, in the "fmtid pid" format, the same
+// of shellco.PKEY. The FMTID may be enclosed in braces.
+func (f *File) Property(pkey string) (*Property, bool) {
+	fmtId, pid, ok := strings.Cut(pkey, " ")
+	id, err := strconv.ParseUint(pid, 10, 32)
+	if !ok || err != nil {
+		return nil, false
+	}
+	fmtId = strings.Trim(fmtId, "{}")
+
+	for i := range f.Properties {
+		p := &f.Properties[i]
+		if p.Name == "" && p.Id == uint32(id) &&
+			strings.EqualFold(p.FormatId, fmtId) {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// Adds the property, replacing any other with the same key.
+func (f *File) SetProperty(prop Property) {
+	for i := range f.Properties {
+		p := &f.Properties[i]
+		if strings.EqualFold(p.FormatId, prop.FormatId) &&
+			p.Id == prop.Id && p.Name == prop.Name {
+			*p = prop
+			return
+		}
+	}
+	f.Properties = append(f.Properties, prop)
+}
+
+// Reads the serialized property storages of a PropertyStoreDataBlock.
+func readPropertyStore(block []byte) ([]Property, error) {
+	le := binary.LittleEndian
+	var props []Property
+
+	for len(block) >= 4 {
+		size := le.Uint32(block)
+		if size == 0 {
+			break // terminal storage
+		}
+		if size < 28 || size > uint32(len(block)) ||
+			le.Uint32(block[4:]) != _PROPSTORE_VERSION {
+			return nil, ErrFormat
+		}
+		fmtId := guidFromBytes(block[8:])
+		isNamed := fmtId == FMTID_STRING_NAMED
+		values := block[24:size]
+		block = block[size:]
+
+		for len(values) >= 4 {
+			valSize := le.Uint32(values)
+			if valSize == 0 {
+				break // terminal value
+			}
+			if valSize < 13 || valSize > uint32(len(values)) {
+				return nil, ErrFormat
+			}
+			val := values[:valSize:valSize]
+			values = values[valSize:]
+
+			prop := Property{FormatId: fmtId}
+			typed := val[9:] // after ValueSize, Id or NameSize, and Reserved
+			if isNamed {
+				nameSize := le.Uint32(val[4:])
+				if nameSize > uint32(len(typed)) {
+					return nil, ErrFormat
+				}
+				prop.Name = decodeUtf16(typed[:nameSize])
+				typed = typed[nameSize:]
+			} else {
+				prop.Id = le.Uint32(val[4:])
+			}
+
+			if len(typed) < 4 {
+				return nil, ErrFormat
+			}
+			prop.Type = VT(le.Uint16(typed))
+			prop.Data = typed[4:] // after the type and its padding
+			props = append(props, prop)
+		}
+	}
+	return props, nil
+}
+
+// Serializes the properties as a PropertyStoreDataBlock contents, grouping
+// them in storages by FormatId.
+func propertyStoreBytes(props []Property) ([]byte, error) {
+	le := binary.LittleEndian
+	var fmtIds []string // in order of first appearance
+	for i := range props {
+		fmtId := strings.ToLower(props[i].FormatId)
+		found := false
+		for _, other := range fmtIds {
+			if other == fmtId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			fmtIds = append(fmtIds, fmtId)
+		}
+	}
+
+	var data []byte
+	for _, fmtId := range fmtIds {
+		fmtIdBytes, err := guidToBytes(fmtId)
+		if err != nil {
+			return nil, err
+		}
+		isNamed := fmtId == FMTID_STRING_NAMED
+
+		storage := make([]byte, 8, 64)
+		le.PutUint32(storage[4:], _PROPSTORE_VERSION)
+		storage = append(storage, fmtIdBytes...)
+
+		for i := range props {
+			prop := &props[i]
+			if strings.ToLower(prop.FormatId) != fmtId {
+				continue
+			}
+			if isNamed != (prop.Name != "") {
+				return nil, errors.New("lnk: only properties of FMTID_STRING_NAMED have names")
+			}
+
+			var name []byte
+			if isNamed {
+				name = encodeUtf16z(prop.Name)
+			}
+			valSize := 9 + len(name) + 4 + len(prop.Data)
+			storage = le.AppendUint32(storage, uint32(valSize))
+			if isNamed {
+				storage = le.AppendUint32(storage, uint32(len(name)))
+			} else {
+				storage = le.AppendUint32(storage, prop.Id)
+			}
+			storage = append(storage, 0) // reserved
+			storage = append(storage, name...)
+			storage = le.AppendUint16(storage, uint16(prop.Type))
+			storage = append(storage, 0, 0) // padding
+			storage = append(storage, prop.Data...)
+		}
+
+		storage = append(storage, 0, 0, 0, 0) // terminal value
+		le.PutUint32(storage, uint32(len(storage)))
+		data = append(data, storage...)
+	}
+	return append(data, 0, 0, 0, 0), nil // terminal storage
+}
+
+// Pads the data with zeros to a multiple of 4 bytes.
+func pad4(data []byte) []byte {
+	for len(data)%4 != 0 {
+		data = append(data, 0)
+	}
+	return data
+}
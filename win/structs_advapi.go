@@ -0,0 +1,417 @@
+//go:build windows
+
+package win
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// [ACL] struct header; the ACEs follow it in memory.
+//
+// Always allocated in Go memory, by functions like SetEntriesInAcl().
+//
+// [ACL]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-acl
+type ACL struct {
+	AclRevision uint8
+	sbz1        uint8
+	AclSize     uint16
+	AceCount    uint16
+	sbz2        uint16
+}
+
+// Copies an ACL allocated by the system into Go memory.
+func _AclClone(pAcl *ACL) *ACL {
+	if pAcl == nil {
+		return nil
+	}
+	buf := make([]uint32, (uint32(pAcl.AclSize)+3)/4) // aligned to 4 bytes
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&buf[0])), pAcl.AclSize),
+		unsafe.Slice((*byte)(unsafe.Pointer(pAcl)), pAcl.AclSize))
+	return (*ACL)(unsafe.Pointer(&buf[0]))
+}
+
+// [CREDENTIAL] struct, in native memory layout.
+//
+// [CREDENTIAL]: https://learn.microsoft.com/en-us/windows/win32/api/wincred/ns-wincred-credentialw
+type _CREDENTIAL struct {
+	Flags              co.CRED_FLAGS
+	Type               co.CRED_TYPE
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        FILETIME
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            co.CRED_PERSIST
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// A credential stored in the Credential Manager, with all its data copied into
+// Go memory.
+//
+// Used with CredWrite(), CredRead() and CredEnumerate().
+type Credential struct {
+	Type        co.CRED_TYPE
+	TargetName  string
+	Comment     string
+	LastWritten time.Time // Ignored by CredWrite().
+	Blob        []byte    // The secret itself; for domain passwords, a UTF-16 string.
+	Persist     co.CRED_PERSIST
+	TargetAlias string
+	UserName    string
+	Flags       co.CRED_FLAGS
+}
+
+// Copies a CREDENTIAL allocated by the system into Go memory.
+func _CredentialClone(pCred *_CREDENTIAL) Credential {
+	var blob []byte
+	if pCred.CredentialBlobSize > 0 {
+		blob = make([]byte, pCred.CredentialBlobSize)
+		copy(blob, unsafe.Slice(pCred.CredentialBlob, pCred.CredentialBlobSize))
+	}
+	return Credential{
+		Type:        pCred.Type,
+		TargetName:  Str.FromNativePtr(pCred.TargetName),
+		Comment:     Str.FromNativePtr(pCred.Comment),
+		LastWritten: pCred.LastWritten.ToTime(),
+		Blob:        blob,
+		Persist:     pCred.Persist,
+		TargetAlias: Str.FromNativePtr(pCred.TargetAlias),
+		UserName:    Str.FromNativePtr(pCred.UserName),
+		Flags:       pCred.Flags,
+	}
+}
+
+// [EXPLICIT_ACCESS] struct.
+//
+// Example:
+//
+//	var sidUsers *win.SID // initialized somewhere
+//
+//	ea := win.EXPLICIT_ACCESS{
+//		GrfAccessPermissions: uint32(co.GENERIC_READ),
+//		GrfAccessMode:        co.ACCESS_MODE_GRANT,
+//		GrfInheritance:       co.ACE_NO_INHERITANCE,
+//	}
+//	ea.Trustee.SetSid(sidUsers, co.TRUSTEE_TYPE_IS_WELL_KNOWN_GROUP)
+//
+// [EXPLICIT_ACCESS]: https://learn.microsoft.com/en-us/windows/win32/api/accctrl/ns-accctrl-explicit_access_w
+type EXPLICIT_ACCESS struct {
+	GrfAccessPermissions uint32
+	GrfAccessMode        co.ACCESS_MODE
+	GrfInheritance       co.ACE
+	Trustee              TRUSTEE
+}
+
+// [LUID] struct.
+//
+// [LUID]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-luid
+type LUID struct {
+	LowPart  uint32
+	HighPart int32
+}
+
+// [LUID_AND_ATTRIBUTES] struct.
+//
+// [LUID_AND_ATTRIBUTES]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-luid_and_attributes
+type LUID_AND_ATTRIBUTES struct {
+	Luid       LUID
+	Attributes co.SE_PRIVILEGE
+}
+
+// [QUERY_SERVICE_CONFIG] struct.
+//
+// [QUERY_SERVICE_CONFIG]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-query_service_configw
+type QUERY_SERVICE_CONFIG struct {
+	DwServiceType      co.SERVICE_TYPE
+	DwStartType        co.SERVICE_START_TYPE
+	DwErrorControl     co.SERVICE_ERROR
+	LpBinaryPathName   *uint16
+	LpLoadOrderGroup   *uint16
+	DwTagId            uint32
+	LpDependencies     *uint16
+	LpServiceStartName *uint16
+	LpDisplayName      *uint16
+}
+
+// [SC_ACTION] struct.
+//
+// [SC_ACTION]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-sc_action
+type SC_ACTION struct {
+	Type  co.SC_ACTION_TYPE
+	Delay uint32 // In milliseconds.
+}
+
+// [SECURITY_DESCRIPTOR] struct header, always in self-relative format; the
+// owner, group and ACLs follow it in memory.
+//
+// Always allocated in Go memory, by functions like
+// ConvertStringSecurityDescriptorToSecurityDescriptor().
+//
+// [SECURITY_DESCRIPTOR]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-security_descriptor
+type SECURITY_DESCRIPTOR struct {
+	Revision uint8
+	sbz1     uint8
+	Control  uint16
+}
+
+// [GetSecurityDescriptorLength] function.
+//
+// [GetSecurityDescriptorLength]: https://learn.microsoft.com/en-us/windows/win32/api/securitybaseapi/nf-securitybaseapi-getsecuritydescriptorlength
+func (sd *SECURITY_DESCRIPTOR) GetSecurityDescriptorLength() uint32 {
+	ret, _, _ := syscall.SyscallN(proc.GetSecurityDescriptorLength.Addr(),
+		uintptr(unsafe.Pointer(sd)))
+	return uint32(ret)
+}
+
+// Copies a self-relative security descriptor allocated by the system into Go
+// memory.
+func _SecurityDescriptorClone(pSd *SECURITY_DESCRIPTOR) *SECURITY_DESCRIPTOR {
+	sdLen := pSd.GetSecurityDescriptorLength()
+	buf := make([]uint64, (sdLen+7)/8) // aligned to 8 bytes
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&buf[0])), sdLen),
+		unsafe.Slice((*byte)(unsafe.Pointer(pSd)), sdLen))
+	return (*SECURITY_DESCRIPTOR)(unsafe.Pointer(&buf[0]))
+}
+
+// Absolute security descriptor, used to build a self-relative one.
+type _SECURITY_DESCRIPTOR_ABSOLUTE struct {
+	revision uint8
+	sbz1     uint8
+	control  uint16
+	owner    *SID
+	group    *SID
+	sacl     *ACL
+	dacl     *ACL
+}
+
+// [SERVICE_DELAYED_AUTO_START_INFO] struct.
+//
+// [SERVICE_DELAYED_AUTO_START_INFO]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_delayed_auto_start_info
+type SERVICE_DELAYED_AUTO_START_INFO struct {
+	fDelayedAutostart int32 // BOOL
+}
+
+func (dasi *SERVICE_DELAYED_AUTO_START_INFO) FDelayedAutostart() bool {
+	return dasi.fDelayedAutostart != 0
+}
+func (dasi *SERVICE_DELAYED_AUTO_START_INFO) SetFDelayedAutostart(val bool) {
+	dasi.fDelayedAutostart = util.BoolToInt32(val)
+}
+
+// [SERVICE_DESCRIPTION] struct.
+//
+// [SERVICE_DESCRIPTION]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_descriptionw
+type SERVICE_DESCRIPTION struct {
+	LpDescription *uint16
+}
+
+// [SERVICE_FAILURE_ACTIONS] struct.
+//
+// [SERVICE_FAILURE_ACTIONS]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_failure_actionsw
+type SERVICE_FAILURE_ACTIONS struct {
+	DwResetPeriod uint32 // In seconds; INFINITE means never reset.
+	LpRebootMsg   *uint16
+	LpCommand     *uint16
+	cActions      uint32
+	lpsaActions   *SC_ACTION
+}
+
+func (sfa *SERVICE_FAILURE_ACTIONS) LpsaActions() []SC_ACTION {
+	if sfa.cActions == 0 {
+		return nil
+	}
+	return unsafe.Slice(sfa.lpsaActions, sfa.cActions)
+}
+func (sfa *SERVICE_FAILURE_ACTIONS) SetLpsaActions(val []SC_ACTION) {
+	sfa.cActions = uint32(len(val))
+	sfa.lpsaActions = nil
+	if len(val) > 0 {
+		sfa.lpsaActions = &val[0]
+	}
+}
+
+// [SERVICE_FAILURE_ACTIONS_FLAG] struct.
+//
+// [SERVICE_FAILURE_ACTIONS_FLAG]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_failure_actions_flag
+type SERVICE_FAILURE_ACTIONS_FLAG struct {
+	fFailureActionsOnNonCrashFailures int32 // BOOL
+}
+
+func (faf *SERVICE_FAILURE_ACTIONS_FLAG) FFailureActionsOnNonCrashFailures() bool {
+	return faf.fFailureActionsOnNonCrashFailures != 0
+}
+func (faf *SERVICE_FAILURE_ACTIONS_FLAG) SetFFailureActionsOnNonCrashFailures(val bool) {
+	faf.fFailureActionsOnNonCrashFailures = util.BoolToInt32(val)
+}
+
+// [SERVICE_STATUS] struct.
+//
+// [SERVICE_STATUS]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_status
+type SERVICE_STATUS struct {
+	DwServiceType             co.SERVICE_TYPE
+	DwCurrentState            co.SERVICE_STATE
+	DwControlsAccepted        co.SERVICE_ACCEPT
+	DwWin32ExitCode           uint32
+	DwServiceSpecificExitCode uint32
+	DwCheckPoint              uint32
+	DwWaitHint                uint32
+}
+
+// [SERVICE_STATUS_PROCESS] struct.
+//
+// [SERVICE_STATUS_PROCESS]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_status_process
+type SERVICE_STATUS_PROCESS struct {
+	DwServiceType             co.SERVICE_TYPE
+	DwCurrentState            co.SERVICE_STATE
+	DwControlsAccepted        co.SERVICE_ACCEPT
+	DwWin32ExitCode           uint32
+	DwServiceSpecificExitCode uint32
+	DwCheckPoint              uint32
+	DwWaitHint                uint32
+	DwProcessId               uint32
+	DwServiceFlags            uint32
+}
+
+// [SERVICE_TABLE_ENTRY] struct.
+//
+// [SERVICE_TABLE_ENTRY]: https://learn.microsoft.com/en-us/windows/win32/api/winsvc/ns-winsvc-service_table_entryw
+type _SERVICE_TABLE_ENTRY struct {
+	lpServiceName *uint16
+	lpServiceProc uintptr
+}
+
+// [SID] struct, with a variable number of subauthorities.
+//
+// Always allocated in Go memory, by functions like ConvertStringSidToSid().
+//
+// [SID]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-sid
+type SID struct {
+	Revision            uint8
+	subAuthorityCount   uint8
+	IdentifierAuthority [6]uint8
+	subAuthority        [1]uint32 // actually variable-length
+}
+
+// Returns the subauthorities, the last one usually being the relative
+// identifier (RID).
+func (sid *SID) SubAuthorities() []uint32 {
+	return unsafe.Slice(&sid.subAuthority[0], sid.subAuthorityCount)
+}
+
+// Returns a copy of the SID, allocated in Go memory.
+func (sid *SID) Clone() *SID {
+	sidLen := sid.GetLengthSid()
+	buf := make([]uint32, (sidLen+3)/4) // aligned to 4 bytes
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&buf[0])), sidLen),
+		unsafe.Slice((*byte)(unsafe.Pointer(sid)), sidLen))
+	return (*SID)(unsafe.Pointer(&buf[0]))
+}
+
+// [EqualSid] function.
+//
+// [EqualSid]: https://learn.microsoft.com/en-us/windows/win32/api/securitybaseapi/nf-securitybaseapi-equalsid
+func (sid *SID) EqualSid(other *SID) bool {
+	ret, _, _ := syscall.SyscallN(proc.EqualSid.Addr(),
+		uintptr(unsafe.Pointer(sid)), uintptr(unsafe.Pointer(other)))
+	return ret != 0
+}
+
+// [GetLengthSid] function.
+//
+// [GetLengthSid]: https://learn.microsoft.com/en-us/windows/win32/api/securitybaseapi/nf-securitybaseapi-getlengthsid
+func (sid *SID) GetLengthSid() uint32 {
+	ret, _, _ := syscall.SyscallN(proc.GetLengthSid.Addr(),
+		uintptr(unsafe.Pointer(sid)))
+	return uint32(ret)
+}
+
+// [IsValidSid] function.
+//
+// [IsValidSid]: https://learn.microsoft.com/en-us/windows/win32/api/securitybaseapi/nf-securitybaseapi-isvalidsid
+func (sid *SID) IsValidSid() bool {
+	ret, _, _ := syscall.SyscallN(proc.IsValidSid.Addr(),
+		uintptr(unsafe.Pointer(sid)))
+	return ret != 0
+}
+
+// [IsWellKnownSid] function.
+//
+// [IsWellKnownSid]: https://learn.microsoft.com/en-us/windows/win32/api/securitybaseapi/nf-securitybaseapi-iswellknownsid
+func (sid *SID) IsWellKnownSid(wellKnownSid co.WELL_KNOWN_SID) bool {
+	ret, _, _ := syscall.SyscallN(proc.IsWellKnownSid.Addr(),
+		uintptr(unsafe.Pointer(sid)), uintptr(wellKnownSid))
+	return ret != 0
+}
+
+// Implements fmt.Stringer, returning the SID in the S-R-I-S-S… format, the
+// same of [ConvertSidToStringSid].
+//
+// [ConvertSidToStringSid]: https://learn.microsoft.com/en-us/windows/win32/api/sddl/nf-sddl-convertsidtostringsidw
+func (sid *SID) String() string {
+	auth := sid.IdentifierAuthority
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "S-%d-", sid.Revision)
+	if auth[0] == 0 && auth[1] == 0 {
+		fmt.Fprintf(&sb, "%d", binary.BigEndian.Uint32(auth[2:]))
+	} else {
+		fmt.Fprintf(&sb, "0x%02X%02X%02X%02X%02X%02X",
+			auth[0], auth[1], auth[2], auth[3], auth[4], auth[5])
+	}
+	for _, subAuth := range sid.SubAuthorities() {
+		fmt.Fprintf(&sb, "-%d", subAuth)
+	}
+	return sb.String()
+}
+
+// [SID_AND_ATTRIBUTES] struct.
+//
+// [SID_AND_ATTRIBUTES]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-sid_and_attributes
+type SID_AND_ATTRIBUTES struct {
+	Sid        *SID
+	Attributes co.SE_GROUP
+}
+
+// [TRUSTEE] struct.
+//
+// [TRUSTEE]: https://learn.microsoft.com/en-us/windows/win32/api/accctrl/ns-accctrl-trustee_w
+type TRUSTEE struct {
+	pMultipleTrustee         *TRUSTEE
+	multipleTrusteeOperation uint32
+	TrusteeForm              co.TRUSTEE_FORM
+	TrusteeType              co.TRUSTEE_TYPE
+	ptstrName                unsafe.Pointer // LPWSTR or PSID, according to TrusteeForm
+}
+
+// Sets the trustee as a name, like "DOMAIN\\User" or "CURRENT_USER".
+func (t *TRUSTEE) SetName(name []uint16, trusteeType co.TRUSTEE_TYPE) {
+	t.TrusteeForm = co.TRUSTEE_FORM_IS_NAME
+	t.TrusteeType = trusteeType
+	t.ptstrName = unsafe.Pointer(&name[0])
+}
+
+// Sets the trustee as a SID.
+func (t *TRUSTEE) SetSid(sid *SID, trusteeType co.TRUSTEE_TYPE) {
+	t.TrusteeForm = co.TRUSTEE_FORM_IS_SID
+	t.TrusteeType = trusteeType
+	t.ptstrName = unsafe.Pointer(sid)
+}
+
+// [WTSSESSION_NOTIFICATION] struct.
+//
+// [WTSSESSION_NOTIFICATION]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-wtssession_notification
+type WTSSESSION_NOTIFICATION struct {
+	cbSize      uint32
+	DwSessionId uint32
+}
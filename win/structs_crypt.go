@@ -0,0 +1,36 @@
+//go:build windows
+
+package win
+
+import (
+	"unsafe"
+)
+
+// [DATA_BLOB] struct.
+//
+// [DATA_BLOB]: https://learn.microsoft.com/en-us/previous-versions/windows/desktop/legacy/aa381414(v=vs.85)
+type _DATA_BLOB struct {
+	cbData uint32
+	pbData *byte
+}
+
+// Points the blob to the given slice, which must outlive the blob.
+func (db *_DATA_BLOB) SetData(data []byte) {
+	db.cbData = uint32(len(data))
+	if len(data) > 0 {
+		db.pbData = &data[0]
+	} else {
+		db.pbData = nil
+	}
+}
+
+// Returns a copy of the data pointed by the blob.
+func (db *_DATA_BLOB) Data() []byte {
+	if db.cbData == 0 {
+		return []byte{}
+	}
+	src := unsafe.Slice(db.pbData, db.cbData)
+	buf := make([]byte, len(src))
+	copy(buf, src)
+	return buf
+}
@@ -3,11 +3,14 @@
 package win
 
 import (
+	"syscall"
 	"time"
 	"unsafe"
 
+	"github.com/rodrigocfd/windigo/internal/proc"
 	"github.com/rodrigocfd/windigo/internal/util"
 	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
 )
 
 // [CONSOLE_CURSOR_INFO] struct.
@@ -122,6 +125,78 @@ func (ft *FILETIME) FromTime(val time.Time) {
 	)
 }
 
+// [IO_COUNTERS] struct.
+//
+// [IO_COUNTERS]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-io_counters
+type IO_COUNTERS struct {
+	ReadOperationCount  uint64
+	WriteOperationCount uint64
+	OtherOperationCount uint64
+	ReadTransferCount   uint64
+	WriteTransferCount  uint64
+	OtherTransferCount  uint64
+}
+
+// [JOBOBJECT_ASSOCIATE_COMPLETION_PORT] struct.
+//
+// [JOBOBJECT_ASSOCIATE_COMPLETION_PORT]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_associate_completion_port
+type JOBOBJECT_ASSOCIATE_COMPLETION_PORT struct {
+	CompletionKey  uintptr
+	CompletionPort HIOCP
+}
+
+// [JOBOBJECT_BASIC_ACCOUNTING_INFORMATION] struct.
+//
+// [JOBOBJECT_BASIC_ACCOUNTING_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_basic_accounting_information
+type JOBOBJECT_BASIC_ACCOUNTING_INFORMATION struct {
+	TotalUserTime             int64 // In 100-nanoseconds unit.
+	TotalKernelTime           int64 // In 100-nanoseconds unit.
+	ThisPeriodTotalUserTime   int64 // In 100-nanoseconds unit.
+	ThisPeriodTotalKernelTime int64 // In 100-nanoseconds unit.
+	TotalPageFaultCount       uint32
+	TotalProcesses            uint32
+	ActiveProcesses           uint32
+	TotalTerminatedProcesses  uint32
+}
+
+// [JOBOBJECT_CPU_RATE_CONTROL_INFORMATION] struct.
+//
+// [JOBOBJECT_CPU_RATE_CONTROL_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_cpu_rate_control_information
+type JOBOBJECT_CPU_RATE_CONTROL_INFORMATION struct {
+	ControlFlags co.JOB_OBJECT_CPU_RATE_CONTROL
+	data         uint32 // union
+}
+
+// Portion of processor cycles, times 100; 10000 means 100%.
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) CpuRate() uint32       { return cr.data }
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) SetCpuRate(val uint32) { cr.data = val }
+
+// Scheduling weight, from 1 to 9.
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) Weight() uint32       { return cr.data }
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) SetWeight(val uint32) { cr.data = val }
+
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) MinRate() uint16 { return uint16(cr.data) }
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) SetMinRate(val uint16) {
+	cr.data = util.Make32(val, cr.MaxRate())
+}
+
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) MaxRate() uint16 { return uint16(cr.data >> 16) }
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) SetMaxRate(val uint16) {
+	cr.data = util.Make32(cr.MinRate(), val)
+}
+
+// [JOBOBJECT_EXTENDED_LIMIT_INFORMATION] struct.
+//
+// [JOBOBJECT_EXTENDED_LIMIT_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_extended_limit_information
+type JOBOBJECT_EXTENDED_LIMIT_INFORMATION struct {
+	BasicLimitInformation JOBOBJECT_BASIC_LIMIT_INFORMATION
+	IoInfo                IO_COUNTERS
+	ProcessMemoryLimit    uintptr
+	JobMemoryLimit        uintptr
+	PeakProcessMemoryUsed uintptr
+	PeakJobMemoryUsed     uintptr
+}
+
 // [MODULEENTRY32] struct.
 //
 // ⚠️ You must call SetDwSize() to initialize the struct.
@@ -225,6 +300,94 @@ type PROCESS_INFORMATION struct {
 	DwThreadId  uint32
 }
 
+// [PROC_THREAD_ATTRIBUTE_LIST] opaque struct, with its memory managed by Go.
+//
+// Created with InitializeProcThreadAttributeList().
+//
+// ⚠️ You must defer PROC_THREAD_ATTRIBUTE_LIST.DeleteProcThreadAttributeList().
+//
+// [PROC_THREAD_ATTRIBUTE_LIST]: https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-initializeprocthreadattributelist
+type PROC_THREAD_ATTRIBUTE_LIST struct {
+	buf  []uintptr        // opaque native memory, pointer-aligned
+	vals []unsafe.Pointer // attribute values must stay alive while the list is used
+}
+
+// [InitializeProcThreadAttributeList] function.
+//
+// ⚠️ You must defer PROC_THREAD_ATTRIBUTE_LIST.DeleteProcThreadAttributeList().
+//
+// [InitializeProcThreadAttributeList]: https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-initializeprocthreadattributelist
+func InitializeProcThreadAttributeList(
+	attributeCount int) (*PROC_THREAD_ATTRIBUTE_LIST, error) {
+
+	var size uintptr
+	syscall.SyscallN(proc.InitializeProcThreadAttributeList.Addr(),
+		0, uintptr(attributeCount), 0, uintptr(unsafe.Pointer(&size)))
+
+	al := &PROC_THREAD_ATTRIBUTE_LIST{
+		buf: make([]uintptr, (size+unsafe.Sizeof(uintptr(0))-1)/unsafe.Sizeof(uintptr(0))),
+	}
+	ret, _, err := syscall.SyscallN(proc.InitializeProcThreadAttributeList.Addr(),
+		al.ptr(), uintptr(attributeCount), 0, uintptr(unsafe.Pointer(&size)))
+	if ret == 0 {
+		return nil, errco.ERROR(err)
+	}
+	return al, nil
+}
+
+// [DeleteProcThreadAttributeList] function.
+//
+// [DeleteProcThreadAttributeList]: https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-deleteprocthreadattributelist
+func (al *PROC_THREAD_ATTRIBUTE_LIST) DeleteProcThreadAttributeList() {
+	if al.buf != nil {
+		syscall.SyscallN(proc.DeleteProcThreadAttributeList.Addr(),
+			al.ptr())
+		al.buf, al.vals = nil, nil
+	}
+}
+
+// [UpdateProcThreadAttribute] function.
+//
+// The value is kept alive until DeleteProcThreadAttributeList() is called.
+//
+// [UpdateProcThreadAttribute]: https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-updateprocthreadattribute
+func (al *PROC_THREAD_ATTRIBUTE_LIST) UpdateProcThreadAttribute(
+	attribute co.PROC_THREAD_ATTRIBUTE, value unsafe.Pointer, size uintptr) error {
+
+	ret, _, err := syscall.SyscallN(proc.UpdateProcThreadAttribute.Addr(),
+		al.ptr(), 0, uintptr(attribute), uintptr(value), size, 0, 0)
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	al.vals = append(al.vals, value)
+	return nil
+}
+
+// Sets the PROC_THREAD_ATTRIBUTE_HANDLE_LIST attribute, which restricts the
+// handles inherited by the child process to the given ones.
+//
+// Returns errco.INVALID_PARAMETER if no handles are passed.
+func (al *PROC_THREAD_ATTRIBUTE_LIST) UpdateHandleList(handles []HANDLE) error {
+	if len(handles) == 0 {
+		return errco.INVALID_PARAMETER
+	}
+	buf := append([]HANDLE{}, handles...) // copy, so the caller can reuse the slice
+	return al.UpdateProcThreadAttribute(co.PROC_THREAD_ATTRIBUTE_HANDLE_LIST,
+		unsafe.Pointer(&buf[0]), uintptr(len(buf))*unsafe.Sizeof(buf[0]))
+}
+
+// Sets the PROC_THREAD_ATTRIBUTE_PARENT_PROCESS attribute, so the child
+// process is created as a child of the given process.
+func (al *PROC_THREAD_ATTRIBUTE_LIST) UpdateParentProcess(hProcess HPROCESS) error {
+	buf := &hProcess
+	return al.UpdateProcThreadAttribute(co.PROC_THREAD_ATTRIBUTE_PARENT_PROCESS,
+		unsafe.Pointer(buf), unsafe.Sizeof(*buf))
+}
+
+func (al *PROC_THREAD_ATTRIBUTE_LIST) ptr() uintptr {
+	return uintptr(unsafe.Pointer(&al.buf[0]))
+}
+
 // [SECURITY_ATTRIBUTES] struct.
 //
 // ⚠️ You must call SetNLength() to initialize the struct.
@@ -241,6 +404,14 @@ func (sa *SECURITY_ATTRIBUTES) SetNLength() { sa.nLength = uint32(unsafe.Sizeof(
 func (sa *SECURITY_ATTRIBUTES) BInheritHandle() bool       { return sa.bInheritHandle != 0 }
 func (sa *SECURITY_ATTRIBUTES) SetBInheritHandle(val bool) { sa.bInheritHandle = util.BoolToInt32(val) }
 
+// Sets LpSecurityDescriptor.
+//
+// ⚠️ The SECURITY_DESCRIPTOR must be kept alive while the SECURITY_ATTRIBUTES
+// is in use.
+func (sa *SECURITY_ATTRIBUTES) SetSecurityDescriptor(sd *SECURITY_DESCRIPTOR) {
+	sa.LpSecurityDescriptor = uintptr(unsafe.Pointer(sd))
+}
+
 // [STARTUPINFO] struct.
 //
 // ⚠️ You must call SetCb() to initialize the struct.
@@ -269,6 +440,26 @@ type STARTUPINFO struct {
 
 func (si *STARTUPINFO) SetCb() { si.cb = uint32(unsafe.Sizeof(*si)) }
 
+// [STARTUPINFOEX] struct.
+//
+// ⚠️ You must call SetCb() to initialize the struct.
+//
+// [STARTUPINFOEX]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/ns-winbase-startupinfoexw
+type STARTUPINFOEX struct {
+	StartupInfo     STARTUPINFO
+	lpAttributeList unsafe.Pointer // PPROC_THREAD_ATTRIBUTE_LIST
+}
+
+func (si *STARTUPINFOEX) SetCb() { si.StartupInfo.cb = uint32(unsafe.Sizeof(*si)) }
+
+func (si *STARTUPINFOEX) SetLpAttributeList(val *PROC_THREAD_ATTRIBUTE_LIST) {
+	if val == nil {
+		si.lpAttributeList = nil
+	} else {
+		si.lpAttributeList = unsafe.Pointer(&val.buf[0])
+	}
+}
+
 // [SYSTEM_INFO] struct.
 //
 // [SYSTEM_INFO]: https://docs.microsoft.com/en-us/windows/win32/api/sysinfoapi/ns-sysinfoapi-system_info
@@ -0,0 +1,23 @@
+//go:build windows
+
+package win
+
+import (
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// [JOBOBJECT_BASIC_LIMIT_INFORMATION] struct.
+//
+// [JOBOBJECT_BASIC_LIMIT_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_basic_limit_information
+type JOBOBJECT_BASIC_LIMIT_INFORMATION struct {
+	PerProcessUserTimeLimit int64 // In 100-nanoseconds unit.
+	PerJobUserTimeLimit     int64 // In 100-nanoseconds unit.
+	LimitFlags              co.JOB_OBJECT_LIMIT
+	MinimumWorkingSetSize   uintptr
+	MaximumWorkingSetSize   uintptr
+	ActiveProcessLimit      uint32
+	Affinity                uintptr
+	PriorityClass           uint32
+	SchedulingClass         uint32
+	padding                 uint32 // 8-byte alignment of LARGE_INTEGER members
+}
@@ -53,6 +53,40 @@ func (nid *NOTIFYICONDATA) SetSzInfoTitle(val string) {
 	copy(nid.szInfoTitle[:], Str.ToNativeSlice(Str.Substr(val, 0, len(nid.szInfoTitle)-1)))
 }
 
+// [SHELLEXECUTEINFO] struct.
+//
+// ⚠️ You must call SetCbSize() to initialize the struct.
+//
+// Example:
+//
+//	sei := &SHELLEXECUTEINFO{}
+//	sei.SetCbSize()
+//	sei.FMask = co.SEE_MASK_NOCLOSEPROCESS
+//	sei.LpVerb = Str.ToNativePtr("open")
+//	sei.LpFile = Str.ToNativePtr("C:\\Temp\\foo.txt")
+//	sei.NShow = co.SW_SHOWNORMAL
+//
+// [SHELLEXECUTEINFO]: https://docs.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shellexecuteinfow
+type SHELLEXECUTEINFO struct {
+	cbSize         uint32
+	FMask          co.SEE_MASK
+	Hwnd           HWND
+	LpVerb         *uint16
+	LpFile         *uint16
+	LpParameters   *uint16
+	LpDirectory    *uint16
+	NShow          co.SW
+	HInstApp       HINSTANCE
+	LpIDList       uintptr
+	LpClass        *uint16
+	HkeyClass      HKEY
+	DwHotKey       uint32
+	HIconOrMonitor HANDLE // union
+	HProcess       HPROCESS
+}
+
+func (sei *SHELLEXECUTEINFO) SetCbSize() { sei.cbSize = uint32(unsafe.Sizeof(*sei)) }
+
 // [SHFILEINFO] struct.
 //
 // [SHFILEINFO]: https://docs.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shfileinfow
@@ -498,6 +498,20 @@ type TITLEBARINFOEX struct {
 
 func (tix *TITLEBARINFOEX) SetCbSize() { tix.cbSize = uint32(unsafe.Sizeof(*tix)) }
 
+// [TRACKMOUSEEVENT] struct.
+//
+// ⚠️ You must call SetCbSize() to initialize the struct.
+//
+// [TRACKMOUSEEVENT]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-trackmouseevent
+type TRACKMOUSEEVENT struct {
+	cbSize      uint32
+	DwFlags     co.TME
+	HwndTrack   HWND
+	DwHoverTime uint32 // HOVER_DEFAULT is 0xffff_ffff.
+}
+
+func (tme *TRACKMOUSEEVENT) SetCbSize() { tme.cbSize = uint32(unsafe.Sizeof(*tme)) }
+
 // [WINDOWPOS] struct.
 //
 // [WINDOWPOS]: https://docs.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-windowpos
@@ -0,0 +1,180 @@
+//go:build windows
+
+package win
+
+import (
+	"math"
+	"time"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// [EVT_VARIANT] struct.
+//
+// Returned by HEVT.EvtRenderValues(), already decoded.
+//
+// [EVT_VARIANT]: https://learn.microsoft.com/en-us/windows/win32/api/winevt/ns-winevt-evt_variant
+type _EVT_VARIANT struct {
+	value uint64 // union
+	Count uint32
+	Type  co.EVT_VAR_TYPE
+}
+
+// Decodes the value into a Go type, copying any pointed data into Go memory.
+//
+// Arrays are returned as []any.
+func (v *_EVT_VARIANT) Value() any {
+	baseType := v.Type & co.EVT_VAR_TYPE_MASK
+	if (v.Type & co.EVT_VAR_TYPE_ARRAY) == 0 {
+		return _EvtDecodeElem(baseType, unsafe.Pointer(&v.value), false)
+	}
+
+	// Arrays of GUID and SYSTEMTIME store the structs themselves; all other
+	// arrays store the elements as they would be stored in the union.
+	pArr := *(*unsafe.Pointer)(unsafe.Pointer(&v.value))
+	elemSz, inline := _EvtElemSize(baseType)
+	vals := make([]any, 0, v.Count)
+	for i := uint32(0); i < v.Count; i++ {
+		vals = append(vals, _EvtDecodeElem(baseType,
+			unsafe.Add(pArr, uintptr(i)*elemSz), inline))
+	}
+	return vals
+}
+
+func _EvtElemSize(t co.EVT_VAR_TYPE) (sz uintptr, inline bool) {
+	switch t {
+	case co.EVT_VAR_TYPE_SBYTE, co.EVT_VAR_TYPE_BYTE:
+		return 1, false
+	case co.EVT_VAR_TYPE_INT16, co.EVT_VAR_TYPE_UINT16:
+		return 2, false
+	case co.EVT_VAR_TYPE_INT32, co.EVT_VAR_TYPE_UINT32, co.EVT_VAR_TYPE_SINGLE,
+		co.EVT_VAR_TYPE_BOOLEAN, co.EVT_VAR_TYPE_HEX_INT32:
+		return 4, false
+	case co.EVT_VAR_TYPE_INT64, co.EVT_VAR_TYPE_UINT64, co.EVT_VAR_TYPE_DOUBLE,
+		co.EVT_VAR_TYPE_FILETIME, co.EVT_VAR_TYPE_HEX_INT64:
+		return 8, false
+	case co.EVT_VAR_TYPE_GUID:
+		return unsafe.Sizeof(GUID{}), true
+	case co.EVT_VAR_TYPE_SYSTIME:
+		return unsafe.Sizeof(SYSTEMTIME{}), true
+	default: // strings, SIDs, handles and size_t
+		return unsafe.Sizeof(uintptr(0)), false
+	}
+}
+
+// Decodes a single element; if inline, p points to the struct itself,
+// otherwise to a pointer to it.
+func _EvtDecodeElem(t co.EVT_VAR_TYPE, p unsafe.Pointer, inline bool) any {
+	deref := func() unsafe.Pointer {
+		if inline {
+			return p
+		}
+		return *(*unsafe.Pointer)(p)
+	}
+
+	switch t {
+	case co.EVT_VAR_TYPE_NULL:
+		return nil
+	case co.EVT_VAR_TYPE_STRING, co.EVT_VAR_TYPE_EVT_XML:
+		return Str.FromNativePtr((*uint16)(deref()))
+	case co.EVT_VAR_TYPE_ANSI_STRING:
+		pStr := (*byte)(deref())
+		if pStr == nil {
+			return ""
+		}
+		n := 0
+		for *(*byte)(unsafe.Add(unsafe.Pointer(pStr), n)) != 0 {
+			n++
+		}
+		return string(unsafe.Slice(pStr, n))
+	case co.EVT_VAR_TYPE_SBYTE:
+		return *(*int8)(p)
+	case co.EVT_VAR_TYPE_BYTE:
+		return *(*uint8)(p)
+	case co.EVT_VAR_TYPE_INT16:
+		return *(*int16)(p)
+	case co.EVT_VAR_TYPE_UINT16:
+		return *(*uint16)(p)
+	case co.EVT_VAR_TYPE_INT32:
+		return *(*int32)(p)
+	case co.EVT_VAR_TYPE_UINT32, co.EVT_VAR_TYPE_HEX_INT32:
+		return *(*uint32)(p)
+	case co.EVT_VAR_TYPE_INT64:
+		return *(*int64)(p)
+	case co.EVT_VAR_TYPE_UINT64, co.EVT_VAR_TYPE_HEX_INT64:
+		return *(*uint64)(p)
+	case co.EVT_VAR_TYPE_SINGLE:
+		return math.Float32frombits(*(*uint32)(p))
+	case co.EVT_VAR_TYPE_DOUBLE:
+		return math.Float64frombits(*(*uint64)(p))
+	case co.EVT_VAR_TYPE_BOOLEAN:
+		return *(*uint32)(p) != 0
+	case co.EVT_VAR_TYPE_SIZET, co.EVT_VAR_TYPE_EVT_HANDLE:
+		return *(*uintptr)(p)
+	case co.EVT_VAR_TYPE_GUID:
+		return *(*GUID)(deref())
+	case co.EVT_VAR_TYPE_FILETIME:
+		var ft FILETIME
+		ft.SetEpochNano100(*(*uint64)(p))
+		return ft.ToTime()
+	case co.EVT_VAR_TYPE_SYSTIME:
+		return (*SYSTEMTIME)(deref()).ToTime()
+	case co.EVT_VAR_TYPE_SID:
+		if pSid := (*SID)(deref()); pSid != nil {
+			return pSid.Clone()
+		}
+		return (*SID)(nil)
+	default:
+		return nil
+	}
+}
+
+// System section of an event, returned by HEVT.EvtRenderSystem().
+type _EvtSystem struct {
+	ProviderName  string
+	ProviderGuid  GUID
+	EventId       uint16
+	Qualifiers    uint16
+	Level         co.EVT_LEVEL
+	Task          uint16
+	Opcode        uint8
+	Keywords      uint64
+	TimeCreated   time.Time
+	EventRecordId uint64
+	ProcessId     uint32
+	ThreadId      uint32
+	Channel       string
+	Computer      string
+	UserId        *SID // May be nil.
+	Version       uint8
+}
+
+// Fills the struct with the values rendered by EVT_RENDER_CONTEXT_SYSTEM;
+// missing values are left as zero.
+func (me *_EvtSystem) fromValues(vals []any) {
+	get := func(id co.EVT_SYSTEM) any {
+		if int(id) < len(vals) {
+			return vals[id]
+		}
+		return nil
+	}
+
+	me.ProviderName, _ = get(co.EVT_SYSTEM_PROVIDER_NAME).(string)
+	me.ProviderGuid, _ = get(co.EVT_SYSTEM_PROVIDER_GUID).(GUID)
+	me.EventId, _ = get(co.EVT_SYSTEM_EVENT_ID).(uint16)
+	me.Qualifiers, _ = get(co.EVT_SYSTEM_QUALIFIERS).(uint16)
+	level, _ := get(co.EVT_SYSTEM_LEVEL).(uint8)
+	me.Level = co.EVT_LEVEL(level)
+	me.Task, _ = get(co.EVT_SYSTEM_TASK).(uint16)
+	me.Opcode, _ = get(co.EVT_SYSTEM_OPCODE).(uint8)
+	me.Keywords, _ = get(co.EVT_SYSTEM_KEYWORDS).(uint64)
+	me.TimeCreated, _ = get(co.EVT_SYSTEM_TIME_CREATED).(time.Time)
+	me.EventRecordId, _ = get(co.EVT_SYSTEM_EVENT_RECORD_ID).(uint64)
+	me.ProcessId, _ = get(co.EVT_SYSTEM_PROCESS_ID).(uint32)
+	me.ThreadId, _ = get(co.EVT_SYSTEM_THREAD_ID).(uint32)
+	me.Channel, _ = get(co.EVT_SYSTEM_CHANNEL).(string)
+	me.Computer, _ = get(co.EVT_SYSTEM_COMPUTER).(string)
+	me.UserId, _ = get(co.EVT_SYSTEM_USER_ID).(*SID)
+	me.Version, _ = get(co.EVT_SYSTEM_VERSION).(uint8)
+}
@@ -0,0 +1,226 @@
+// Package toast builds the XML content of [toast notifications], with the
+// ToastGeneric template: adaptive texts, images, groups, inputs, actions and
+// audio.
+//
+// This package has no Windows dependencies, so it can be used on any OS. To
+// display a toast, see ui.Toast.
+//
+// Example:
+//
+//	t := &toast.Toast{
+//		Launch: "action=open&id=42",
+//		Texts: []toast.Text{
+//			{Text: "New message"},
+//			{Text: "Are we still on for lunch?"},
+//		},
+//		Inputs: []toast.Input{
+//			{Id: "reply", Type: toast.INPUT_TEXT, PlaceHolder: "Type a reply"},
+//		},
+//		Actions: []toast.Action{
+//			{Content: "Send", Arguments: "action=reply&id=42", InputId: "reply"},
+//		},
+//	}
+//
+//	xmlStr, err := t.Xml()
+//
+// [toast notifications]: https://docs.microsoft.com/en-us/windows/apps/design/shell/tiles-and-notifications/toast-schema
+package toast
+
+import (
+	"errors"
+	"time"
+)
+
+// Returned, wrapped, when the toast content doesn't follow the schema.
+var ErrInvalid = errors.New("toast: invalid content")
+
+// Toast activationType, also used by actions.
+type ACTIVATION string
+
+const (
+	ACTIVATION_DEFAULT    ACTIVATION = ""
+	ACTIVATION_FOREGROUND ACTIVATION = "foreground"
+	ACTIVATION_BACKGROUND ACTIVATION = "background"
+	ACTIVATION_PROTOCOL   ACTIVATION = "protocol"
+)
+
+// Action hint-buttonStyle.
+type BUTTON_STYLE string
+
+const (
+	BUTTON_STYLE_DEFAULT  BUTTON_STYLE = ""
+	BUTTON_STYLE_SUCCESS  BUTTON_STYLE = "Success"
+	BUTTON_STYLE_CRITICAL BUTTON_STYLE = "Critical"
+)
+
+// Toast duration.
+type DURATION string
+
+const (
+	DURATION_DEFAULT DURATION = ""
+	DURATION_SHORT   DURATION = "short"
+	DURATION_LONG    DURATION = "long"
+)
+
+// Image hint-crop.
+type IMAGE_CROP string
+
+const (
+	IMAGE_CROP_DEFAULT IMAGE_CROP = ""
+	IMAGE_CROP_NONE    IMAGE_CROP = "none"
+	IMAGE_CROP_CIRCLE  IMAGE_CROP = "circle"
+)
+
+// Input type.
+type INPUT_TYPE string
+
+const (
+	INPUT_TEXT      INPUT_TYPE = "text"
+	INPUT_SELECTION INPUT_TYPE = "selection"
+)
+
+// Toast scenario.
+type SCENARIO string
+
+const (
+	SCENARIO_DEFAULT       SCENARIO = ""
+	SCENARIO_REMINDER      SCENARIO = "reminder"
+	SCENARIO_ALARM         SCENARIO = "alarm"
+	SCENARIO_INCOMING_CALL SCENARIO = "incomingCall"
+	SCENARIO_URGENT        SCENARIO = "urgent"
+)
+
+// Text hint-align, supported only inside groups.
+type TEXT_ALIGN string
+
+const (
+	TEXT_ALIGN_DEFAULT TEXT_ALIGN = ""
+	TEXT_ALIGN_AUTO    TEXT_ALIGN = "auto"
+	TEXT_ALIGN_LEFT    TEXT_ALIGN = "left"
+	TEXT_ALIGN_CENTER  TEXT_ALIGN = "center"
+	TEXT_ALIGN_RIGHT   TEXT_ALIGN = "right"
+)
+
+// Subgroup hint-textStacking.
+type TEXT_STACKING string
+
+const (
+	TEXT_STACKING_DEFAULT TEXT_STACKING = ""
+	TEXT_STACKING_TOP     TEXT_STACKING = "top"
+	TEXT_STACKING_CENTER  TEXT_STACKING = "center"
+	TEXT_STACKING_BOTTOM  TEXT_STACKING = "bottom"
+)
+
+// Text hint-style, supported only inside groups.
+//
+// 📑 https://docs.microsoft.com/en-us/windows/apps/design/shell/tiles-and-notifications/adaptive-interactive-toasts#text-styling
+type TEXT_STYLE string
+
+const (
+	TEXT_STYLE_DEFAULT           TEXT_STYLE = ""
+	TEXT_STYLE_CAPTION           TEXT_STYLE = "caption"
+	TEXT_STYLE_CAPTION_SUBTLE    TEXT_STYLE = "captionSubtle"
+	TEXT_STYLE_BODY              TEXT_STYLE = "body"
+	TEXT_STYLE_BODY_SUBTLE       TEXT_STYLE = "bodySubtle"
+	TEXT_STYLE_BASE              TEXT_STYLE = "base"
+	TEXT_STYLE_BASE_SUBTLE       TEXT_STYLE = "baseSubtle"
+	TEXT_STYLE_SUBTITLE          TEXT_STYLE = "subtitle"
+	TEXT_STYLE_SUBTITLE_SUBTLE   TEXT_STYLE = "subtitleSubtle"
+	TEXT_STYLE_TITLE             TEXT_STYLE = "title"
+	TEXT_STYLE_TITLE_SUBTLE      TEXT_STYLE = "titleSubtle"
+	TEXT_STYLE_TITLE_NUMERAL     TEXT_STYLE = "titleNumeral"
+	TEXT_STYLE_SUBHEADER         TEXT_STYLE = "subheader"
+	TEXT_STYLE_SUBHEADER_SUBTLE  TEXT_STYLE = "subheaderSubtle"
+	TEXT_STYLE_SUBHEADER_NUMERAL TEXT_STYLE = "subheaderNumeral"
+	TEXT_STYLE_HEADER            TEXT_STYLE = "header"
+	TEXT_STYLE_HEADER_SUBTLE     TEXT_STYLE = "headerSubtle"
+	TEXT_STYLE_HEADER_NUMERAL    TEXT_STYLE = "headerNumeral"
+)
+
+// The content of a toast notification. Call Toast.Xml() to validate it and
+// generate the XML.
+type Toast struct {
+	Launch           string     // Arguments passed to the activator when the toast body is clicked.
+	ActivationType   ACTIVATION // What happens when the toast body is clicked.
+	Duration         DURATION   // How long the toast is shown.
+	Scenario         SCENARIO   // Reminders, alarms and incoming calls stay on screen until dismissed.
+	DisplayTimestamp time.Time  // Replaces the time the toast was delivered. Zero means not set.
+
+	Texts       []Text  // Between 1 and 3 texts; the first one is the title.
+	Attribution string  // Small text shown below the other texts. Optional.
+	AppLogo     *Image  // Replaces the app logo. Optional.
+	Hero        *Image  // Large image shown at the top. Optional.
+	Images      []Image // Inline images, shown after the texts.
+	Groups      []Group // Adaptive columns, shown after the images.
+
+	Inputs  []Input  // Up to 5 inputs.
+	Actions []Action // Up to 5 actions, including the context menu ones.
+	Audio   *Audio   // Replaces the default sound. Optional.
+}
+
+// A text element. The hints other than MaxLines are supported only inside
+// groups.
+type Text struct {
+	Text     string
+	Style    TEXT_STYLE
+	Align    TEXT_ALIGN
+	Wrap     bool
+	MaxLines int // Zero means not set.
+	MinLines int // Zero means not set.
+}
+
+// An image element. The src can be an absolute file path, or an URI with the
+// file, http, https, ms-appx or ms-appdata schemes.
+type Image struct {
+	Src          string
+	Alt          string
+	Crop         IMAGE_CROP
+	RemoveMargin bool // Supported only inside groups.
+}
+
+// A row of subgroups, which are displayed as columns.
+type Group struct {
+	Subgroups []Subgroup // Between 1 and 5 subgroups.
+}
+
+// A column inside a group.
+type Subgroup struct {
+	Weight       int // Relative width, between 1 and 100. Zero means not set.
+	TextStacking TEXT_STACKING
+	Texts        []Text
+	Images       []Image
+}
+
+// A text box or a combo box, whose value is passed to the activator.
+type Input struct {
+	Id           string      // Key of the value passed to the activator.
+	Type         INPUT_TYPE  // Text box or combo box.
+	Title        string      // Shown above the input. Optional.
+	PlaceHolder  string      // Shown in an empty text box. Optional.
+	DefaultInput string      // Text, or the Id of the selection. Optional.
+	Selections   []Selection // Between 1 and 5 choices, for INPUT_SELECTION only.
+}
+
+// A choice of an INPUT_SELECTION input.
+type Selection struct {
+	Id      string // Value passed to the activator.
+	Content string // Text shown to the user.
+}
+
+// A button, or a context menu item.
+type Action struct {
+	Content        string       // Button text.
+	Arguments      string       // Passed to the activator when clicked.
+	ActivationType ACTIVATION   // What happens when clicked.
+	ImageUri       string       // Button icon. Optional.
+	InputId        string       // Id of a text input, so the button is placed next to it. Optional.
+	ContextMenu    bool         // Shows the action in the context menu, instead of a button.
+	ButtonStyle    BUTTON_STYLE // Colors the button green or red.
+}
+
+// The sound played when the toast is shown.
+type Audio struct {
+	Src    string // Like "ms-winsoundevent:Notification.Reminder". Empty means the default sound.
+	Loop   bool   // Requires DURATION_LONG.
+	Silent bool
+}
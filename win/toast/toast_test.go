@@ -0,0 +1,302 @@
+package toast
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Returns a minimal valid toast, modified by fun.
+func toastWith(fun func(t *Toast)) *Toast {
+	t := &Toast{Texts: []Text{{Text: "Title"}}}
+	fun(t)
+	return t
+}
+
+func textInputs(n int) []Input {
+	inputs := make([]Input, n)
+	for i := range inputs {
+		inputs[i] = Input{Id: "in" + string(rune('a'+i)), Type: INPUT_TEXT}
+	}
+	return inputs
+}
+
+func actions(n int) []Action {
+	actions := make([]Action, n)
+	for i := range actions {
+		actions[i] = Action{Content: "Button", Arguments: "arg"}
+	}
+	return actions
+}
+
+func subgroups(n int) []Subgroup {
+	subs := make([]Subgroup, n)
+	for i := range subs {
+		subs[i] = Subgroup{Texts: []Text{{Text: "sub"}}}
+	}
+	return subs
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name  string
+		toast *Toast
+		valid bool
+	}{
+		{"minimal", toastWith(func(t *Toast) {}), true},
+		{"no texts", toastWith(func(t *Toast) { t.Texts = nil }), false},
+		{"3 texts", toastWith(func(t *Toast) {
+			t.Texts = []Text{{Text: "a"}, {Text: "b"}, {Text: "c"}}
+		}), true},
+		{"4 texts", toastWith(func(t *Toast) {
+			t.Texts = []Text{{Text: "a"}, {Text: "b"}, {Text: "c"}, {Text: "d"}}
+		}), false},
+		{"empty title", toastWith(func(t *Toast) { t.Texts[0].Text = "" }), false},
+		{"top-level text style", toastWith(func(t *Toast) {
+			t.Texts[0].Style = TEXT_STYLE_BASE
+		}), false},
+		{"top-level max lines", toastWith(func(t *Toast) { t.Texts[0].MaxLines = 2 }), true},
+		{"negative max lines", toastWith(func(t *Toast) { t.Texts[0].MaxLines = -1 }), false},
+		{"unknown scenario", toastWith(func(t *Toast) { t.Scenario = "party" }), false},
+		{"unknown duration", toastWith(func(t *Toast) { t.Duration = "forever" }), false},
+
+		{"5 inputs", toastWith(func(t *Toast) { t.Inputs = textInputs(5) }), true},
+		{"6 inputs", toastWith(func(t *Toast) { t.Inputs = textInputs(6) }), false},
+		{"duplicate input ids", toastWith(func(t *Toast) {
+			t.Inputs = textInputs(2)
+			t.Inputs[1].Id = t.Inputs[0].Id
+		}), false},
+		{"empty input id", toastWith(func(t *Toast) {
+			t.Inputs = []Input{{Type: INPUT_TEXT}}
+		}), false},
+		{"unknown input type", toastWith(func(t *Toast) {
+			t.Inputs = []Input{{Id: "a", Type: "slider"}}
+		}), false},
+		{"selection input", toastWith(func(t *Toast) {
+			t.Inputs = []Input{{Id: "a", Type: INPUT_SELECTION, DefaultInput: "y",
+				Selections: []Selection{{"x", "X"}, {"y", "Y"}}}}
+		}), true},
+		{"selection without selections", toastWith(func(t *Toast) {
+			t.Inputs = []Input{{Id: "a", Type: INPUT_SELECTION}}
+		}), false},
+		{"selection with unknown default", toastWith(func(t *Toast) {
+			t.Inputs = []Input{{Id: "a", Type: INPUT_SELECTION, DefaultInput: "z",
+				Selections: []Selection{{"x", "X"}}}}
+		}), false},
+		{"duplicate selection ids", toastWith(func(t *Toast) {
+			t.Inputs = []Input{{Id: "a", Type: INPUT_SELECTION,
+				Selections: []Selection{{"x", "X"}, {"x", "Y"}}}}
+		}), false},
+		{"text input with selections", toastWith(func(t *Toast) {
+			t.Inputs = []Input{{Id: "a", Type: INPUT_TEXT,
+				Selections: []Selection{{"x", "X"}}}}
+		}), false},
+
+		{"5 actions", toastWith(func(t *Toast) { t.Actions = actions(5) }), true},
+		{"6 actions", toastWith(func(t *Toast) { t.Actions = actions(6) }), false},
+		{"action without content", toastWith(func(t *Toast) {
+			t.Actions = []Action{{Arguments: "a"}}
+		}), false},
+		{"action with unknown input", toastWith(func(t *Toast) {
+			t.Actions = []Action{{Content: "Send", Arguments: "a", InputId: "reply"}}
+		}), false},
+		{"action with text input", toastWith(func(t *Toast) {
+			t.Inputs = []Input{{Id: "reply", Type: INPUT_TEXT}}
+			t.Actions = []Action{{Content: "Send", Arguments: "a", InputId: "reply"}}
+		}), true},
+		{"unknown button style", toastWith(func(t *Toast) {
+			t.Actions = []Action{{Content: "Ok", Arguments: "a", ButtonStyle: "Blue"}}
+		}), false},
+
+		{"5 subgroups", toastWith(func(t *Toast) {
+			t.Groups = []Group{{Subgroups: subgroups(5)}}
+		}), true},
+		{"6 subgroups", toastWith(func(t *Toast) {
+			t.Groups = []Group{{Subgroups: subgroups(6)}}
+		}), false},
+		{"no subgroups", toastWith(func(t *Toast) { t.Groups = []Group{{}} }), false},
+		{"subgroup weight too large", toastWith(func(t *Toast) {
+			t.Groups = []Group{{Subgroups: []Subgroup{{Weight: 101}}}}
+		}), false},
+		{"subgroup text hints", toastWith(func(t *Toast) {
+			t.Groups = []Group{{Subgroups: []Subgroup{{Texts: []Text{{
+				Text: "a", Style: TEXT_STYLE_CAPTION_SUBTLE, Align: TEXT_ALIGN_RIGHT,
+				Wrap: true, MinLines: 1, MaxLines: 2}}}}}}
+		}), true},
+		{"subgroup min lines above max", toastWith(func(t *Toast) {
+			t.Groups = []Group{{Subgroups: []Subgroup{{Texts: []Text{{
+				Text: "a", MinLines: 3, MaxLines: 2}}}}}}
+		}), false},
+
+		{"hero", toastWith(func(t *Toast) { t.Hero = &Image{Src: "ms-appx:///a.png"} }), true},
+		{"hero crop", toastWith(func(t *Toast) {
+			t.Hero = &Image{Src: "ms-appx:///a.png", Crop: IMAGE_CROP_CIRCLE}
+		}), false},
+		{"app logo crop", toastWith(func(t *Toast) {
+			t.AppLogo = &Image{Src: "ms-appx:///a.png", Crop: IMAGE_CROP_CIRCLE}
+		}), true},
+		{"image without source", toastWith(func(t *Toast) { t.Images = []Image{{}} }), false},
+		{"top-level image remove margin", toastWith(func(t *Toast) {
+			t.Images = []Image{{Src: "a.png", RemoveMargin: true}}
+		}), false},
+
+		{"looping audio", toastWith(func(t *Toast) {
+			t.Duration = DURATION_LONG
+			t.Audio = &Audio{Src: "ms-winsoundevent:Notification.Looping.Alarm", Loop: true}
+		}), true},
+		{"looping audio on short toast", toastWith(func(t *Toast) {
+			t.Audio = &Audio{Loop: true}
+		}), false},
+		{"audio from file", toastWith(func(t *Toast) {
+			t.Audio = &Audio{Src: `C:\Windows\Media\ding.wav`}
+		}), false},
+	}
+
+	for _, c := range cases {
+		err := c.toast.Validate()
+		if c.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		} else if !c.valid && !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: got %v, want ErrInvalid", c.name, err)
+		}
+	}
+}
+
+func TestXmlInvalid(t *testing.T) {
+	xml, err := (&Toast{}).Xml()
+	if xml != "" || !errors.Is(err, ErrInvalid) {
+		t.Errorf("got %q, %v", xml, err)
+	}
+}
+
+func TestXmlMinimal(t *testing.T) {
+	const golden = `<toast><visual><binding template="ToastGeneric">` +
+		`<text>Hello</text>` +
+		`</binding></visual></toast>`
+
+	xml, err := toastWith(func(t *Toast) { t.Texts[0].Text = "Hello" }).Xml()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != golden {
+		t.Errorf("got\n%s\nwant\n%s", xml, golden)
+	}
+}
+
+func TestXmlEscaping(t *testing.T) {
+	const golden = `<toast launch="a=1&amp;b=&lt;2&gt;&amp;c=&#34;x&#34;">` +
+		`<visual><binding template="ToastGeneric">` +
+		`<text>&lt;script&gt;alert(&#39;x&#39;)&lt;/script&gt; &amp; more</text>` +
+		`<text>line 1&#xA;line 2</text>` +
+		`</binding></visual>` +
+		`<actions>` +
+		`<action content="Yes &amp; no" arguments="k=&#34;v&#34;"></action>` +
+		`</actions></toast>`
+
+	xml, err := (&Toast{
+		Launch: `a=1&b=<2>&c="x"`,
+		Texts: []Text{
+			{Text: "<script>alert('x')</script> & more"},
+			{Text: "line 1\nline 2"},
+		},
+		Actions: []Action{{Content: "Yes & no", Arguments: `k="v"`}},
+	}).Xml()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != golden {
+		t.Errorf("got\n%s\nwant\n%s", xml, golden)
+	}
+}
+
+func TestXmlFull(t *testing.T) {
+	golden := strings.Join([]string{
+		`<toast launch="open" activationType="foreground" duration="long" scenario="reminder" displayTimestamp="2020-01-02T03:04:05Z" useButtonStyle="true">`,
+		`<visual><binding template="ToastGeneric">`,
+		`<text>Title</text>`,
+		`<text hint-maxLines="2">Body</text>`,
+		`<text placement="attribution">via Go</text>`,
+		`<image placement="appLogoOverride" src="file:///C:/Temp/a%20b.png" hint-crop="circle"></image>`,
+		`<image placement="hero" src="file://server/share/hero.png"></image>`,
+		`<image src="ms-appx:///inline.png" alt="Inline"></image>`,
+		`<group>`,
+		`<subgroup hint-weight="1" hint-textStacking="center">`,
+		`<text hint-style="captionSubtle" hint-align="right" hint-wrap="true" hint-maxLines="3" hint-minLines="1">Sub</text>`,
+		`<image src="https://example.com/i.png" hint-removeMargin="true"></image>`,
+		`</subgroup>`,
+		`<subgroup hint-weight="2"><text>Other</text></subgroup>`,
+		`</group>`,
+		`</binding></visual>`,
+		`<actions>`,
+		`<input id="reply" type="text" title="Reply" placeHolderContent="Type here"></input>`,
+		`<input id="snooze" type="selection" defaultInput="15">`,
+		`<selection id="5" content="5 minutes"></selection>`,
+		`<selection id="15" content="15 minutes"></selection>`,
+		`</input>`,
+		`<action content="Send" arguments="send" activationType="background" imageUri="ms-appx:///send.png" hint-inputId="reply" hint-buttonStyle="Success"></action>`,
+		`<action content="Settings" arguments="settings" placement="contextMenu"></action>`,
+		`</actions>`,
+		`<audio src="ms-winsoundevent:Notification.Looping.Alarm" loop="true"></audio>`,
+		`</toast>`,
+	}, "")
+
+	xml, err := (&Toast{
+		Launch:           "open",
+		ActivationType:   ACTIVATION_FOREGROUND,
+		Duration:         DURATION_LONG,
+		Scenario:         SCENARIO_REMINDER,
+		DisplayTimestamp: time.Date(2020, 1, 2, 0, 4, 5, 0, time.FixedZone("", -3*60*60)),
+		Texts:            []Text{{Text: "Title"}, {Text: "Body", MaxLines: 2}},
+		Attribution:      "via Go",
+		AppLogo:          &Image{Src: `C:\Temp\a b.png`, Crop: IMAGE_CROP_CIRCLE},
+		Hero:             &Image{Src: `\\server\share\hero.png`},
+		Images:           []Image{{Src: "ms-appx:///inline.png", Alt: "Inline"}},
+		Groups: []Group{{Subgroups: []Subgroup{
+			{
+				Weight:       1,
+				TextStacking: TEXT_STACKING_CENTER,
+				Texts: []Text{{Text: "Sub", Style: TEXT_STYLE_CAPTION_SUBTLE,
+					Align: TEXT_ALIGN_RIGHT, Wrap: true, MaxLines: 3, MinLines: 1}},
+				Images: []Image{{Src: "https://example.com/i.png", RemoveMargin: true}},
+			},
+			{Weight: 2, Texts: []Text{{Text: "Other"}}},
+		}}},
+		Inputs: []Input{
+			{Id: "reply", Type: INPUT_TEXT, Title: "Reply", PlaceHolder: "Type here"},
+			{Id: "snooze", Type: INPUT_SELECTION, DefaultInput: "15",
+				Selections: []Selection{{"5", "5 minutes"}, {"15", "15 minutes"}}},
+		},
+		Actions: []Action{
+			{Content: "Send", Arguments: "send", ActivationType: ACTIVATION_BACKGROUND,
+				ImageUri: "ms-appx:///send.png", InputId: "reply",
+				ButtonStyle: BUTTON_STYLE_SUCCESS},
+			{Content: "Settings", Arguments: "settings", ContextMenu: true},
+		},
+		Audio: &Audio{Src: "ms-winsoundevent:Notification.Looping.Alarm", Loop: true},
+	}).Xml()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != golden {
+		t.Errorf("got\n%s\nwant\n%s", xml, golden)
+	}
+}
+
+func TestXmlSilentAudio(t *testing.T) {
+	const golden = `<toast><visual><binding template="ToastGeneric">` +
+		`<text>Quiet</text>` +
+		`</binding></visual>` +
+		`<audio silent="true"></audio></toast>`
+
+	xml, err := (&Toast{
+		Texts: []Text{{Text: "Quiet"}},
+		Audio: &Audio{Silent: true},
+	}).Xml()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != golden {
+		t.Errorf("got\n%s\nwant\n%s", xml, golden)
+	}
+}
@@ -0,0 +1,240 @@
+package toast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Checks whether the toast content follows the schema, returning an error
+// which wraps ErrInvalid if it doesn't.
+//
+// Example:
+//
+//	t := &toast.Toast{} // no texts
+//
+//	if err := t.Validate(); errors.Is(err, toast.ErrInvalid) {
+//		println(err.Error())
+//	}
+func (t *Toast) Validate() error {
+	if !_IsOneOf(t.ActivationType, ACTIVATION_DEFAULT, ACTIVATION_FOREGROUND,
+		ACTIVATION_BACKGROUND, ACTIVATION_PROTOCOL) {
+		return _Invalid("unknown activation type %q", t.ActivationType)
+	}
+	if !_IsOneOf(t.Duration, DURATION_DEFAULT, DURATION_SHORT, DURATION_LONG) {
+		return _Invalid("unknown duration %q", t.Duration)
+	}
+	if !_IsOneOf(t.Scenario, SCENARIO_DEFAULT, SCENARIO_REMINDER, SCENARIO_ALARM,
+		SCENARIO_INCOMING_CALL, SCENARIO_URGENT) {
+		return _Invalid("unknown scenario %q", t.Scenario)
+	}
+
+	if len(t.Texts) == 0 || len(t.Texts) > 3 {
+		return _Invalid("must have between 1 and 3 texts, has %d", len(t.Texts))
+	}
+	for i := range t.Texts {
+		text := &t.Texts[i]
+		if text.Style != TEXT_STYLE_DEFAULT || text.Align != TEXT_ALIGN_DEFAULT ||
+			text.Wrap || text.MinLines != 0 {
+			return _Invalid("text %d: only MaxLines is supported outside groups", i)
+		}
+		if err := _ValidateText(text); err != nil {
+			return _Invalid("text %d: %s", i, err)
+		}
+	}
+	if t.Texts[0].Text == "" {
+		return _Invalid("the title text cannot be empty")
+	}
+
+	if t.AppLogo != nil {
+		if err := _ValidateImage(t.AppLogo, false); err != nil {
+			return _Invalid("app logo: %s", err)
+		}
+	}
+	if t.Hero != nil {
+		if err := _ValidateImage(t.Hero, false); err != nil {
+			return _Invalid("hero: %s", err)
+		}
+		if t.Hero.Crop != IMAGE_CROP_DEFAULT {
+			return _Invalid("hero: cannot be cropped")
+		}
+	}
+	for i := range t.Images {
+		if err := _ValidateImage(&t.Images[i], false); err != nil {
+			return _Invalid("image %d: %s", i, err)
+		}
+	}
+
+	for g, group := range t.Groups {
+		if len(group.Subgroups) == 0 || len(group.Subgroups) > 5 {
+			return _Invalid("group %d: must have between 1 and 5 subgroups, has %d",
+				g, len(group.Subgroups))
+		}
+		for s, sub := range group.Subgroups {
+			if sub.Weight < 0 || sub.Weight > 100 {
+				return _Invalid("group %d, subgroup %d: weight must be between 1 and 100, is %d",
+					g, s, sub.Weight)
+			}
+			if !_IsOneOf(sub.TextStacking, TEXT_STACKING_DEFAULT, TEXT_STACKING_TOP,
+				TEXT_STACKING_CENTER, TEXT_STACKING_BOTTOM) {
+				return _Invalid("group %d, subgroup %d: unknown text stacking %q",
+					g, s, sub.TextStacking)
+			}
+			for i := range sub.Texts {
+				if err := _ValidateText(&sub.Texts[i]); err != nil {
+					return _Invalid("group %d, subgroup %d, text %d: %s", g, s, i, err)
+				}
+			}
+			for i := range sub.Images {
+				if err := _ValidateImage(&sub.Images[i], true); err != nil {
+					return _Invalid("group %d, subgroup %d, image %d: %s", g, s, i, err)
+				}
+			}
+		}
+	}
+
+	if len(t.Inputs) > 5 {
+		return _Invalid("must have up to 5 inputs, has %d", len(t.Inputs))
+	}
+	inputTypes := make(map[string]INPUT_TYPE, len(t.Inputs))
+	for i, input := range t.Inputs {
+		if err := _ValidateInput(&input); err != nil {
+			return _Invalid("input %d: %s", i, err)
+		}
+		if _, exists := inputTypes[input.Id]; exists {
+			return _Invalid("input %d: duplicated id %q", i, input.Id)
+		}
+		inputTypes[input.Id] = input.Type
+	}
+
+	if len(t.Actions) > 5 {
+		return _Invalid("must have up to 5 actions, has %d", len(t.Actions))
+	}
+	for i, action := range t.Actions {
+		if action.Content == "" {
+			return _Invalid("action %d: content cannot be empty", i)
+		}
+		if !_IsOneOf(action.ActivationType, ACTIVATION_DEFAULT, ACTIVATION_FOREGROUND,
+			ACTIVATION_BACKGROUND, ACTIVATION_PROTOCOL) {
+			return _Invalid("action %d: unknown activation type %q", i, action.ActivationType)
+		}
+		if !_IsOneOf(action.ButtonStyle, BUTTON_STYLE_DEFAULT,
+			BUTTON_STYLE_SUCCESS, BUTTON_STYLE_CRITICAL) {
+			return _Invalid("action %d: unknown button style %q", i, action.ButtonStyle)
+		}
+		if action.InputId != "" {
+			if typ, exists := inputTypes[action.InputId]; !exists {
+				return _Invalid("action %d: unknown input id %q", i, action.InputId)
+			} else if typ != INPUT_TEXT {
+				return _Invalid("action %d: input %q is not a text input", i, action.InputId)
+			}
+			if action.ContextMenu {
+				return _Invalid("action %d: context menu items cannot have an input", i)
+			}
+		}
+	}
+
+	if t.Audio != nil {
+		if t.Audio.Src != "" &&
+			!strings.HasPrefix(t.Audio.Src, "ms-winsoundevent:") &&
+			!strings.HasPrefix(t.Audio.Src, "ms-appx:") &&
+			!strings.HasPrefix(t.Audio.Src, "ms-appdata:") {
+			return _Invalid("audio: unsupported source %q", t.Audio.Src)
+		}
+		if t.Audio.Loop && t.Duration != DURATION_LONG {
+			return _Invalid("audio: looping requires a long duration")
+		}
+	}
+
+	return nil
+}
+
+func _ValidateText(text *Text) error {
+	if !_IsOneOf(text.Style, TEXT_STYLE_DEFAULT,
+		TEXT_STYLE_CAPTION, TEXT_STYLE_CAPTION_SUBTLE,
+		TEXT_STYLE_BODY, TEXT_STYLE_BODY_SUBTLE,
+		TEXT_STYLE_BASE, TEXT_STYLE_BASE_SUBTLE,
+		TEXT_STYLE_SUBTITLE, TEXT_STYLE_SUBTITLE_SUBTLE,
+		TEXT_STYLE_TITLE, TEXT_STYLE_TITLE_SUBTLE, TEXT_STYLE_TITLE_NUMERAL,
+		TEXT_STYLE_SUBHEADER, TEXT_STYLE_SUBHEADER_SUBTLE, TEXT_STYLE_SUBHEADER_NUMERAL,
+		TEXT_STYLE_HEADER, TEXT_STYLE_HEADER_SUBTLE, TEXT_STYLE_HEADER_NUMERAL) {
+		return fmt.Errorf("unknown style %q", text.Style)
+	}
+	if !_IsOneOf(text.Align, TEXT_ALIGN_DEFAULT, TEXT_ALIGN_AUTO,
+		TEXT_ALIGN_LEFT, TEXT_ALIGN_CENTER, TEXT_ALIGN_RIGHT) {
+		return fmt.Errorf("unknown alignment %q", text.Align)
+	}
+	if text.MaxLines < 0 || text.MinLines < 0 {
+		return fmt.Errorf("line counts cannot be negative")
+	}
+	if text.MaxLines != 0 && text.MinLines > text.MaxLines {
+		return fmt.Errorf("MinLines %d is greater than MaxLines %d",
+			text.MinLines, text.MaxLines)
+	}
+	return nil
+}
+
+func _ValidateImage(img *Image, insideGroup bool) error {
+	if img.Src == "" {
+		return fmt.Errorf("source cannot be empty")
+	}
+	if !_IsOneOf(img.Crop, IMAGE_CROP_DEFAULT, IMAGE_CROP_NONE, IMAGE_CROP_CIRCLE) {
+		return fmt.Errorf("unknown crop %q", img.Crop)
+	}
+	if img.RemoveMargin && !insideGroup {
+		return fmt.Errorf("RemoveMargin is supported only inside groups")
+	}
+	return nil
+}
+
+func _ValidateInput(input *Input) error {
+	if input.Id == "" {
+		return fmt.Errorf("id cannot be empty")
+	}
+
+	switch input.Type {
+	case INPUT_TEXT:
+		if len(input.Selections) > 0 {
+			return fmt.Errorf("text inputs cannot have selections")
+		}
+	case INPUT_SELECTION:
+		if len(input.Selections) == 0 || len(input.Selections) > 5 {
+			return fmt.Errorf("must have between 1 and 5 selections, has %d",
+				len(input.Selections))
+		}
+		if input.PlaceHolder != "" {
+			return fmt.Errorf("selection inputs cannot have a placeholder")
+		}
+
+		ids := make(map[string]struct{}, len(input.Selections))
+		for i, sel := range input.Selections {
+			if sel.Id == "" {
+				return fmt.Errorf("selection %d: id cannot be empty", i)
+			}
+			if _, exists := ids[sel.Id]; exists {
+				return fmt.Errorf("selection %d: duplicated id %q", i, sel.Id)
+			}
+			ids[sel.Id] = struct{}{}
+		}
+		if _, exists := ids[input.DefaultInput]; input.DefaultInput != "" && !exists {
+			return fmt.Errorf("default input %q is not a selection id", input.DefaultInput)
+		}
+	default:
+		return fmt.Errorf("unknown type %q", input.Type)
+	}
+	return nil
+}
+
+// Returns an error wrapping ErrInvalid.
+func _Invalid(format string, a ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalid, fmt.Sprintf(format, a...))
+}
+
+// Tells whether val is one of the given values.
+func _IsOneOf[T comparable](val T, values ...T) bool {
+	for _, v := range values {
+		if val == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,247 @@
+package toast
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Validates the toast with Toast.Validate(), then generates its XML.
+//
+// Example:
+//
+//	t := &toast.Toast{
+//		Texts: []toast.Text{{Text: "Download finished"}},
+//	}
+//
+//	xmlStr, err := t.Xml()
+//	if err != nil {
+//		panic(err)
+//	}
+func (t *Toast) Xml() (string, error) {
+	if err := t.Validate(); err != nil {
+		return "", err
+	}
+
+	root := _XmlToast{
+		Launch:         t.Launch,
+		ActivationType: t.ActivationType,
+		Duration:       t.Duration,
+		Scenario:       t.Scenario,
+		Binding:        _XmlBinding{Template: "ToastGeneric"},
+	}
+	if !t.DisplayTimestamp.IsZero() {
+		root.DisplayTimestamp = t.DisplayTimestamp.UTC().Format(time.RFC3339)
+	}
+
+	for i := range t.Texts {
+		root.Binding.Texts = append(root.Binding.Texts, _XmlTextFrom(&t.Texts[i]))
+	}
+	if t.Attribution != "" {
+		root.Binding.Texts = append(root.Binding.Texts,
+			_XmlText{Placement: "attribution", Text: t.Attribution})
+	}
+	if t.AppLogo != nil {
+		img := _XmlImageFrom(t.AppLogo)
+		img.Placement = "appLogoOverride"
+		root.Binding.Images = append(root.Binding.Images, img)
+	}
+	if t.Hero != nil {
+		img := _XmlImageFrom(t.Hero)
+		img.Placement = "hero"
+		root.Binding.Images = append(root.Binding.Images, img)
+	}
+	for i := range t.Images {
+		root.Binding.Images = append(root.Binding.Images, _XmlImageFrom(&t.Images[i]))
+	}
+
+	for _, group := range t.Groups {
+		xmlGroup := _XmlGroup{}
+		for _, sub := range group.Subgroups {
+			xmlSub := _XmlSubgroup{
+				Weight:       sub.Weight,
+				TextStacking: sub.TextStacking,
+			}
+			for i := range sub.Texts {
+				xmlSub.Texts = append(xmlSub.Texts, _XmlTextFrom(&sub.Texts[i]))
+			}
+			for i := range sub.Images {
+				xmlSub.Images = append(xmlSub.Images, _XmlImageFrom(&sub.Images[i]))
+			}
+			xmlGroup.Subgroups = append(xmlGroup.Subgroups, xmlSub)
+		}
+		root.Binding.Groups = append(root.Binding.Groups, xmlGroup)
+	}
+
+	if len(t.Inputs) > 0 || len(t.Actions) > 0 {
+		root.Actions = &_XmlActions{}
+		for _, input := range t.Inputs {
+			xmlInput := _XmlInput{
+				Id:           input.Id,
+				Type:         input.Type,
+				Title:        input.Title,
+				PlaceHolder:  input.PlaceHolder,
+				DefaultInput: input.DefaultInput,
+			}
+			for _, sel := range input.Selections {
+				xmlInput.Selections = append(xmlInput.Selections,
+					_XmlSelection{Id: sel.Id, Content: sel.Content})
+			}
+			root.Actions.Inputs = append(root.Actions.Inputs, xmlInput)
+		}
+		for _, action := range t.Actions {
+			xmlAction := _XmlAction{
+				Content:        action.Content,
+				Arguments:      action.Arguments,
+				ActivationType: action.ActivationType,
+				ImageUri:       _ImageUri(action.ImageUri),
+				InputId:        action.InputId,
+				ButtonStyle:    action.ButtonStyle,
+			}
+			if action.ContextMenu {
+				xmlAction.Placement = "contextMenu"
+			}
+			if action.ButtonStyle != BUTTON_STYLE_DEFAULT {
+				root.UseButtonStyle = true
+			}
+			root.Actions.Actions = append(root.Actions.Actions, xmlAction)
+		}
+	}
+
+	if t.Audio != nil {
+		root.Audio = &_XmlAudio{
+			Src:    t.Audio.Src,
+			Loop:   t.Audio.Loop,
+			Silent: t.Audio.Silent,
+		}
+	}
+
+	data, err := xml.Marshal(&root)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Converts absolute file paths to file URIs, leaving other URIs untouched.
+func _ImageUri(src string) string {
+	if src == "" || strings.Index(src, ":") > 1 { // already an URI; drive letters have a single char
+		return src
+	}
+
+	slashed := strings.ReplaceAll(src, `\`, "/")
+	if strings.HasPrefix(slashed, "//") { // UNC path
+		hostAndPath := strings.SplitN(slashed[2:], "/", 2)
+		u := url.URL{Scheme: "file", Host: hostAndPath[0]}
+		if len(hostAndPath) > 1 {
+			u.Path = "/" + hostAndPath[1]
+		}
+		return u.String()
+	}
+	return (&url.URL{Scheme: "file", Path: "/" + slashed}).String()
+}
+
+func _XmlTextFrom(text *Text) _XmlText {
+	return _XmlText{
+		Text:     text.Text,
+		Style:    text.Style,
+		Align:    text.Align,
+		Wrap:     text.Wrap,
+		MaxLines: text.MaxLines,
+		MinLines: text.MinLines,
+	}
+}
+
+func _XmlImageFrom(img *Image) _XmlImage {
+	return _XmlImage{
+		Src:          _ImageUri(img.Src),
+		Alt:          img.Alt,
+		Crop:         img.Crop,
+		RemoveMargin: img.RemoveMargin,
+	}
+}
+
+type _XmlToast struct {
+	XMLName          xml.Name     `xml:"toast"`
+	Launch           string       `xml:"launch,attr,omitempty"`
+	ActivationType   ACTIVATION   `xml:"activationType,attr,omitempty"`
+	Duration         DURATION     `xml:"duration,attr,omitempty"`
+	Scenario         SCENARIO     `xml:"scenario,attr,omitempty"`
+	DisplayTimestamp string       `xml:"displayTimestamp,attr,omitempty"`
+	UseButtonStyle   bool         `xml:"useButtonStyle,attr,omitempty"`
+	Binding          _XmlBinding  `xml:"visual>binding"`
+	Actions          *_XmlActions `xml:"actions"`
+	Audio            *_XmlAudio   `xml:"audio"`
+}
+
+type _XmlBinding struct {
+	Template string      `xml:"template,attr"`
+	Texts    []_XmlText  `xml:"text"`
+	Images   []_XmlImage `xml:"image"`
+	Groups   []_XmlGroup `xml:"group"`
+}
+
+type _XmlText struct {
+	Placement string     `xml:"placement,attr,omitempty"`
+	Style     TEXT_STYLE `xml:"hint-style,attr,omitempty"`
+	Align     TEXT_ALIGN `xml:"hint-align,attr,omitempty"`
+	Wrap      bool       `xml:"hint-wrap,attr,omitempty"`
+	MaxLines  int        `xml:"hint-maxLines,attr,omitempty"`
+	MinLines  int        `xml:"hint-minLines,attr,omitempty"`
+	Text      string     `xml:",chardata"`
+}
+
+type _XmlImage struct {
+	Placement    string     `xml:"placement,attr,omitempty"`
+	Src          string     `xml:"src,attr"`
+	Alt          string     `xml:"alt,attr,omitempty"`
+	Crop         IMAGE_CROP `xml:"hint-crop,attr,omitempty"`
+	RemoveMargin bool       `xml:"hint-removeMargin,attr,omitempty"`
+}
+
+type _XmlGroup struct {
+	Subgroups []_XmlSubgroup `xml:"subgroup"`
+}
+
+type _XmlSubgroup struct {
+	Weight       int           `xml:"hint-weight,attr,omitempty"`
+	TextStacking TEXT_STACKING `xml:"hint-textStacking,attr,omitempty"`
+	Texts        []_XmlText    `xml:"text"`
+	Images       []_XmlImage   `xml:"image"`
+}
+
+type _XmlActions struct {
+	Inputs  []_XmlInput  `xml:"input"`
+	Actions []_XmlAction `xml:"action"`
+}
+
+type _XmlInput struct {
+	Id           string          `xml:"id,attr"`
+	Type         INPUT_TYPE      `xml:"type,attr"`
+	Title        string          `xml:"title,attr,omitempty"`
+	PlaceHolder  string          `xml:"placeHolderContent,attr,omitempty"`
+	DefaultInput string          `xml:"defaultInput,attr,omitempty"`
+	Selections   []_XmlSelection `xml:"selection"`
+}
+
+type _XmlSelection struct {
+	Id      string `xml:"id,attr"`
+	Content string `xml:"content,attr"`
+}
+
+type _XmlAction struct {
+	Content        string       `xml:"content,attr"`
+	Arguments      string       `xml:"arguments,attr"`
+	ActivationType ACTIVATION   `xml:"activationType,attr,omitempty"`
+	ImageUri       string       `xml:"imageUri,attr,omitempty"`
+	InputId        string       `xml:"hint-inputId,attr,omitempty"`
+	Placement      string       `xml:"placement,attr,omitempty"`
+	ButtonStyle    BUTTON_STYLE `xml:"hint-buttonStyle,attr,omitempty"`
+}
+
+type _XmlAudio struct {
+	Src    string `xml:"src,attr,omitempty"`
+	Loop   bool   `xml:"loop,attr,omitempty"`
+	Silent bool   `xml:"silent,attr,omitempty"`
+}
@@ -66,11 +66,6 @@ func (atom ATOM) GlobalGetAtomName() string {
 // [handle]: https://docs.microsoft.com/en-us/windows/win32/winprog/windows-data-types#handle
 type HANDLE syscall.Handle
 
-// A handle to an [event].
-//
-// [event]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-createeventw
-type HEVENT HANDLE
-
 // A handle to a [resource].
 //
 // [resource]: https://learn.microsoft.com/en-us/windows/win32/api/libloaderapi/nf-libloaderapi-findresourcew